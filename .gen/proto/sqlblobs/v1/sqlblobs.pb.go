@@ -0,0 +1,13115 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/sqlblobs/v1/sqlblobs.proto
+
+package sqlblobsv1
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ShardInfo struct {
+	StolenSinceRenew                          int32                       `protobuf:"varint,1,opt,name=stolen_since_renew,json=stolenSinceRenew,proto3" json:"stolen_since_renew,omitempty"`
+	UpdatedAt                                 *types.Timestamp            `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReplicationAckLevel                       int64                       `protobuf:"varint,3,opt,name=replication_ack_level,json=replicationAckLevel,proto3" json:"replication_ack_level,omitempty"`
+	TransferAckLevel                          int64                       `protobuf:"varint,4,opt,name=transfer_ack_level,json=transferAckLevel,proto3" json:"transfer_ack_level,omitempty"`
+	TimerAckLevel                             *types.Timestamp            `protobuf:"bytes,5,opt,name=timer_ack_level,json=timerAckLevel,proto3" json:"timer_ack_level,omitempty"`
+	DomainNotificationVersion                 int64                       `protobuf:"varint,6,opt,name=domain_notification_version,json=domainNotificationVersion,proto3" json:"domain_notification_version,omitempty"`
+	ClusterTransferAckLevel                   map[string]int64            `protobuf:"bytes,7,rep,name=cluster_transfer_ack_level,json=clusterTransferAckLevel,proto3" json:"cluster_transfer_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ClusterTimerAckLevel                      map[string]*types.Timestamp `protobuf:"bytes,8,rep,name=cluster_timer_ack_level,json=clusterTimerAckLevel,proto3" json:"cluster_timer_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Owner                                     string                      `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	ClusterReplicationLevel                   map[string]int64            `protobuf:"bytes,10,rep,name=cluster_replication_level,json=clusterReplicationLevel,proto3" json:"cluster_replication_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PendingFailoverMarkers                    []byte                      `protobuf:"bytes,11,opt,name=pending_failover_markers,json=pendingFailoverMarkers,proto3" json:"pending_failover_markers,omitempty"`
+	PendingFailoverMarkersEncoding            string                      `protobuf:"bytes,12,opt,name=pending_failover_markers_encoding,json=pendingFailoverMarkersEncoding,proto3" json:"pending_failover_markers_encoding,omitempty"`
+	ReplicationDlqAckLevel                    map[string]int64            `protobuf:"bytes,13,rep,name=replication_dlq_ack_level,json=replicationDlqAckLevel,proto3" json:"replication_dlq_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TransferProcessingQueueStates             []byte                      `protobuf:"bytes,14,opt,name=transfer_processing_queue_states,json=transferProcessingQueueStates,proto3" json:"transfer_processing_queue_states,omitempty"`
+	TransferProcessingQueueStatesEncoding     string                      `protobuf:"bytes,15,opt,name=transfer_processing_queue_states_encoding,json=transferProcessingQueueStatesEncoding,proto3" json:"transfer_processing_queue_states_encoding,omitempty"`
+	TimerProcessingQueueStates                []byte                      `protobuf:"bytes,16,opt,name=timer_processing_queue_states,json=timerProcessingQueueStates,proto3" json:"timer_processing_queue_states,omitempty"`
+	TimerProcessingQueueStatesEncoding        string                      `protobuf:"bytes,17,opt,name=timer_processing_queue_states_encoding,json=timerProcessingQueueStatesEncoding,proto3" json:"timer_processing_queue_states_encoding,omitempty"`
+	CrossClusterProcessingQueueStates         []byte                      `protobuf:"bytes,18,opt,name=cross_cluster_processing_queue_states,json=crossClusterProcessingQueueStates,proto3" json:"cross_cluster_processing_queue_states,omitempty"`
+	CrossClusterProcessingQueueStatesEncoding string                      `protobuf:"bytes,19,opt,name=cross_cluster_processing_queue_states_encoding,json=crossClusterProcessingQueueStatesEncoding,proto3" json:"cross_cluster_processing_queue_states_encoding,omitempty"`
+	XXX_NoUnkeyedLiteral                      struct{}                    `json:"-"`
+	XXX_unrecognized                          []byte                      `json:"-"`
+	XXX_sizecache                             int32                       `json:"-"`
+}
+
+func (m *ShardInfo) Reset()         { *m = ShardInfo{} }
+func (m *ShardInfo) String() string { return proto.CompactTextString(m) }
+func (*ShardInfo) ProtoMessage()    {}
+func (*ShardInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{0}
+}
+func (m *ShardInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardInfo.Merge(m, src)
+}
+func (m *ShardInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardInfo proto.InternalMessageInfo
+
+func (m *ShardInfo) GetStolenSinceRenew() int32 {
+	if m != nil {
+		return m.StolenSinceRenew
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetUpdatedAt() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetReplicationAckLevel() int64 {
+	if m != nil {
+		return m.ReplicationAckLevel
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetTransferAckLevel() int64 {
+	if m != nil {
+		return m.TransferAckLevel
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetTimerAckLevel() *types.Timestamp {
+	if m != nil {
+		return m.TimerAckLevel
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetDomainNotificationVersion() int64 {
+	if m != nil {
+		return m.DomainNotificationVersion
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetClusterTransferAckLevel() map[string]int64 {
+	if m != nil {
+		return m.ClusterTransferAckLevel
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetClusterTimerAckLevel() map[string]*types.Timestamp {
+	if m != nil {
+		return m.ClusterTimerAckLevel
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ShardInfo) GetClusterReplicationLevel() map[string]int64 {
+	if m != nil {
+		return m.ClusterReplicationLevel
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetPendingFailoverMarkers() []byte {
+	if m != nil {
+		return m.PendingFailoverMarkers
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetPendingFailoverMarkersEncoding() string {
+	if m != nil {
+		return m.PendingFailoverMarkersEncoding
+	}
+	return ""
+}
+
+func (m *ShardInfo) GetReplicationDlqAckLevel() map[string]int64 {
+	if m != nil {
+		return m.ReplicationDlqAckLevel
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetTransferProcessingQueueStates() []byte {
+	if m != nil {
+		return m.TransferProcessingQueueStates
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetTransferProcessingQueueStatesEncoding() string {
+	if m != nil {
+		return m.TransferProcessingQueueStatesEncoding
+	}
+	return ""
+}
+
+func (m *ShardInfo) GetTimerProcessingQueueStates() []byte {
+	if m != nil {
+		return m.TimerProcessingQueueStates
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetTimerProcessingQueueStatesEncoding() string {
+	if m != nil {
+		return m.TimerProcessingQueueStatesEncoding
+	}
+	return ""
+}
+
+func (m *ShardInfo) GetCrossClusterProcessingQueueStates() []byte {
+	if m != nil {
+		return m.CrossClusterProcessingQueueStates
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetCrossClusterProcessingQueueStatesEncoding() string {
+	if m != nil {
+		return m.CrossClusterProcessingQueueStatesEncoding
+	}
+	return ""
+}
+
+type DomainInfo struct {
+	Name                        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description                 string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Owner                       string            `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status                      int32             `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Retention                   *types.Duration   `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	EmitMetric                  bool              `protobuf:"varint,6,opt,name=emit_metric,json=emitMetric,proto3" json:"emit_metric,omitempty"`
+	ArchivalBucket              string            `protobuf:"bytes,7,opt,name=archival_bucket,json=archivalBucket,proto3" json:"archival_bucket,omitempty"`
+	ArchivalStatus              int32             `protobuf:"varint,8,opt,name=archival_status,json=archivalStatus,proto3" json:"archival_status,omitempty"`
+	ConfigVersion               int64             `protobuf:"varint,9,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	NotificationVersion         int64             `protobuf:"varint,10,opt,name=notification_version,json=notificationVersion,proto3" json:"notification_version,omitempty"`
+	FailoverNotificationVersion int64             `protobuf:"varint,11,opt,name=failover_notification_version,json=failoverNotificationVersion,proto3" json:"failover_notification_version,omitempty"`
+	FailoverVersion             int64             `protobuf:"varint,12,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	ActiveClusterName           string            `protobuf:"bytes,13,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
+	Clusters                    []string          `protobuf:"bytes,14,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Data                        map[string]string `protobuf:"bytes,15,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BadBinaries                 []byte            `protobuf:"bytes,16,opt,name=bad_binaries,json=badBinaries,proto3" json:"bad_binaries,omitempty"`
+	BadBinariesEncoding         string            `protobuf:"bytes,17,opt,name=bad_binaries_encoding,json=badBinariesEncoding,proto3" json:"bad_binaries_encoding,omitempty"`
+	HistoryArchivalStatus       int32             `protobuf:"varint,18,opt,name=history_archival_status,json=historyArchivalStatus,proto3" json:"history_archival_status,omitempty"`
+	HistoryArchivalUri          string            `protobuf:"bytes,19,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
+	VisibilityArchivalStatus    int32             `protobuf:"varint,20,opt,name=visibility_archival_status,json=visibilityArchivalStatus,proto3" json:"visibility_archival_status,omitempty"`
+	VisibilityArchivalUri       string            `protobuf:"bytes,21,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+	FailoverEndTime             *types.Timestamp  `protobuf:"bytes,22,opt,name=failover_end_time,json=failoverEndTime,proto3" json:"failover_end_time,omitempty"`
+	PreviousFailoverVersion     int64             `protobuf:"varint,23,opt,name=previous_failover_version,json=previousFailoverVersion,proto3" json:"previous_failover_version,omitempty"`
+	LastUpdatedTime             *types.Timestamp  `protobuf:"bytes,24,opt,name=last_updated_time,json=lastUpdatedTime,proto3" json:"last_updated_time,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}          `json:"-"`
+	XXX_unrecognized            []byte            `json:"-"`
+	XXX_sizecache               int32             `json:"-"`
+}
+
+func (m *DomainInfo) Reset()         { *m = DomainInfo{} }
+func (m *DomainInfo) String() string { return proto.CompactTextString(m) }
+func (*DomainInfo) ProtoMessage()    {}
+func (*DomainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{1}
+}
+func (m *DomainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainInfo.Merge(m, src)
+}
+func (m *DomainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainInfo proto.InternalMessageInfo
+
+func (m *DomainInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetRetention() *types.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *DomainInfo) GetEmitMetric() bool {
+	if m != nil {
+		return m.EmitMetric
+	}
+	return false
+}
+
+func (m *DomainInfo) GetArchivalBucket() string {
+	if m != nil {
+		return m.ArchivalBucket
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetArchivalStatus() int32 {
+	if m != nil {
+		return m.ArchivalStatus
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetConfigVersion() int64 {
+	if m != nil {
+		return m.ConfigVersion
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetNotificationVersion() int64 {
+	if m != nil {
+		return m.NotificationVersion
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetFailoverNotificationVersion() int64 {
+	if m != nil {
+		return m.FailoverNotificationVersion
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetFailoverVersion() int64 {
+	if m != nil {
+		return m.FailoverVersion
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetActiveClusterName() string {
+	if m != nil {
+		return m.ActiveClusterName
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetClusters() []string {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *DomainInfo) GetData() map[string]string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DomainInfo) GetBadBinaries() []byte {
+	if m != nil {
+		return m.BadBinaries
+	}
+	return nil
+}
+
+func (m *DomainInfo) GetBadBinariesEncoding() string {
+	if m != nil {
+		return m.BadBinariesEncoding
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetHistoryArchivalStatus() int32 {
+	if m != nil {
+		return m.HistoryArchivalStatus
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetHistoryArchivalUri() string {
+	if m != nil {
+		return m.HistoryArchivalUri
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetVisibilityArchivalStatus() int32 {
+	if m != nil {
+		return m.VisibilityArchivalStatus
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetVisibilityArchivalUri() string {
+	if m != nil {
+		return m.VisibilityArchivalUri
+	}
+	return ""
+}
+
+func (m *DomainInfo) GetFailoverEndTime() *types.Timestamp {
+	if m != nil {
+		return m.FailoverEndTime
+	}
+	return nil
+}
+
+func (m *DomainInfo) GetPreviousFailoverVersion() int64 {
+	if m != nil {
+		return m.PreviousFailoverVersion
+	}
+	return 0
+}
+
+func (m *DomainInfo) GetLastUpdatedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastUpdatedTime
+	}
+	return nil
+}
+
+type HistoryBranchRange struct {
+	BranchId             string   `protobuf:"bytes,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	BeginNodeId          int64    `protobuf:"varint,2,opt,name=begin_node_id,json=beginNodeId,proto3" json:"begin_node_id,omitempty"`
+	EndNodeId            int64    `protobuf:"varint,3,opt,name=end_node_id,json=endNodeId,proto3" json:"end_node_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryBranchRange) Reset()         { *m = HistoryBranchRange{} }
+func (m *HistoryBranchRange) String() string { return proto.CompactTextString(m) }
+func (*HistoryBranchRange) ProtoMessage()    {}
+func (*HistoryBranchRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{2}
+}
+func (m *HistoryBranchRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryBranchRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryBranchRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryBranchRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryBranchRange.Merge(m, src)
+}
+func (m *HistoryBranchRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryBranchRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryBranchRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryBranchRange proto.InternalMessageInfo
+
+func (m *HistoryBranchRange) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *HistoryBranchRange) GetBeginNodeId() int64 {
+	if m != nil {
+		return m.BeginNodeId
+	}
+	return 0
+}
+
+func (m *HistoryBranchRange) GetEndNodeId() int64 {
+	if m != nil {
+		return m.EndNodeId
+	}
+	return 0
+}
+
+type HistoryTreeInfo struct {
+	CreatedTime          *types.Timestamp      `protobuf:"bytes,1,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	Ancestors            []*HistoryBranchRange `protobuf:"bytes,2,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	Info                 string                `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HistoryTreeInfo) Reset()         { *m = HistoryTreeInfo{} }
+func (m *HistoryTreeInfo) String() string { return proto.CompactTextString(m) }
+func (*HistoryTreeInfo) ProtoMessage()    {}
+func (*HistoryTreeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{3}
+}
+func (m *HistoryTreeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryTreeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryTreeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryTreeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryTreeInfo.Merge(m, src)
+}
+func (m *HistoryTreeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryTreeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryTreeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryTreeInfo proto.InternalMessageInfo
+
+func (m *HistoryTreeInfo) GetCreatedTime() *types.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *HistoryTreeInfo) GetAncestors() []*HistoryBranchRange {
+	if m != nil {
+		return m.Ancestors
+	}
+	return nil
+}
+
+func (m *HistoryTreeInfo) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+type WorkflowExecutionInfo struct {
+	ParentDomainId                     []byte            `protobuf:"bytes,1,opt,name=parent_domain_id,json=parentDomainId,proto3" json:"parent_domain_id,omitempty"`
+	ParentWorkflowId                   string            `protobuf:"bytes,2,opt,name=parent_workflow_id,json=parentWorkflowId,proto3" json:"parent_workflow_id,omitempty"`
+	ParentRunId                        []byte            `protobuf:"bytes,3,opt,name=parent_run_id,json=parentRunId,proto3" json:"parent_run_id,omitempty"`
+	InitiatedId                        int64             `protobuf:"varint,4,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
+	CompletionEventBatchId             *types.Int64Value `protobuf:"bytes,5,opt,name=completion_event_batch_id,json=completionEventBatchId,proto3" json:"completion_event_batch_id,omitempty"`
+	CompletionEvent                    []byte            `protobuf:"bytes,6,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
+	CompletionEventEncoding            string            `protobuf:"bytes,7,opt,name=completion_event_encoding,json=completionEventEncoding,proto3" json:"completion_event_encoding,omitempty"`
+	TaskList                           string            `protobuf:"bytes,8,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	WorkflowTypeName                   string            `protobuf:"bytes,9,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	WorkflowTimeout                    *types.Duration   `protobuf:"bytes,10,opt,name=workflow_timeout,json=workflowTimeout,proto3" json:"workflow_timeout,omitempty"`
+	DecisionTaskTimeout                *types.Duration   `protobuf:"bytes,11,opt,name=decision_task_timeout,json=decisionTaskTimeout,proto3" json:"decision_task_timeout,omitempty"`
+	ExecutionContext                   []byte            `protobuf:"bytes,12,opt,name=execution_context,json=executionContext,proto3" json:"execution_context,omitempty"`
+	State                              int32             `protobuf:"varint,13,opt,name=state,proto3" json:"state,omitempty"`
+	CloseStatus                        int32             `protobuf:"varint,14,opt,name=close_status,json=closeStatus,proto3" json:"close_status,omitempty"`
+	StartVersion                       int64             `protobuf:"varint,15,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
+	LastWriteEventId                   *types.Int64Value `protobuf:"bytes,16,opt,name=last_write_event_id,json=lastWriteEventId,proto3" json:"last_write_event_id,omitempty"`
+	LastEventTaskId                    int64             `protobuf:"varint,17,opt,name=last_event_task_id,json=lastEventTaskId,proto3" json:"last_event_task_id,omitempty"`
+	LastFirstEventId                   int64             `protobuf:"varint,18,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
+	LastProcessedEvent                 int64             `protobuf:"varint,19,opt,name=last_processed_event,json=lastProcessedEvent,proto3" json:"last_processed_event,omitempty"`
+	StartTime                          *types.Timestamp  `protobuf:"bytes,20,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	LastUpdatedTime                    *types.Timestamp  `protobuf:"bytes,21,opt,name=last_updated_time,json=lastUpdatedTime,proto3" json:"last_updated_time,omitempty"`
+	DecisionVersion                    int64             `protobuf:"varint,22,opt,name=decision_version,json=decisionVersion,proto3" json:"decision_version,omitempty"`
+	DecisionScheduleId                 int64             `protobuf:"varint,23,opt,name=decision_schedule_id,json=decisionScheduleId,proto3" json:"decision_schedule_id,omitempty"`
+	DecisionStartedId                  int64             `protobuf:"varint,24,opt,name=decision_started_id,json=decisionStartedId,proto3" json:"decision_started_id,omitempty"`
+	DecisionTimeout                    *types.Duration   `protobuf:"bytes,25,opt,name=decision_timeout,json=decisionTimeout,proto3" json:"decision_timeout,omitempty"`
+	DecisionAttempt                    int64             `protobuf:"varint,26,opt,name=decision_attempt,json=decisionAttempt,proto3" json:"decision_attempt,omitempty"`
+	DecisionStartedTimestamp           *types.Timestamp  `protobuf:"bytes,27,opt,name=decision_started_timestamp,json=decisionStartedTimestamp,proto3" json:"decision_started_timestamp,omitempty"`
+	DecisionScheduledTimestamp         *types.Timestamp  `protobuf:"bytes,28,opt,name=decision_scheduled_timestamp,json=decisionScheduledTimestamp,proto3" json:"decision_scheduled_timestamp,omitempty"`
+	CancelRequested                    bool              `protobuf:"varint,29,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	DecisionOriginalScheduledTimestamp *types.Timestamp  `protobuf:"bytes,30,opt,name=decision_original_scheduled_timestamp,json=decisionOriginalScheduledTimestamp,proto3" json:"decision_original_scheduled_timestamp,omitempty"`
+	CreateRequestId                    string            `protobuf:"bytes,31,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
+	DecisionRequestId                  string            `protobuf:"bytes,32,opt,name=decision_request_id,json=decisionRequestId,proto3" json:"decision_request_id,omitempty"`
+	CancelRequestId                    string            `protobuf:"bytes,33,opt,name=cancel_request_id,json=cancelRequestId,proto3" json:"cancel_request_id,omitempty"`
+	StickyTaskList                     string            `protobuf:"bytes,34,opt,name=sticky_task_list,json=stickyTaskList,proto3" json:"sticky_task_list,omitempty"`
+	StickyScheduleToStartTimeout       *types.Duration   `protobuf:"bytes,35,opt,name=sticky_schedule_to_start_timeout,json=stickyScheduleToStartTimeout,proto3" json:"sticky_schedule_to_start_timeout,omitempty"`
+	RetryAttempt                       int64             `protobuf:"varint,36,opt,name=retry_attempt,json=retryAttempt,proto3" json:"retry_attempt,omitempty"`
+	RetryInitialInterval               *types.Duration   `protobuf:"bytes,37,opt,name=retry_initial_interval,json=retryInitialInterval,proto3" json:"retry_initial_interval,omitempty"`
+	RetryMaximumInterval               *types.Duration   `protobuf:"bytes,38,opt,name=retry_maximum_interval,json=retryMaximumInterval,proto3" json:"retry_maximum_interval,omitempty"`
+	RetryMaximumAttempts               int32             `protobuf:"varint,39,opt,name=retry_maximum_attempts,json=retryMaximumAttempts,proto3" json:"retry_maximum_attempts,omitempty"`
+	RetryExpiration                    *types.Duration   `protobuf:"bytes,40,opt,name=retry_expiration,json=retryExpiration,proto3" json:"retry_expiration,omitempty"`
+	RetryBackoffCoefficient            float64           `protobuf:"fixed64,41,opt,name=retry_backoff_coefficient,json=retryBackoffCoefficient,proto3" json:"retry_backoff_coefficient,omitempty"`
+	RetryExpirationTime                *types.Timestamp  `protobuf:"bytes,42,opt,name=retry_expiration_time,json=retryExpirationTime,proto3" json:"retry_expiration_time,omitempty"`
+	RetryNonRetryableErrors            []string          `protobuf:"bytes,43,rep,name=retry_non_retryable_errors,json=retryNonRetryableErrors,proto3" json:"retry_non_retryable_errors,omitempty"`
+	HasRetryPolicy                     bool              `protobuf:"varint,44,opt,name=has_retry_policy,json=hasRetryPolicy,proto3" json:"has_retry_policy,omitempty"`
+	CronSchedule                       string            `protobuf:"bytes,45,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	EventStoreVersion                  int32             `protobuf:"varint,46,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
+	EventBranchToken                   []byte            `protobuf:"bytes,47,opt,name=event_branch_token,json=eventBranchToken,proto3" json:"event_branch_token,omitempty"`
+	SignalCount                        int64             `protobuf:"varint,48,opt,name=signal_count,json=signalCount,proto3" json:"signal_count,omitempty"`
+	HistorySize                        int64             `protobuf:"varint,49,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	ClientLibraryVersion               string            `protobuf:"bytes,50,opt,name=client_library_version,json=clientLibraryVersion,proto3" json:"client_library_version,omitempty"`
+	ClientFeatureVersion               string            `protobuf:"bytes,51,opt,name=client_feature_version,json=clientFeatureVersion,proto3" json:"client_feature_version,omitempty"`
+	ClientImpl                         string            `protobuf:"bytes,52,opt,name=client_impl,json=clientImpl,proto3" json:"client_impl,omitempty"`
+	AutoResetPoints                    []byte            `protobuf:"bytes,53,opt,name=auto_reset_points,json=autoResetPoints,proto3" json:"auto_reset_points,omitempty"`
+	AutoResetPointsEncoding            string            `protobuf:"bytes,54,opt,name=auto_reset_points_encoding,json=autoResetPointsEncoding,proto3" json:"auto_reset_points_encoding,omitempty"`
+	SearchAttributes                   map[string][]byte `protobuf:"bytes,55,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Memo                               map[string][]byte `protobuf:"bytes,56,rep,name=memo,proto3" json:"memo,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	VersionHistories                   []byte            `protobuf:"bytes,57,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	VersionHistoriesEncoding           string            `protobuf:"bytes,58,opt,name=version_histories_encoding,json=versionHistoriesEncoding,proto3" json:"version_histories_encoding,omitempty"`
+	XXX_NoUnkeyedLiteral               struct{}          `json:"-"`
+	XXX_unrecognized                   []byte            `json:"-"`
+	XXX_sizecache                      int32             `json:"-"`
+}
+
+func (m *WorkflowExecutionInfo) Reset()         { *m = WorkflowExecutionInfo{} }
+func (m *WorkflowExecutionInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionInfo) ProtoMessage()    {}
+func (*WorkflowExecutionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{4}
+}
+func (m *WorkflowExecutionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionInfo.Merge(m, src)
+}
+func (m *WorkflowExecutionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionInfo proto.InternalMessageInfo
+
+func (m *WorkflowExecutionInfo) GetParentDomainId() []byte {
+	if m != nil {
+		return m.ParentDomainId
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetParentWorkflowId() string {
+	if m != nil {
+		return m.ParentWorkflowId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetParentRunId() []byte {
+	if m != nil {
+		return m.ParentRunId
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetInitiatedId() int64 {
+	if m != nil {
+		return m.InitiatedId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetCompletionEventBatchId() *types.Int64Value {
+	if m != nil {
+		return m.CompletionEventBatchId
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetCompletionEvent() []byte {
+	if m != nil {
+		return m.CompletionEvent
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetCompletionEventEncoding() string {
+	if m != nil {
+		return m.CompletionEventEncoding
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetTaskList() string {
+	if m != nil {
+		return m.TaskList
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetWorkflowTimeout() *types.Duration {
+	if m != nil {
+		return m.WorkflowTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionTaskTimeout() *types.Duration {
+	if m != nil {
+		return m.DecisionTaskTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetExecutionContext() []byte {
+	if m != nil {
+		return m.ExecutionContext
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetCloseStatus() int32 {
+	if m != nil {
+		return m.CloseStatus
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetStartVersion() int64 {
+	if m != nil {
+		return m.StartVersion
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetLastWriteEventId() *types.Int64Value {
+	if m != nil {
+		return m.LastWriteEventId
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetLastEventTaskId() int64 {
+	if m != nil {
+		return m.LastEventTaskId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetLastFirstEventId() int64 {
+	if m != nil {
+		return m.LastFirstEventId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetLastProcessedEvent() int64 {
+	if m != nil {
+		return m.LastProcessedEvent
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetLastUpdatedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastUpdatedTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionVersion() int64 {
+	if m != nil {
+		return m.DecisionVersion
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionScheduleId() int64 {
+	if m != nil {
+		return m.DecisionScheduleId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionStartedId() int64 {
+	if m != nil {
+		return m.DecisionStartedId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionTimeout() *types.Duration {
+	if m != nil {
+		return m.DecisionTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionAttempt() int64 {
+	if m != nil {
+		return m.DecisionAttempt
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionStartedTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.DecisionStartedTimestamp
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionScheduledTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.DecisionScheduledTimestamp
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetCancelRequested() bool {
+	if m != nil {
+		return m.CancelRequested
+	}
+	return false
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionOriginalScheduledTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.DecisionOriginalScheduledTimestamp
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetCreateRequestId() string {
+	if m != nil {
+		return m.CreateRequestId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetDecisionRequestId() string {
+	if m != nil {
+		return m.DecisionRequestId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetCancelRequestId() string {
+	if m != nil {
+		return m.CancelRequestId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetStickyTaskList() string {
+	if m != nil {
+		return m.StickyTaskList
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetStickyScheduleToStartTimeout() *types.Duration {
+	if m != nil {
+		return m.StickyScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetRetryAttempt() int64 {
+	if m != nil {
+		return m.RetryAttempt
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetRetryInitialInterval() *types.Duration {
+	if m != nil {
+		return m.RetryInitialInterval
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetRetryMaximumInterval() *types.Duration {
+	if m != nil {
+		return m.RetryMaximumInterval
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetRetryMaximumAttempts() int32 {
+	if m != nil {
+		return m.RetryMaximumAttempts
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetRetryExpiration() *types.Duration {
+	if m != nil {
+		return m.RetryExpiration
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetRetryBackoffCoefficient() float64 {
+	if m != nil {
+		return m.RetryBackoffCoefficient
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetRetryExpirationTime() *types.Timestamp {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetRetryNonRetryableErrors() []string {
+	if m != nil {
+		return m.RetryNonRetryableErrors
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetHasRetryPolicy() bool {
+	if m != nil {
+		return m.HasRetryPolicy
+	}
+	return false
+}
+
+func (m *WorkflowExecutionInfo) GetCronSchedule() string {
+	if m != nil {
+		return m.CronSchedule
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetEventStoreVersion() int32 {
+	if m != nil {
+		return m.EventStoreVersion
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetEventBranchToken() []byte {
+	if m != nil {
+		return m.EventBranchToken
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetSignalCount() int64 {
+	if m != nil {
+		return m.SignalCount
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetHistorySize() int64 {
+	if m != nil {
+		return m.HistorySize
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetClientLibraryVersion() string {
+	if m != nil {
+		return m.ClientLibraryVersion
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetClientFeatureVersion() string {
+	if m != nil {
+		return m.ClientFeatureVersion
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetClientImpl() string {
+	if m != nil {
+		return m.ClientImpl
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetAutoResetPoints() []byte {
+	if m != nil {
+		return m.AutoResetPoints
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetAutoResetPointsEncoding() string {
+	if m != nil {
+		return m.AutoResetPointsEncoding
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetSearchAttributes() map[string][]byte {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetMemo() map[string][]byte {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetVersionHistories() []byte {
+	if m != nil {
+		return m.VersionHistories
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetVersionHistoriesEncoding() string {
+	if m != nil {
+		return m.VersionHistoriesEncoding
+	}
+	return ""
+}
+
+type ActivityInfo struct {
+	Version                 int64            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ScheduledEventBatchId   int64            `protobuf:"varint,2,opt,name=scheduled_event_batch_id,json=scheduledEventBatchId,proto3" json:"scheduled_event_batch_id,omitempty"`
+	ScheduledEvent          []byte           `protobuf:"bytes,3,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
+	ScheduledEventEncoding  string           `protobuf:"bytes,4,opt,name=scheduled_event_encoding,json=scheduledEventEncoding,proto3" json:"scheduled_event_encoding,omitempty"`
+	ScheduledTime           *types.Timestamp `protobuf:"bytes,5,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	StartedId               int64            `protobuf:"varint,6,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	StartedEvent            []byte           `protobuf:"bytes,7,opt,name=started_event,json=startedEvent,proto3" json:"started_event,omitempty"`
+	StartedEventEncoding    string           `protobuf:"bytes,8,opt,name=started_event_encoding,json=startedEventEncoding,proto3" json:"started_event_encoding,omitempty"`
+	StartedTime             *types.Timestamp `protobuf:"bytes,9,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
+	ActivityId              string           `protobuf:"bytes,10,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	RequestId               string           `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ScheduleToStartTimeout  *types.Duration  `protobuf:"bytes,12,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	ScheduleToCloseTimeout  *types.Duration  `protobuf:"bytes,13,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	StartToCloseTimeout     *types.Duration  `protobuf:"bytes,14,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout        *types.Duration  `protobuf:"bytes,15,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3" json:"heartbeat_timeout,omitempty"`
+	CancelRequested         bool             `protobuf:"varint,16,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	CancelRequestId         int64            `protobuf:"varint,17,opt,name=cancel_request_id,json=cancelRequestId,proto3" json:"cancel_request_id,omitempty"`
+	TimerTaskStatus         int32            `protobuf:"varint,18,opt,name=timer_task_status,json=timerTaskStatus,proto3" json:"timer_task_status,omitempty"`
+	Attempt                 int32            `protobuf:"varint,19,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	TaskList                string           `protobuf:"bytes,20,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	StartedIdentity         string           `protobuf:"bytes,21,opt,name=started_identity,json=startedIdentity,proto3" json:"started_identity,omitempty"`
+	HasRetryPolicy          bool             `protobuf:"varint,22,opt,name=has_retry_policy,json=hasRetryPolicy,proto3" json:"has_retry_policy,omitempty"`
+	RetryInitialInterval    *types.Duration  `protobuf:"bytes,23,opt,name=retry_initial_interval,json=retryInitialInterval,proto3" json:"retry_initial_interval,omitempty"`
+	RetryMaximumInterval    *types.Duration  `protobuf:"bytes,24,opt,name=retry_maximum_interval,json=retryMaximumInterval,proto3" json:"retry_maximum_interval,omitempty"`
+	RetryMaximumAttempts    int32            `protobuf:"varint,25,opt,name=retry_maximum_attempts,json=retryMaximumAttempts,proto3" json:"retry_maximum_attempts,omitempty"`
+	RetryExpirationTime     *types.Timestamp `protobuf:"bytes,26,opt,name=retry_expiration_time,json=retryExpirationTime,proto3" json:"retry_expiration_time,omitempty"`
+	RetryBackoffCoefficient float64          `protobuf:"fixed64,27,opt,name=retry_backoff_coefficient,json=retryBackoffCoefficient,proto3" json:"retry_backoff_coefficient,omitempty"`
+	RetryNonRetryableErrors []string         `protobuf:"bytes,28,rep,name=retry_non_retryable_errors,json=retryNonRetryableErrors,proto3" json:"retry_non_retryable_errors,omitempty"`
+	RetryLastFailureReason  string           `protobuf:"bytes,29,opt,name=retry_last_failure_reason,json=retryLastFailureReason,proto3" json:"retry_last_failure_reason,omitempty"`
+	RetryLastWorkerIdentity string           `protobuf:"bytes,30,opt,name=retry_last_worker_identity,json=retryLastWorkerIdentity,proto3" json:"retry_last_worker_identity,omitempty"`
+	RetryLastFailureDetails []byte           `protobuf:"bytes,31,opt,name=retry_last_failure_details,json=retryLastFailureDetails,proto3" json:"retry_last_failure_details,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}         `json:"-"`
+	XXX_unrecognized        []byte           `json:"-"`
+	XXX_sizecache           int32            `json:"-"`
+}
+
+func (m *ActivityInfo) Reset()         { *m = ActivityInfo{} }
+func (m *ActivityInfo) String() string { return proto.CompactTextString(m) }
+func (*ActivityInfo) ProtoMessage()    {}
+func (*ActivityInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{5}
+}
+func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityInfo.Merge(m, src)
+}
+func (m *ActivityInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityInfo proto.InternalMessageInfo
+
+func (m *ActivityInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetScheduledEventBatchId() int64 {
+	if m != nil {
+		return m.ScheduledEventBatchId
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetScheduledEvent() []byte {
+	if m != nil {
+		return m.ScheduledEvent
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetScheduledEventEncoding() string {
+	if m != nil {
+		return m.ScheduledEventEncoding
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetScheduledTime() *types.Timestamp {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetStartedEvent() []byte {
+	if m != nil {
+		return m.StartedEvent
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetStartedEventEncoding() string {
+	if m != nil {
+		return m.StartedEventEncoding
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetStartedTime() *types.Timestamp {
+	if m != nil {
+		return m.StartedTime
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetScheduleToStartTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetScheduleToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetHeartbeatTimeout() *types.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetCancelRequested() bool {
+	if m != nil {
+		return m.CancelRequested
+	}
+	return false
+}
+
+func (m *ActivityInfo) GetCancelRequestId() int64 {
+	if m != nil {
+		return m.CancelRequestId
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetTimerTaskStatus() int32 {
+	if m != nil {
+		return m.TimerTaskStatus
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetTaskList() string {
+	if m != nil {
+		return m.TaskList
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetStartedIdentity() string {
+	if m != nil {
+		return m.StartedIdentity
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetHasRetryPolicy() bool {
+	if m != nil {
+		return m.HasRetryPolicy
+	}
+	return false
+}
+
+func (m *ActivityInfo) GetRetryInitialInterval() *types.Duration {
+	if m != nil {
+		return m.RetryInitialInterval
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetRetryMaximumInterval() *types.Duration {
+	if m != nil {
+		return m.RetryMaximumInterval
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetRetryMaximumAttempts() int32 {
+	if m != nil {
+		return m.RetryMaximumAttempts
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetRetryExpirationTime() *types.Timestamp {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetRetryBackoffCoefficient() float64 {
+	if m != nil {
+		return m.RetryBackoffCoefficient
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetRetryNonRetryableErrors() []string {
+	if m != nil {
+		return m.RetryNonRetryableErrors
+	}
+	return nil
+}
+
+func (m *ActivityInfo) GetRetryLastFailureReason() string {
+	if m != nil {
+		return m.RetryLastFailureReason
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetRetryLastWorkerIdentity() string {
+	if m != nil {
+		return m.RetryLastWorkerIdentity
+	}
+	return ""
+}
+
+func (m *ActivityInfo) GetRetryLastFailureDetails() []byte {
+	if m != nil {
+		return m.RetryLastFailureDetails
+	}
+	return nil
+}
+
+type ChildExecutionInfo struct {
+	Version                int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InitiatedEventBatchId  int64    `protobuf:"varint,2,opt,name=initiated_event_batch_id,json=initiatedEventBatchId,proto3" json:"initiated_event_batch_id,omitempty"`
+	StartedId              int64    `protobuf:"varint,3,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	InitiatedEvent         []byte   `protobuf:"bytes,4,opt,name=initiated_event,json=initiatedEvent,proto3" json:"initiated_event,omitempty"`
+	InitiatedEventEncoding string   `protobuf:"bytes,5,opt,name=initiated_event_encoding,json=initiatedEventEncoding,proto3" json:"initiated_event_encoding,omitempty"`
+	StartedWorkflowId      string   `protobuf:"bytes,6,opt,name=started_workflow_id,json=startedWorkflowId,proto3" json:"started_workflow_id,omitempty"`
+	StartedRunId           []byte   `protobuf:"bytes,7,opt,name=started_run_id,json=startedRunId,proto3" json:"started_run_id,omitempty"`
+	StartedEvent           []byte   `protobuf:"bytes,8,opt,name=started_event,json=startedEvent,proto3" json:"started_event,omitempty"`
+	StartedEventEncoding   string   `protobuf:"bytes,9,opt,name=started_event_encoding,json=startedEventEncoding,proto3" json:"started_event_encoding,omitempty"`
+	CreateRequestId        string   `protobuf:"bytes,10,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
+	DomainId               string   `protobuf:"bytes,11,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	DomainName             string   `protobuf:"bytes,12,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	WorkflowTypeName       string   `protobuf:"bytes,13,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	ParentClosePolicy      int32    `protobuf:"varint,14,opt,name=parent_close_policy,json=parentClosePolicy,proto3" json:"parent_close_policy,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *ChildExecutionInfo) Reset()         { *m = ChildExecutionInfo{} }
+func (m *ChildExecutionInfo) String() string { return proto.CompactTextString(m) }
+func (*ChildExecutionInfo) ProtoMessage()    {}
+func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{6}
+}
+func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildExecutionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildExecutionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildExecutionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildExecutionInfo.Merge(m, src)
+}
+func (m *ChildExecutionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildExecutionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildExecutionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildExecutionInfo proto.InternalMessageInfo
+
+func (m *ChildExecutionInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChildExecutionInfo) GetInitiatedEventBatchId() int64 {
+	if m != nil {
+		return m.InitiatedEventBatchId
+	}
+	return 0
+}
+
+func (m *ChildExecutionInfo) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
+}
+
+func (m *ChildExecutionInfo) GetInitiatedEvent() []byte {
+	if m != nil {
+		return m.InitiatedEvent
+	}
+	return nil
+}
+
+func (m *ChildExecutionInfo) GetInitiatedEventEncoding() string {
+	if m != nil {
+		return m.InitiatedEventEncoding
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetStartedWorkflowId() string {
+	if m != nil {
+		return m.StartedWorkflowId
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetStartedRunId() []byte {
+	if m != nil {
+		return m.StartedRunId
+	}
+	return nil
+}
+
+func (m *ChildExecutionInfo) GetStartedEvent() []byte {
+	if m != nil {
+		return m.StartedEvent
+	}
+	return nil
+}
+
+func (m *ChildExecutionInfo) GetStartedEventEncoding() string {
+	if m != nil {
+		return m.StartedEventEncoding
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetCreateRequestId() string {
+	if m != nil {
+		return m.CreateRequestId
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *ChildExecutionInfo) GetParentClosePolicy() int32 {
+	if m != nil {
+		return m.ParentClosePolicy
+	}
+	return 0
+}
+
+type SignalInfo struct {
+	Version               int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InitiatedEventBatchId int64    `protobuf:"varint,2,opt,name=initiated_event_batch_id,json=initiatedEventBatchId,proto3" json:"initiated_event_batch_id,omitempty"`
+	RequestId             string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Name                  string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Input                 []byte   `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	Control               []byte   `protobuf:"bytes,6,opt,name=control,proto3" json:"control,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *SignalInfo) Reset()         { *m = SignalInfo{} }
+func (m *SignalInfo) String() string { return proto.CompactTextString(m) }
+func (*SignalInfo) ProtoMessage()    {}
+func (*SignalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{7}
+}
+func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalInfo.Merge(m, src)
+}
+func (m *SignalInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalInfo proto.InternalMessageInfo
+
+func (m *SignalInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SignalInfo) GetInitiatedEventBatchId() int64 {
+	if m != nil {
+		return m.InitiatedEventBatchId
+	}
+	return 0
+}
+
+func (m *SignalInfo) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *SignalInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SignalInfo) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *SignalInfo) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type RequestCancelInfo struct {
+	Version               int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InitiatedEventBatchId int64    `protobuf:"varint,2,opt,name=initiated_event_batch_id,json=initiatedEventBatchId,proto3" json:"initiated_event_batch_id,omitempty"`
+	CancelRequestId       string   `protobuf:"bytes,3,opt,name=cancel_request_id,json=cancelRequestId,proto3" json:"cancel_request_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *RequestCancelInfo) Reset()         { *m = RequestCancelInfo{} }
+func (m *RequestCancelInfo) String() string { return proto.CompactTextString(m) }
+func (*RequestCancelInfo) ProtoMessage()    {}
+func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{8}
+}
+func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCancelInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCancelInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCancelInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelInfo.Merge(m, src)
+}
+func (m *RequestCancelInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCancelInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelInfo proto.InternalMessageInfo
+
+func (m *RequestCancelInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RequestCancelInfo) GetInitiatedEventBatchId() int64 {
+	if m != nil {
+		return m.InitiatedEventBatchId
+	}
+	return 0
+}
+
+func (m *RequestCancelInfo) GetCancelRequestId() string {
+	if m != nil {
+		return m.CancelRequestId
+	}
+	return ""
+}
+
+type TimerInfo struct {
+	Version              int64            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	StartedId            int64            `protobuf:"varint,2,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	ExpiryTime           *types.Timestamp `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	TaskId               int64            `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TimerInfo) Reset()         { *m = TimerInfo{} }
+func (m *TimerInfo) String() string { return proto.CompactTextString(m) }
+func (*TimerInfo) ProtoMessage()    {}
+func (*TimerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{9}
+}
+func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerInfo.Merge(m, src)
+}
+func (m *TimerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimerInfo proto.InternalMessageInfo
+
+func (m *TimerInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TimerInfo) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
+}
+
+func (m *TimerInfo) GetExpiryTime() *types.Timestamp {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *TimerInfo) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type TaskInfo struct {
+	WorkflowId           string           `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId                []byte           `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ScheduleId           int64            `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	ExpiryTime           *types.Timestamp `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	CreatedTime          *types.Timestamp `protobuf:"bytes,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TaskInfo) Reset()         { *m = TaskInfo{} }
+func (m *TaskInfo) String() string { return proto.CompactTextString(m) }
+func (*TaskInfo) ProtoMessage()    {}
+func (*TaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{10}
+}
+func (m *TaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskInfo.Merge(m, src)
+}
+func (m *TaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskInfo proto.InternalMessageInfo
+
+func (m *TaskInfo) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetRunId() []byte {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *TaskInfo) GetExpiryTime() *types.Timestamp {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetCreatedTime() *types.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+type TaskListInfo struct {
+	Kind                 int32            `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	AckLevel             int64            `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	ExpiryTime           *types.Timestamp `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	LastUpdated          *types.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TaskListInfo) Reset()         { *m = TaskListInfo{} }
+func (m *TaskListInfo) String() string { return proto.CompactTextString(m) }
+func (*TaskListInfo) ProtoMessage()    {}
+func (*TaskListInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{11}
+}
+func (m *TaskListInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListInfo.Merge(m, src)
+}
+func (m *TaskListInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListInfo proto.InternalMessageInfo
+
+func (m *TaskListInfo) GetKind() int32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
+func (m *TaskListInfo) GetAckLevel() int64 {
+	if m != nil {
+		return m.AckLevel
+	}
+	return 0
+}
+
+func (m *TaskListInfo) GetExpiryTime() *types.Timestamp {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *TaskListInfo) GetLastUpdated() *types.Timestamp {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return nil
+}
+
+type TransferTaskInfo struct {
+	DomainId                []byte           `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowId              string           `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId                   []byte           `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskType                int32            `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	TargetDomainId          []byte           `protobuf:"bytes,5,opt,name=target_domain_id,json=targetDomainId,proto3" json:"target_domain_id,omitempty"`
+	TargetWorkflowId        string           `protobuf:"bytes,6,opt,name=target_workflow_id,json=targetWorkflowId,proto3" json:"target_workflow_id,omitempty"`
+	TargetRunId             []byte           `protobuf:"bytes,7,opt,name=target_run_id,json=targetRunId,proto3" json:"target_run_id,omitempty"`
+	TaskList                string           `protobuf:"bytes,8,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TargetChildWorkflowOnly bool             `protobuf:"varint,9,opt,name=target_child_workflow_only,json=targetChildWorkflowOnly,proto3" json:"target_child_workflow_only,omitempty"`
+	ScheduleId              int64            `protobuf:"varint,10,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Version                 int64            `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	VisibilityTimestamp     *types.Timestamp `protobuf:"bytes,12,opt,name=visibility_timestamp,json=visibilityTimestamp,proto3" json:"visibility_timestamp,omitempty"`
+	TargetDomainIds         [][]byte         `protobuf:"bytes,13,rep,name=target_domain_ids,json=targetDomainIds,proto3" json:"target_domain_ids,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}         `json:"-"`
+	XXX_unrecognized        []byte           `json:"-"`
+	XXX_sizecache           int32            `json:"-"`
+}
+
+func (m *TransferTaskInfo) Reset()         { *m = TransferTaskInfo{} }
+func (m *TransferTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*TransferTaskInfo) ProtoMessage()    {}
+func (*TransferTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{12}
+}
+func (m *TransferTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTaskInfo.Merge(m, src)
+}
+func (m *TransferTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTaskInfo proto.InternalMessageInfo
+
+func (m *TransferTaskInfo) GetDomainId() []byte {
+	if m != nil {
+		return m.DomainId
+	}
+	return nil
+}
+
+func (m *TransferTaskInfo) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *TransferTaskInfo) GetRunId() []byte {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *TransferTaskInfo) GetTaskType() int32 {
+	if m != nil {
+		return m.TaskType
+	}
+	return 0
+}
+
+func (m *TransferTaskInfo) GetTargetDomainId() []byte {
+	if m != nil {
+		return m.TargetDomainId
+	}
+	return nil
+}
+
+func (m *TransferTaskInfo) GetTargetWorkflowId() string {
+	if m != nil {
+		return m.TargetWorkflowId
+	}
+	return ""
+}
+
+func (m *TransferTaskInfo) GetTargetRunId() []byte {
+	if m != nil {
+		return m.TargetRunId
+	}
+	return nil
+}
+
+func (m *TransferTaskInfo) GetTaskList() string {
+	if m != nil {
+		return m.TaskList
+	}
+	return ""
+}
+
+func (m *TransferTaskInfo) GetTargetChildWorkflowOnly() bool {
+	if m != nil {
+		return m.TargetChildWorkflowOnly
+	}
+	return false
+}
+
+func (m *TransferTaskInfo) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *TransferTaskInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TransferTaskInfo) GetVisibilityTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.VisibilityTimestamp
+	}
+	return nil
+}
+
+func (m *TransferTaskInfo) GetTargetDomainIds() [][]byte {
+	if m != nil {
+		return m.TargetDomainIds
+	}
+	return nil
+}
+
+type TimerTaskInfo struct {
+	DomainId             []byte            `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowId           string            `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId                []byte            `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskType             int32             `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	TimeoutType          *types.Int32Value `protobuf:"bytes,5,opt,name=timeout_type,json=timeoutType,proto3" json:"timeout_type,omitempty"`
+	Version              int64             `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	ScheduleAttempt      int64             `protobuf:"varint,7,opt,name=schedule_attempt,json=scheduleAttempt,proto3" json:"schedule_attempt,omitempty"`
+	EventId              int64             `protobuf:"varint,8,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TimerTaskInfo) Reset()         { *m = TimerTaskInfo{} }
+func (m *TimerTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*TimerTaskInfo) ProtoMessage()    {}
+func (*TimerTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{13}
+}
+func (m *TimerTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimerTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimerTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimerTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerTaskInfo.Merge(m, src)
+}
+func (m *TimerTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimerTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimerTaskInfo proto.InternalMessageInfo
+
+func (m *TimerTaskInfo) GetDomainId() []byte {
+	if m != nil {
+		return m.DomainId
+	}
+	return nil
+}
+
+func (m *TimerTaskInfo) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *TimerTaskInfo) GetRunId() []byte {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *TimerTaskInfo) GetTaskType() int32 {
+	if m != nil {
+		return m.TaskType
+	}
+	return 0
+}
+
+func (m *TimerTaskInfo) GetTimeoutType() *types.Int32Value {
+	if m != nil {
+		return m.TimeoutType
+	}
+	return nil
+}
+
+func (m *TimerTaskInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TimerTaskInfo) GetScheduleAttempt() int64 {
+	if m != nil {
+		return m.ScheduleAttempt
+	}
+	return 0
+}
+
+func (m *TimerTaskInfo) GetEventId() int64 {
+	if m != nil {
+		return m.EventId
+	}
+	return 0
+}
+
+type ReplicationTaskInfo struct {
+	DomainId                []byte           `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowId              string           `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId                   []byte           `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskType                int32            `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	Version                 int64            `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	FirstEventId            int64            `protobuf:"varint,6,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
+	NextEventId             int64            `protobuf:"varint,7,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	ScheduledId             int64            `protobuf:"varint,8,opt,name=scheduled_id,json=scheduledId,proto3" json:"scheduled_id,omitempty"`
+	EventStoreVersion       int32            `protobuf:"varint,9,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
+	NewRunEventStoreVersion int32            `protobuf:"varint,10,opt,name=new_run_event_store_version,json=newRunEventStoreVersion,proto3" json:"new_run_event_store_version,omitempty"`
+	BranchToken             []byte           `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+	NewRunBranchToken       []byte           `protobuf:"bytes,12,opt,name=new_run_branch_token,json=newRunBranchToken,proto3" json:"new_run_branch_token,omitempty"`
+	CreationTime            *types.Timestamp `protobuf:"bytes,13,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}         `json:"-"`
+	XXX_unrecognized        []byte           `json:"-"`
+	XXX_sizecache           int32            `json:"-"`
+}
+
+func (m *ReplicationTaskInfo) Reset()         { *m = ReplicationTaskInfo{} }
+func (m *ReplicationTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicationTaskInfo) ProtoMessage()    {}
+func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bf8356e38665f8b, []int{14}
+}
+func (m *ReplicationTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationTaskInfo.Merge(m, src)
+}
+func (m *ReplicationTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationTaskInfo proto.InternalMessageInfo
+
+func (m *ReplicationTaskInfo) GetDomainId() []byte {
+	if m != nil {
+		return m.DomainId
+	}
+	return nil
+}
+
+func (m *ReplicationTaskInfo) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *ReplicationTaskInfo) GetRunId() []byte {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *ReplicationTaskInfo) GetTaskType() int32 {
+	if m != nil {
+		return m.TaskType
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetFirstEventId() int64 {
+	if m != nil {
+		return m.FirstEventId
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetScheduledId() int64 {
+	if m != nil {
+		return m.ScheduledId
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetEventStoreVersion() int32 {
+	if m != nil {
+		return m.EventStoreVersion
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetNewRunEventStoreVersion() int32 {
+	if m != nil {
+		return m.NewRunEventStoreVersion
+	}
+	return 0
+}
+
+func (m *ReplicationTaskInfo) GetBranchToken() []byte {
+	if m != nil {
+		return m.BranchToken
+	}
+	return nil
+}
+
+func (m *ReplicationTaskInfo) GetNewRunBranchToken() []byte {
+	if m != nil {
+		return m.NewRunBranchToken
+	}
+	return nil
+}
+
+func (m *ReplicationTaskInfo) GetCreationTime() *types.Timestamp {
+	if m != nil {
+		return m.CreationTime
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ShardInfo)(nil), "uber.cadence.sqlblobs.v1.ShardInfo")
+	proto.RegisterMapType((map[string]int64)(nil), "uber.cadence.sqlblobs.v1.ShardInfo.ClusterReplicationLevelEntry")
+	proto.RegisterMapType((map[string]*types.Timestamp)(nil), "uber.cadence.sqlblobs.v1.ShardInfo.ClusterTimerAckLevelEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "uber.cadence.sqlblobs.v1.ShardInfo.ClusterTransferAckLevelEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "uber.cadence.sqlblobs.v1.ShardInfo.ReplicationDlqAckLevelEntry")
+	proto.RegisterType((*DomainInfo)(nil), "uber.cadence.sqlblobs.v1.DomainInfo")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.sqlblobs.v1.DomainInfo.DataEntry")
+	proto.RegisterType((*HistoryBranchRange)(nil), "uber.cadence.sqlblobs.v1.HistoryBranchRange")
+	proto.RegisterType((*HistoryTreeInfo)(nil), "uber.cadence.sqlblobs.v1.HistoryTreeInfo")
+	proto.RegisterType((*WorkflowExecutionInfo)(nil), "uber.cadence.sqlblobs.v1.WorkflowExecutionInfo")
+	proto.RegisterMapType((map[string][]byte)(nil), "uber.cadence.sqlblobs.v1.WorkflowExecutionInfo.MemoEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "uber.cadence.sqlblobs.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterType((*ActivityInfo)(nil), "uber.cadence.sqlblobs.v1.ActivityInfo")
+	proto.RegisterType((*ChildExecutionInfo)(nil), "uber.cadence.sqlblobs.v1.ChildExecutionInfo")
+	proto.RegisterType((*SignalInfo)(nil), "uber.cadence.sqlblobs.v1.SignalInfo")
+	proto.RegisterType((*RequestCancelInfo)(nil), "uber.cadence.sqlblobs.v1.RequestCancelInfo")
+	proto.RegisterType((*TimerInfo)(nil), "uber.cadence.sqlblobs.v1.TimerInfo")
+	proto.RegisterType((*TaskInfo)(nil), "uber.cadence.sqlblobs.v1.TaskInfo")
+	proto.RegisterType((*TaskListInfo)(nil), "uber.cadence.sqlblobs.v1.TaskListInfo")
+	proto.RegisterType((*TransferTaskInfo)(nil), "uber.cadence.sqlblobs.v1.TransferTaskInfo")
+	proto.RegisterType((*TimerTaskInfo)(nil), "uber.cadence.sqlblobs.v1.TimerTaskInfo")
+	proto.RegisterType((*ReplicationTaskInfo)(nil), "uber.cadence.sqlblobs.v1.ReplicationTaskInfo")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/sqlblobs/v1/sqlblobs.proto", fileDescriptor_6bf8356e38665f8b)
+}
+
+var fileDescriptor_6bf8356e38665f8b = []byte{
+	// 3491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0x05, 0x91, 0x94, 0x88, 0x07, 0x90, 0x00, 0x87, 0x5f, 0x43, 0x50, 0x1f, 0x14, 0x6c, 0xad,
+	0x28, 0xdb, 0x0b, 0x59, 0xb2, 0x63, 0x59, 0xf6, 0x7a, 0x37, 0x22, 0x45, 0xed, 0xc2, 0x65, 0xc9,
+	0xca, 0x90, 0x6b, 0x6f, 0xa5, 0x52, 0x35, 0x35, 0x98, 0x69, 0x92, 0x5d, 0x1c, 0xcc, 0x40, 0x33,
+	0x0d, 0x52, 0x74, 0x25, 0xff, 0x21, 0xc9, 0x31, 0x39, 0xe5, 0x98, 0x5b, 0x72, 0xce, 0x2d, 0xb9,
+	0xe4, 0x98, 0x7f, 0x90, 0x94, 0x7e, 0x49, 0xaa, 0xdf, 0xeb, 0xee, 0xf9, 0xc0, 0x90, 0x10, 0x93,
+	0xdd, 0xf2, 0x0d, 0x78, 0x5f, 0xfd, 0xfa, 0xf5, 0xeb, 0xf7, 0xd5, 0x03, 0xf7, 0xc7, 0x03, 0x96,
+	0x3c, 0xf4, 0xbd, 0x80, 0x45, 0x3e, 0x7b, 0x98, 0xbe, 0x09, 0x07, 0x61, 0x3c, 0x48, 0x1f, 0x9e,
+	0x3e, 0x32, 0xbf, 0x7b, 0xa3, 0x24, 0x16, 0xb1, 0x65, 0x4b, 0xc2, 0x9e, 0x22, 0xec, 0x19, 0xe4,
+	0xe9, 0xa3, 0xce, 0xed, 0xa3, 0x38, 0x3e, 0x0a, 0xd9, 0x43, 0xa4, 0x1b, 0x8c, 0x0f, 0x1f, 0x06,
+	0xe3, 0xc4, 0x13, 0x3c, 0x8e, 0x88, 0xb3, 0x73, 0xa7, 0x8c, 0x17, 0x7c, 0xc8, 0x52, 0xe1, 0x0d,
+	0x47, 0x8a, 0x60, 0x42, 0xc0, 0x59, 0xe2, 0x8d, 0x46, 0x2c, 0x51, 0x4b, 0x77, 0xff, 0x71, 0x11,
+	0xea, 0xfb, 0xc7, 0x5e, 0x12, 0xf4, 0xa3, 0xc3, 0xd8, 0xfa, 0x04, 0xac, 0x54, 0xc4, 0x21, 0x8b,
+	0xdc, 0x94, 0x47, 0x3e, 0x73, 0x13, 0x16, 0xb1, 0x33, 0xbb, 0xb6, 0x55, 0xdb, 0x9e, 0x73, 0xda,
+	0x84, 0xd9, 0x97, 0x08, 0x47, 0xc2, 0xad, 0xa7, 0x00, 0xe3, 0x51, 0xe0, 0x09, 0x16, 0xb8, 0x9e,
+	0xb0, 0xaf, 0x6d, 0xd5, 0xb6, 0x1b, 0x8f, 0x3b, 0x3d, 0x5a, 0xb0, 0xa7, 0x17, 0xec, 0x1d, 0x68,
+	0x8d, 0x9c, 0xba, 0xa2, 0x7e, 0x26, 0xac, 0xc7, 0xb0, 0x9a, 0xb0, 0x51, 0xc8, 0x7d, 0xdc, 0x8c,
+	0xeb, 0xf9, 0x27, 0x6e, 0xc8, 0x4e, 0x59, 0x68, 0xcf, 0x6c, 0xd5, 0xb6, 0x67, 0x9c, 0xe5, 0x1c,
+	0xf2, 0x99, 0x7f, 0xf2, 0x9d, 0x44, 0x49, 0xe5, 0x44, 0xe2, 0x45, 0xe9, 0x21, 0x4b, 0x72, 0x0c,
+	0xb3, 0xc8, 0xd0, 0xd6, 0x18, 0x43, 0xbd, 0x03, 0x2d, 0x69, 0x8b, 0x3c, 0xe9, 0xdc, 0x54, 0x0d,
+	0x17, 0x90, 0xc5, 0xc8, 0xf8, 0x35, 0x6c, 0x06, 0xf1, 0xd0, 0xe3, 0x91, 0x1b, 0xc5, 0x82, 0x1f,
+	0x6a, 0x6d, 0x4f, 0x59, 0x92, 0xf2, 0x38, 0xb2, 0xaf, 0xe3, 0xd2, 0x1b, 0x44, 0xf2, 0x2a, 0x47,
+	0xf1, 0x03, 0x11, 0x58, 0x7f, 0x03, 0x1d, 0x3f, 0x1c, 0xa7, 0x82, 0x25, 0x6e, 0x85, 0xe6, 0x37,
+	0xb6, 0x66, 0xb6, 0x1b, 0x8f, 0xff, 0xbc, 0x77, 0xd1, 0xe1, 0xf7, 0xcc, 0xb9, 0xf4, 0x76, 0x49,
+	0xca, 0x41, 0x69, 0x93, 0x7b, 0x91, 0x48, 0xce, 0x9d, 0x75, 0xbf, 0x1a, 0x6b, 0x09, 0x58, 0x37,
+	0xcb, 0x97, 0x4c, 0x31, 0x8f, 0x6b, 0x7f, 0x73, 0x95, 0xb5, 0xf9, 0xb0, 0xbc, 0xf0, 0x8a, 0x5f,
+	0x81, 0xb2, 0x56, 0x60, 0x2e, 0x3e, 0x8b, 0x58, 0x62, 0xd7, 0xb7, 0x6a, 0xdb, 0x75, 0x87, 0xfe,
+	0x58, 0x7f, 0x0d, 0x1b, 0x5a, 0x97, 0xfc, 0xc1, 0x93, 0x36, 0x70, 0x65, 0x4b, 0x38, 0x99, 0x8c,
+	0x0a, 0x4b, 0x94, 0xb1, 0xd6, 0x97, 0x60, 0x8f, 0x58, 0x14, 0xf0, 0xe8, 0xc8, 0x3d, 0xf4, 0x78,
+	0x18, 0x9f, 0xb2, 0xc4, 0x1d, 0x7a, 0xc9, 0x09, 0x4b, 0x52, 0xbb, 0xb1, 0x55, 0xdb, 0x6e, 0x3a,
+	0x6b, 0x0a, 0xff, 0x42, 0xa1, 0x5f, 0x12, 0xd6, 0xea, 0xc3, 0xdd, 0x8b, 0x38, 0x5d, 0x16, 0xf9,
+	0xb1, 0xc4, 0xd8, 0x4d, 0xdc, 0xe9, 0xed, 0x6a, 0x11, 0x7b, 0x8a, 0xca, 0xfa, 0x09, 0x36, 0xf2,
+	0x5b, 0x0f, 0xc2, 0x37, 0xb9, 0x03, 0x59, 0x40, 0x13, 0xfc, 0xe6, 0x7d, 0x4c, 0x90, 0xdb, 0xdd,
+	0xf3, 0xf0, 0x4d, 0xf1, 0x48, 0xd6, 0x92, 0x4a, 0xa4, 0xf5, 0x5b, 0xd8, 0x32, 0x1e, 0x38, 0x4a,
+	0x62, 0x9f, 0xa5, 0xa9, 0xdc, 0xd2, 0x9b, 0x31, 0x1b, 0x33, 0x37, 0x15, 0x9e, 0x60, 0xa9, 0xbd,
+	0x88, 0x86, 0xb8, 0xa5, 0xe9, 0x5e, 0x1b, 0xb2, 0xbf, 0x90, 0x54, 0xfb, 0x48, 0x64, 0xfd, 0x01,
+	0x1e, 0x4c, 0x13, 0x94, 0xd9, 0xa5, 0x85, 0x76, 0xb9, 0x77, 0xa9, 0x44, 0x63, 0x9e, 0x67, 0x70,
+	0x8b, 0xbc, 0xf4, 0x22, 0xfd, 0xda, 0xa8, 0x5f, 0x07, 0x89, 0xaa, 0x95, 0x73, 0xe0, 0x17, 0x97,
+	0x8a, 0xc8, 0x34, 0x5b, 0x42, 0xcd, 0xba, 0x17, 0xcb, 0x32, 0x6a, 0xbd, 0x86, 0x7b, 0x7e, 0x12,
+	0xa7, 0xa9, 0xab, 0xdd, 0xf7, 0x22, 0xf5, 0x2c, 0x54, 0xef, 0x2e, 0x12, 0x2b, 0x2f, 0xad, 0xd6,
+	0xd2, 0x83, 0xde, 0x7b, 0x49, 0xcc, 0xb4, 0x5d, 0x46, 0x6d, 0x1f, 0x4c, 0x15, 0xad, 0x95, 0xee,
+	0x7c, 0x0b, 0x37, 0x2f, 0x0b, 0x19, 0x56, 0x1b, 0x66, 0x4e, 0xd8, 0x39, 0x06, 0xf6, 0xba, 0x23,
+	0x7f, 0xca, 0x5b, 0x7b, 0xea, 0x85, 0x63, 0x86, 0x61, 0x7c, 0xc6, 0xa1, 0x3f, 0x5f, 0x5d, 0xfb,
+	0xb2, 0xd6, 0xf1, 0x61, 0xe3, 0xc2, 0x10, 0x50, 0x21, 0xe8, 0xd3, 0xbc, 0xa0, 0xcb, 0xa3, 0x6d,
+	0x6e, 0x91, 0x4c, 0xe1, 0xca, 0x9b, 0x7d, 0x25, 0x85, 0xfb, 0xb0, 0x79, 0xc9, 0x15, 0xb9, 0x8a,
+	0xa8, 0xee, 0xbf, 0xd6, 0x01, 0x9e, 0x63, 0x78, 0xc7, 0xf4, 0x68, 0xc1, 0x6c, 0xe4, 0x0d, 0x99,
+	0xe2, 0xc5, 0xdf, 0xd6, 0x16, 0x34, 0x02, 0x96, 0xfa, 0x09, 0x1f, 0xc9, 0xd5, 0x50, 0x44, 0xdd,
+	0xc9, 0x83, 0xb2, 0x80, 0x38, 0x93, 0x0f, 0x88, 0x6b, 0x70, 0x5d, 0x1e, 0xf3, 0x38, 0xc5, 0x0c,
+	0x36, 0xe7, 0xa8, 0x7f, 0xd6, 0x13, 0xa8, 0x27, 0x4c, 0xb0, 0x08, 0xa5, 0x51, 0xc6, 0xda, 0x98,
+	0xb0, 0xe1, 0x73, 0x55, 0x05, 0x38, 0x19, 0xad, 0x75, 0x07, 0x1a, 0x6c, 0xc8, 0x85, 0x3b, 0x64,
+	0x22, 0xe1, 0x3e, 0x26, 0xa7, 0x79, 0x07, 0x24, 0xe8, 0x25, 0x42, 0xac, 0xfb, 0xd0, 0xf2, 0x12,
+	0xff, 0x98, 0x9f, 0x7a, 0xa1, 0x3b, 0x18, 0xfb, 0x27, 0x4c, 0xd8, 0x37, 0x50, 0xa3, 0x45, 0x0d,
+	0xde, 0x41, 0x68, 0x81, 0x50, 0xe9, 0x38, 0x8f, 0x3a, 0x1a, 0xc2, 0x7d, 0xd2, 0xf5, 0x1e, 0x2c,
+	0xfa, 0x71, 0x74, 0xc8, 0x8f, 0x4c, 0x4a, 0xac, 0xa3, 0x05, 0x17, 0x08, 0xaa, 0xd3, 0xe0, 0x23,
+	0x58, 0xa9, 0xcc, 0x9f, 0x40, 0xb9, 0x3e, 0xaa, 0xc8, 0x9c, 0x3b, 0x70, 0xcb, 0x84, 0xdb, 0x4a,
+	0xde, 0x06, 0xf2, 0x6e, 0x6a, 0xa2, 0xaa, 0xec, 0xfb, 0x00, 0xda, 0x46, 0x86, 0x66, 0x6b, 0x22,
+	0x5b, 0x4b, 0xc3, 0x35, 0x69, 0x0f, 0x96, 0x3d, 0x5f, 0xf0, 0x53, 0x66, 0xee, 0x24, 0x9e, 0xf3,
+	0x02, 0x9a, 0x67, 0x89, 0x50, 0xca, 0x3f, 0x5f, 0xc9, 0x43, 0xef, 0xc0, 0xbc, 0x22, 0x94, 0x61,
+	0x73, 0x66, 0xbb, 0xee, 0x98, 0xff, 0xd6, 0x0e, 0xcc, 0x06, 0x9e, 0xf0, 0xec, 0x16, 0x46, 0xf4,
+	0xde, 0xc5, 0x11, 0x3d, 0x73, 0xac, 0xde, 0x73, 0x4f, 0x78, 0x14, 0xc0, 0x91, 0xd7, 0xba, 0x0b,
+	0xcd, 0x81, 0x17, 0xb8, 0x03, 0x1e, 0x79, 0x09, 0x37, 0xa1, 0xaf, 0x31, 0xf0, 0x82, 0x1d, 0x05,
+	0x92, 0x15, 0x54, 0x9e, 0xa4, 0x1c, 0xda, 0x96, 0x73, 0xb4, 0x26, 0x96, 0x7d, 0x01, 0xeb, 0xc7,
+	0x3c, 0x15, 0x71, 0x72, 0xee, 0x96, 0x0f, 0xd8, 0xc2, 0x03, 0x5e, 0x55, 0xe8, 0x67, 0xc5, 0x73,
+	0xfe, 0x14, 0x56, 0x26, 0xf8, 0xc6, 0x09, 0x57, 0x71, 0xc9, 0x2a, 0x31, 0xfd, 0x3e, 0xe1, 0xd6,
+	0xaf, 0xa0, 0x73, 0xca, 0x53, 0x3e, 0xe0, 0x21, 0x17, 0x93, 0x8b, 0xad, 0xe0, 0x62, 0x76, 0x46,
+	0x51, 0x5a, 0xef, 0x0b, 0x58, 0xaf, 0xe2, 0x96, 0x4b, 0xae, 0xe2, 0x92, 0xab, 0x93, 0xac, 0x72,
+	0xd5, 0x17, 0xb0, 0x64, 0x4e, 0x9c, 0x45, 0x01, 0x56, 0x3d, 0xf6, 0xda, 0xd4, 0x38, 0x64, 0xdc,
+	0x61, 0x2f, 0x0a, 0x24, 0xd4, 0xfa, 0x0a, 0x36, 0x46, 0x09, 0x3b, 0xe5, 0xf1, 0x38, 0x75, 0x27,
+	0x5c, 0x68, 0x1d, 0x5d, 0x68, 0x5d, 0x13, 0xbc, 0x28, 0xb9, 0xd2, 0x0b, 0x58, 0x0a, 0xbd, 0x54,
+	0xb8, 0xba, 0x32, 0x46, 0x1d, 0xec, 0xe9, 0x3a, 0x48, 0xa6, 0xdf, 0x13, 0x8f, 0x84, 0x76, 0x9e,
+	0x40, 0xdd, 0x78, 0xc5, 0xb4, 0x98, 0x55, 0xcf, 0xc7, 0xac, 0x31, 0x58, 0xbf, 0xa3, 0x03, 0xd9,
+	0x49, 0xbc, 0xc8, 0x3f, 0x76, 0xbc, 0xe8, 0x88, 0x59, 0x9b, 0x50, 0x1f, 0xe0, 0x5f, 0x97, 0x07,
+	0x4a, 0xce, 0x3c, 0x01, 0xfa, 0x81, 0xd5, 0x85, 0x85, 0x01, 0x3b, 0xc2, 0x32, 0x37, 0x60, 0x92,
+	0x80, 0x02, 0x61, 0x03, 0x81, 0xaf, 0xe2, 0x80, 0xf5, 0x03, 0xeb, 0x36, 0x34, 0xa4, 0x49, 0x35,
+	0x05, 0xd5, 0xe9, 0x75, 0x16, 0x05, 0x84, 0xef, 0xfe, 0x4b, 0x0d, 0x5a, 0x6a, 0xdd, 0x83, 0x84,
+	0x31, 0x8c, 0x97, 0xdf, 0x40, 0xd3, 0x4f, 0x58, 0x66, 0x86, 0xda, 0x54, 0x33, 0x34, 0x14, 0x3d,
+	0x1e, 0xc3, 0xb7, 0x50, 0xf7, 0x22, 0x9f, 0x49, 0x99, 0xa9, 0x7d, 0x0d, 0xaf, 0xd3, 0x27, 0x17,
+	0x5f, 0xa7, 0xc9, 0x4d, 0x3b, 0x19, 0xbb, 0x0c, 0xdd, 0x3c, 0x3a, 0x8c, 0x55, 0x0c, 0xc6, 0xdf,
+	0xdd, 0xff, 0xe8, 0xc0, 0xea, 0x8f, 0x71, 0x72, 0x72, 0x18, 0xc6, 0x67, 0x7b, 0x6f, 0x99, 0x3f,
+	0x96, 0xd1, 0x03, 0x15, 0xdf, 0x86, 0xf6, 0xc8, 0x4b, 0x58, 0x24, 0x5c, 0x55, 0xff, 0x2b, 0xa3,
+	0x35, 0x9d, 0x45, 0x82, 0xab, 0xbb, 0x1b, 0xc8, 0xa6, 0x44, 0x51, 0x9e, 0x29, 0x49, 0xda, 0x7e,
+	0x75, 0x47, 0xc9, 0xd0, 0x4b, 0x90, 0xa1, 0x15, 0x75, 0x32, 0x8e, 0xb4, 0x19, 0x9b, 0x4e, 0x83,
+	0x80, 0xce, 0x58, 0x4a, 0xbc, 0x0b, 0x4d, 0x1e, 0x71, 0xc1, 0xd1, 0x6c, 0x3c, 0x50, 0x0d, 0x4e,
+	0xc3, 0xc0, 0xfa, 0x81, 0xf5, 0x03, 0x6c, 0xf8, 0xf1, 0x70, 0x14, 0x32, 0x0c, 0x89, 0xec, 0x54,
+	0x0a, 0x1c, 0x78, 0x82, 0x0e, 0x97, 0x72, 0xc6, 0xe6, 0x84, 0x91, 0xfb, 0x91, 0xf8, 0xe2, 0xf3,
+	0x1f, 0xa4, 0x9b, 0x38, 0x6b, 0x19, 0xf7, 0x9e, 0x64, 0xde, 0x91, 0xbc, 0xfd, 0x40, 0x46, 0xcc,
+	0xb2, 0x5c, 0xcc, 0x23, 0x4d, 0xa7, 0x55, 0xe2, 0x90, 0x57, 0x64, 0x42, 0x05, 0x13, 0x82, 0x28,
+	0xad, 0xac, 0x97, 0x78, 0x4c, 0x18, 0xda, 0x84, 0xba, 0xf0, 0xd2, 0x13, 0x37, 0xe4, 0xa9, 0xc0,
+	0xcc, 0x52, 0x77, 0xe6, 0x25, 0xe0, 0x3b, 0x9e, 0x0a, 0x69, 0x50, 0x63, 0x49, 0x71, 0x3e, 0x62,
+	0x14, 0x89, 0xa9, 0x97, 0x68, 0x6b, 0xcc, 0xc1, 0xf9, 0x88, 0x61, 0x20, 0x7e, 0x0e, 0xed, 0x8c,
+	0x9a, 0x0f, 0x59, 0x3c, 0x16, 0x36, 0x4c, 0x4b, 0x9a, 0x2d, 0x23, 0x86, 0x38, 0xac, 0x97, 0xb0,
+	0x1a, 0x30, 0x9f, 0xcb, 0xfb, 0xeb, 0xa2, 0x66, 0x5a, 0x54, 0x63, 0x9a, 0xa8, 0x65, 0xcd, 0x77,
+	0xe0, 0xa5, 0x27, 0x5a, 0xdc, 0xc7, 0xb0, 0xc4, 0xb4, 0x3b, 0xb9, 0x7e, 0x1c, 0x09, 0xf6, 0x56,
+	0x60, 0xe6, 0x69, 0x3a, 0x6d, 0x83, 0xd8, 0x25, 0xb8, 0xbc, 0xc8, 0x58, 0xee, 0x61, 0xb2, 0x99,
+	0x73, 0xe8, 0x8f, 0x74, 0x02, 0x3f, 0x8c, 0x53, 0xa6, 0x23, 0xe6, 0x22, 0x22, 0x1b, 0x08, 0x53,
+	0x41, 0xf2, 0x03, 0x58, 0x48, 0x85, 0x97, 0x08, 0x13, 0x98, 0x5a, 0xe8, 0x28, 0x4d, 0x04, 0xea,
+	0x68, 0xf4, 0x2d, 0x2c, 0x63, 0x34, 0x3a, 0x4b, 0xb8, 0x60, 0xea, 0x98, 0x78, 0x60, 0xb7, 0xa7,
+	0xfb, 0x48, 0x5b, 0xf2, 0xfd, 0x28, 0xd9, 0xf0, 0xf0, 0xfa, 0x81, 0xf5, 0x31, 0x58, 0x28, 0x8b,
+	0xa4, 0xa0, 0x9d, 0x78, 0x80, 0xe9, 0x66, 0x86, 0xc2, 0x17, 0x12, 0x4a, 0x43, 0xf4, 0x03, 0xeb,
+	0x97, 0x6a, 0xe1, 0x43, 0x9e, 0x18, 0x16, 0x1e, 0x60, 0x9a, 0x99, 0x21, 0xd9, 0x2f, 0x78, 0xa2,
+	0x58, 0xfa, 0x81, 0xcc, 0x30, 0x48, 0xae, 0x4a, 0x61, 0x16, 0x28, 0xef, 0x5b, 0x46, 0x7a, 0x5c,
+	0xf7, 0xb5, 0x46, 0x91, 0x03, 0x3e, 0x05, 0xa0, 0xed, 0x63, 0x64, 0x59, 0x99, 0x3e, 0x7c, 0x40,
+	0x6a, 0xf9, 0xbf, 0x3a, 0x44, 0xaf, 0x5e, 0x39, 0x44, 0xcb, 0xeb, 0x62, 0xdc, 0x46, 0x1f, 0xc2,
+	0x1a, 0x99, 0x43, 0xc3, 0xf5, 0x39, 0x7c, 0x0a, 0x2b, 0x86, 0x34, 0xf5, 0x8f, 0x59, 0x30, 0x0e,
+	0x31, 0x8c, 0x52, 0x32, 0xb1, 0x34, 0x6e, 0x5f, 0xa1, 0xfa, 0x81, 0x2c, 0x49, 0x32, 0x0e, 0xa9,
+	0x3a, 0x45, 0x03, 0x1b, 0x19, 0x96, 0x0c, 0x03, 0x61, 0xfa, 0x81, 0xbc, 0x09, 0x86, 0x5e, 0xbb,
+	0xef, 0xc6, 0xd4, 0x9b, 0x60, 0xdc, 0x57, 0xb9, 0x6e, 0x7e, 0x4b, 0x9e, 0x10, 0x6c, 0x38, 0x12,
+	0x76, 0xa7, 0xb8, 0xa5, 0x67, 0x04, 0xb6, 0xfe, 0x00, 0x9d, 0x09, 0x05, 0xcd, 0xf4, 0xc9, 0xde,
+	0x9c, 0x6a, 0x4e, 0xbb, 0xb4, 0x07, 0x83, 0xb1, 0xfe, 0x0a, 0x6e, 0x4e, 0x18, 0x2b, 0x2f, 0xfb,
+	0xe6, 0x54, 0xd9, 0x9d, 0xb2, 0x41, 0x73, 0xd2, 0x65, 0x90, 0x93, 0x79, 0x21, 0x74, 0x13, 0xf6,
+	0x66, 0xcc, 0x52, 0xc1, 0x02, 0xfb, 0x16, 0x16, 0xcb, 0x2d, 0x82, 0x3b, 0x1a, 0x6c, 0x0d, 0xe1,
+	0x9e, 0x51, 0x24, 0x4e, 0xf8, 0x11, 0x8f, 0x64, 0x0d, 0x53, 0xa1, 0xd1, 0xed, 0xa9, 0x1a, 0x75,
+	0xb5, 0xa0, 0xef, 0x95, 0x9c, 0x0a, 0xcd, 0x3e, 0x82, 0x25, 0x4a, 0x7f, 0x5a, 0x33, 0x79, 0xe0,
+	0x77, 0x30, 0xf2, 0xb5, 0x08, 0xa1, 0x54, 0x2b, 0xb9, 0x47, 0x8e, 0x7a, 0x8b, 0x2a, 0x56, 0x8d,
+	0xca, 0xe8, 0xa5, 0xec, 0xc2, 0xae, 0x25, 0xf5, 0x5d, 0x25, 0x3b, 0xbf, 0xed, 0x7e, 0x20, 0xb3,
+	0x5f, 0x2a, 0xb8, 0x7f, 0x72, 0xee, 0x66, 0x61, 0xba, 0x4b, 0x9d, 0x02, 0xc1, 0x0f, 0x74, 0xb0,
+	0xf6, 0x60, 0x4b, 0x51, 0x1a, 0xa7, 0x16, 0xb1, 0x9b, 0xdd, 0x4b, 0xe9, 0x84, 0x1f, 0x4c, 0x73,
+	0xc2, 0x9b, 0x24, 0x42, 0x1b, 0xe4, 0x20, 0xde, 0xd7, 0x37, 0x55, 0x7a, 0xe4, 0x07, 0xb0, 0x90,
+	0x30, 0x21, 0x2b, 0x4f, 0xe5, 0x8e, 0x1f, 0x52, 0x98, 0x43, 0xa0, 0xf6, 0xc5, 0xef, 0x61, 0x8d,
+	0x88, 0x28, 0x4b, 0x86, 0x2e, 0x8f, 0x04, 0x4b, 0x4e, 0xbd, 0xd0, 0xbe, 0x37, 0x6d, 0xf5, 0x15,
+	0x64, 0xec, 0x13, 0x5f, 0x5f, 0xb1, 0x65, 0x02, 0x87, 0xde, 0x5b, 0x3e, 0x1c, 0x0f, 0x33, 0x81,
+	0xbf, 0x78, 0x3f, 0x81, 0x2f, 0x89, 0xcf, 0x08, 0xfc, 0xbc, 0x2c, 0x50, 0x6d, 0x27, 0xb5, 0xef,
+	0x63, 0x68, 0x2f, 0x70, 0xa9, 0x6d, 0xa5, 0xf2, 0x52, 0x13, 0x17, 0x7b, 0x3b, 0xe2, 0x24, 0xdf,
+	0xde, 0x9e, 0x7a, 0xa9, 0x91, 0x65, 0xcf, 0x70, 0xc8, 0x5c, 0x4d, 0x52, 0x06, 0x9e, 0x7f, 0x12,
+	0x1f, 0x1e, 0xba, 0x7e, 0xcc, 0x0e, 0x0f, 0xb9, 0xcf, 0x65, 0x84, 0x7d, 0xb0, 0x55, 0xdb, 0xae,
+	0x39, 0xeb, 0x48, 0xb0, 0x43, 0xf8, 0xdd, 0x0c, 0x6d, 0xbd, 0x82, 0x55, 0xe2, 0xcd, 0x34, 0xa0,
+	0x78, 0xf9, 0xd1, 0x54, 0x97, 0x5f, 0x2e, 0xe9, 0x21, 0x31, 0xd6, 0xd7, 0xd0, 0x21, 0x79, 0x11,
+	0x3a, 0xae, 0x48, 0xce, 0xbd, 0x41, 0xc8, 0x5c, 0x96, 0x24, 0xb2, 0xc8, 0xfb, 0x18, 0x7b, 0x29,
+	0x52, 0xe6, 0x95, 0x74, 0x5f, 0x85, 0xdf, 0x43, 0xb4, 0x74, 0xcc, 0x63, 0x2f, 0x25, 0x36, 0x77,
+	0x14, 0x87, 0xdc, 0x3f, 0xb7, 0x3f, 0xc1, 0xab, 0xbb, 0x78, 0xec, 0xa5, 0x48, 0xfd, 0x1a, 0xa1,
+	0xd2, 0x6b, 0xfc, 0x24, 0x17, 0x3e, 0xec, 0x5f, 0xa2, 0xff, 0x36, 0x25, 0x50, 0x3b, 0x9a, 0xbc,
+	0x43, 0x94, 0x98, 0x52, 0x11, 0x27, 0xcc, 0x84, 0xf0, 0x1e, 0x1e, 0xc8, 0x12, 0xa2, 0xf6, 0x25,
+	0x46, 0x07, 0xf1, 0x4f, 0xc0, 0x52, 0xb5, 0x16, 0x55, 0xd2, 0x22, 0x3e, 0x61, 0x91, 0xfd, 0x50,
+	0x25, 0x76, 0x2c, 0xa4, 0x10, 0x71, 0x20, 0xe1, 0x32, 0x85, 0xa7, 0xfc, 0x48, 0x46, 0x0c, 0x3f,
+	0x1e, 0x47, 0xc2, 0xfe, 0x94, 0xea, 0x38, 0x82, 0xed, 0x4a, 0x90, 0x24, 0xd1, 0x7d, 0x55, 0xca,
+	0x7f, 0x62, 0xf6, 0x23, 0x22, 0x51, 0xb0, 0x7d, 0xfe, 0x13, 0x93, 0x7e, 0xe3, 0x87, 0xf2, 0x24,
+	0xdc, 0x90, 0x0f, 0x12, 0x2f, 0x39, 0x37, 0x6a, 0x3e, 0xc6, 0x1d, 0xad, 0x10, 0xf6, 0x3b, 0x42,
+	0x6a, 0x4d, 0x33, 0xae, 0x43, 0xe6, 0x89, 0x71, 0x6e, 0x73, 0x9f, 0xe5, 0xb9, 0x5e, 0x10, 0x52,
+	0x73, 0xdd, 0x81, 0x86, 0xe2, 0xe2, 0xc3, 0x51, 0x68, 0x7f, 0x8e, 0xa4, 0x40, 0xa0, 0xfe, 0x70,
+	0x14, 0xca, 0x20, 0xe2, 0x8d, 0x45, 0xec, 0x26, 0x2c, 0x65, 0xc2, 0x1d, 0xc5, 0x3c, 0x12, 0xa9,
+	0xfd, 0x67, 0x54, 0x20, 0x4a, 0x84, 0x23, 0xe1, 0xaf, 0x11, 0x2c, 0x0f, 0x7a, 0x82, 0x36, 0xab,
+	0x10, 0xbf, 0xa0, 0x0a, 0xb1, 0xc4, 0x64, 0x2a, 0xc4, 0x04, 0x96, 0x52, 0x26, 0xfb, 0x3e, 0x79,
+	0x4d, 0x12, 0x3e, 0x18, 0xcb, 0x01, 0xdb, 0x13, 0xec, 0x00, 0xf6, 0x2e, 0xee, 0x00, 0x2a, 0x6b,
+	0xf9, 0xde, 0x3e, 0x0a, 0x7a, 0x66, 0xe4, 0x50, 0x9f, 0xdd, 0x4e, 0x4b, 0x60, 0xeb, 0x25, 0xcc,
+	0x0e, 0xd9, 0x30, 0xb6, 0xbf, 0xc4, 0x65, 0x9e, 0x5e, 0x75, 0x99, 0x97, 0x6c, 0x18, 0xab, 0x16,
+	0x5e, 0x8a, 0x91, 0x45, 0xa0, 0xb2, 0xb9, 0x4b, 0xe7, 0x29, 0xfb, 0xf8, 0xa7, 0xe4, 0x2b, 0x0a,
+	0xf1, 0x3b, 0x0d, 0xc7, 0x76, 0xb9, 0x4c, 0x9c, 0x19, 0xeb, 0x2b, 0x34, 0x96, 0x5d, 0xe6, 0x32,
+	0xd3, 0xbe, 0x5d, 0x58, 0xad, 0xdc, 0xe4, 0xb4, 0xb6, 0xb1, 0x99, 0x9f, 0x9a, 0x3d, 0x81, 0xba,
+	0xd9, 0xc2, 0x55, 0x18, 0xbb, 0xff, 0xbc, 0x00, 0xcd, 0x67, 0x72, 0x42, 0xc2, 0xc5, 0x39, 0x36,
+	0x4f, 0x36, 0xdc, 0xd0, 0xde, 0x56, 0x43, 0x87, 0xd6, 0x7f, 0xad, 0x27, 0x60, 0x67, 0xd9, 0xb3,
+	0xd4, 0xb6, 0x50, 0xcb, 0xb9, 0x6a, 0xf0, 0x85, 0xc6, 0xe4, 0x3e, 0xb4, 0x4a, 0x8c, 0xaa, 0x73,
+	0x5a, 0x2c, 0xd2, 0xcb, 0x41, 0x7f, 0x79, 0x05, 0x63, 0xc6, 0x59, 0xdc, 0xcd, 0x5a, 0x91, 0x23,
+	0x37, 0x7e, 0x5e, 0x2c, 0x66, 0xf6, 0xf7, 0x79, 0x2e, 0x4a, 0xf3, 0x49, 0xdc, 0xba, 0x05, 0x90,
+	0xab, 0xd4, 0xe8, 0x75, 0xa8, 0x9e, 0x9a, 0x0a, 0x4d, 0x17, 0xec, 0x66, 0x0b, 0x37, 0x70, 0x0b,
+	0x4d, 0x05, 0xa4, 0x0d, 0x7c, 0x0e, 0x6b, 0x05, 0xa2, 0x4c, 0x7d, 0x6a, 0x94, 0x56, 0xf2, 0xd4,
+	0x46, 0xf9, 0x6f, 0xa0, 0x99, 0x2f, 0xc1, 0xec, 0xfa, 0x54, 0xd5, 0x1b, 0x69, 0x56, 0x75, 0xc9,
+	0x8b, 0xef, 0xa9, 0x13, 0x94, 0x9a, 0x03, 0x5d, 0x7c, 0x0d, 0xea, 0x07, 0x72, 0x67, 0xb9, 0xb2,
+	0xa1, 0x81, 0xf8, 0x7a, 0x62, 0x0a, 0x86, 0x03, 0xd8, 0xb8, 0x38, 0xff, 0x37, 0xa7, 0xe5, 0xab,
+	0xb5, 0xb4, 0x3a, 0xf3, 0x97, 0xa4, 0x52, 0x3f, 0xa4, 0xa5, 0x2e, 0x5c, 0x41, 0xea, 0xae, 0xe4,
+	0xd4, 0x52, 0x5f, 0x29, 0x03, 0x4f, 0x8a, 0x5c, 0x9c, 0xda, 0xec, 0x51, 0x0b, 0x51, 0x94, 0xf7,
+	0x02, 0x96, 0x8e, 0x99, 0x97, 0x88, 0x01, 0xf3, 0xb2, 0x3d, 0xb7, 0xa6, 0x89, 0x6a, 0x1b, 0x9e,
+	0x5c, 0xe5, 0x3d, 0x51, 0x96, 0xb6, 0xab, 0xcb, 0xd2, 0xca, 0x5a, 0x4e, 0xf5, 0x61, 0xe5, 0x5a,
+	0xee, 0x23, 0x58, 0xa2, 0x27, 0x11, 0x2c, 0xe5, 0x0a, 0xc3, 0x3e, 0x7a, 0x1f, 0x95, 0xb5, 0x9c,
+	0xea, 0x28, 0x6d, 0xb8, 0xa1, 0x8b, 0xac, 0x65, 0xa4, 0xd0, 0x7f, 0x8b, 0x1d, 0xfb, 0x4a, 0xa9,
+	0x63, 0x7f, 0x00, 0x6d, 0xe5, 0x4c, 0x2e, 0x0f, 0x58, 0x24, 0xb8, 0x38, 0x57, 0x63, 0xba, 0x96,
+	0x71, 0x7e, 0x02, 0x57, 0x26, 0xf0, 0xb5, 0xca, 0x04, 0x7e, 0x71, 0x45, 0xb7, 0xfe, 0xc7, 0xae,
+	0xe8, 0xec, 0x3f, 0x76, 0x45, 0xb7, 0x71, 0x49, 0x45, 0x77, 0x61, 0x3d, 0xd5, 0xf9, 0xbf, 0xd5,
+	0x53, 0x97, 0xd6, 0x76, 0x9b, 0x97, 0xd7, 0x76, 0x97, 0xd7, 0x62, 0x37, 0x2f, 0xaf, 0xc5, 0x9e,
+	0xea, 0x85, 0xa9, 0xcd, 0xf7, 0x78, 0x28, 0xcb, 0x8c, 0x84, 0x79, 0x69, 0x1c, 0x61, 0x3f, 0x55,
+	0x77, 0xc8, 0x3e, 0xdf, 0xc9, 0x5e, 0x9f, 0xd0, 0x0e, 0x62, 0xb3, 0x75, 0x69, 0x34, 0x11, 0xcb,
+	0x67, 0xd2, 0xcc, 0x75, 0x6e, 0x53, 0x69, 0x60, 0x78, 0x7f, 0x44, 0xbc, 0x71, 0xa1, 0x22, 0xb3,
+	0x5e, 0x37, 0x60, 0xc2, 0xe3, 0x61, 0x8a, 0xdd, 0x52, 0xd3, 0x59, 0x2f, 0x2f, 0xfc, 0x9c, 0xd0,
+	0xdd, 0x77, 0xb3, 0x60, 0xed, 0x1e, 0xf3, 0x30, 0x28, 0x8e, 0xfb, 0x2e, 0xcd, 0x58, 0xd9, 0x30,
+	0xae, 0x3a, 0x63, 0x19, 0x7c, 0x21, 0x63, 0x15, 0x73, 0xc1, 0x4c, 0x39, 0x17, 0xdc, 0x87, 0x56,
+	0x49, 0x2e, 0xa6, 0xa7, 0xa6, 0xb3, 0x58, 0x14, 0x27, 0x13, 0x5a, 0x59, 0x01, 0x93, 0x11, 0xe6,
+	0xc8, 0xca, 0x45, 0x0e, 0x93, 0x13, 0x7a, 0xb0, 0xac, 0x35, 0xc8, 0x8f, 0x26, 0xaf, 0x53, 0x87,
+	0xa8, 0x50, 0xb9, 0xd9, 0xe4, 0x87, 0xb0, 0xa8, 0xe9, 0xd5, 0x70, 0xb2, 0x98, 0x9f, 0x68, 0x3a,
+	0x39, 0x91, 0xc4, 0xe6, 0xaf, 0x94, 0xc4, 0xea, 0x97, 0x24, 0xb1, 0xca, 0xf6, 0x17, 0xaa, 0xdb,
+	0xdf, 0x4d, 0xa8, 0x67, 0x93, 0x59, 0xca, 0x47, 0xf3, 0x81, 0x9e, 0xc9, 0xde, 0x81, 0x86, 0x42,
+	0xe2, 0xec, 0x90, 0x5e, 0xe7, 0x41, 0x7d, 0xa6, 0x21, 0xa7, 0x86, 0xd5, 0x33, 0xc6, 0x85, 0x0b,
+	0x66, 0x8c, 0x3d, 0x58, 0x56, 0x43, 0x5b, 0xca, 0x17, 0x2a, 0x6e, 0xd1, 0x48, 0x6e, 0x89, 0x50,
+	0x98, 0x12, 0x28, 0x74, 0x75, 0xff, 0xbd, 0x06, 0xb0, 0x8f, 0x55, 0xfe, 0x9f, 0xd0, 0xb9, 0x72,
+	0x26, 0x9a, 0x29, 0xa7, 0x63, 0xfd, 0x4c, 0x39, 0x9b, 0x7b, 0xa6, 0x5c, 0x81, 0x39, 0x1e, 0x8d,
+	0xc6, 0x02, 0x9d, 0xa6, 0xe9, 0xd0, 0x1f, 0xa9, 0x9b, 0x1f, 0x47, 0x22, 0x89, 0x43, 0x35, 0xe7,
+	0xd5, 0x7f, 0xbb, 0x7f, 0x5f, 0x83, 0x25, 0x65, 0xee, 0x5d, 0x4c, 0x29, 0x7f, 0xaa, 0xbd, 0x54,
+	0x26, 0xb3, 0x99, 0xca, 0xc1, 0x44, 0xf7, 0x1f, 0x6a, 0x50, 0xc7, 0x47, 0xe8, 0x29, 0xca, 0x14,
+	0x2f, 0xdf, 0xb5, 0xf2, 0xe5, 0xfb, 0x1a, 0x1a, 0x18, 0x7d, 0xcf, 0x29, 0xf2, 0xce, 0x4c, 0x8d,
+	0xbc, 0x40, 0xe4, 0x12, 0x60, 0xad, 0xc3, 0x0d, 0x3d, 0xfa, 0xa4, 0xc9, 0xfc, 0x75, 0x81, 0x13,
+	0xcf, 0xee, 0x7f, 0xd7, 0x60, 0x1e, 0x87, 0x9f, 0x52, 0xb7, 0x3b, 0xd0, 0xc8, 0x5f, 0x3a, 0x2a,
+	0xa4, 0xe1, 0x2c, 0xbb, 0x6d, 0xab, 0x70, 0x5d, 0xdd, 0x32, 0x55, 0x50, 0x27, 0x63, 0xe5, 0xba,
+	0xf9, 0xf1, 0x20, 0xc5, 0x0d, 0x48, 0xb3, 0xb1, 0x60, 0x49, 0xf7, 0xd9, 0x2b, 0xe9, 0x5e, 0x7e,
+	0x8f, 0x99, 0xbb, 0xd2, 0x7b, 0x4c, 0xf7, 0xdf, 0x6a, 0xd0, 0xd4, 0xa3, 0x1f, 0xfd, 0x1e, 0x7e,
+	0xc2, 0xa3, 0x40, 0x7d, 0x20, 0x86, 0xbf, 0xe5, 0xcd, 0xcc, 0xbe, 0x6a, 0x21, 0xd3, 0xcf, 0x7b,
+	0xfa, 0x33, 0x94, 0xff, 0x97, 0xe5, 0xbf, 0x81, 0x66, 0x7e, 0x6c, 0xfb, 0x1e, 0x7b, 0x6f, 0xe4,
+	0x26, 0xb6, 0xdd, 0xbf, 0x9d, 0x85, 0xb6, 0xfe, 0x1a, 0xc2, 0x9c, 0x53, 0x21, 0x8e, 0xd0, 0x0b,
+	0x4f, 0x21, 0x8e, 0x4c, 0x3e, 0xea, 0x54, 0x1f, 0xe2, 0x4c, 0xfe, 0x10, 0x75, 0xb5, 0x24, 0x43,
+	0x8b, 0x7a, 0xdd, 0xc7, 0x6a, 0x49, 0x46, 0x14, 0x59, 0x02, 0x09, 0x2f, 0x39, 0x62, 0xf9, 0xa7,
+	0x25, 0xba, 0x93, 0x8b, 0x04, 0xcf, 0x3f, 0x2d, 0x29, 0xca, 0xc9, 0xf8, 0xad, 0x64, 0x14, 0x9f,
+	0x96, 0x14, 0x75, 0x21, 0x7a, 0x37, 0x08, 0xe8, 0x14, 0x14, 0xab, 0x7c, 0x78, 0xf9, 0x1a, 0x3a,
+	0x4a, 0x80, 0x2f, 0x33, 0x64, 0xb6, 0x68, 0x1c, 0x85, 0xe7, 0x18, 0xb8, 0xe7, 0x9d, 0x75, 0xa2,
+	0xc0, 0x14, 0xaa, 0xd7, 0xfe, 0x3e, 0x0a, 0xcf, 0xcb, 0x7e, 0x0b, 0x13, 0x7e, 0x9b, 0xbb, 0xac,
+	0x8d, 0xe2, 0x65, 0x7d, 0x09, 0x2b, 0xb9, 0xc7, 0xde, 0x6c, 0xa6, 0xda, 0x9c, 0x5e, 0x10, 0x65,
+	0x7c, 0x85, 0x21, 0x6a, 0xd9, 0xbe, 0x29, 0x7e, 0x5d, 0xd5, 0x74, 0x5a, 0x45, 0x03, 0xa7, 0xdd,
+	0x7f, 0xba, 0x06, 0x0b, 0x07, 0x7c, 0xf8, 0xb3, 0xfa, 0xc3, 0xaf, 0xa1, 0xa9, 0xba, 0x06, 0xc2,
+	0x5f, 0xf2, 0x7c, 0xf7, 0xd9, 0x63, 0x7a, 0x9a, 0x69, 0x28, 0x06, 0xe4, 0xcf, 0x19, 0xf6, 0x7a,
+	0xd1, 0xb0, 0xb2, 0x2e, 0xd7, 0x67, 0xa2, 0xeb, 0xfa, 0x1b, 0xd4, 0x25, 0x68, 0xb8, 0x9e, 0x9f,
+	0x6e, 0xc0, 0xbc, 0x79, 0xa2, 0x99, 0x27, 0x29, 0x8c, 0x5e, 0x66, 0xba, 0x7f, 0x37, 0x0b, 0xcb,
+	0xb9, 0xcf, 0x69, 0x7e, 0x46, 0x4b, 0xe5, 0x76, 0x3a, 0x57, 0xdc, 0xe9, 0x87, 0xb0, 0x58, 0x7a,
+	0x67, 0x22, 0x53, 0x34, 0x0f, 0xf3, 0x6f, 0x4c, 0x5d, 0x58, 0x88, 0xd8, 0xdb, 0x1c, 0x11, 0x19,
+	0xa3, 0x21, 0x81, 0x9a, 0x46, 0x0e, 0xed, 0xcc, 0x14, 0xc0, 0x18, 0xc3, 0xf8, 0x76, 0x40, 0x93,
+	0xf7, 0xaa, 0xa9, 0x61, 0xfd, 0xa2, 0xa9, 0xe1, 0xaf, 0x60, 0x33, 0x62, 0x67, 0x78, 0x2b, 0xab,
+	0xf8, 0x00, 0xf9, 0xd6, 0x23, 0x76, 0xe6, 0x8c, 0xa3, 0xbd, 0x09, 0x6e, 0xf9, 0x25, 0x48, 0x7e,
+	0xda, 0xd8, 0x50, 0x5f, 0x82, 0xe4, 0x06, 0x8d, 0x0f, 0x61, 0x45, 0x2f, 0x50, 0x20, 0xa5, 0x17,
+	0xc7, 0x25, 0x92, 0x9c, 0x9f, 0x4c, 0xfe, 0x46, 0x0e, 0x47, 0x59, 0xae, 0xf7, 0x58, 0x98, 0x7a,
+	0xd5, 0x9a, 0x9a, 0x41, 0x82, 0x76, 0x7e, 0xfb, 0x9f, 0xef, 0x6e, 0xd7, 0xfe, 0xeb, 0xdd, 0xed,
+	0xda, 0xff, 0xbc, 0xbb, 0x5d, 0xfb, 0xcb, 0xa7, 0x47, 0x5c, 0x1c, 0x8f, 0x07, 0x3d, 0x3f, 0x1e,
+	0x3e, 0x2c, 0x7c, 0xf1, 0xdc, 0x3b, 0x62, 0x11, 0x7d, 0x78, 0x9c, 0xff, 0xf8, 0xf9, 0x6b, 0xfd,
+	0xfb, 0xf4, 0xd1, 0xe0, 0x3a, 0x62, 0x3f, 0xfb, 0xdf, 0x01, 0x00, 0x07, 0x8b, 0x62, 0xe0, 0x2a,
+	0x2d, 0x00, 0x00,
+}
+
+func (m *ShardInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CrossClusterProcessingQueueStatesEncoding) > 0 {
+		i -= len(m.CrossClusterProcessingQueueStatesEncoding)
+		copy(dAtA[i:], m.CrossClusterProcessingQueueStatesEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CrossClusterProcessingQueueStatesEncoding)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.CrossClusterProcessingQueueStates) > 0 {
+		i -= len(m.CrossClusterProcessingQueueStates)
+		copy(dAtA[i:], m.CrossClusterProcessingQueueStates)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CrossClusterProcessingQueueStates)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.TimerProcessingQueueStatesEncoding) > 0 {
+		i -= len(m.TimerProcessingQueueStatesEncoding)
+		copy(dAtA[i:], m.TimerProcessingQueueStatesEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TimerProcessingQueueStatesEncoding)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.TimerProcessingQueueStates) > 0 {
+		i -= len(m.TimerProcessingQueueStates)
+		copy(dAtA[i:], m.TimerProcessingQueueStates)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TimerProcessingQueueStates)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.TransferProcessingQueueStatesEncoding) > 0 {
+		i -= len(m.TransferProcessingQueueStatesEncoding)
+		copy(dAtA[i:], m.TransferProcessingQueueStatesEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TransferProcessingQueueStatesEncoding)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.TransferProcessingQueueStates) > 0 {
+		i -= len(m.TransferProcessingQueueStates)
+		copy(dAtA[i:], m.TransferProcessingQueueStates)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TransferProcessingQueueStates)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ReplicationDlqAckLevel) > 0 {
+		for k := range m.ReplicationDlqAckLevel {
+			v := m.ReplicationDlqAckLevel[k]
+			baseI := i
+			i = encodeVarintSqlblobs(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingFailoverMarkersEncoding) > 0 {
+		i -= len(m.PendingFailoverMarkersEncoding)
+		copy(dAtA[i:], m.PendingFailoverMarkersEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.PendingFailoverMarkersEncoding)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PendingFailoverMarkers) > 0 {
+		i -= len(m.PendingFailoverMarkers)
+		copy(dAtA[i:], m.PendingFailoverMarkers)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.PendingFailoverMarkers)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ClusterReplicationLevel) > 0 {
+		for k := range m.ClusterReplicationLevel {
+			v := m.ClusterReplicationLevel[k]
+			baseI := i
+			i = encodeVarintSqlblobs(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClusterTimerAckLevel) > 0 {
+		for k := range m.ClusterTimerAckLevel {
+			v := m.ClusterTimerAckLevel[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClusterTransferAckLevel) > 0 {
+		for k := range m.ClusterTransferAckLevel {
+			v := m.ClusterTransferAckLevel[k]
+			baseI := i
+			i = encodeVarintSqlblobs(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DomainNotificationVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.DomainNotificationVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimerAckLevel != nil {
+		{
+			size, err := m.TimerAckLevel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TransferAckLevel != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.TransferAckLevel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReplicationAckLevel != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ReplicationAckLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StolenSinceRenew != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.StolenSinceRenew))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastUpdatedTime != nil {
+		{
+			size, err := m.LastUpdatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.PreviousFailoverVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.PreviousFailoverVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.FailoverEndTime != nil {
+		{
+			size, err := m.FailoverEndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.VisibilityArchivalUri) > 0 {
+		i -= len(m.VisibilityArchivalUri)
+		copy(dAtA[i:], m.VisibilityArchivalUri)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.VisibilityArchivalUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.VisibilityArchivalStatus != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.VisibilityArchivalStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.HistoryArchivalUri) > 0 {
+		i -= len(m.HistoryArchivalUri)
+		copy(dAtA[i:], m.HistoryArchivalUri)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.HistoryArchivalUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.HistoryArchivalStatus != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.HistoryArchivalStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.BadBinariesEncoding) > 0 {
+		i -= len(m.BadBinariesEncoding)
+		copy(dAtA[i:], m.BadBinariesEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.BadBinariesEncoding)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.BadBinaries) > 0 {
+		i -= len(m.BadBinaries)
+		copy(dAtA[i:], m.BadBinaries)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.BadBinaries)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ActiveClusterName) > 0 {
+		i -= len(m.ActiveClusterName)
+		copy(dAtA[i:], m.ActiveClusterName)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ActiveClusterName)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.FailoverVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.FailoverVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FailoverNotificationVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.FailoverNotificationVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NotificationVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.NotificationVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ConfigVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ConfigVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ArchivalStatus != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ArchivalStatus))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ArchivalBucket) > 0 {
+		i -= len(m.ArchivalBucket)
+		copy(dAtA[i:], m.ArchivalBucket)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ArchivalBucket)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EmitMetric {
+		i--
+		if m.EmitMetric {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Retention != nil {
+		{
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryBranchRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryBranchRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryBranchRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndNodeId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.EndNodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeginNodeId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.BeginNodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryTreeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTreeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTreeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ancestors) > 0 {
+		for iNdEx := len(m.Ancestors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ancestors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CreatedTime != nil {
+		{
+			size, err := m.CreatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.VersionHistoriesEncoding) > 0 {
+		i -= len(m.VersionHistoriesEncoding)
+		copy(dAtA[i:], m.VersionHistoriesEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.VersionHistoriesEncoding)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.VersionHistories) > 0 {
+		i -= len(m.VersionHistories)
+		copy(dAtA[i:], m.VersionHistories)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.VersionHistories)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Memo) > 0 {
+		for k := range m.Memo {
+			v := m.Memo[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintSqlblobs(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.SearchAttributes) > 0 {
+		for k := range m.SearchAttributes {
+			v := m.SearchAttributes[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintSqlblobs(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSqlblobs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.AutoResetPointsEncoding) > 0 {
+		i -= len(m.AutoResetPointsEncoding)
+		copy(dAtA[i:], m.AutoResetPointsEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.AutoResetPointsEncoding)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.AutoResetPoints) > 0 {
+		i -= len(m.AutoResetPoints)
+		copy(dAtA[i:], m.AutoResetPoints)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.AutoResetPoints)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ClientImpl) > 0 {
+		i -= len(m.ClientImpl)
+		copy(dAtA[i:], m.ClientImpl)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ClientImpl)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ClientFeatureVersion) > 0 {
+		i -= len(m.ClientFeatureVersion)
+		copy(dAtA[i:], m.ClientFeatureVersion)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ClientFeatureVersion)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ClientLibraryVersion) > 0 {
+		i -= len(m.ClientLibraryVersion)
+		copy(dAtA[i:], m.ClientLibraryVersion)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ClientLibraryVersion)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.HistorySize != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SignalCount != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.SignalCount))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.EventBranchToken) > 0 {
+		i -= len(m.EventBranchToken)
+		copy(dAtA[i:], m.EventBranchToken)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.EventBranchToken)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.CronSchedule) > 0 {
+		i -= len(m.CronSchedule)
+		copy(dAtA[i:], m.CronSchedule)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CronSchedule)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.HasRetryPolicy {
+		i--
+		if m.HasRetryPolicy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.RetryNonRetryableErrors) > 0 {
+		for iNdEx := len(m.RetryNonRetryableErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryNonRetryableErrors[iNdEx])
+			copy(dAtA[i:], m.RetryNonRetryableErrors[iNdEx])
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RetryNonRetryableErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.RetryExpirationTime != nil {
+		{
+			size, err := m.RetryExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.RetryBackoffCoefficient != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RetryBackoffCoefficient))))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc9
+	}
+	if m.RetryExpiration != nil {
+		{
+			size, err := m.RetryExpiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.RetryMaximumAttempts != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.RetryMaximumAttempts))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.RetryMaximumInterval != nil {
+		{
+			size, err := m.RetryMaximumInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.RetryInitialInterval != nil {
+		{
+			size, err := m.RetryInitialInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.RetryAttempt != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.RetryAttempt))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.StickyScheduleToStartTimeout != nil {
+		{
+			size, err := m.StickyScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.StickyTaskList) > 0 {
+		i -= len(m.StickyTaskList)
+		copy(dAtA[i:], m.StickyTaskList)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StickyTaskList)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CancelRequestId) > 0 {
+		i -= len(m.CancelRequestId)
+		copy(dAtA[i:], m.CancelRequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CancelRequestId)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.DecisionRequestId) > 0 {
+		i -= len(m.DecisionRequestId)
+		copy(dAtA[i:], m.DecisionRequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.DecisionRequestId)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CreateRequestId) > 0 {
+		i -= len(m.CreateRequestId)
+		copy(dAtA[i:], m.CreateRequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CreateRequestId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.DecisionOriginalScheduledTimestamp != nil {
+		{
+			size, err := m.DecisionOriginalScheduledTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.CancelRequested {
+		i--
+		if m.CancelRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.DecisionScheduledTimestamp != nil {
+		{
+			size, err := m.DecisionScheduledTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.DecisionStartedTimestamp != nil {
+		{
+			size, err := m.DecisionStartedTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.DecisionAttempt != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.DecisionAttempt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.DecisionTimeout != nil {
+		{
+			size, err := m.DecisionTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.DecisionStartedId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.DecisionStartedId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.DecisionScheduleId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.DecisionScheduleId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.DecisionVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.DecisionVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.LastUpdatedTime != nil {
+		{
+			size, err := m.LastUpdatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.LastProcessedEvent != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.LastProcessedEvent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.LastFirstEventId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastEventTaskId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.LastEventTaskId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastWriteEventId != nil {
+		{
+			size, err := m.LastWriteEventId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.StartVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.StartVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CloseStatus != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.CloseStatus))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.State != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ExecutionContext) > 0 {
+		i -= len(m.ExecutionContext)
+		copy(dAtA[i:], m.ExecutionContext)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ExecutionContext)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DecisionTaskTimeout != nil {
+		{
+			size, err := m.DecisionTaskTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.WorkflowTimeout != nil {
+		{
+			size, err := m.WorkflowTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TaskList) > 0 {
+		i -= len(m.TaskList)
+		copy(dAtA[i:], m.TaskList)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TaskList)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CompletionEventEncoding) > 0 {
+		i -= len(m.CompletionEventEncoding)
+		copy(dAtA[i:], m.CompletionEventEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CompletionEventEncoding)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CompletionEvent) > 0 {
+		i -= len(m.CompletionEvent)
+		copy(dAtA[i:], m.CompletionEvent)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CompletionEvent)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CompletionEventBatchId != nil {
+		{
+			size, err := m.CompletionEventBatchId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParentRunId) > 0 {
+		i -= len(m.ParentRunId)
+		copy(dAtA[i:], m.ParentRunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ParentRunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentWorkflowId) > 0 {
+		i -= len(m.ParentWorkflowId)
+		copy(dAtA[i:], m.ParentWorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ParentWorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParentDomainId) > 0 {
+		i -= len(m.ParentDomainId)
+		copy(dAtA[i:], m.ParentDomainId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ParentDomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RetryLastFailureDetails) > 0 {
+		i -= len(m.RetryLastFailureDetails)
+		copy(dAtA[i:], m.RetryLastFailureDetails)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RetryLastFailureDetails)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.RetryLastWorkerIdentity) > 0 {
+		i -= len(m.RetryLastWorkerIdentity)
+		copy(dAtA[i:], m.RetryLastWorkerIdentity)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RetryLastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.RetryLastFailureReason) > 0 {
+		i -= len(m.RetryLastFailureReason)
+		copy(dAtA[i:], m.RetryLastFailureReason)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RetryLastFailureReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.RetryNonRetryableErrors) > 0 {
+		for iNdEx := len(m.RetryNonRetryableErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryNonRetryableErrors[iNdEx])
+			copy(dAtA[i:], m.RetryNonRetryableErrors[iNdEx])
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RetryNonRetryableErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.RetryBackoffCoefficient != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RetryBackoffCoefficient))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd9
+	}
+	if m.RetryExpirationTime != nil {
+		{
+			size, err := m.RetryExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.RetryMaximumAttempts != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.RetryMaximumAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.RetryMaximumInterval != nil {
+		{
+			size, err := m.RetryMaximumInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.RetryInitialInterval != nil {
+		{
+			size, err := m.RetryInitialInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HasRetryPolicy {
+		i--
+		if m.HasRetryPolicy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.StartedIdentity) > 0 {
+		i -= len(m.StartedIdentity)
+		copy(dAtA[i:], m.StartedIdentity)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedIdentity)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.TaskList) > 0 {
+		i -= len(m.TaskList)
+		copy(dAtA[i:], m.TaskList)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TaskList)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.TimerTaskStatus != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.TimerTaskStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.CancelRequestId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.CancelRequestId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.CancelRequested {
+		i--
+		if m.CancelRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HeartbeatTimeout != nil {
+		{
+			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		{
+			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StartedEventEncoding) > 0 {
+		i -= len(m.StartedEventEncoding)
+		copy(dAtA[i:], m.StartedEventEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedEventEncoding)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StartedEvent) > 0 {
+		i -= len(m.StartedEvent)
+		copy(dAtA[i:], m.StartedEvent)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedEvent)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ScheduledEventEncoding) > 0 {
+		i -= len(m.ScheduledEventEncoding)
+		copy(dAtA[i:], m.ScheduledEventEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ScheduledEventEncoding)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScheduledEvent) > 0 {
+		i -= len(m.ScheduledEvent)
+		copy(dAtA[i:], m.ScheduledEvent)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.ScheduledEvent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScheduledEventBatchId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ScheduledEventBatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildExecutionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ParentClosePolicy != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ParentClosePolicy))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DomainName) > 0 {
+		i -= len(m.DomainName)
+		copy(dAtA[i:], m.DomainName)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.DomainName)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreateRequestId) > 0 {
+		i -= len(m.CreateRequestId)
+		copy(dAtA[i:], m.CreateRequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CreateRequestId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.StartedEventEncoding) > 0 {
+		i -= len(m.StartedEventEncoding)
+		copy(dAtA[i:], m.StartedEventEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedEventEncoding)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StartedEvent) > 0 {
+		i -= len(m.StartedEvent)
+		copy(dAtA[i:], m.StartedEvent)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedEvent)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StartedRunId) > 0 {
+		i -= len(m.StartedRunId)
+		copy(dAtA[i:], m.StartedRunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedRunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartedWorkflowId) > 0 {
+		i -= len(m.StartedWorkflowId)
+		copy(dAtA[i:], m.StartedWorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.StartedWorkflowId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InitiatedEventEncoding) > 0 {
+		i -= len(m.InitiatedEventEncoding)
+		copy(dAtA[i:], m.InitiatedEventEncoding)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.InitiatedEventEncoding)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InitiatedEvent) > 0 {
+		i -= len(m.InitiatedEvent)
+		copy(dAtA[i:], m.InitiatedEvent)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.InitiatedEvent)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitiatedEventBatchId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.InitiatedEventBatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitiatedEventBatchId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.InitiatedEventBatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CancelRequestId) > 0 {
+		i -= len(m.CancelRequestId)
+		copy(dAtA[i:], m.CancelRequestId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.CancelRequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitiatedEventBatchId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.InitiatedEventBatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiryTime != nil {
+		{
+			size, err := m.ExpiryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreatedTime != nil {
+		{
+			size, err := m.CreatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryTime != nil {
+		{
+			size, err := m.ExpiryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskListInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastUpdated != nil {
+		{
+			size, err := m.LastUpdated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiryTime != nil {
+		{
+			size, err := m.ExpiryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AckLevel != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.AckLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TargetDomainIds) > 0 {
+		for iNdEx := len(m.TargetDomainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetDomainIds[iNdEx])
+			copy(dAtA[i:], m.TargetDomainIds[iNdEx])
+			i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TargetDomainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.VisibilityTimestamp != nil {
+		{
+			size, err := m.VisibilityTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TargetChildWorkflowOnly {
+		i--
+		if m.TargetChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TaskList) > 0 {
+		i -= len(m.TaskList)
+		copy(dAtA[i:], m.TaskList)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TaskList)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TargetRunId) > 0 {
+		i -= len(m.TargetRunId)
+		copy(dAtA[i:], m.TargetRunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TargetRunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TargetWorkflowId) > 0 {
+		i -= len(m.TargetWorkflowId)
+		copy(dAtA[i:], m.TargetWorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TargetWorkflowId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetDomainId) > 0 {
+		i -= len(m.TargetDomainId)
+		copy(dAtA[i:], m.TargetDomainId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.TargetDomainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimerTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimerTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EventId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.EventId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ScheduleAttempt != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ScheduleAttempt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutType != nil {
+		{
+			size, err := m.TimeoutType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreationTime != nil {
+		{
+			size, err := m.CreationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSqlblobs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.NewRunBranchToken) > 0 {
+		i -= len(m.NewRunBranchToken)
+		copy(dAtA[i:], m.NewRunBranchToken)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.NewRunBranchToken)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.NewRunEventStoreVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.NewRunEventStoreVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ScheduledId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FirstEventId != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Version != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintSqlblobs(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintSqlblobs(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSqlblobs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSqlblobs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ShardInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StolenSinceRenew != 0 {
+		n += 1 + sovSqlblobs(uint64(m.StolenSinceRenew))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ReplicationAckLevel != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ReplicationAckLevel))
+	}
+	if m.TransferAckLevel != 0 {
+		n += 1 + sovSqlblobs(uint64(m.TransferAckLevel))
+	}
+	if m.TimerAckLevel != nil {
+		l = m.TimerAckLevel.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.DomainNotificationVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.DomainNotificationVersion))
+	}
+	if len(m.ClusterTransferAckLevel) > 0 {
+		for k, v := range m.ClusterTransferAckLevel {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + 1 + sovSqlblobs(uint64(v))
+			n += mapEntrySize + 1 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ClusterTimerAckLevel) > 0 {
+		for k, v := range m.ClusterTimerAckLevel {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSqlblobs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.ClusterReplicationLevel) > 0 {
+		for k, v := range m.ClusterReplicationLevel {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + 1 + sovSqlblobs(uint64(v))
+			n += mapEntrySize + 1 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PendingFailoverMarkers)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.PendingFailoverMarkersEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.ReplicationDlqAckLevel) > 0 {
+		for k, v := range m.ReplicationDlqAckLevel {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + 1 + sovSqlblobs(uint64(v))
+			n += mapEntrySize + 1 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.TransferProcessingQueueStates)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TransferProcessingQueueStatesEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TimerProcessingQueueStates)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TimerProcessingQueueStatesEncoding)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CrossClusterProcessingQueueStates)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CrossClusterProcessingQueueStatesEncoding)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Status))
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.EmitMetric {
+		n += 2
+	}
+	l = len(m.ArchivalBucket)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ArchivalStatus != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ArchivalStatus))
+	}
+	if m.ConfigVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ConfigVersion))
+	}
+	if m.NotificationVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.NotificationVersion))
+	}
+	if m.FailoverNotificationVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.FailoverNotificationVersion))
+	}
+	if m.FailoverVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.FailoverVersion))
+	}
+	l = len(m.ActiveClusterName)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovSqlblobs(uint64(l))
+		}
+	}
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + 1 + len(v) + sovSqlblobs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.BadBinaries)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.BadBinariesEncoding)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.HistoryArchivalStatus != 0 {
+		n += 2 + sovSqlblobs(uint64(m.HistoryArchivalStatus))
+	}
+	l = len(m.HistoryArchivalUri)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.VisibilityArchivalStatus != 0 {
+		n += 2 + sovSqlblobs(uint64(m.VisibilityArchivalStatus))
+	}
+	l = len(m.VisibilityArchivalUri)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.FailoverEndTime != nil {
+		l = m.FailoverEndTime.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.PreviousFailoverVersion != 0 {
+		n += 2 + sovSqlblobs(uint64(m.PreviousFailoverVersion))
+	}
+	if m.LastUpdatedTime != nil {
+		l = m.LastUpdatedTime.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryBranchRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.BeginNodeId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.BeginNodeId))
+	}
+	if m.EndNodeId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.EndNodeId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryTreeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedTime != nil {
+		l = m.CreatedTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.Ancestors) > 0 {
+		for _, e := range m.Ancestors {
+			l = e.Size()
+			n += 1 + l + sovSqlblobs(uint64(l))
+		}
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParentDomainId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ParentWorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ParentRunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.InitiatedId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.InitiatedId))
+	}
+	if m.CompletionEventBatchId != nil {
+		l = m.CompletionEventBatchId.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CompletionEvent)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CompletionEventEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TaskList)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.WorkflowTimeout != nil {
+		l = m.WorkflowTimeout.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.DecisionTaskTimeout != nil {
+		l = m.DecisionTaskTimeout.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ExecutionContext)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovSqlblobs(uint64(m.State))
+	}
+	if m.CloseStatus != 0 {
+		n += 1 + sovSqlblobs(uint64(m.CloseStatus))
+	}
+	if m.StartVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.StartVersion))
+	}
+	if m.LastWriteEventId != nil {
+		l = m.LastWriteEventId.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.LastEventTaskId != 0 {
+		n += 2 + sovSqlblobs(uint64(m.LastEventTaskId))
+	}
+	if m.LastFirstEventId != 0 {
+		n += 2 + sovSqlblobs(uint64(m.LastFirstEventId))
+	}
+	if m.LastProcessedEvent != 0 {
+		n += 2 + sovSqlblobs(uint64(m.LastProcessedEvent))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.LastUpdatedTime != nil {
+		l = m.LastUpdatedTime.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.DecisionVersion != 0 {
+		n += 2 + sovSqlblobs(uint64(m.DecisionVersion))
+	}
+	if m.DecisionScheduleId != 0 {
+		n += 2 + sovSqlblobs(uint64(m.DecisionScheduleId))
+	}
+	if m.DecisionStartedId != 0 {
+		n += 2 + sovSqlblobs(uint64(m.DecisionStartedId))
+	}
+	if m.DecisionTimeout != nil {
+		l = m.DecisionTimeout.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.DecisionAttempt != 0 {
+		n += 2 + sovSqlblobs(uint64(m.DecisionAttempt))
+	}
+	if m.DecisionStartedTimestamp != nil {
+		l = m.DecisionStartedTimestamp.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.DecisionScheduledTimestamp != nil {
+		l = m.DecisionScheduledTimestamp.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.CancelRequested {
+		n += 3
+	}
+	if m.DecisionOriginalScheduledTimestamp != nil {
+		l = m.DecisionOriginalScheduledTimestamp.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CreateRequestId)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.DecisionRequestId)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CancelRequestId)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StickyTaskList)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.StickyScheduleToStartTimeout != nil {
+		l = m.StickyScheduleToStartTimeout.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryAttempt != 0 {
+		n += 2 + sovSqlblobs(uint64(m.RetryAttempt))
+	}
+	if m.RetryInitialInterval != nil {
+		l = m.RetryInitialInterval.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryMaximumInterval != nil {
+		l = m.RetryMaximumInterval.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryMaximumAttempts != 0 {
+		n += 2 + sovSqlblobs(uint64(m.RetryMaximumAttempts))
+	}
+	if m.RetryExpiration != nil {
+		l = m.RetryExpiration.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryBackoffCoefficient != 0 {
+		n += 10
+	}
+	if m.RetryExpirationTime != nil {
+		l = m.RetryExpirationTime.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.RetryNonRetryableErrors) > 0 {
+		for _, s := range m.RetryNonRetryableErrors {
+			l = len(s)
+			n += 2 + l + sovSqlblobs(uint64(l))
+		}
+	}
+	if m.HasRetryPolicy {
+		n += 3
+	}
+	l = len(m.CronSchedule)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 2 + sovSqlblobs(uint64(m.EventStoreVersion))
+	}
+	l = len(m.EventBranchToken)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.SignalCount != 0 {
+		n += 2 + sovSqlblobs(uint64(m.SignalCount))
+	}
+	if m.HistorySize != 0 {
+		n += 2 + sovSqlblobs(uint64(m.HistorySize))
+	}
+	l = len(m.ClientLibraryVersion)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ClientFeatureVersion)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ClientImpl)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.AutoResetPoints)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.AutoResetPointsEncoding)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.SearchAttributes) > 0 {
+		for k, v := range m.SearchAttributes {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovSqlblobs(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Memo) > 0 {
+		for k, v := range m.Memo {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovSqlblobs(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovSqlblobs(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovSqlblobs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.VersionHistories)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.VersionHistoriesEncoding)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.ScheduledEventBatchId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ScheduledEventBatchId))
+	}
+	l = len(m.ScheduledEvent)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ScheduledEventEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.StartedId))
+	}
+	l = len(m.StartedEvent)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StartedEventEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.CancelRequested {
+		n += 3
+	}
+	if m.CancelRequestId != 0 {
+		n += 2 + sovSqlblobs(uint64(m.CancelRequestId))
+	}
+	if m.TimerTaskStatus != 0 {
+		n += 2 + sovSqlblobs(uint64(m.TimerTaskStatus))
+	}
+	if m.Attempt != 0 {
+		n += 2 + sovSqlblobs(uint64(m.Attempt))
+	}
+	l = len(m.TaskList)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StartedIdentity)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.HasRetryPolicy {
+		n += 3
+	}
+	if m.RetryInitialInterval != nil {
+		l = m.RetryInitialInterval.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryMaximumInterval != nil {
+		l = m.RetryMaximumInterval.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryMaximumAttempts != 0 {
+		n += 2 + sovSqlblobs(uint64(m.RetryMaximumAttempts))
+	}
+	if m.RetryExpirationTime != nil {
+		l = m.RetryExpirationTime.Size()
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.RetryBackoffCoefficient != 0 {
+		n += 10
+	}
+	if len(m.RetryNonRetryableErrors) > 0 {
+		for _, s := range m.RetryNonRetryableErrors {
+			l = len(s)
+			n += 2 + l + sovSqlblobs(uint64(l))
+		}
+	}
+	l = len(m.RetryLastFailureReason)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RetryLastWorkerIdentity)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RetryLastFailureDetails)
+	if l > 0 {
+		n += 2 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildExecutionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.InitiatedEventBatchId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.InitiatedEventBatchId))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.StartedId))
+	}
+	l = len(m.InitiatedEvent)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.InitiatedEventEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StartedWorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StartedRunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StartedEvent)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.StartedEventEncoding)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.CreateRequestId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.DomainName)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ParentClosePolicy != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ParentClosePolicy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.InitiatedEventBatchId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.InitiatedEventBatchId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.InitiatedEventBatchId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.InitiatedEventBatchId))
+	}
+	l = len(m.CancelRequestId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.StartedId))
+	}
+	if m.ExpiryTime != nil {
+		l = m.ExpiryTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.TaskId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ScheduleId))
+	}
+	if m.ExpiryTime != nil {
+		l = m.ExpiryTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.CreatedTime != nil {
+		l = m.CreatedTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Kind))
+	}
+	if m.AckLevel != 0 {
+		n += 1 + sovSqlblobs(uint64(m.AckLevel))
+	}
+	if m.ExpiryTime != nil {
+		l = m.ExpiryTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.LastUpdated != nil {
+		l = m.LastUpdated.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovSqlblobs(uint64(m.TaskType))
+	}
+	l = len(m.TargetDomainId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TargetWorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TargetRunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.TaskList)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.TargetChildWorkflowOnly {
+		n += 2
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ScheduleId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.VisibilityTimestamp != nil {
+		l = m.VisibilityTimestamp.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if len(m.TargetDomainIds) > 0 {
+		for _, b := range m.TargetDomainIds {
+			l = len(b)
+			n += 1 + l + sovSqlblobs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimerTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovSqlblobs(uint64(m.TaskType))
+	}
+	if m.TimeoutType != nil {
+		l = m.TimeoutType.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.ScheduleAttempt != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ScheduleAttempt))
+	}
+	if m.EventId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.EventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovSqlblobs(uint64(m.TaskType))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSqlblobs(uint64(m.Version))
+	}
+	if m.FirstEventId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.FirstEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.NextEventId))
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovSqlblobs(uint64(m.ScheduledId))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.EventStoreVersion))
+	}
+	if m.NewRunEventStoreVersion != 0 {
+		n += 1 + sovSqlblobs(uint64(m.NewRunEventStoreVersion))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	l = len(m.NewRunBranchToken)
+	if l > 0 {
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.CreationTime != nil {
+		l = m.CreationTime.Size()
+		n += 1 + l + sovSqlblobs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSqlblobs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSqlblobs(x uint64) (n int) {
+	return sovSqlblobs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ShardInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StolenSinceRenew", wireType)
+			}
+			m.StolenSinceRenew = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StolenSinceRenew |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationAckLevel", wireType)
+			}
+			m.ReplicationAckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationAckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAckLevel", wireType)
+			}
+			m.TransferAckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferAckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerAckLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimerAckLevel == nil {
+				m.TimerAckLevel = &types.Timestamp{}
+			}
+			if err := m.TimerAckLevel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainNotificationVersion", wireType)
+			}
+			m.DomainNotificationVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainNotificationVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterTransferAckLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterTransferAckLevel == nil {
+				m.ClusterTransferAckLevel = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterTransferAckLevel[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterTimerAckLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterTimerAckLevel == nil {
+				m.ClusterTimerAckLevel = make(map[string]*types.Timestamp)
+			}
+			var mapkey string
+			var mapvalue *types.Timestamp
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Timestamp{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterTimerAckLevel[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterReplicationLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterReplicationLevel == nil {
+				m.ClusterReplicationLevel = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterReplicationLevel[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFailoverMarkers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFailoverMarkers = append(m.PendingFailoverMarkers[:0], dAtA[iNdEx:postIndex]...)
+			if m.PendingFailoverMarkers == nil {
+				m.PendingFailoverMarkers = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFailoverMarkersEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFailoverMarkersEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationDlqAckLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplicationDlqAckLevel == nil {
+				m.ReplicationDlqAckLevel = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ReplicationDlqAckLevel[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferProcessingQueueStates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferProcessingQueueStates = append(m.TransferProcessingQueueStates[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransferProcessingQueueStates == nil {
+				m.TransferProcessingQueueStates = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferProcessingQueueStatesEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferProcessingQueueStatesEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerProcessingQueueStates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerProcessingQueueStates = append(m.TimerProcessingQueueStates[:0], dAtA[iNdEx:postIndex]...)
+			if m.TimerProcessingQueueStates == nil {
+				m.TimerProcessingQueueStates = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerProcessingQueueStatesEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerProcessingQueueStatesEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossClusterProcessingQueueStates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossClusterProcessingQueueStates = append(m.CrossClusterProcessingQueueStates[:0], dAtA[iNdEx:postIndex]...)
+			if m.CrossClusterProcessingQueueStates == nil {
+				m.CrossClusterProcessingQueueStates = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossClusterProcessingQueueStatesEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossClusterProcessingQueueStatesEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &types.Duration{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitMetric", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitMetric = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivalBucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivalBucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivalStatus", wireType)
+			}
+			m.ArchivalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivalStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
+			}
+			m.ConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationVersion", wireType)
+			}
+			m.NotificationVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotificationVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverNotificationVersion", wireType)
+			}
+			m.FailoverNotificationVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverNotificationVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
+			}
+			m.FailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Data[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadBinaries", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadBinaries = append(m.BadBinaries[:0], dAtA[iNdEx:postIndex]...)
+			if m.BadBinaries == nil {
+				m.BadBinaries = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadBinariesEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadBinariesEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryArchivalStatus", wireType)
+			}
+			m.HistoryArchivalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryArchivalStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryArchivalUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryArchivalUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityArchivalStatus", wireType)
+			}
+			m.VisibilityArchivalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VisibilityArchivalStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityArchivalUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VisibilityArchivalUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailoverEndTime == nil {
+				m.FailoverEndTime = &types.Timestamp{}
+			}
+			if err := m.FailoverEndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFailoverVersion", wireType)
+			}
+			m.PreviousFailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousFailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdatedTime == nil {
+				m.LastUpdatedTime = &types.Timestamp{}
+			}
+			if err := m.LastUpdatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryBranchRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryBranchRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryBranchRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginNodeId", wireType)
+			}
+			m.BeginNodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginNodeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndNodeId", wireType)
+			}
+			m.EndNodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndNodeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryTreeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryTreeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryTreeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedTime == nil {
+				m.CreatedTime = &types.Timestamp{}
+			}
+			if err := m.CreatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ancestors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ancestors = append(m.Ancestors, &HistoryBranchRange{})
+			if err := m.Ancestors[len(m.Ancestors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentDomainId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentDomainId = append(m.ParentDomainId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentDomainId == nil {
+				m.ParentDomainId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentWorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentWorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRunId = append(m.ParentRunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRunId == nil {
+				m.ParentRunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			}
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEventBatchId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionEventBatchId == nil {
+				m.CompletionEventBatchId = &types.Int64Value{}
+			}
+			if err := m.CompletionEventBatchId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionEvent = append(m.CompletionEvent[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEventEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionEventEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTimeout == nil {
+				m.WorkflowTimeout = &types.Duration{}
+			}
+			if err := m.WorkflowTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionTaskTimeout == nil {
+				m.DecisionTaskTimeout = &types.Duration{}
+			}
+			if err := m.DecisionTaskTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionContext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionContext = append(m.ExecutionContext[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionContext == nil {
+				m.ExecutionContext = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseStatus", wireType)
+			}
+			m.CloseStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+			}
+			m.StartVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWriteEventId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastWriteEventId == nil {
+				m.LastWriteEventId = &types.Int64Value{}
+			}
+			if err := m.LastWriteEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventTaskId", wireType)
+			}
+			m.LastEventTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedEvent", wireType)
+			}
+			m.LastProcessedEvent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProcessedEvent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdatedTime == nil {
+				m.LastUpdatedTime = &types.Timestamp{}
+			}
+			if err := m.LastUpdatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionVersion", wireType)
+			}
+			m.DecisionVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionScheduleId", wireType)
+			}
+			m.DecisionScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionStartedId", wireType)
+			}
+			m.DecisionStartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionStartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionTimeout == nil {
+				m.DecisionTimeout = &types.Duration{}
+			}
+			if err := m.DecisionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionAttempt", wireType)
+			}
+			m.DecisionAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionAttempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionStartedTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionStartedTimestamp == nil {
+				m.DecisionStartedTimestamp = &types.Timestamp{}
+			}
+			if err := m.DecisionStartedTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionScheduledTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionScheduledTimestamp == nil {
+				m.DecisionScheduledTimestamp = &types.Timestamp{}
+			}
+			if err := m.DecisionScheduledTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelRequested = bool(v != 0)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionOriginalScheduledTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionOriginalScheduledTimestamp == nil {
+				m.DecisionOriginalScheduledTimestamp = &types.Timestamp{}
+			}
+			if err := m.DecisionOriginalScheduledTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionRequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecisionRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StickyTaskList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyScheduleToStartTimeout == nil {
+				m.StickyScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.StickyScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAttempt", wireType)
+			}
+			m.RetryAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAttempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInitialInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryInitialInterval == nil {
+				m.RetryInitialInterval = &types.Duration{}
+			}
+			if err := m.RetryInitialInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryMaximumInterval == nil {
+				m.RetryMaximumInterval = &types.Duration{}
+			}
+			if err := m.RetryMaximumInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumAttempts", wireType)
+			}
+			m.RetryMaximumAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryMaximumAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpiration == nil {
+				m.RetryExpiration = &types.Duration{}
+			}
+			if err := m.RetryExpiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffCoefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RetryBackoffCoefficient = float64(math.Float64frombits(v))
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = &types.Timestamp{}
+			}
+			if err := m.RetryExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryNonRetryableErrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryNonRetryableErrors = append(m.RetryNonRetryableErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRetryPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRetryPolicy = bool(v != 0)
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBranchToken = append(m.EventBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventBranchToken == nil {
+				m.EventBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalCount", wireType)
+			}
+			m.SignalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResetPoints", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoResetPoints = append(m.AutoResetPoints[:0], dAtA[iNdEx:postIndex]...)
+			if m.AutoResetPoints == nil {
+				m.AutoResetPoints = []byte{}
+			}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResetPointsEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoResetPointsEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSqlblobs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSqlblobs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSqlblobs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSqlblobs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Memo[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionHistories = append(m.VersionHistories[:0], dAtA[iNdEx:postIndex]...)
+			if m.VersionHistories == nil {
+				m.VersionHistories = []byte{}
+			}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoriesEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionHistoriesEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventBatchId", wireType)
+			}
+			m.ScheduledEventBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventBatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledEvent = append(m.ScheduledEvent[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledEventEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEvent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedEvent = append(m.StartedEvent[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartedEvent == nil {
+				m.StartedEvent = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedEventEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
+			}
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelRequested = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequestId", wireType)
+			}
+			m.CancelRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelRequestId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerTaskStatus", wireType)
+			}
+			m.TimerTaskStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimerTaskStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedIdentity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRetryPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRetryPolicy = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInitialInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryInitialInterval == nil {
+				m.RetryInitialInterval = &types.Duration{}
+			}
+			if err := m.RetryInitialInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryMaximumInterval == nil {
+				m.RetryMaximumInterval = &types.Duration{}
+			}
+			if err := m.RetryMaximumInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumAttempts", wireType)
+			}
+			m.RetryMaximumAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryMaximumAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = &types.Timestamp{}
+			}
+			if err := m.RetryExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffCoefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RetryBackoffCoefficient = float64(math.Float64frombits(v))
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryNonRetryableErrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryNonRetryableErrors = append(m.RetryNonRetryableErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryLastFailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryLastFailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryLastWorkerIdentity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryLastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryLastFailureDetails", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryLastFailureDetails = append(m.RetryLastFailureDetails[:0], dAtA[iNdEx:postIndex]...)
+			if m.RetryLastFailureDetails == nil {
+				m.RetryLastFailureDetails = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildExecutionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildExecutionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventBatchId", wireType)
+			}
+			m.InitiatedEventBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventBatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEvent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitiatedEvent = append(m.InitiatedEvent[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitiatedEvent == nil {
+				m.InitiatedEvent = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitiatedEventEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedWorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedWorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedRunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedRunId = append(m.StartedRunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartedRunId == nil {
+				m.StartedRunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEvent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedEvent = append(m.StartedEvent[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartedEvent == nil {
+				m.StartedEvent = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedEventEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentClosePolicy", wireType)
+			}
+			m.ParentClosePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentClosePolicy |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventBatchId", wireType)
+			}
+			m.InitiatedEventBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventBatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventBatchId", wireType)
+			}
+			m.InitiatedEventBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventBatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = &types.Timestamp{}
+			}
+			if err := m.ExpiryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = append(m.RunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RunId == nil {
+				m.RunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = &types.Timestamp{}
+			}
+			if err := m.ExpiryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedTime == nil {
+				m.CreatedTime = &types.Timestamp{}
+			}
+			if err := m.CreatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskListInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
+			}
+			m.AckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = &types.Timestamp{}
+			}
+			if err := m.ExpiryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdated == nil {
+				m.LastUpdated = &types.Timestamp{}
+			}
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = append(m.DomainId[:0], dAtA[iNdEx:postIndex]...)
+			if m.DomainId == nil {
+				m.DomainId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = append(m.RunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RunId == nil {
+				m.RunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDomainId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDomainId = append(m.TargetDomainId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TargetDomainId == nil {
+				m.TargetDomainId = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetWorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRunId = append(m.TargetRunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TargetRunId == nil {
+				m.TargetRunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetChildWorkflowOnly = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTimestamp == nil {
+				m.VisibilityTimestamp = &types.Timestamp{}
+			}
+			if err := m.VisibilityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDomainIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDomainIds = append(m.TargetDomainIds, make([]byte, postIndex-iNdEx))
+			copy(m.TargetDomainIds[len(m.TargetDomainIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimerTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimerTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = append(m.DomainId[:0], dAtA[iNdEx:postIndex]...)
+			if m.DomainId == nil {
+				m.DomainId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = append(m.RunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RunId == nil {
+				m.RunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutType == nil {
+				m.TimeoutType = &types.Int32Value{}
+			}
+			if err := m.TimeoutType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleAttempt", wireType)
+			}
+			m.ScheduleAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleAttempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = append(m.DomainId[:0], dAtA[iNdEx:postIndex]...)
+			if m.DomainId == nil {
+				m.DomainId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = append(m.RunId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RunId == nil {
+				m.RunId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+			}
+			m.FirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEventStoreVersion", wireType)
+			}
+			m.NewRunEventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRunEventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRunBranchToken = append(m.NewRunBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewRunBranchToken == nil {
+				m.NewRunBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTime == nil {
+				m.CreationTime = &types.Timestamp{}
+			}
+			if err := m.CreationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSqlblobs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSqlblobs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSqlblobs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSqlblobs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSqlblobs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSqlblobs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSqlblobs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSqlblobs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSqlblobs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSqlblobs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSqlblobs = fmt.Errorf("proto: unexpected end of group")
+)
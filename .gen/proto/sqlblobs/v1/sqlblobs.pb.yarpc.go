@@ -0,0 +1,299 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/sqlblobs/v1/sqlblobs.proto
+
+package sqlblobsv1
+
+var yarpcFileDescriptorClosure6bf8356e38665f8b = [][]byte{
+	// uber/cadence/sqlblobs/v1/sqlblobs.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x5d, 0x73, 0x1b, 0x47,
+		0x72, 0x05, 0x91, 0x94, 0x88, 0x06, 0x48, 0x80, 0xcb, 0xaf, 0x25, 0xa8, 0x0f, 0x0a, 0xb6, 0x4e,
+		0x94, 0xed, 0x03, 0x2d, 0xd9, 0xb1, 0x2c, 0xeb, 0x7c, 0x17, 0x91, 0xa2, 0x72, 0x70, 0x59, 0xb2,
+		0xb2, 0xe4, 0xd9, 0x57, 0xa9, 0x54, 0x6d, 0x2d, 0x76, 0x87, 0xe4, 0x14, 0x17, 0xbb, 0xd0, 0xee,
+		0x80, 0x14, 0x5d, 0xc9, 0x7f, 0x48, 0xf2, 0x98, 0x3c, 0xe5, 0x31, 0x6f, 0xc9, 0x73, 0xde, 0x92,
+		0x1f, 0x92, 0xaa, 0xfc, 0x92, 0xd4, 0x74, 0xcf, 0xcc, 0x7e, 0x60, 0x49, 0x88, 0xb9, 0xbb, 0xba,
+		0x37, 0xa0, 0xbf, 0xa6, 0xa7, 0xa7, 0xa7, 0xbf, 0x66, 0xe1, 0xe1, 0x78, 0xc0, 0x92, 0x1d, 0xdf,
+		0x0b, 0x58, 0xe4, 0xb3, 0x9d, 0xf4, 0x5d, 0x38, 0x08, 0xe3, 0x41, 0xba, 0x73, 0xf6, 0xd8, 0xfc,
+		0xee, 0x8d, 0x92, 0x58, 0xc4, 0x96, 0x2d, 0x09, 0x7b, 0x8a, 0xb0, 0x67, 0x90, 0x67, 0x8f, 0x3b,
+		0x77, 0x8f, 0xe3, 0xf8, 0x38, 0x64, 0x3b, 0x48, 0x37, 0x18, 0x1f, 0xed, 0x04, 0xe3, 0xc4, 0x13,
+		0x3c, 0x8e, 0x88, 0xb3, 0x73, 0xaf, 0x8c, 0x17, 0x7c, 0xc8, 0x52, 0xe1, 0x0d, 0x47, 0x8a, 0x60,
+		0x42, 0xc0, 0x79, 0xe2, 0x8d, 0x46, 0x2c, 0x51, 0x4b, 0x77, 0xff, 0x65, 0x11, 0xea, 0x07, 0x27,
+		0x5e, 0x12, 0xf4, 0xa3, 0xa3, 0xd8, 0xfa, 0x0c, 0xac, 0x54, 0xc4, 0x21, 0x8b, 0xdc, 0x94, 0x47,
+		0x3e, 0x73, 0x13, 0x16, 0xb1, 0x73, 0xbb, 0xb6, 0x55, 0xdb, 0x9e, 0x73, 0xda, 0x84, 0x39, 0x90,
+		0x08, 0x47, 0xc2, 0xad, 0x67, 0x00, 0xe3, 0x51, 0xe0, 0x09, 0x16, 0xb8, 0x9e, 0xb0, 0x6f, 0x6c,
+		0xd5, 0xb6, 0x1b, 0x4f, 0x3a, 0x3d, 0x5a, 0xb0, 0xa7, 0x17, 0xec, 0x1d, 0x6a, 0x8d, 0x9c, 0xba,
+		0xa2, 0x7e, 0x21, 0xac, 0x27, 0xb0, 0x9a, 0xb0, 0x51, 0xc8, 0x7d, 0xdc, 0x8c, 0xeb, 0xf9, 0xa7,
+		0x6e, 0xc8, 0xce, 0x58, 0x68, 0xcf, 0x6c, 0xd5, 0xb6, 0x67, 0x9c, 0xe5, 0x1c, 0xf2, 0x85, 0x7f,
+		0xfa, 0xbd, 0x44, 0x49, 0xe5, 0x44, 0xe2, 0x45, 0xe9, 0x11, 0x4b, 0x72, 0x0c, 0xb3, 0xc8, 0xd0,
+		0xd6, 0x18, 0x43, 0xbd, 0x0b, 0x2d, 0x69, 0x8b, 0x3c, 0xe9, 0xdc, 0x54, 0x0d, 0x17, 0x90, 0xc5,
+		0xc8, 0xf8, 0x35, 0x6c, 0x06, 0xf1, 0xd0, 0xe3, 0x91, 0x1b, 0xc5, 0x82, 0x1f, 0x69, 0x6d, 0xcf,
+		0x58, 0x92, 0xf2, 0x38, 0xb2, 0x6f, 0xe2, 0xd2, 0x1b, 0x44, 0xf2, 0x26, 0x47, 0xf1, 0x23, 0x11,
+		0x58, 0x7f, 0x0f, 0x1d, 0x3f, 0x1c, 0xa7, 0x82, 0x25, 0x6e, 0x85, 0xe6, 0xb7, 0xb6, 0x66, 0xb6,
+		0x1b, 0x4f, 0xfe, 0xb2, 0x77, 0xd9, 0xe1, 0xf7, 0xcc, 0xb9, 0xf4, 0xf6, 0x48, 0xca, 0x61, 0x69,
+		0x93, 0xfb, 0x91, 0x48, 0x2e, 0x9c, 0x75, 0xbf, 0x1a, 0x6b, 0x09, 0x58, 0x37, 0xcb, 0x97, 0x4c,
+		0x31, 0x8f, 0x6b, 0x7f, 0x7b, 0x9d, 0xb5, 0xf9, 0xb0, 0xbc, 0xf0, 0x8a, 0x5f, 0x81, 0xb2, 0x56,
+		0x60, 0x2e, 0x3e, 0x8f, 0x58, 0x62, 0xd7, 0xb7, 0x6a, 0xdb, 0x75, 0x87, 0xfe, 0x58, 0x7f, 0x07,
+		0x1b, 0x5a, 0x97, 0xfc, 0xc1, 0x93, 0x36, 0x70, 0x6d, 0x4b, 0x38, 0x99, 0x8c, 0x0a, 0x4b, 0x94,
+		0xb1, 0xd6, 0xd7, 0x60, 0x8f, 0x58, 0x14, 0xf0, 0xe8, 0xd8, 0x3d, 0xf2, 0x78, 0x18, 0x9f, 0xb1,
+		0xc4, 0x1d, 0x7a, 0xc9, 0x29, 0x4b, 0x52, 0xbb, 0xb1, 0x55, 0xdb, 0x6e, 0x3a, 0x6b, 0x0a, 0xff,
+		0x4a, 0xa1, 0x5f, 0x13, 0xd6, 0xea, 0xc3, 0xfd, 0xcb, 0x38, 0x5d, 0x16, 0xf9, 0xb1, 0xc4, 0xd8,
+		0x4d, 0xdc, 0xe9, 0xdd, 0x6a, 0x11, 0xfb, 0x8a, 0xca, 0xfa, 0x19, 0x36, 0xf2, 0x5b, 0x0f, 0xc2,
+		0x77, 0xb9, 0x03, 0x59, 0x40, 0x13, 0xfc, 0xe6, 0x43, 0x4c, 0x90, 0xdb, 0xdd, 0xcb, 0xf0, 0x5d,
+		0xf1, 0x48, 0xd6, 0x92, 0x4a, 0xa4, 0xf5, 0x57, 0xb0, 0x65, 0x3c, 0x70, 0x94, 0xc4, 0x3e, 0x4b,
+		0x53, 0xb9, 0xa5, 0x77, 0x63, 0x36, 0x66, 0x6e, 0x2a, 0x3c, 0xc1, 0x52, 0x7b, 0x11, 0x0d, 0x71,
+		0x47, 0xd3, 0xbd, 0x35, 0x64, 0x7f, 0x2d, 0xa9, 0x0e, 0x90, 0xc8, 0xfa, 0x3d, 0x3c, 0x9a, 0x26,
+		0x28, 0xb3, 0x4b, 0x0b, 0xed, 0xf2, 0xe0, 0x4a, 0x89, 0xc6, 0x3c, 0x2f, 0xe0, 0x0e, 0x79, 0xe9,
+		0x65, 0xfa, 0xb5, 0x51, 0xbf, 0x0e, 0x12, 0x55, 0x2b, 0xe7, 0xc0, 0x2f, 0xae, 0x14, 0x91, 0x69,
+		0xb6, 0x84, 0x9a, 0x75, 0x2f, 0x97, 0x65, 0xd4, 0x7a, 0x0b, 0x0f, 0xfc, 0x24, 0x4e, 0x53, 0x57,
+		0xbb, 0xef, 0x65, 0xea, 0x59, 0xa8, 0xde, 0x7d, 0x24, 0x56, 0x5e, 0x5a, 0xad, 0xa5, 0x07, 0xbd,
+		0x0f, 0x92, 0x98, 0x69, 0xbb, 0x8c, 0xda, 0x3e, 0x9a, 0x2a, 0x5a, 0x2b, 0xdd, 0xf9, 0x0e, 0x6e,
+		0x5f, 0x15, 0x32, 0xac, 0x36, 0xcc, 0x9c, 0xb2, 0x0b, 0x0c, 0xec, 0x75, 0x47, 0xfe, 0x94, 0xb7,
+		0xf6, 0xcc, 0x0b, 0xc7, 0x0c, 0xc3, 0xf8, 0x8c, 0x43, 0x7f, 0xbe, 0xb9, 0xf1, 0x75, 0xad, 0xe3,
+		0xc3, 0xc6, 0xa5, 0x21, 0xa0, 0x42, 0xd0, 0xe7, 0x79, 0x41, 0x57, 0x47, 0xdb, 0xdc, 0x22, 0x99,
+		0xc2, 0x95, 0x37, 0xfb, 0x5a, 0x0a, 0xf7, 0x61, 0xf3, 0x8a, 0x2b, 0x72, 0x1d, 0x51, 0xdd, 0xff,
+		0xa8, 0x03, 0xbc, 0xc4, 0xf0, 0x8e, 0xe9, 0xd1, 0x82, 0xd9, 0xc8, 0x1b, 0x32, 0xc5, 0x8b, 0xbf,
+		0xad, 0x2d, 0x68, 0x04, 0x2c, 0xf5, 0x13, 0x3e, 0x92, 0xab, 0xa1, 0x88, 0xba, 0x93, 0x07, 0x65,
+		0x01, 0x71, 0x26, 0x1f, 0x10, 0xd7, 0xe0, 0xa6, 0x3c, 0xe6, 0x71, 0x8a, 0x19, 0x6c, 0xce, 0x51,
+		0xff, 0xac, 0xa7, 0x50, 0x4f, 0x98, 0x60, 0x11, 0x4a, 0xa3, 0x8c, 0xb5, 0x31, 0x61, 0xc3, 0x97,
+		0xaa, 0x0a, 0x70, 0x32, 0x5a, 0xeb, 0x1e, 0x34, 0xd8, 0x90, 0x0b, 0x77, 0xc8, 0x44, 0xc2, 0x7d,
+		0x4c, 0x4e, 0xf3, 0x0e, 0x48, 0xd0, 0x6b, 0x84, 0x58, 0x0f, 0xa1, 0xe5, 0x25, 0xfe, 0x09, 0x3f,
+		0xf3, 0x42, 0x77, 0x30, 0xf6, 0x4f, 0x99, 0xb0, 0x6f, 0xa1, 0x46, 0x8b, 0x1a, 0xbc, 0x8b, 0xd0,
+		0x02, 0xa1, 0xd2, 0x71, 0x1e, 0x75, 0x34, 0x84, 0x07, 0xa4, 0xeb, 0x03, 0x58, 0xf4, 0xe3, 0xe8,
+		0x88, 0x1f, 0x9b, 0x94, 0x58, 0x47, 0x0b, 0x2e, 0x10, 0x54, 0xa7, 0xc1, 0xc7, 0xb0, 0x52, 0x99,
+		0x3f, 0x81, 0x72, 0x7d, 0x54, 0x91, 0x39, 0x77, 0xe1, 0x8e, 0x09, 0xb7, 0x95, 0xbc, 0x0d, 0xe4,
+		0xdd, 0xd4, 0x44, 0x55, 0xd9, 0xf7, 0x11, 0xb4, 0x8d, 0x0c, 0xcd, 0xd6, 0x44, 0xb6, 0x96, 0x86,
+		0x6b, 0xd2, 0x1e, 0x2c, 0x7b, 0xbe, 0xe0, 0x67, 0xcc, 0xdc, 0x49, 0x3c, 0xe7, 0x05, 0x34, 0xcf,
+		0x12, 0xa1, 0x94, 0x7f, 0xbe, 0x91, 0x87, 0xde, 0x81, 0x79, 0x45, 0x28, 0xc3, 0xe6, 0xcc, 0x76,
+		0xdd, 0x31, 0xff, 0xad, 0x5d, 0x98, 0x0d, 0x3c, 0xe1, 0xd9, 0x2d, 0x8c, 0xe8, 0xbd, 0xcb, 0x23,
+		0x7a, 0xe6, 0x58, 0xbd, 0x97, 0x9e, 0xf0, 0x28, 0x80, 0x23, 0xaf, 0x75, 0x1f, 0x9a, 0x03, 0x2f,
+		0x70, 0x07, 0x3c, 0xf2, 0x12, 0x6e, 0x42, 0x5f, 0x63, 0xe0, 0x05, 0xbb, 0x0a, 0x24, 0x2b, 0xa8,
+		0x3c, 0x49, 0x39, 0xb4, 0x2d, 0xe7, 0x68, 0x4d, 0x2c, 0xfb, 0x0a, 0xd6, 0x4f, 0x78, 0x2a, 0xe2,
+		0xe4, 0xc2, 0x2d, 0x1f, 0xb0, 0x85, 0x07, 0xbc, 0xaa, 0xd0, 0x2f, 0x8a, 0xe7, 0xfc, 0x39, 0xac,
+		0x4c, 0xf0, 0x8d, 0x13, 0xae, 0xe2, 0x92, 0x55, 0x62, 0xfa, 0x5d, 0xc2, 0xad, 0x5f, 0x41, 0xe7,
+		0x8c, 0xa7, 0x7c, 0xc0, 0x43, 0x2e, 0x26, 0x17, 0x5b, 0xc1, 0xc5, 0xec, 0x8c, 0xa2, 0xb4, 0xde,
+		0x57, 0xb0, 0x5e, 0xc5, 0x2d, 0x97, 0x5c, 0xc5, 0x25, 0x57, 0x27, 0x59, 0xe5, 0xaa, 0xaf, 0x60,
+		0xc9, 0x9c, 0x38, 0x8b, 0x02, 0xac, 0x7a, 0xec, 0xb5, 0xa9, 0x71, 0xc8, 0xb8, 0xc3, 0x7e, 0x14,
+		0x48, 0xa8, 0xf5, 0x0d, 0x6c, 0x8c, 0x12, 0x76, 0xc6, 0xe3, 0x71, 0xea, 0x4e, 0xb8, 0xd0, 0x3a,
+		0xba, 0xd0, 0xba, 0x26, 0x78, 0x55, 0x72, 0xa5, 0x57, 0xb0, 0x14, 0x7a, 0xa9, 0x70, 0x75, 0x65,
+		0x8c, 0x3a, 0xd8, 0xd3, 0x75, 0x90, 0x4c, 0xbf, 0x23, 0x1e, 0x09, 0xed, 0x3c, 0x85, 0xba, 0xf1,
+		0x8a, 0x69, 0x31, 0xab, 0x9e, 0x8f, 0x59, 0x63, 0xb0, 0x7e, 0x4b, 0x07, 0xb2, 0x9b, 0x78, 0x91,
+		0x7f, 0xe2, 0x78, 0xd1, 0x31, 0xb3, 0x36, 0xa1, 0x3e, 0xc0, 0xbf, 0x2e, 0x0f, 0x94, 0x9c, 0x79,
+		0x02, 0xf4, 0x03, 0xab, 0x0b, 0x0b, 0x03, 0x76, 0x8c, 0x65, 0x6e, 0xc0, 0x24, 0x01, 0x05, 0xc2,
+		0x06, 0x02, 0xdf, 0xc4, 0x01, 0xeb, 0x07, 0xd6, 0x5d, 0x68, 0x48, 0x93, 0x6a, 0x0a, 0xaa, 0xd3,
+		0xeb, 0x2c, 0x0a, 0x08, 0xdf, 0xfd, 0xf7, 0x1a, 0xb4, 0xd4, 0xba, 0x87, 0x09, 0x63, 0x18, 0x2f,
+		0xbf, 0x85, 0xa6, 0x9f, 0xb0, 0xcc, 0x0c, 0xb5, 0xa9, 0x66, 0x68, 0x28, 0x7a, 0x3c, 0x86, 0xef,
+		0xa0, 0xee, 0x45, 0x3e, 0x93, 0x32, 0x53, 0xfb, 0x06, 0x5e, 0xa7, 0xcf, 0x2e, 0xbf, 0x4e, 0x93,
+		0x9b, 0x76, 0x32, 0x76, 0x19, 0xba, 0x79, 0x74, 0x14, 0xab, 0x18, 0x8c, 0xbf, 0xbb, 0xff, 0xdd,
+		0x81, 0xd5, 0x9f, 0xe2, 0xe4, 0xf4, 0x28, 0x8c, 0xcf, 0xf7, 0xdf, 0x33, 0x7f, 0x2c, 0xa3, 0x07,
+		0x2a, 0xbe, 0x0d, 0xed, 0x91, 0x97, 0xb0, 0x48, 0xb8, 0xaa, 0xfe, 0x57, 0x46, 0x6b, 0x3a, 0x8b,
+		0x04, 0x57, 0x77, 0x37, 0x90, 0x4d, 0x89, 0xa2, 0x3c, 0x57, 0x92, 0xb4, 0xfd, 0xea, 0x8e, 0x92,
+		0xa1, 0x97, 0x20, 0x43, 0x2b, 0xea, 0x64, 0x1c, 0x69, 0x33, 0x36, 0x9d, 0x06, 0x01, 0x9d, 0xb1,
+		0x94, 0x78, 0x1f, 0x9a, 0x3c, 0xe2, 0x82, 0xa3, 0xd9, 0x78, 0xa0, 0x1a, 0x9c, 0x86, 0x81, 0xf5,
+		0x03, 0xeb, 0x47, 0xd8, 0xf0, 0xe3, 0xe1, 0x28, 0x64, 0x18, 0x12, 0xd9, 0x99, 0x14, 0x38, 0xf0,
+		0x04, 0x1d, 0x2e, 0xe5, 0x8c, 0xcd, 0x09, 0x23, 0xf7, 0x23, 0xf1, 0xd5, 0x97, 0x3f, 0x4a, 0x37,
+		0x71, 0xd6, 0x32, 0xee, 0x7d, 0xc9, 0xbc, 0x2b, 0x79, 0xfb, 0x81, 0x8c, 0x98, 0x65, 0xb9, 0x98,
+		0x47, 0x9a, 0x4e, 0xab, 0xc4, 0x21, 0xaf, 0xc8, 0x84, 0x0a, 0x26, 0x04, 0x51, 0x5a, 0x59, 0x2f,
+		0xf1, 0x98, 0x30, 0xb4, 0x09, 0x75, 0xe1, 0xa5, 0xa7, 0x6e, 0xc8, 0x53, 0x81, 0x99, 0xa5, 0xee,
+		0xcc, 0x4b, 0xc0, 0xf7, 0x3c, 0x15, 0xd2, 0xa0, 0xc6, 0x92, 0xe2, 0x62, 0xc4, 0x28, 0x12, 0x53,
+		0x2f, 0xd1, 0xd6, 0x98, 0xc3, 0x8b, 0x11, 0xc3, 0x40, 0xfc, 0x12, 0xda, 0x19, 0x35, 0x1f, 0xb2,
+		0x78, 0x2c, 0x6c, 0x98, 0x96, 0x34, 0x5b, 0x46, 0x0c, 0x71, 0x58, 0xaf, 0x61, 0x35, 0x60, 0x3e,
+		0x97, 0xf7, 0xd7, 0x45, 0xcd, 0xb4, 0xa8, 0xc6, 0x34, 0x51, 0xcb, 0x9a, 0xef, 0xd0, 0x4b, 0x4f,
+		0xb5, 0xb8, 0x4f, 0x61, 0x89, 0x69, 0x77, 0x72, 0xfd, 0x38, 0x12, 0xec, 0xbd, 0xc0, 0xcc, 0xd3,
+		0x74, 0xda, 0x06, 0xb1, 0x47, 0x70, 0x79, 0x91, 0xb1, 0xdc, 0xc3, 0x64, 0x33, 0xe7, 0xd0, 0x1f,
+		0xe9, 0x04, 0x7e, 0x18, 0xa7, 0x4c, 0x47, 0xcc, 0x45, 0x44, 0x36, 0x10, 0xa6, 0x82, 0xe4, 0x47,
+		0xb0, 0x90, 0x0a, 0x2f, 0x11, 0x26, 0x30, 0xb5, 0xd0, 0x51, 0x9a, 0x08, 0xd4, 0xd1, 0xe8, 0x3b,
+		0x58, 0xc6, 0x68, 0x74, 0x9e, 0x70, 0xc1, 0xd4, 0x31, 0xf1, 0xc0, 0x6e, 0x4f, 0xf7, 0x91, 0xb6,
+		0xe4, 0xfb, 0x49, 0xb2, 0xe1, 0xe1, 0xf5, 0x03, 0xeb, 0x53, 0xb0, 0x50, 0x16, 0x49, 0x41, 0x3b,
+		0xf1, 0x00, 0xd3, 0xcd, 0x0c, 0x85, 0x2f, 0x24, 0x94, 0x86, 0xe8, 0x07, 0xd6, 0x2f, 0xd5, 0xc2,
+		0x47, 0x3c, 0x31, 0x2c, 0x3c, 0xc0, 0x34, 0x33, 0x43, 0xb2, 0x5f, 0xf1, 0x44, 0xb1, 0xf4, 0x03,
+		0x99, 0x61, 0x90, 0x5c, 0x95, 0xc2, 0x2c, 0x50, 0xde, 0xb7, 0x8c, 0xf4, 0xb8, 0xee, 0x5b, 0x8d,
+		0x22, 0x07, 0x7c, 0x06, 0x40, 0xdb, 0xc7, 0xc8, 0xb2, 0x32, 0x7d, 0xf8, 0x80, 0xd4, 0xf2, 0x7f,
+		0x75, 0x88, 0x5e, 0xbd, 0x76, 0x88, 0x96, 0xd7, 0xc5, 0xb8, 0x8d, 0x3e, 0x84, 0x35, 0x32, 0x87,
+		0x86, 0xeb, 0x73, 0xf8, 0x1c, 0x56, 0x0c, 0x69, 0xea, 0x9f, 0xb0, 0x60, 0x1c, 0x62, 0x18, 0xa5,
+		0x64, 0x62, 0x69, 0xdc, 0x81, 0x42, 0xf5, 0x03, 0x59, 0x92, 0x64, 0x1c, 0x52, 0x75, 0x8a, 0x06,
+		0x36, 0x32, 0x2c, 0x19, 0x06, 0xc2, 0xf4, 0x03, 0x79, 0x13, 0x0c, 0xbd, 0x76, 0xdf, 0x8d, 0xa9,
+		0x37, 0xc1, 0xb8, 0xaf, 0x72, 0xdd, 0xfc, 0x96, 0x3c, 0x21, 0xd8, 0x70, 0x24, 0xec, 0x4e, 0x71,
+		0x4b, 0x2f, 0x08, 0x6c, 0xfd, 0x1e, 0x3a, 0x13, 0x0a, 0x9a, 0xe9, 0x93, 0xbd, 0x39, 0xd5, 0x9c,
+		0x76, 0x69, 0x0f, 0x06, 0x63, 0xfd, 0x2d, 0xdc, 0x9e, 0x30, 0x56, 0x5e, 0xf6, 0xed, 0xa9, 0xb2,
+		0x3b, 0x65, 0x83, 0xe6, 0xa4, 0xcb, 0x20, 0x27, 0xf3, 0x42, 0xe8, 0x26, 0xec, 0xdd, 0x98, 0xa5,
+		0x82, 0x05, 0xf6, 0x1d, 0x2c, 0x96, 0x5b, 0x04, 0x77, 0x34, 0xd8, 0x1a, 0xc2, 0x03, 0xa3, 0x48,
+		0x9c, 0xf0, 0x63, 0x1e, 0xc9, 0x1a, 0xa6, 0x42, 0xa3, 0xbb, 0x53, 0x35, 0xea, 0x6a, 0x41, 0x3f,
+		0x28, 0x39, 0x15, 0x9a, 0x7d, 0x02, 0x4b, 0x94, 0xfe, 0xb4, 0x66, 0xf2, 0xc0, 0xef, 0x61, 0xe4,
+		0x6b, 0x11, 0x42, 0xa9, 0x56, 0x72, 0x8f, 0x1c, 0xf5, 0x16, 0x55, 0xac, 0x1a, 0x95, 0xd1, 0x4b,
+		0xd9, 0x85, 0x5d, 0x4b, 0xea, 0xfb, 0x4a, 0x76, 0x7e, 0xdb, 0xfd, 0x40, 0x66, 0xbf, 0x54, 0x70,
+		0xff, 0xf4, 0xc2, 0xcd, 0xc2, 0x74, 0x97, 0x3a, 0x05, 0x82, 0x1f, 0xea, 0x60, 0xed, 0xc1, 0x96,
+		0xa2, 0x34, 0x4e, 0x2d, 0x62, 0x37, 0xbb, 0x97, 0xd2, 0x09, 0x3f, 0x9a, 0xe6, 0x84, 0xb7, 0x49,
+		0x84, 0x36, 0xc8, 0x61, 0x7c, 0xa0, 0x6f, 0xaa, 0xf4, 0xc8, 0x8f, 0x60, 0x21, 0x61, 0x42, 0x56,
+		0x9e, 0xca, 0x1d, 0x3f, 0xa6, 0x30, 0x87, 0x40, 0xed, 0x8b, 0x3f, 0xc0, 0x1a, 0x11, 0x51, 0x96,
+		0x0c, 0x5d, 0x1e, 0x09, 0x96, 0x9c, 0x79, 0xa1, 0xfd, 0x60, 0xda, 0xea, 0x2b, 0xc8, 0xd8, 0x27,
+		0xbe, 0xbe, 0x62, 0xcb, 0x04, 0x0e, 0xbd, 0xf7, 0x7c, 0x38, 0x1e, 0x66, 0x02, 0x7f, 0xf1, 0x61,
+		0x02, 0x5f, 0x13, 0x9f, 0x11, 0xf8, 0x65, 0x59, 0xa0, 0xda, 0x4e, 0x6a, 0x3f, 0xc4, 0xd0, 0x5e,
+		0xe0, 0x52, 0xdb, 0x4a, 0xe5, 0xa5, 0x26, 0x2e, 0xf6, 0x7e, 0xc4, 0x49, 0xbe, 0xbd, 0x3d, 0xf5,
+		0x52, 0x23, 0xcb, 0xbe, 0xe1, 0x90, 0xb9, 0x9a, 0xa4, 0x0c, 0x3c, 0xff, 0x34, 0x3e, 0x3a, 0x72,
+		0xfd, 0x98, 0x1d, 0x1d, 0x71, 0x9f, 0xcb, 0x08, 0xfb, 0x68, 0xab, 0xb6, 0x5d, 0x73, 0xd6, 0x91,
+		0x60, 0x97, 0xf0, 0x7b, 0x19, 0xda, 0x7a, 0x03, 0xab, 0xc4, 0x9b, 0x69, 0x40, 0xf1, 0xf2, 0x93,
+		0xa9, 0x2e, 0xbf, 0x5c, 0xd2, 0x43, 0x62, 0xac, 0xe7, 0xd0, 0x21, 0x79, 0x11, 0x3a, 0xae, 0x48,
+		0x2e, 0xbc, 0x41, 0xc8, 0x5c, 0x96, 0x24, 0xb2, 0xc8, 0xfb, 0x14, 0x7b, 0x29, 0x52, 0xe6, 0x8d,
+		0x74, 0x5f, 0x85, 0xdf, 0x47, 0xb4, 0x74, 0xcc, 0x13, 0x2f, 0x25, 0x36, 0x77, 0x14, 0x87, 0xdc,
+		0xbf, 0xb0, 0x3f, 0xc3, 0xab, 0xbb, 0x78, 0xe2, 0xa5, 0x48, 0xfd, 0x16, 0xa1, 0xd2, 0x6b, 0xfc,
+		0x24, 0x17, 0x3e, 0xec, 0x5f, 0xa2, 0xff, 0x36, 0x25, 0x50, 0x3b, 0x9a, 0xbc, 0x43, 0x94, 0x98,
+		0x52, 0x11, 0x27, 0xcc, 0x84, 0xf0, 0x1e, 0x1e, 0xc8, 0x12, 0xa2, 0x0e, 0x24, 0x46, 0x07, 0xf1,
+		0xcf, 0xc0, 0x52, 0xb5, 0x16, 0x55, 0xd2, 0x22, 0x3e, 0x65, 0x91, 0xbd, 0xa3, 0x12, 0x3b, 0x16,
+		0x52, 0x88, 0x38, 0x94, 0x70, 0x99, 0xc2, 0x53, 0x7e, 0x2c, 0x23, 0x86, 0x1f, 0x8f, 0x23, 0x61,
+		0x7f, 0x4e, 0x75, 0x1c, 0xc1, 0xf6, 0x24, 0x48, 0x92, 0xe8, 0xbe, 0x2a, 0xe5, 0x3f, 0x33, 0xfb,
+		0x31, 0x91, 0x28, 0xd8, 0x01, 0xff, 0x99, 0x49, 0xbf, 0xf1, 0x43, 0x79, 0x12, 0x6e, 0xc8, 0x07,
+		0x89, 0x97, 0x5c, 0x18, 0x35, 0x9f, 0xe0, 0x8e, 0x56, 0x08, 0xfb, 0x3d, 0x21, 0xb5, 0xa6, 0x19,
+		0xd7, 0x11, 0xf3, 0xc4, 0x38, 0xb7, 0xb9, 0x2f, 0xf2, 0x5c, 0xaf, 0x08, 0xa9, 0xb9, 0xee, 0x41,
+		0x43, 0x71, 0xf1, 0xe1, 0x28, 0xb4, 0xbf, 0x44, 0x52, 0x20, 0x50, 0x7f, 0x38, 0x0a, 0x65, 0x10,
+		0xf1, 0xc6, 0x22, 0x76, 0x13, 0x96, 0x32, 0xe1, 0x8e, 0x62, 0x1e, 0x89, 0xd4, 0xfe, 0x0b, 0x2a,
+		0x10, 0x25, 0xc2, 0x91, 0xf0, 0xb7, 0x08, 0x96, 0x07, 0x3d, 0x41, 0x9b, 0x55, 0x88, 0x5f, 0x51,
+		0x85, 0x58, 0x62, 0x32, 0x15, 0x62, 0x02, 0x4b, 0x29, 0x93, 0x7d, 0x9f, 0xbc, 0x26, 0x09, 0x1f,
+		0x8c, 0xe5, 0x80, 0xed, 0x29, 0x76, 0x00, 0xfb, 0x97, 0x77, 0x00, 0x95, 0xb5, 0x7c, 0xef, 0x00,
+		0x05, 0xbd, 0x30, 0x72, 0xa8, 0xcf, 0x6e, 0xa7, 0x25, 0xb0, 0xf5, 0x1a, 0x66, 0x87, 0x6c, 0x18,
+		0xdb, 0x5f, 0xe3, 0x32, 0xcf, 0xae, 0xbb, 0xcc, 0x6b, 0x36, 0x8c, 0x55, 0x0b, 0x2f, 0xc5, 0xc8,
+		0x22, 0x50, 0xd9, 0xdc, 0xa5, 0xf3, 0x94, 0x7d, 0xfc, 0x33, 0xf2, 0x15, 0x85, 0xf8, 0xad, 0x86,
+		0x63, 0xbb, 0x5c, 0x26, 0xce, 0x8c, 0xf5, 0x0d, 0x1a, 0xcb, 0x2e, 0x73, 0x99, 0x69, 0xdf, 0x1e,
+		0xac, 0x56, 0x6e, 0x72, 0x5a, 0xdb, 0xd8, 0xcc, 0x4f, 0xcd, 0x9e, 0x42, 0xdd, 0x6c, 0xe1, 0x3a,
+		0x8c, 0xdd, 0x7f, 0x5b, 0x80, 0xe6, 0x0b, 0x39, 0x21, 0xe1, 0xe2, 0x02, 0x9b, 0x27, 0x1b, 0x6e,
+		0x69, 0x6f, 0xab, 0xa1, 0x43, 0xeb, 0xbf, 0xd6, 0x53, 0xb0, 0xb3, 0xec, 0x59, 0x6a, 0x5b, 0xa8,
+		0xe5, 0x5c, 0x35, 0xf8, 0x42, 0x63, 0xf2, 0x10, 0x5a, 0x25, 0x46, 0xd5, 0x39, 0x2d, 0x16, 0xe9,
+		0xe5, 0xa0, 0xbf, 0xbc, 0x82, 0x31, 0xe3, 0x2c, 0xee, 0x66, 0xad, 0xc8, 0x91, 0x1b, 0x3f, 0x2f,
+		0x16, 0x33, 0xfb, 0x87, 0x3c, 0x17, 0xa5, 0xf9, 0x24, 0x6e, 0xdd, 0x01, 0xc8, 0x55, 0x6a, 0xf4,
+		0x3a, 0x54, 0x4f, 0x4d, 0x85, 0xa6, 0x0b, 0x76, 0xb3, 0x85, 0x5b, 0xb8, 0x85, 0xa6, 0x02, 0xd2,
+		0x06, 0xbe, 0x84, 0xb5, 0x02, 0x51, 0xa6, 0x3e, 0x35, 0x4a, 0x2b, 0x79, 0x6a, 0xa3, 0xfc, 0xb7,
+		0xd0, 0xcc, 0x97, 0x60, 0x76, 0x7d, 0xaa, 0xea, 0x8d, 0x34, 0xab, 0xba, 0xe4, 0xc5, 0xf7, 0xd4,
+		0x09, 0x4a, 0xcd, 0x81, 0x2e, 0xbe, 0x06, 0xf5, 0x03, 0xb9, 0xb3, 0x5c, 0xd9, 0xd0, 0x40, 0x7c,
+		0x3d, 0x31, 0x05, 0xc3, 0x21, 0x6c, 0x5c, 0x9e, 0xff, 0x9b, 0xd3, 0xf2, 0xd5, 0x5a, 0x5a, 0x9d,
+		0xf9, 0x4b, 0x52, 0xa9, 0x1f, 0xd2, 0x52, 0x17, 0xae, 0x21, 0x75, 0x4f, 0x72, 0x6a, 0xa9, 0x6f,
+		0x94, 0x81, 0x27, 0x45, 0x2e, 0x4e, 0x6d, 0xf6, 0xa8, 0x85, 0x28, 0xca, 0x7b, 0x05, 0x4b, 0x27,
+		0xcc, 0x4b, 0xc4, 0x80, 0x79, 0xd9, 0x9e, 0x5b, 0xd3, 0x44, 0xb5, 0x0d, 0x4f, 0xae, 0xf2, 0x9e,
+		0x28, 0x4b, 0xdb, 0xd5, 0x65, 0x69, 0x65, 0x2d, 0xa7, 0xfa, 0xb0, 0x72, 0x2d, 0xf7, 0x09, 0x2c,
+		0xd1, 0x93, 0x08, 0x96, 0x72, 0x85, 0x61, 0x1f, 0xbd, 0x8f, 0xca, 0x5a, 0x4e, 0x75, 0x94, 0x36,
+		0xdc, 0xd2, 0x45, 0xd6, 0x32, 0x52, 0xe8, 0xbf, 0xc5, 0x8e, 0x7d, 0xa5, 0xd4, 0xb1, 0x3f, 0x82,
+		0xb6, 0x72, 0x26, 0x97, 0x07, 0x2c, 0x12, 0x5c, 0x5c, 0xa8, 0x31, 0x5d, 0xcb, 0x38, 0x3f, 0x81,
+		0x2b, 0x13, 0xf8, 0x5a, 0x65, 0x02, 0xbf, 0xbc, 0xa2, 0x5b, 0xff, 0x63, 0x57, 0x74, 0xf6, 0x1f,
+		0xbb, 0xa2, 0xdb, 0xb8, 0xa2, 0xa2, 0xbb, 0xb4, 0x9e, 0xea, 0xfc, 0xff, 0xea, 0xa9, 0x2b, 0x6b,
+		0xbb, 0xcd, 0xab, 0x6b, 0xbb, 0xab, 0x6b, 0xb1, 0xdb, 0x57, 0xd7, 0x62, 0xcf, 0xf4, 0xc2, 0xd4,
+		0xe6, 0x7b, 0x3c, 0x94, 0x65, 0x46, 0xc2, 0xbc, 0x34, 0x8e, 0xb0, 0x9f, 0xaa, 0x3b, 0x64, 0x9f,
+		0xef, 0x65, 0xaf, 0x4f, 0x68, 0x07, 0xb1, 0xd9, 0xba, 0x34, 0x9a, 0x88, 0xe5, 0x33, 0x69, 0xe6,
+		0x3a, 0x77, 0xa9, 0x34, 0x30, 0xbc, 0x3f, 0x21, 0xde, 0xb8, 0x50, 0x91, 0x59, 0xaf, 0x1b, 0x30,
+		0xe1, 0xf1, 0x30, 0xc5, 0x6e, 0xa9, 0xe9, 0xac, 0x97, 0x17, 0x7e, 0x49, 0xe8, 0xee, 0xff, 0xce,
+		0x82, 0xb5, 0x77, 0xc2, 0xc3, 0xa0, 0x38, 0xee, 0xbb, 0x32, 0x63, 0x65, 0xc3, 0xb8, 0xea, 0x8c,
+		0x65, 0xf0, 0x85, 0x8c, 0x55, 0xcc, 0x05, 0x33, 0xe5, 0x5c, 0xf0, 0x10, 0x5a, 0x25, 0xb9, 0x98,
+		0x9e, 0x9a, 0xce, 0x62, 0x51, 0x9c, 0x4c, 0x68, 0x65, 0x05, 0x4c, 0x46, 0x98, 0x23, 0x2b, 0x17,
+		0x39, 0x4c, 0x4e, 0xe8, 0xc1, 0xb2, 0xd6, 0x20, 0x3f, 0x9a, 0xbc, 0x49, 0x1d, 0xa2, 0x42, 0xe5,
+		0x66, 0x93, 0x1f, 0xc3, 0xa2, 0xa6, 0x57, 0xc3, 0xc9, 0x62, 0x7e, 0xa2, 0xe9, 0xe4, 0x44, 0x12,
+		0x9b, 0xbf, 0x56, 0x12, 0xab, 0x5f, 0x91, 0xc4, 0x2a, 0xdb, 0x5f, 0xa8, 0x6e, 0x7f, 0x37, 0xa1,
+		0x9e, 0x4d, 0x66, 0x29, 0x1f, 0xcd, 0x07, 0x7a, 0x26, 0x7b, 0x0f, 0x1a, 0x0a, 0x89, 0xb3, 0x43,
+		0x7a, 0x9d, 0x07, 0xf5, 0x99, 0x86, 0x9c, 0x1a, 0x56, 0xcf, 0x18, 0x17, 0x2e, 0x99, 0x31, 0xf6,
+		0x60, 0x59, 0x0d, 0x6d, 0x29, 0x5f, 0xa8, 0xb8, 0x45, 0x23, 0xb9, 0x25, 0x42, 0x61, 0x4a, 0xa0,
+		0xd0, 0xd5, 0xfd, 0xaf, 0x1a, 0xc0, 0x01, 0x56, 0xf9, 0x7f, 0x42, 0xe7, 0xca, 0x99, 0x68, 0xa6,
+		0x9c, 0x8e, 0xf5, 0x33, 0xe5, 0x6c, 0xee, 0x99, 0x72, 0x05, 0xe6, 0x78, 0x34, 0x1a, 0x0b, 0x74,
+		0x9a, 0xa6, 0x43, 0x7f, 0xa4, 0x6e, 0x7e, 0x1c, 0x89, 0x24, 0x0e, 0xd5, 0x9c, 0x57, 0xff, 0xed,
+		0xfe, 0x53, 0x0d, 0x96, 0x94, 0xb9, 0xf7, 0x30, 0xa5, 0xfc, 0xa9, 0xf6, 0x52, 0x99, 0xcc, 0x66,
+		0x2a, 0x07, 0x13, 0xdd, 0x7f, 0xae, 0x41, 0x1d, 0x1f, 0xa1, 0xa7, 0x28, 0x53, 0xbc, 0x7c, 0x37,
+		0xca, 0x97, 0xef, 0x39, 0x34, 0x30, 0xfa, 0x5e, 0x50, 0xe4, 0x9d, 0x99, 0x1a, 0x79, 0x81, 0xc8,
+		0x25, 0xc0, 0x5a, 0x87, 0x5b, 0x7a, 0xf4, 0x49, 0x93, 0xf9, 0x9b, 0x02, 0x27, 0x9e, 0xdd, 0xff,
+		0xa9, 0xc1, 0x3c, 0x0e, 0x3f, 0xa5, 0x6e, 0xf7, 0xa0, 0x91, 0xbf, 0x74, 0x54, 0x48, 0xc3, 0x79,
+		0x76, 0xdb, 0x56, 0xe1, 0xa6, 0xba, 0x65, 0xaa, 0xa0, 0x4e, 0xc6, 0xca, 0x75, 0xf3, 0xe3, 0x41,
+		0x8a, 0x1b, 0x90, 0x66, 0x63, 0xc1, 0x92, 0xee, 0xb3, 0xd7, 0xd2, 0xbd, 0xfc, 0x1e, 0x33, 0x77,
+		0xad, 0xf7, 0x98, 0xee, 0x7f, 0xd6, 0xa0, 0xa9, 0x47, 0x3f, 0xfa, 0x3d, 0xfc, 0x94, 0x47, 0x81,
+		0xfa, 0x40, 0x0c, 0x7f, 0xcb, 0x9b, 0x99, 0x7d, 0xd5, 0x42, 0xa6, 0x9f, 0xf7, 0xf4, 0x67, 0x28,
+		0x7f, 0x90, 0xe5, 0xbf, 0x85, 0x66, 0x7e, 0x6c, 0xfb, 0x01, 0x7b, 0x6f, 0xe4, 0x26, 0xb6, 0xdd,
+		0x7f, 0x98, 0x85, 0xb6, 0xfe, 0x1a, 0xc2, 0x9c, 0x53, 0x21, 0x8e, 0xd0, 0x0b, 0x4f, 0x21, 0x8e,
+		0x4c, 0x3e, 0xea, 0x54, 0x1f, 0xe2, 0x4c, 0xfe, 0x10, 0x75, 0xb5, 0x24, 0x43, 0x8b, 0x7a, 0xdd,
+		0xc7, 0x6a, 0x49, 0x46, 0x14, 0x59, 0x02, 0x09, 0x2f, 0x39, 0x66, 0xf9, 0xa7, 0x25, 0xba, 0x93,
+		0x8b, 0x04, 0xcf, 0x3f, 0x2d, 0x29, 0xca, 0xc9, 0xf8, 0xad, 0x64, 0x14, 0x9f, 0x96, 0x14, 0x75,
+		0x21, 0x7a, 0x37, 0x08, 0xe8, 0x14, 0x14, 0xab, 0x7c, 0x78, 0x79, 0x0e, 0x1d, 0x25, 0xc0, 0x97,
+		0x19, 0x32, 0x5b, 0x34, 0x8e, 0xc2, 0x0b, 0x0c, 0xdc, 0xf3, 0xce, 0x3a, 0x51, 0x60, 0x0a, 0xd5,
+		0x6b, 0xff, 0x10, 0x85, 0x17, 0x65, 0xbf, 0x85, 0x09, 0xbf, 0xcd, 0x5d, 0xd6, 0x46, 0xf1, 0xb2,
+		0xbe, 0x86, 0x95, 0xdc, 0x63, 0x6f, 0x36, 0x53, 0x6d, 0x4e, 0x2f, 0x88, 0x32, 0xbe, 0xc2, 0x10,
+		0xb5, 0x6c, 0xdf, 0x14, 0xbf, 0xae, 0x6a, 0x3a, 0xad, 0xa2, 0x81, 0xd3, 0xee, 0xbf, 0xde, 0x80,
+		0x85, 0x43, 0x3e, 0xfc, 0xb3, 0xfa, 0xc3, 0xaf, 0xa1, 0xa9, 0xba, 0x06, 0xc2, 0x5f, 0xf1, 0x7c,
+		0xf7, 0xc5, 0x13, 0x7a, 0x9a, 0x69, 0x28, 0x06, 0xe4, 0xcf, 0x19, 0xf6, 0x66, 0xd1, 0xb0, 0xb2,
+		0x2e, 0xd7, 0x67, 0xa2, 0xeb, 0xfa, 0x5b, 0xd4, 0x25, 0x68, 0xb8, 0x9e, 0x9f, 0x6e, 0xc0, 0xbc,
+		0x79, 0xa2, 0x99, 0x27, 0x29, 0x8c, 0x5e, 0x66, 0xba, 0xff, 0x38, 0x0b, 0xcb, 0xb9, 0xcf, 0x69,
+		0xfe, 0x8c, 0x96, 0xca, 0xed, 0x74, 0xae, 0xb8, 0xd3, 0x8f, 0x61, 0xb1, 0xf4, 0xce, 0x44, 0xa6,
+		0x68, 0x1e, 0xe5, 0xdf, 0x98, 0xba, 0xb0, 0x10, 0xb1, 0xf7, 0x39, 0x22, 0x32, 0x46, 0x43, 0x02,
+		0x35, 0x8d, 0x1c, 0xda, 0x99, 0x29, 0x80, 0x31, 0x86, 0xf1, 0xed, 0x80, 0x26, 0xef, 0x55, 0x53,
+		0xc3, 0xfa, 0x65, 0x53, 0xc3, 0x5f, 0xc1, 0x66, 0xc4, 0xce, 0xf1, 0x56, 0x56, 0xf1, 0x01, 0xf2,
+		0xad, 0x47, 0xec, 0xdc, 0x19, 0x47, 0xfb, 0x13, 0xdc, 0xf2, 0x4b, 0x90, 0xfc, 0xb4, 0xb1, 0xa1,
+		0xbe, 0x04, 0xc9, 0x0d, 0x1a, 0x77, 0x60, 0x45, 0x2f, 0x50, 0x20, 0xa5, 0x17, 0xc7, 0x25, 0x92,
+		0x9c, 0x9f, 0x4c, 0xfe, 0x46, 0x0e, 0x47, 0x59, 0xae, 0xf7, 0x58, 0x98, 0x7a, 0xd5, 0x9a, 0x9a,
+		0x41, 0x82, 0x76, 0x9f, 0xff, 0xcd, 0xb3, 0x63, 0x2e, 0x4e, 0xc6, 0x83, 0x9e, 0x1f, 0x0f, 0x77,
+		0x0a, 0x5f, 0x39, 0xf7, 0x8e, 0x59, 0x44, 0x1f, 0x1b, 0xe7, 0x3f, 0x78, 0x7e, 0xae, 0x7f, 0x9f,
+		0x3d, 0x1e, 0xdc, 0x44, 0xec, 0x17, 0xff, 0x37, 0x00, 0xa7, 0xdd, 0x1f, 0xec, 0x1e, 0x2d, 0x00,
+		0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0x54, 0xc3, 0x25, 0x9c, 0x9c,
+		0x9f, 0xab, 0x87, 0x66, 0xa4, 0x13, 0x2f, 0xcc, 0xc0, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94, 0x56,
+		0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x7a, 0x7e, 0x4e, 0x62, 0x5e,
+		0x3a, 0xc2, 0x7d, 0x05, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x70, 0x67, 0xfe, 0x60, 0x64, 0x5c, 0xc4,
+		0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0x62, 0x6e, 0x00, 0x54, 0xa9, 0x5e, 0x78,
+		0x6a, 0x4e, 0x8e, 0x77, 0x5e, 0x7e, 0x79, 0x5e, 0x08, 0x48, 0x4b, 0x12, 0x1b, 0xd8, 0x0c, 0x63,
+		0xc0, 0x00, 0xdc, 0x84, 0x30, 0xff, 0xf3, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x1d, 0x97, 0x70,
+		0x72, 0x7e, 0xae, 0x1e, 0x9a, 0x99, 0x4e, 0x7c, 0x70, 0x13, 0x03, 0x40, 0x42, 0x01, 0x8c, 0x51,
+		0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0x39, 0x89,
+		0x79, 0xe9, 0x08, 0x27, 0x16, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x23, 0x5c, 0xfa, 0x83, 0x91, 0x71,
+		0x11, 0x13, 0xb3, 0x7b, 0x80, 0xd3, 0x2a, 0x26, 0x39, 0x77, 0x88, 0xc9, 0x01, 0x50, 0xb5, 0x7a,
+		0xe1, 0xa9, 0x39, 0x39, 0xde, 0x79, 0xf9, 0xe5, 0x79, 0x21, 0x20, 0x3d, 0x49, 0x6c, 0x60, 0x43,
+		0x8c, 0x01, 0x03, 0x00, 0xbc, 0x77, 0x4a, 0x07, 0xf7, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x0d,
+		0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xe8, 0x3a, 0xf1, 0x86, 0x43, 0x83, 0x3f, 0x00, 0x24,
+		0x12, 0xc0, 0x18, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
+		0x9e, 0x9f, 0x93, 0x98, 0x97, 0x8e, 0x88, 0xaa, 0x82, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x78, 0x8c,
+		0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0x62, 0x6e,
+		0x00, 0x54, 0xa9, 0x5e, 0x78, 0x6a, 0x4e, 0x8e, 0x77, 0x5e, 0x7e, 0x79, 0x5e, 0x08, 0x48, 0x4b,
+		0x12, 0x1b, 0xd8, 0x0c, 0x63, 0xc0, 0x00, 0x19, 0x6c, 0xb9, 0xb8, 0xfe, 0x01, 0x00, 0x00,
+	},
+}
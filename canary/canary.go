@@ -105,6 +105,7 @@ func (c *canaryImpl) Run(mode string) error {
 	if mode == ModeAll || mode == ModeCronCanary {
 		// start the initial cron workflow
 		c.startCronWorkflow()
+		c.startScenarioCronWorkflows()
 	}
 
 	if mode == ModeAll || mode == ModeWorker {
@@ -166,6 +167,31 @@ func (c *canaryImpl) startCronWorkflow() {
 	}
 }
 
+// startScenarioCronWorkflows starts a cron workflow for each enabled
+// scenario that runs on its own schedule instead of the sanity suite
+func (c *canaryImpl) startScenarioCronWorkflows() {
+	_, cronScenarios := enabledScenarios(c.canaryConfig)
+	for _, name := range cronScenarios {
+		c.runtime.logger.Info("starting canary scenario cron workflow...", zap.String("scenario", name))
+		opts := newWorkflowOptions(concat("cadence.canary.cron", name), c.canaryConfig.Cron.CronExecutionTimeout)
+		opts.CronSchedule = c.canaryConfig.Scenarios[name].CronSchedule
+
+		ctx := context.Background()
+		span := opentracing.StartSpan("start-scenario-cron-workflow-span")
+		ctx = opentracing.ContextWithSpan(ctx, span)
+		_, err := c.canaryClient.StartWorkflow(ctx, opts, cronWorkflow, name)
+		span.Finish()
+		if err != nil {
+			if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); !ok {
+				c.runtime.logger.Error("error starting scenario cron workflow", zap.String("scenario", name), zap.Error(err))
+			} else {
+				c.runtime.logger.Info("scenario cron workflow already started, you may need to terminate and restart if cron schedule is changed...",
+					zap.String("scenario", name))
+			}
+		}
+	}
+}
+
 // newActivityContext builds an activity context containing
 // logger, metricsClient and cadenceClient
 func (c *canaryImpl) newActivityContext() context.Context {
@@ -22,6 +22,7 @@ package canary
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/uber-go/tally"
@@ -66,11 +67,13 @@ type (
 
 	// Canary contains the configuration for canary tests
 	Canary struct {
-		CrossClusterTestMode string   `yaml:"crossClusterTestMode"`
-		CanaryDomainClusters []string `yaml:"canaryDomainClusters"` // the clusters to set for each domain
-		Domains              []string `yaml:"domains"`
-		Excludes             []string `yaml:"excludes"`
-		Cron                 Cron     `yaml:"cron"`
+		CrossClusterTestMode string                    `yaml:"crossClusterTestMode"`
+		CanaryDomainClusters []string                  `yaml:"canaryDomainClusters"` // the clusters to set for each domain
+		Domains              []string                  `yaml:"domains"`
+		Excludes             []string                  `yaml:"excludes"`
+		Cron                 Cron                      `yaml:"cron"`
+		Stress               Stress                    `yaml:"stress"`
+		Scenarios            map[string]ScenarioConfig `yaml:"scenarios"` // keyed by the name of a registered Scenario
 	}
 
 	// Cron contains configuration for the cron workflow for canary
@@ -80,6 +83,23 @@ type (
 		StartJobTimeout      time.Duration `yaml:"startJobTimeout"`      // default to 9 minutes
 	}
 
+	// Stress contains configuration for the stress workflow, which is only part of the sanity suite when enabled
+	Stress struct {
+		Enabled           bool          `yaml:"enabled"`
+		HistoryLength     int           `yaml:"historyLength"`     // number of activities per run, default to 200
+		PayloadSizeBytes  int           `yaml:"payloadSizeBytes"`  // size of each activity result, default to 64KB
+		ContinueAsNewRuns int           `yaml:"continueAsNewRuns"` // number of runs chained with continue-as-new, default to 3
+		SignalFanIn       int           `yaml:"signalFanIn"`       // number of signals sent concurrently to the workflow, default to 100
+		LatencySLO        time.Duration `yaml:"latencySLO"`        // max duration of the stress workflow, default to 5 minutes
+	}
+
+	// ScenarioConfig contains configuration for a custom scenario registered through RegisterScenario
+	ScenarioConfig struct {
+		Enabled      bool          `yaml:"enabled"`
+		CronSchedule string        `yaml:"cronSchedule"` // runs the scenario on its own cron instead of as part of the sanity suite
+		SLA          time.Duration `yaml:"sla"`          // max duration of each run, default to the timeout of the suite running it
+	}
+
 	// Cadence contains the configuration for cadence service
 	Cadence struct {
 		ServiceName string `yaml:"service"`
@@ -95,6 +115,11 @@ func (c *Config) Validate() error {
 	if len(c.Canary.Domains) == 0 {
 		return errors.New("missing value for domains property")
 	}
+	for name := range c.Canary.Scenarios {
+		if !isScenarioRegistered(name) {
+			return fmt.Errorf("unknown canary scenario %v", name)
+		}
+	}
 	return nil
 }
 
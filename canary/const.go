@@ -58,7 +58,7 @@ const (
 // that the decision task it is executing is compatible with this version
 // Bump this version whenever a backward incompatible change for any workflow
 // also see beingWorkflow function
-const workflowVersion = workflow.Version(3)
+const workflowVersion = workflow.Version(4)
 const workflowChangeID = "initial version"
 
 // wfType/activityType refers to the friendly short names given to
@@ -89,6 +89,8 @@ const (
 	wfTypeCrossClusterChild    = "workflow.CrossCluster.child"
 	wfTypeBatchParent          = "workflow.batch.parent"
 	wfTypeBatchChild           = "workflow.batch.child"
+	wfTypeStress               = "workflow.stress"
+	wfTypeStressHistory        = "workflow.stress.history"
 
 	activityTypeEcho                 = "activity.echo"
 	activityTypeCron                 = "activity.cron"
@@ -113,4 +115,7 @@ const (
 	activityTypeStartBatch           = "activity.batch.start.batch"
 	activityTypeCrossCluster         = "activity.crosscluster.sample"
 	activityTypeCrossClusterFailover = "activity.crosscluster.failover"
+	activityTypeStressConfig         = "activity.stress.config"
+	activityTypeStressPayload        = "activity.stress.payload"
+	activityTypeStressSignal         = "activity.stress.signal"
 )
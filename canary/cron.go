@@ -118,7 +118,7 @@ func startJob(
 	defer span.Finish()
 	ctx = opentracing.ContextWithSpan(ctx, span)
 
-	opts := newWorkflowOptions(jobID, config.Cron.StartJobTimeout)
+	opts := newWorkflowOptions(jobID, getScenarioSLA(config, jobName, config.Cron.StartJobTimeout))
 	wf, err := cadenceClient.StartWorkflow(ctx, opts, jobName, time.Now().UnixNano(), domain)
 	if err != nil {
 		scope.Counter(startWorkflowFailureCount).Inc(1)
@@ -112,6 +112,14 @@ func NewCanaryRunner(cfg *Config) (Runnable, error) {
 // Run runs the canaries
 func (r *canaryRunner) Run(mode string) error {
 	r.metrics.Counter("restarts").Inc(1)
+	if r.config.Stress.Enabled {
+		sanityChildWFList = append(sanityChildWFList, wfTypeStress)
+	}
+	sanityScenarios, _ := enabledScenarios(r.config)
+	for _, name := range sanityScenarios {
+		sanityChildWFList = append(sanityChildWFList, name)
+		sanityChildWFTimeouts[name] = getScenarioSLA(r.config, name, childWorkflowTimeout)
+	}
 	if len(r.config.Excludes) != 0 {
 		updateSanityChildWFList(r.config.Excludes)
 	}
@@ -125,6 +133,21 @@ func (r *canaryRunner) Run(mode string) error {
 	if r.config.Cron.StartJobTimeout == 0 {
 		r.config.Cron.StartJobTimeout = 9 * time.Minute
 	}
+	if r.config.Stress.HistoryLength == 0 {
+		r.config.Stress.HistoryLength = 200
+	}
+	if r.config.Stress.PayloadSizeBytes == 0 {
+		r.config.Stress.PayloadSizeBytes = 64 * 1024
+	}
+	if r.config.Stress.ContinueAsNewRuns == 0 {
+		r.config.Stress.ContinueAsNewRuns = 3
+	}
+	if r.config.Stress.SignalFanIn == 0 {
+		r.config.Stress.SignalFanIn = 100
+	}
+	if r.config.Stress.LatencySLO == 0 {
+		r.config.Stress.LatencySLO = 5 * time.Minute
+	}
 
 	var wg sync.WaitGroup
 	for _, d := range r.config.Domains {
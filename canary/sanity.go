@@ -21,6 +21,8 @@
 package canary
 
 import (
+	"time"
+
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap"
 )
@@ -44,6 +46,10 @@ var sanityChildWFList = []string{
 	wfTypeCrossClusterParent,
 }
 
+// sanityChildWFTimeouts overrides the default execution timeout of the child workflows
+// invoked by the sanity canary, e.g. with the SLA of custom scenarios
+var sanityChildWFTimeouts = map[string]time.Duration{}
+
 func init() {
 	registerWorkflow(sanityWorkflow, wfTypeSanity)
 }
@@ -68,7 +74,12 @@ func sanityWorkflow(ctx workflow.Context, inputScheduledTimeNanos int64) error {
 		return profile.end(err)
 	}
 
-	selector, resultC := forkChildWorkflows(ctx, domain, childNames)
+	childTimeouts, err := getChildWorkflowTimeouts(ctx)
+	if err != nil {
+		return profile.end(err)
+	}
+
+	selector, resultC := forkChildWorkflows(ctx, domain, childNames, childTimeouts)
 	err = joinChildWorkflows(ctx, childNames, selector, resultC)
 	if err != nil {
 		workflow.GetLogger(ctx).Error("sanity workflow failed", zap.Error(err))
@@ -81,7 +92,12 @@ func sanityWorkflow(ctx workflow.Context, inputScheduledTimeNanos int64) error {
 
 // forkChildWorkflows spawns child workflows with the given names
 // this method assumes that all child workflows have the same method signature
-func forkChildWorkflows(ctx workflow.Context, domain string, names []string) (workflow.Selector, workflow.Channel) {
+func forkChildWorkflows(
+	ctx workflow.Context,
+	domain string,
+	names []string,
+	timeouts map[string]time.Duration,
+) (workflow.Selector, workflow.Channel) {
 	now := workflow.Now(ctx).UnixNano()
 	selector := workflow.NewSelector(ctx)
 	resultC := workflow.NewBufferedChannel(ctx, len(names))
@@ -89,6 +105,9 @@ func forkChildWorkflows(ctx workflow.Context, domain string, names []string) (wo
 	myID := workflow.GetInfo(ctx).WorkflowExecution.ID
 	for _, childName := range names {
 		cwo := newChildWorkflowOptions(domain, concat(myID, childName))
+		if timeout, ok := timeouts[childName]; ok {
+			cwo.ExecutionStartToCloseTimeout = timeout
+		}
 		childCtx := workflow.WithChildOptions(ctx, cwo)
 		future := workflow.ExecuteChildWorkflow(childCtx, childName, now)
 		selector.AddFuture(future, func(f workflow.Future) {
@@ -128,3 +147,11 @@ func getChildWorkflowNames(ctx workflow.Context) ([]string, error) {
 	err := workflow.SideEffect(ctx, func(workflow.Context) interface{} { return sanityChildWFList }).Get(&names)
 	return names, err
 }
+
+// getChildWorkflowTimeouts records the child workflow timeout overrides
+// as a side effect for the same reason as getChildWorkflowNames
+func getChildWorkflowTimeouts(ctx workflow.Context) (map[string]time.Duration, error) {
+	var timeouts map[string]time.Duration
+	err := workflow.SideEffect(ctx, func(workflow.Context) interface{} { return sanityChildWFTimeouts }).Get(&timeouts)
+	return timeouts, err
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// Scenario is a canary test case that is defined outside of the canary package.
+	// Its workflow MUST have the same signature as the built-in test cases, i.e.
+	// func(ctx workflow.Context, scheduledTimeNanos int64) error
+	Scenario interface {
+		// Name returns the workflow type of the scenario, it is also the key of the scenario in the config
+		Name() string
+		// Workflow returns the workflow function of the scenario
+		Workflow() interface{}
+		// Activities returns the activity functions used by the scenario, keyed by activity type
+		Activities() map[string]interface{}
+	}
+)
+
+var (
+	scenariosLock sync.RWMutex
+	scenarios     = map[string]Scenario{}
+)
+
+// RegisterScenario makes a custom scenario available to the canary and registers its
+// workflow and activities with the cadence client. It must be called before the canary
+// runner is created, typically from an init function. Registered scenarios only run
+// when they are enabled in the canary config.
+func RegisterScenario(scenario Scenario) error {
+	scenariosLock.Lock()
+	defer scenariosLock.Unlock()
+
+	name := scenario.Name()
+	if name == "" {
+		return fmt.Errorf("canary scenario name cannot be empty")
+	}
+	if _, ok := scenarios[name]; ok || isStringInList(name, sanityChildWFList) {
+		return fmt.Errorf("canary scenario %v is already registered", name)
+	}
+
+	registerWorkflow(scenario.Workflow(), name)
+	for activityType, activityFunc := range scenario.Activities() {
+		registerActivity(activityFunc, activityType)
+	}
+	scenarios[name] = scenario
+	return nil
+}
+
+func isScenarioRegistered(name string) bool {
+	scenariosLock.RLock()
+	defer scenariosLock.RUnlock()
+
+	_, ok := scenarios[name]
+	return ok
+}
+
+// enabledScenarios returns the names of the enabled scenarios that run as part of the
+// sanity suite and those that run on their own cron schedule, both in sorted order
+func enabledScenarios(config *Canary) (sanity []string, cron []string) {
+	for name, scenarioConfig := range config.Scenarios {
+		if !scenarioConfig.Enabled || !isScenarioRegistered(name) {
+			continue
+		}
+		if scenarioConfig.CronSchedule == "" {
+			sanity = append(sanity, name)
+		} else {
+			cron = append(cron, name)
+		}
+	}
+	sort.Strings(sanity)
+	sort.Strings(cron)
+	return sanity, cron
+}
+
+// getScenarioSLA returns the SLA configured for the given workflow type, or the
+// fallback if the workflow type is not a scenario or has no SLA configured
+func getScenarioSLA(config *Canary, name string, fallback time.Duration) time.Duration {
+	if scenarioConfig, ok := config.Scenarios[name]; ok && scenarioConfig.SLA > 0 {
+		return scenarioConfig.SLA
+	}
+	return fallback
+}
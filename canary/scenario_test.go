@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/cadence/workflow"
+)
+
+const testScenarioName = "workflow.test.scenario"
+
+type testScenario struct {
+	name string
+}
+
+func init() {
+	if err := RegisterScenario(&testScenario{name: testScenarioName}); err != nil {
+		panic(err)
+	}
+}
+
+func (s *testScenario) Name() string {
+	return s.name
+}
+
+func (s *testScenario) Workflow() interface{} {
+	return func(ctx workflow.Context, scheduledTimeNanos int64) error {
+		return nil
+	}
+}
+
+func (s *testScenario) Activities() map[string]interface{} {
+	return nil
+}
+
+func TestRegisterScenario(t *testing.T) {
+	assert.Error(t, RegisterScenario(&testScenario{name: testScenarioName}))
+	assert.Error(t, RegisterScenario(&testScenario{name: wfTypeEcho}))
+	assert.Error(t, RegisterScenario(&testScenario{}))
+	assert.True(t, isScenarioRegistered(testScenarioName))
+	assert.False(t, isScenarioRegistered("workflow.unknown"))
+}
+
+func TestValidateScenarios(t *testing.T) {
+	cfg := &Config{Canary: Canary{
+		Domains:   []string{"cadence-canary"},
+		Scenarios: map[string]ScenarioConfig{testScenarioName: {Enabled: true}},
+	}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Canary.Scenarios["workflow.unknown"] = ScenarioConfig{Enabled: true}
+	assert.Error(t, cfg.Validate())
+}
+
+func TestEnabledScenarios(t *testing.T) {
+	config := &Canary{
+		Scenarios: map[string]ScenarioConfig{testScenarioName: {Enabled: false}},
+	}
+	sanity, cron := enabledScenarios(config)
+	assert.Empty(t, sanity)
+	assert.Empty(t, cron)
+
+	config.Scenarios[testScenarioName] = ScenarioConfig{Enabled: true, SLA: time.Minute}
+	sanity, cron = enabledScenarios(config)
+	assert.Equal(t, []string{testScenarioName}, sanity)
+	assert.Empty(t, cron)
+	assert.Equal(t, time.Minute, getScenarioSLA(config, testScenarioName, childWorkflowTimeout))
+	assert.Equal(t, childWorkflowTimeout, getScenarioSLA(config, wfTypeEcho, childWorkflowTimeout))
+
+	config.Scenarios[testScenarioName] = ScenarioConfig{Enabled: true, CronSchedule: "@every 1h"}
+	sanity, cron = enabledScenarios(config)
+	assert.Empty(t, sanity)
+	assert.Equal(t, []string{testScenarioName}, cron)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const stressSignalName = "stress-signal"
+
+func init() {
+	registerWorkflow(stressWorkflow, wfTypeStress)
+	registerWorkflow(stressHistoryWorkflow, wfTypeStressHistory)
+	registerActivity(stressConfigActivity, activityTypeStressConfig)
+	registerActivity(stressPayloadActivity, activityTypeStressPayload)
+	registerActivity(stressSignalActivity, activityTypeStressSignal)
+}
+
+// stressWorkflow pushes a workflow to the configured limits: a long history with large
+// activity payloads carried over several continue-as-new runs, followed by a burst of
+// concurrent signals. It fails if it does not finish within the configured latency SLO.
+func stressWorkflow(ctx workflow.Context, inputScheduledTimeNanos int64) error {
+	scheduledTimeNanos := getScheduledTimeFromInputIfNonZero(ctx, inputScheduledTimeNanos)
+	domain := workflow.GetInfo(ctx).Domain
+
+	var err error
+	profile, err := beginWorkflow(ctx, wfTypeStress, scheduledTimeNanos)
+	if err != nil {
+		return err
+	}
+
+	// the config is read through an activity so that it is recorded in history
+	var config Stress
+	aCtx := workflow.WithActivityOptions(ctx, newActivityOptions())
+	err = workflow.ExecuteActivity(aCtx, activityTypeStressConfig).Get(ctx, &config)
+	if err != nil {
+		return profile.end(err)
+	}
+	startTime := workflow.Now(ctx)
+
+	execInfo := workflow.GetInfo(ctx).WorkflowExecution
+	cwo := newChildWorkflowOptions(domain, concat(execInfo.ID, wfTypeStressHistory))
+	cwo.ExecutionStartToCloseTimeout = config.LatencySLO
+	childCtx := workflow.WithChildOptions(ctx, cwo)
+	err = workflow.ExecuteChildWorkflow(childCtx, wfTypeStressHistory, workflow.Now(ctx).UnixNano(), config, 0).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("stress history workflow failed", zap.Error(err))
+		return profile.end(err)
+	}
+
+	sigCh := workflow.GetSignalChannel(ctx, stressSignalName)
+	err = workflow.ExecuteActivity(aCtx, activityTypeStressSignal, workflow.Now(ctx).UnixNano(), execInfo, config.SignalFanIn).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("stress signal activity failed", zap.Error(err))
+		return profile.end(err)
+	}
+	for i := 0; i < config.SignalFanIn; i++ {
+		var index int
+		sigCh.Receive(ctx, &index)
+	}
+
+	if elapsed := workflow.Now(ctx).Sub(startTime); elapsed > config.LatencySLO {
+		err = fmt.Errorf("stress workflow took %v, exceeding the latency SLO of %v", elapsed, config.LatencySLO)
+		workflow.GetLogger(ctx).Error("stress workflow is too slow", zap.Error(err))
+	}
+	return profile.end(err)
+}
+
+// stressHistoryWorkflow runs the configured number of activities returning large payloads,
+// then continues as new until the configured number of runs is reached
+func stressHistoryWorkflow(ctx workflow.Context, scheduledTimeNanos int64, config Stress, run int) error {
+	profile, err := beginWorkflow(ctx, wfTypeStressHistory, scheduledTimeNanos)
+	if err != nil {
+		return err
+	}
+
+	aCtx := workflow.WithActivityOptions(ctx, newActivityOptions())
+	for i := 0; i < config.HistoryLength; i++ {
+		var payload []byte
+		err = workflow.ExecuteActivity(aCtx, activityTypeStressPayload, config.PayloadSizeBytes).Get(ctx, &payload)
+		if err != nil {
+			return profile.end(err)
+		}
+		if len(payload) != config.PayloadSizeBytes {
+			return profile.end(fmt.Errorf("stress payload has %v bytes, expected %v", len(payload), config.PayloadSizeBytes))
+		}
+	}
+
+	if run+1 < config.ContinueAsNewRuns {
+		profile.end(nil) //nolint:errcheck
+		return workflow.NewContinueAsNewError(ctx, wfTypeStressHistory, workflow.Now(ctx).UnixNano(), config, run+1)
+	}
+	return profile.end(nil)
+}
+
+// stressConfigActivity returns the stress configuration of the canary
+func stressConfigActivity(ctx context.Context) (Stress, error) {
+	return getContextValue(ctx, ctxKeyConfig).(*Canary).Stress, nil
+}
+
+// stressPayloadActivity returns a payload of the given size
+func stressPayloadActivity(sizeBytes int) ([]byte, error) {
+	return make([]byte, sizeBytes), nil
+}
+
+// stressSignalActivity sends the given number of signals concurrently to the workflow
+func stressSignalActivity(ctx context.Context, scheduledTimeNanos int64, execInfo workflow.Execution, count int) error {
+	scope := activity.GetMetricsScope(ctx)
+	var err error
+	scope, sw := recordActivityStart(scope, activityTypeStressSignal, scheduledTimeNanos)
+	defer recordActivityEnd(scope, sw, err)
+
+	client := getActivityContext(ctx).cadence
+	g := &errgroup.Group{}
+	for i := 0; i < count; i++ {
+		index := i
+		g.Go(func() error {
+			return client.SignalWorkflow(context.Background(), execInfo.ID, execInfo.RunID, stressSignalName, index)
+		})
+	}
+	err = g.Wait()
+	return err
+}
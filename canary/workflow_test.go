@@ -31,6 +31,7 @@ import (
 	"go.uber.org/cadence/mocks"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -130,6 +131,36 @@ func (s *workflowTestSuite) TestSanityWorkflow() {
 	s.NoError(s.env.GetWorkflowError())
 }
 
+func (s *workflowTestSuite) TestStressWorkflow() {
+	mockClient := newMockCadenceClient()
+	mockClient.client.On("SignalWorkflow",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		s.env.SignalWorkflow(args.Get(3).(string), args.Get(4))
+	}).Return(nil)
+	options := newTestWorkerOptions(newMockActivityContext(mockClient))
+	options.BackgroundActivityContext = context.WithValue(options.BackgroundActivityContext, ctxKeyConfig, &Canary{
+		Stress: Stress{
+			HistoryLength:     5,
+			PayloadSizeBytes:  1024,
+			ContinueAsNewRuns: 1,
+			SignalFanIn:       3,
+			LatencySLO:        time.Minute,
+		},
+	})
+	s.env.SetWorkerOptions(options)
+	s.env.ExecuteWorkflow(wfTypeStress, time.Now().UnixNano())
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *workflowTestSuite) TestStressHistoryWorkflow_ContinueAsNew() {
+	config := Stress{HistoryLength: 2, PayloadSizeBytes: 16, ContinueAsNewRuns: 2}
+	s.env.ExecuteWorkflow(wfTypeStressHistory, time.Now().UnixNano(), config, 0)
+	s.True(s.env.IsWorkflowCompleted())
+	_, ok := s.env.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+}
+
 func (s *workflowTestSuite) TestLocalActivityWorkflow() {
 	s.env.OnActivity(getConditionData).Return(int32(20), nil).Once()
 	s.env.ExecuteWorkflow(localActivityWorkfow)
@@ -77,18 +77,33 @@ func startHandler(c *cli.Context) {
 		log.Fatal("sql schema version compatibility check failed: ", err)
 	}
 
-	var daemons []common.Daemon
 	services := getServices(c)
+	daemons := make([]common.Daemon, 0, len(services))
+	for _, svc := range services {
+		daemons = append(daemons, newServer(svc, &cfg))
+	}
+	runServices(services, daemons, cfg.Drain)
+}
+
+// runServices starts the daemons of the services and blocks until
+// the process receives SIGTERM or SIGINT, then drains and stops them
+func runServices(services []string, daemons []common.Daemon, drainCfg config.Drain) {
+	drainables := make(map[string]common.Drainable)
 	sigc := make(chan os.Signal, 1)
 	signal.Notify(sigc, syscall.SIGTERM, syscall.SIGINT)
-	for _, svc := range services {
-		server := newServer(svc, &cfg)
-		daemons = append(daemons, server)
+	for i, svc := range services {
+		server := daemons[i]
+		drainables[svc] = server.(common.Drainable)
 		server.Start()
 	}
+	startDrainServer(drainCfg, drainables)
 
 	<-sigc
 	log.Println("Received SIGTERM signal, initiating shutdown.")
+	// drain all services first, so they hand off their work at the same time
+	for _, drainable := range drainables {
+		drainable.Drain()
+	}
 	for _, daemon := range daemons {
 		daemon.Stop()
 	}
@@ -200,6 +215,25 @@ func BuildCLI(releaseVersion string, gitRevision string) *cli.App {
 	}
 
 	app.Commands = []cli.Command{
+		{
+			Name:  "dev-server",
+			Usage: "start all cadence services in one process with in-memory persistence, for local development",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "domain, do",
+					Value: devServerDefaultDomain,
+					Usage: "name of the domain registered on startup",
+				},
+				cli.StringFlag{
+					Name:  "log-level",
+					Value: "warn",
+					Usage: "log level of the services",
+				},
+			},
+			Action: func(c *cli.Context) {
+				devServerHandler(c)
+			},
+		},
 		{
 			Name:    "start",
 			Aliases: []string{""},
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/peerprovider/ringpopprovider"
+	"github.com/uber/cadence/common/persistence"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/memory"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	devServerDefaultDomain  = "default"
+	devServerClusterName    = "cluster0"
+	devServerRetentionDays  = 1
+	devServerGRPCMaxMsgSize = 32 * 1024 * 1024
+)
+
+// devServerPorts are the tchannel and grpc ports of the services, the same as in config/development.yaml
+var devServerPorts = map[string]config.RPC{
+	service.ShortName(service.Frontend): {Port: 7933, GRPCPort: 7833},
+	service.ShortName(service.Matching): {Port: 7935, GRPCPort: 7835},
+	service.ShortName(service.History):  {Port: 7934, GRPCPort: 7834},
+	service.ShortName(service.Worker):   {Port: 7939},
+}
+
+// devServerHandler is the handler for the cli dev-server command
+func devServerHandler(c *cli.Context) {
+	cfg := newDevServerConfig(c.String("log-level"))
+	if err := cfg.ValidateAndFillDefaults(); err != nil {
+		log.Fatalf("config validation failed: %v", err)
+	}
+
+	domain := c.String("domain")
+	if err := registerDevServerDomain(cfg, domain); err != nil {
+		log.Fatalf("failed to register domain %v: %v", domain, err)
+	}
+
+	dynamicConfig := newDevServerDynamicConfig()
+	services := validServices
+	daemons := make([]common.Daemon, 0, len(services))
+	for _, svc := range services {
+		daemons = append(daemons, &server{
+			name:          svc,
+			cfg:           cfg,
+			doneC:         make(chan struct{}),
+			dynamicConfig: dynamicConfig,
+		})
+	}
+	log.Printf("Starting cadence dev server; frontend=%v, domain=%v\n", cfg.PublicClient.HostPort, domain)
+	runServices(services, daemons, cfg.Drain)
+}
+
+// newDevServerConfig returns the config of a single cluster whose services all run
+// in the current process and share the in-memory persistence
+func newDevServerConfig(logLevel string) *config.Config {
+	cfg := &config.Config{
+		Ringpop: ringpopprovider.Config{
+			Name:            "cadence",
+			BootstrapMode:   ringpopprovider.BootstrapModeHosts,
+			MaxJoinDuration: 30 * time.Second,
+		},
+		Persistence: config.Persistence{
+			DefaultStore:     "memory-default",
+			VisibilityStore:  "memory-visibility",
+			NumHistoryShards: 4,
+			DataStores: map[string]config.DataStore{
+				"memory-default": {
+					NoSQL: &config.NoSQL{PluginName: memory.PluginName, Keyspace: "cadence"},
+				},
+				"memory-visibility": {
+					NoSQL: &config.NoSQL{PluginName: memory.PluginName, Keyspace: "cadence_visibility"},
+				},
+			},
+		},
+		Log: config.Logger{
+			Stdout: true,
+			Level:  logLevel,
+		},
+		ClusterGroupMetadata: &config.ClusterGroupMetadata{
+			FailoverVersionIncrement: 10,
+			PrimaryClusterName:       devServerClusterName,
+			CurrentClusterName:       devServerClusterName,
+			ClusterGroup: map[string]config.ClusterInformation{
+				devServerClusterName: {
+					Enabled:      true,
+					RPCAddress:   fmt.Sprintf("localhost:%v", devServerPorts[service.ShortName(service.Frontend)].GRPCPort),
+					RPCTransport: "grpc",
+				},
+			},
+		},
+		Services: make(map[string]config.Service),
+		Archival: config.Archival{
+			History:    config.HistoryArchival{Status: common.ArchivalDisabled},
+			Visibility: config.VisibilityArchival{Status: common.ArchivalDisabled},
+		},
+		Authorization: config.Authorization{
+			NoopAuthorizer: config.NoopAuthorizer{Enable: true},
+		},
+	}
+	for svc, rpc := range devServerPorts {
+		rpc.BindOnLocalHost = true
+		rpc.GRPCMaxMsgSize = devServerGRPCMaxMsgSize
+		cfg.Services[svc] = config.Service{RPC: rpc}
+		cfg.Ringpop.BootstrapHosts = append(cfg.Ringpop.BootstrapHosts, fmt.Sprintf("127.0.0.1:%v", rpc.Port))
+	}
+	return cfg
+}
+
+// newDevServerDynamicConfig returns the dynamic config shared by the services of the dev server
+func newDevServerDynamicConfig() dynamicconfig.Client {
+	client := dynamicconfig.NewInMemoryClient()
+	values := map[dynamicconfig.Key]interface{}{
+		dynamicconfig.MinRetentionDays:              0,
+		dynamicconfig.EnableClientVersionCheck:      true,
+		dynamicconfig.EnableConsistentQueryByDomain: true,
+	}
+	for key, value := range values {
+		if err := client.UpdateValue(key, value); err != nil {
+			log.Fatalf("invalid dynamic config %v: %v", key, err)
+		}
+	}
+	return client
+}
+
+// registerDevServerDomain registers the local domain directly in persistence,
+// so that it exists before any service starts
+func registerDevServerDomain(cfg *config.Config, domain string) error {
+	persistenceCfg := cfg.Persistence
+	persistenceCfg.TransactionSizeLimit = dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit)
+	persistenceCfg.ErrorInjectionRate = dynamicconfig.GetFloatPropertyFn(0.0)
+	factory := persistenceClient.NewFactory(
+		&persistenceCfg,
+		nil,
+		devServerClusterName,
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+		&persistence.DynamicConfiguration{
+			EnableSQLAsyncTransaction: dynamicconfig.GetBoolPropertyFn(false),
+		},
+	)
+	defer factory.Close()
+
+	domainManager, err := factory.NewDomainManager()
+	if err != nil {
+		return err
+	}
+	defer domainManager.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err = domainManager.CreateDomain(ctx, &persistence.CreateDomainRequest{
+		Info: &persistence.DomainInfo{
+			ID:          uuid.New(),
+			Name:        domain,
+			Status:      persistence.DomainStatusRegistered,
+			Description: "Cadence dev server domain",
+		},
+		Config: &persistence.DomainConfig{
+			Retention:  devServerRetentionDays,
+			EmitMetric: true,
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: devServerClusterName,
+			Clusters:          cluster.GetOrUseDefaultClusters(devServerClusterName, nil),
+		},
+		IsGlobalDomain:  false,
+		FailoverVersion: common.EmptyVersion,
+	})
+	return err
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
+)
+
+func TestNewDevServerConfig(t *testing.T) {
+	cfg := newDevServerConfig("info")
+	require.NoError(t, cfg.ValidateAndFillDefaults())
+
+	for _, svc := range validServices {
+		svcCfg, err := cfg.GetServiceConfig(svc)
+		require.NoError(t, err)
+		assert.NotZero(t, svcCfg.RPC.Port)
+	}
+	assert.Len(t, cfg.Ringpop.BootstrapHosts, len(validServices))
+	assert.Equal(t, "localhost:7833", cfg.PublicClient.HostPort)
+}
+
+func TestNewDevServerDynamicConfig(t *testing.T) {
+	client := newDevServerDynamicConfig()
+
+	minRetentionDays, err := client.GetIntValue(dynamicconfig.MinRetentionDays, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, minRetentionDays)
+}
+
+func TestRegisterDevServerDomain(t *testing.T) {
+	cfg := newDevServerConfig("info")
+	cfg.Persistence.DataStores[cfg.Persistence.DefaultStore].NoSQL.Keyspace = "TestRegisterDevServerDomain"
+	require.NoError(t, cfg.ValidateAndFillDefaults())
+	require.NoError(t, registerDevServerDomain(cfg, "test-domain"))
+
+	persistenceCfg := cfg.Persistence
+	persistenceCfg.ErrorInjectionRate = dynamicconfig.GetFloatPropertyFn(0.0)
+	factory := persistenceClient.NewFactory(&persistenceCfg, nil, devServerClusterName, nil, loggerimpl.NewNopLogger(), &persistence.DynamicConfiguration{})
+	defer factory.Close()
+	domainManager, err := factory.NewDomainManager()
+	require.NoError(t, err)
+	resp, err := domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: "test-domain"})
+	require.NoError(t, err)
+	assert.Equal(t, devServerClusterName, resp.ReplicationConfig.ActiveClusterName)
+
+	// registering the same domain again fails, as the data is kept in memory
+	assert.Error(t, registerDevServerDomain(cfg, "test-domain"))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+)
+
+const drainPath = "/drain"
+
+// startDrainServer serves the drain endpoint on localhost at the configured port, so that only
+// processes on the host (e.g. a pre-stop hook) can drain the services. It is disabled if the port is not set.
+func startDrainServer(cfg config.Drain, servers map[string]common.Drainable) {
+	if cfg.Port == 0 {
+		return
+	}
+	server := newDrainServer(cfg, servers)
+	go func() {
+		log.Printf("Drain endpoint listening on %v\n", server.Addr)
+		if err := server.ListenAndServe(); err != nil {
+			log.Printf("Drain endpoint stopped: %v\n", err)
+		}
+	}()
+}
+
+func newDrainServer(cfg config.Drain, servers map[string]common.Drainable) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(drainPath, newDrainHandler(servers))
+	return &http.Server{
+		Addr:    fmt.Sprintf("localhost:%d", cfg.Port),
+		Handler: mux,
+	}
+}
+
+// newDrainHandler returns the handler of the drain endpoint. POST puts all services of the process into
+// draining mode, and GET reports their progress, responding with 200 once all of them are drained and 503 otherwise.
+// Draining is one-way: drained services have left the membership ring and there is no way to undrain them,
+// the process is expected to be stopped once drained and has to be restarted to serve again.
+func newDrainHandler(servers map[string]common.Drainable) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		switch r.Method {
+		case http.MethodPost:
+			for _, server := range servers {
+				server.Drain()
+			}
+			status = http.StatusAccepted
+		case http.MethodGet:
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		statuses := make(map[string]common.DrainStatus, len(servers))
+		for name, server := range servers {
+			statuses[name] = server.DrainStatus()
+			if !statuses[name].Done && status == http.StatusOK {
+				status = http.StatusServiceUnavailable
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(statuses)
+	})
+}
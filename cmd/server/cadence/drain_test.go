@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+)
+
+type fakeDrainable struct {
+	status common.DrainStatus
+}
+
+func (d *fakeDrainable) Drain() {
+	d.status.Draining = true
+}
+
+func (d *fakeDrainable) DrainStatus() common.DrainStatus {
+	return d.status
+}
+
+func TestDrainHandler(t *testing.T) {
+	history := &fakeDrainable{}
+	matching := &fakeDrainable{}
+	handler := newDrainHandler(map[string]common.Drainable{
+		"history":  history,
+		"matching": matching,
+	})
+
+	serve := func(method string) (int, map[string]common.DrainStatus) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, drainPath, nil))
+		var statuses map[string]common.DrainStatus
+		if recorder.Code != http.StatusMethodNotAllowed {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &statuses))
+		}
+		return recorder.Code, statuses
+	}
+
+	code, statuses := serve(http.MethodGet)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, statuses["history"].Draining)
+
+	code, statuses = serve(http.MethodPost)
+	assert.Equal(t, http.StatusAccepted, code)
+	assert.True(t, statuses["history"].Draining)
+	assert.True(t, statuses["matching"].Draining)
+
+	history.status.Done = true
+	matching.status.Remaining = 3
+	code, statuses = serve(http.MethodGet)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, int64(3), statuses["matching"].Remaining)
+
+	matching.status.Done = true
+	code, _ = serve(http.MethodGet)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = serve(http.MethodDelete)
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
+
+func TestDrainServer(t *testing.T) {
+	history := &fakeDrainable{}
+	server := newDrainServer(config.Drain{Port: 7941}, map[string]common.Drainable{"history": history})
+	assert.Equal(t, "localhost:7941", server.Addr)
+
+	recorder := httptest.NewRecorder()
+	server.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, drainPath, nil))
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.True(t, history.status.Draining)
+
+	// only the drain endpoint is served, unlike the default mux shared with pprof
+	recorder = httptest.NewRecorder()
+	server.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
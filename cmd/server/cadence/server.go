@@ -22,6 +22,7 @@ package cadence
 
 import (
 	"log"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
@@ -55,10 +56,14 @@ import (
 
 type (
 	server struct {
-		name   string
-		cfg    *config.Config
-		doneC  chan struct{}
-		daemon common.Daemon
+		name     string
+		cfg      *config.Config
+		doneC    chan struct{}
+		daemon   common.Daemon
+		draining int32
+
+		// dynamicConfig overrides the dynamic config client built from cfg if not nil
+		dynamicConfig dynamicconfig.Client
 	}
 )
 
@@ -96,6 +101,27 @@ func (s *server) Stop() {
 	}
 }
 
+// Drain puts the service into draining mode, services which
+// can not hand off their work are considered drained right away
+func (s *server) Drain() {
+	atomic.StoreInt32(&s.draining, 1)
+	if drainable, ok := s.daemon.(common.Drainable); ok {
+		drainable.Drain()
+	}
+}
+
+// DrainStatus returns the draining progress of the service
+func (s *server) DrainStatus() common.DrainStatus {
+	if drainable, ok := s.daemon.(common.Drainable); ok {
+		return drainable.DrainStatus()
+	}
+	draining := atomic.LoadInt32(&s.draining) != 0
+	return common.DrainStatus{
+		Draining: draining,
+		Done:     draining,
+	}
+}
+
 // startService starts a service with the given name and config
 func (s *server) startService() common.Daemon {
 	svcCfg, err := s.cfg.GetServiceConfig(s.name)
@@ -115,7 +141,9 @@ func (s *server) startService() common.Daemon {
 	params.PersistenceConfig = s.cfg.Persistence
 
 	err = nil
-	if s.cfg.DynamicConfig.Client == "" {
+	if s.dynamicConfig != nil {
+		params.DynamicConfig = s.dynamicConfig
+	} else if s.cfg.DynamicConfig.Client == "" {
 		//try to fallback to legacy dynamicClientConfig
 		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.doneC)
 	} else {
@@ -184,6 +212,7 @@ func (s *server) startService() common.Daemon {
 		log.Fatalf("error creating membership monitor: %v", err)
 	}
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
+	params.GraphQLConfig = svcCfg.GraphQL
 
 	params.ClusterRedirectionPolicy = s.cfg.ClusterGroupMetadata.ClusterRedirectionPolicy
 
@@ -53,6 +53,14 @@ type Cache interface {
 
 	// Size returns the number of entries currently stored in the Cache
 	Size() int
+
+	// SizeBytes returns the total size of the entries currently stored in the Cache,
+	// it is always 0 if the Cache is not size based
+	SizeBytes() uint64
+
+	// PartitionUsage returns the number of entries and their total size stored in the Cache
+	// for the given partition, it is always 0 if the Cache is not partitioned
+	PartitionUsage(partition string) (int, uint64)
 }
 
 // Options control the behavior of the cache
@@ -76,14 +84,35 @@ type Options struct {
 	MaxCount int
 
 	// GetCacheItemSizeFunc is a function called upon adding the item to update the cache size.
+	// In Pin mode it is called again when the item is released, so items growing while in use are accounted for.
 	// It returns 0 by default, assuming the cache is just count based
 	// It is required option if MaxCount is not provided
 	GetCacheItemSizeFunc GetCacheItemSizeFunc
 
 	// MaxSize is an optional and must be set along with GetCacheItemSizeFunc
-	// to control the max size in bytes of the cache
+	// to control the max size in bytes of the cache. If MaxCount is provided as well,
+	// entries are evicted as soon as either of the limits is exceeded.
 	// It is required option if MaxCount is not provided
 	MaxSize uint64
+
+	// CapacityScale is an optional function returning a factor in (0, 1] which MaxCount
+	// and MaxSize are multiplied with, so the capacity of the cache can be adjusted at runtime.
+	// Entries above the adjusted capacity are evicted on the next insertion.
+	CapacityScale func() float64
+
+	// EvictionPolicy is an optional policy deciding which entry is evicted when the cache is full,
+	// entries are evicted in LRU order if not provided. A policy instance must not be shared between caches.
+	EvictionPolicy EvictionPolicy
+
+	// GetPartitionFunc is an optional function returning the partition of a key. Together with
+	// PartitionQuota it limits the share of the cache a partition can use, so a single partition
+	// cannot evict the entries of all others.
+	GetPartitionFunc GetPartitionFunc
+
+	// PartitionQuota returns the fraction in (0, 1] of MaxCount and MaxSize a partition may use.
+	// Once a partition exceeds its quota, its own least recently used entries are evicted on insertion,
+	// and the insertion fails with ErrCacheFull if they are all pinned.
+	PartitionQuota func(partition string) float64
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
@@ -126,6 +155,9 @@ type Entry interface {
 // GetCacheItemSizeFunc returns the cache item size in bytes
 type GetCacheItemSizeFunc func(interface{}) uint64
 
+// GetPartitionFunc returns the partition of a cache key
+type GetPartitionFunc func(key interface{}) string
+
 // DomainMetricsScopeCache represents a interface for mapping domainID and scopeIdx to metricsScope
 type DomainMetricsScopeCache interface {
 	// Get retrieves metrics scope for a domainID and scopeIdx
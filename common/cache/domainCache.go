@@ -58,6 +58,9 @@ const (
 	domainCacheMinRefreshInterval = 1 * time.Second
 	// DomainCacheRefreshInterval domain cache refresh interval
 	DomainCacheRefreshInterval = 10 * time.Second
+	// DomainCacheVersionCheckInterval is the interval of checking the domain metadata notification
+	// version, domain changes are loaded as soon as the version moves instead of waiting for the refresh interval
+	DomainCacheVersionCheckInterval = 1 * time.Second
 	// DomainCacheRefreshFailureRetryInterval is the wait time
 	// if refreshment encounters error
 	DomainCacheRefreshFailureRetryInterval = 1 * time.Second
@@ -110,6 +113,8 @@ type (
 		// coroutine is doing domain refreshment
 		refreshLock     sync.Mutex
 		lastRefreshTime time.Time
+		// metadata notification version loaded by the last refresh
+		notificationVersion int64
 		// This is debug field to emit callback count
 		lastCallbackEmitTime time.Time
 
@@ -370,11 +375,18 @@ func (c *domainCache) GetDomainName(
 func (c *domainCache) refreshLoop() {
 	timer := time.NewTicker(DomainCacheRefreshInterval)
 	defer timer.Stop()
+	versionTimer := time.NewTicker(DomainCacheVersionCheckInterval)
+	defer versionTimer.Stop()
 
 	for {
 		select {
 		case <-c.shutdownChan:
 			return
+		case <-versionTimer.C:
+			// the periodic refresh is the fallback if this fails
+			if err := c.refreshDomainsIfVersionChanged(); err != nil {
+				c.logger.Warn("Error checking domain metadata notification version", tag.Error(err))
+			}
 		case <-timer.C:
 			for err := c.refreshDomains(); err != nil; err = c.refreshDomains() {
 				select {
@@ -395,6 +407,23 @@ func (c *domainCache) refreshDomains() error {
 	return c.refreshDomainsLocked()
 }
 
+// refreshDomainsIfVersionChanged only loads the metadata record, every domain change bumps its
+// notification version, and refreshes the domains if the version is newer than the one in cache
+func (c *domainCache) refreshDomainsIfVersionChanged() error {
+	ctx, cancel := context.WithTimeout(context.Background(), domainCachePersistenceTimeout)
+	defer cancel()
+	metadata, err := c.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	if metadata.NotificationVersion <= atomic.LoadInt64(&c.notificationVersion) {
+		return nil
+	}
+
+	c.scope.IncCounter(metrics.DomainCacheVersionRefreshCount)
+	return c.refreshDomains()
+}
+
 // this function only refresh the domains in the v2 table
 // the domains in the v1 table will be refreshed if cache is stale
 func (c *domainCache) refreshDomainsLocked() error {
@@ -485,6 +514,7 @@ UpdateLoop:
 
 	// only update last refresh time when refresh succeeded
 	c.lastRefreshTime = now
+	atomic.StoreInt64(&c.notificationVersion, metadata.NotificationVersion)
 	if now.Sub(c.lastCallbackEmitTime) > 30*time.Minute {
 		c.lastCallbackEmitTime = now
 		c.scope.AddCounter(metrics.DomainCacheCallbacksCount, int64(len(c.callbacks)))
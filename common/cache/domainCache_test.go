@@ -193,6 +193,43 @@ func (s *domainCacheSuite) TestListDomain() {
 	}, allDomains)
 }
 
+func (s *domainCacheSuite) TestRefreshDomainsIfVersionChanged() {
+	domainRecord := &persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: uuid.New(), Name: "some random domain name", Data: make(map[string]string)},
+		Config: &persistence.DomainConfig{
+			Retention: 1,
+			BadBinaries: types.BadBinaries{
+				Binaries: map[string]*types.BadBinaryInfo{},
+			}},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		NotificationVersion: 0,
+	}
+	entry := s.buildEntryFromRecord(domainRecord)
+	s.domainCache.notificationVersion = 0
+
+	s.metadataMgr.On("GetMetadata", mock.Anything).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil).Times(3)
+	s.metadataMgr.On("ListDomains", mock.Anything, &persistence.ListDomainsRequest{
+		PageSize: domainCacheRefreshPageSize,
+	}).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{domainRecord},
+	}, nil).Once()
+
+	// version moved, domains are refreshed
+	s.NoError(s.domainCache.refreshDomainsIfVersionChanged())
+	s.Equal(int64(1), s.domainCache.notificationVersion)
+	entryByID, err := s.domainCache.GetDomainByID(domainRecord.Info.ID)
+	s.NoError(err)
+	s.Equal(entry, entryByID)
+
+	// version not changed, only the metadata record is loaded
+	s.NoError(s.domainCache.refreshDomainsIfVersionChanged())
+}
+
 func (s *domainCacheSuite) TestGetDomain_NonLoaded_GetByName() {
 	domainNotificationVersion := int64(999999) // make this notification version really large for test
 	s.metadataMgr.On("GetMetadata", mock.Anything).Return(&persistence.GetMetadataResponse{NotificationVersion: domainNotificationVersion}, nil)
@@ -23,6 +23,7 @@ package cache
 import (
 	"container/list"
 	"errors"
+	"math"
 	"sync"
 	"time"
 )
@@ -35,7 +36,8 @@ var (
 // upper limit to prevent infinite growing
 const cacheCountLimit = 1 << 25
 
-// lru is a concurrent fixed size cache that evicts elements in lru order
+// lru is a concurrent fixed size cache that evicts elements in lru order,
+// or in the order of the eviction policy if one is provided
 type (
 	lru struct {
 		mut         sync.Mutex
@@ -50,6 +52,17 @@ type (
 		currSize    uint64
 		sizeByKey   map[interface{}]uint64
 		isSizeBased bool
+		scaleFunc   func() float64
+		policy      EvictionPolicy
+
+		partitionFunc  GetPartitionFunc
+		partitionQuota func(partition string) float64
+		partitions     map[string]*partitionUsage
+	}
+
+	partitionUsage struct {
+		count int
+		size  uint64
 	}
 
 	iteratorImpl struct {
@@ -141,14 +154,23 @@ func New(opts *Options) Cache {
 	}
 
 	cache := &lru{
-		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
-		ttl:      opts.TTL,
-		pin:      opts.Pin,
-		rmFunc:   opts.RemovedFunc,
+		byAccess:  list.New(),
+		byKey:     make(map[interface{}]*list.Element, opts.InitialCapacity),
+		ttl:       opts.TTL,
+		pin:       opts.Pin,
+		rmFunc:    opts.RemovedFunc,
+		scaleFunc: opts.CapacityScale,
+		policy:    opts.EvictionPolicy,
 	}
 
 	cache.isSizeBased = opts.GetCacheItemSizeFunc != nil && opts.MaxSize > 0
+	cache.maxCount = opts.MaxCount
+
+	if opts.GetPartitionFunc != nil && opts.PartitionQuota != nil {
+		cache.partitionFunc = opts.GetPartitionFunc
+		cache.partitionQuota = opts.PartitionQuota
+		cache.partitions = make(map[string]*partitionUsage)
+	}
 
 	if cache.isSizeBased {
 		cache.sizeFunc = opts.GetCacheItemSizeFunc
@@ -156,7 +178,6 @@ func New(opts *Options) Cache {
 		cache.sizeByKey = make(map[interface{}]uint64, opts.InitialCapacity)
 	} else {
 		// cache is count based if max size and sizeFunc are not provided
-		cache.maxCount = opts.MaxCount
 		cache.sizeFunc = func(interface{}) uint64 {
 			return 0
 		}
@@ -186,6 +207,9 @@ func (c *lru) Get(key interface{}) interface{} {
 		entry.refCount++
 	}
 	c.byAccess.MoveToFront(element)
+	if c.policy != nil {
+		c.policy.Access(key, entry.value)
+	}
 	return entry.value
 }
 
@@ -235,6 +259,29 @@ func (c *lru) Release(key interface{}) {
 	}
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+
+	if c.isSizeBased {
+		// the value may have grown while in use
+		c.updateSizeOnDelete(key)
+		c.updateSizeOnAdd(key, c.sizeFunc(entry.value))
+		if c.partitions != nil {
+			partition := c.partitionFunc(key)
+			for c.isPartitionFull(partition) {
+				victim := c.oldestInPartition(partition, nil)
+				if victim == nil {
+					break
+				}
+				c.deleteInternal(victim)
+			}
+		}
+		for c.isCacheFull() {
+			victim := c.nextVictim(nil)
+			if victim == nil {
+				return
+			}
+			c.deleteInternal(victim)
+		}
+	}
 }
 
 // Size returns the number of entries currently in the lru, useful if cache is not full
@@ -245,6 +292,25 @@ func (c *lru) Size() int {
 	return len(c.byKey)
 }
 
+// SizeBytes returns the total size of the entries currently in the lru, 0 if it is not size based
+func (c *lru) SizeBytes() uint64 {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return c.currSize
+}
+
+// PartitionUsage returns the number of entries and their total size in the given partition
+func (c *lru) PartitionUsage(partition string) (int, uint64) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if usage, ok := c.partitions[partition]; ok {
+		return usage.count, usage.size
+	}
+	return 0, 0
+}
+
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists
 func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool) (interface{}, error) {
@@ -268,6 +334,9 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			}
 
 			c.byAccess.MoveToFront(elt)
+			if c.policy != nil {
+				c.policy.Access(key, entry.value)
+			}
 			if c.pin {
 				entry.refCount++
 			}
@@ -289,22 +358,78 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	}
 
 	c.byKey[key] = c.byAccess.PushFront(entry)
+	c.addToPartition(key)
 	c.updateSizeOnAdd(key, valueSize)
+	if c.policy != nil {
+		c.policy.Add(key, value)
+	}
+	if c.partitions != nil {
+		partition := c.partitionFunc(key)
+		for c.isPartitionFull(partition) {
+			victim := c.oldestInPartition(partition, c.byAccess.Front())
+			if victim == nil {
+				// partition is full with pinned elements
+				// revert the insert and return
+				c.deleteInternal(c.byAccess.Front())
+				return nil, ErrCacheFull
+			}
+			c.deleteInternal(victim)
+		}
+	}
 	for c.isCacheFull() {
-		oldest := c.byAccess.Back().Value.(*entryImpl)
-
-		if oldest.refCount > 0 {
+		oldest := c.nextVictim(c.byAccess.Front())
+		if oldest == nil {
 			// Cache is full with pinned elements
 			// revert the insert and return
 			c.deleteInternal(c.byAccess.Front())
 			return nil, ErrCacheFull
 		}
 
-		c.deleteInternal(c.byAccess.Back())
+		c.deleteInternal(oldest)
 	}
 	return nil, nil
 }
 
+// nextVictim returns the element to evict according to the eviction policy,
+// not counting pinned elements and the excluded element
+func (c *lru) nextVictim(exclude *list.Element) *list.Element {
+	if c.policy == nil {
+		return c.oldestUnpinned(exclude)
+	}
+
+	key, ok := c.policy.Victim(func(key interface{}) bool {
+		element, ok := c.byKey[key]
+		return ok && element != exclude && element.Value.(*entryImpl).refCount == 0
+	})
+	if !ok {
+		return nil
+	}
+	return c.byKey[key]
+}
+
+// oldestUnpinned returns the least recently used element which is not pinned,
+// not counting the excluded element
+func (c *lru) oldestUnpinned(exclude *list.Element) *list.Element {
+	for element := c.byAccess.Back(); element != nil; element = element.Prev() {
+		if element != exclude && element.Value.(*entryImpl).refCount == 0 {
+			return element
+		}
+	}
+	return nil
+}
+
+// oldestInPartition returns the least recently used element of the partition which is not pinned,
+// not counting the excluded element
+func (c *lru) oldestInPartition(partition string, exclude *list.Element) *list.Element {
+	for element := c.byAccess.Back(); element != nil; element = element.Prev() {
+		entry := element.Value.(*entryImpl)
+		if element != exclude && entry.refCount == 0 && c.partitionFunc(entry.key) == partition {
+			return element
+		}
+	}
+	return nil
+}
+
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	if c.rmFunc != nil {
@@ -312,6 +437,10 @@ func (c *lru) deleteInternal(element *list.Element) {
 	}
 	delete(c.byKey, entry.key)
 	c.updateSizeOnDelete(entry.key)
+	c.removeFromPartition(entry.key)
+	if c.policy != nil {
+		c.policy.Remove(entry.key)
+	}
 }
 
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
@@ -320,8 +449,40 @@ func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
 
 func (c *lru) isCacheFull() bool {
 	count := len(c.byKey)
+	maxCount, maxSize := c.capacity()
 	// if the value size is greater than maxSize(should never happen) then the item wont be cached
-	return (!c.isSizeBased && count == c.maxCount) || c.currSize > c.maxSize || count > cacheCountLimit
+	return (maxCount > 0 && count >= maxCount) || (c.isSizeBased && c.currSize > maxSize) || count > cacheCountLimit
+}
+
+// isPartitionFull returns true if the partition uses more than its quota of the cache capacity
+func (c *lru) isPartitionFull(partition string) bool {
+	usage, ok := c.partitions[partition]
+	if !ok {
+		return false
+	}
+	quota := c.partitionQuota(partition)
+	if quota <= 0 || quota >= 1 {
+		return false
+	}
+	maxCount, maxSize := c.capacity()
+	// a partition can always hold at least one entry
+	return (maxCount > 0 && usage.count > int(math.Max(1, float64(maxCount)*quota))) ||
+		(c.isSizeBased && float64(usage.size) > float64(maxSize)*quota)
+}
+
+// capacity returns MaxCount and MaxSize adjusted by the capacity scale
+func (c *lru) capacity() (int, uint64) {
+	maxCount, maxSize := c.maxCount, c.maxSize
+	if c.scaleFunc != nil {
+		if scale := c.scaleFunc(); scale > 0 && scale < 1 {
+			// the inserted entry counts towards maxCount, so it is never scaled below 2
+			if maxCount > 2 {
+				maxCount = int(math.Max(2, float64(maxCount)*scale))
+			}
+			maxSize = uint64(float64(maxSize) * scale)
+		}
+	}
+	return maxCount, maxSize
 }
 
 func (c *lru) updateSizeOnAdd(key interface{}, valueSize uint64) {
@@ -329,12 +490,43 @@ func (c *lru) updateSizeOnAdd(key interface{}, valueSize uint64) {
 		c.sizeByKey[key] = valueSize
 		// the int overflow should not happen here
 		c.currSize += uint64(valueSize)
+		if c.partitions != nil {
+			c.partitions[c.partitionFunc(key)].size += valueSize
+		}
 	}
 }
 
 func (c *lru) updateSizeOnDelete(key interface{}) {
 	if c.isSizeBased {
 		c.currSize -= uint64(c.sizeByKey[key])
+		if c.partitions != nil {
+			c.partitions[c.partitionFunc(key)].size -= c.sizeByKey[key]
+		}
 		delete(c.sizeByKey, key)
 	}
 }
+
+func (c *lru) addToPartition(key interface{}) {
+	if c.partitions == nil {
+		return
+	}
+	partition := c.partitionFunc(key)
+	usage, ok := c.partitions[partition]
+	if !ok {
+		usage = &partitionUsage{}
+		c.partitions[partition] = usage
+	}
+	usage.count++
+}
+
+func (c *lru) removeFromPartition(key interface{}) {
+	if c.partitions == nil {
+		return
+	}
+	partition := c.partitionFunc(key)
+	usage := c.partitions[partition]
+	usage.count--
+	if usage.count == 0 {
+		delete(c.partitions, partition)
+	}
+}
@@ -347,6 +347,188 @@ func TestLRU_SizeBased_CountExceeded(t *testing.T) {
 	assert.Equal(t, 4, cache.Size())
 }
 
+func TestLRU_CountAndSizeBased(t *testing.T) {
+	cache := New(&Options{
+		MaxCount: 4,
+		GetCacheItemSizeFunc: func(value interface{}) uint64 {
+			return uint64(value.(int))
+		},
+		MaxSize: 10,
+	})
+
+	cache.Put("A", 1)
+	cache.Put("B", 1)
+	cache.Put("C", 1)
+	cache.Put("D", 1)
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 3, cache.Size())
+	assert.Equal(t, uint64(3), cache.SizeBytes())
+
+	// size limit is hit before the count limit
+	cache.Put("E", 9)
+	assert.Nil(t, cache.Get("B"))
+	assert.Nil(t, cache.Get("C"))
+	assert.Equal(t, 1, cache.Get("D"))
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, uint64(10), cache.SizeBytes())
+}
+
+func TestLRU_SizeBased_ResizedOnRelease(t *testing.T) {
+	type entry struct {
+		size uint64
+	}
+	cache := New(&Options{
+		Pin: true,
+		GetCacheItemSizeFunc: func(value interface{}) uint64 {
+			return value.(*entry).size
+		},
+		MaxSize: 10,
+	})
+
+	a := &entry{size: 1}
+	b := &entry{size: 1}
+	_, err := cache.PutIfNotExist("A", a)
+	assert.NoError(t, err)
+	cache.Release("A")
+	_, err = cache.PutIfNotExist("B", b)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), cache.SizeBytes())
+
+	// B grows while pinned, its size is accounted once it is released
+	b.size = 9
+	assert.Equal(t, uint64(2), cache.SizeBytes())
+	cache.Release("B")
+	assert.Equal(t, uint64(10), cache.SizeBytes())
+	assert.Equal(t, 2, cache.Size())
+
+	b.size = 10
+	cache.Get("B")
+	cache.Release("B")
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, uint64(10), cache.SizeBytes())
+}
+
+func TestLRU_PartitionQuota(t *testing.T) {
+	quota := map[string]float64{"noisy": 0.2}
+	cache := New(&Options{
+		MaxCount: 10,
+		GetPartitionFunc: func(key interface{}) string {
+			return key.(keyType).dummyString
+		},
+		PartitionQuota: func(partition string) float64 {
+			if q, ok := quota[partition]; ok {
+				return q
+			}
+			return 1
+		},
+	})
+
+	cache.Put(keyType{"quiet", 0}, 0)
+	for i := 0; i < 5; i++ {
+		cache.Put(keyType{"noisy", i}, i)
+	}
+
+	// noisy entries only evict each other
+	assert.Equal(t, 3, cache.Size())
+	assert.Equal(t, 0, cache.Get(keyType{"quiet", 0}))
+	assert.Nil(t, cache.Get(keyType{"noisy", 2}))
+	assert.Equal(t, 3, cache.Get(keyType{"noisy", 3}))
+	assert.Equal(t, 4, cache.Get(keyType{"noisy", 4}))
+
+	count, size := cache.PartitionUsage("noisy")
+	assert.Equal(t, 2, count)
+	assert.Equal(t, uint64(0), size)
+	count, _ = cache.PartitionUsage("quiet")
+	assert.Equal(t, 1, count)
+
+	cache.Delete(keyType{"quiet", 0})
+	count, _ = cache.PartitionUsage("quiet")
+	assert.Equal(t, 0, count)
+}
+
+func TestLRU_PartitionQuota_SizeBased(t *testing.T) {
+	cache := New(&Options{
+		MaxCount: 10,
+		GetCacheItemSizeFunc: func(value interface{}) uint64 {
+			return uint64(value.(int))
+		},
+		MaxSize: 100,
+		GetPartitionFunc: func(key interface{}) string {
+			return key.(keyType).dummyString
+		},
+		PartitionQuota: func(string) float64 {
+			return 0.5
+		},
+	})
+
+	cache.Put(keyType{"A", 0}, 30)
+	cache.Put(keyType{"B", 0}, 30)
+	cache.Put(keyType{"A", 1}, 30)
+	assert.Nil(t, cache.Get(keyType{"A", 0}))
+	assert.Equal(t, 30, cache.Get(keyType{"B", 0}))
+
+	count, size := cache.PartitionUsage("A")
+	assert.Equal(t, 1, count)
+	assert.Equal(t, uint64(30), size)
+	assert.Equal(t, uint64(60), cache.SizeBytes())
+}
+
+func TestLRU_PartitionQuota_Pinned(t *testing.T) {
+	cache := New(&Options{
+		MaxCount: 10,
+		Pin:      true,
+		GetPartitionFunc: func(key interface{}) string {
+			return key.(keyType).dummyString
+		},
+		PartitionQuota: func(string) float64 {
+			return 0.1
+		},
+	})
+
+	_, err := cache.PutIfNotExist(keyType{"A", 0}, 0)
+	assert.NoError(t, err)
+	_, err = cache.PutIfNotExist(keyType{"A", 1}, 1)
+	assert.Equal(t, ErrCacheFull, err)
+
+	// other partitions are not affected
+	_, err = cache.PutIfNotExist(keyType{"B", 0}, 0)
+	assert.NoError(t, err)
+
+	cache.Release(keyType{"A", 0})
+	_, err = cache.PutIfNotExist(keyType{"A", 1}, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, cache.Get(keyType{"A", 0}))
+}
+
+func TestLRU_CapacityScale(t *testing.T) {
+	scale := 1.0
+	cache := New(&Options{
+		MaxCount: 10,
+		CapacityScale: func() float64 {
+			return scale
+		},
+	})
+
+	for i := 0; i < 9; i++ {
+		cache.Put(i, i)
+	}
+	assert.Equal(t, 9, cache.Size())
+
+	// shrinking takes effect on the next insert, evicting the oldest entries
+	scale = 0.5
+	cache.Put(9, 9)
+	assert.Equal(t, 4, cache.Size())
+	assert.Nil(t, cache.Get(5))
+	assert.Equal(t, 9, cache.Get(9))
+
+	scale = 1.0
+	for i := 10; i < 15; i++ {
+		cache.Put(i, i)
+	}
+	assert.Equal(t, 9, cache.Size())
+}
+
 func TestPanicMaxCountAndSizeNotProvided(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
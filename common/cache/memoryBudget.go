@@ -0,0 +1,212 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"io/ioutil"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// MemoryBudget observes the process heap and computes a scale factor
+	// that caches apply to their configured capacity to stay within budget
+	MemoryBudget interface {
+		common.Daemon
+		// CapacityScale returns a factor in (0, 1] to be used as Options.CapacityScale
+		CapacityScale() float64
+	}
+
+	memoryBudget struct {
+		status        int32
+		shutdownCh    chan struct{}
+		scale         uint64 // math.Float64bits of the current scale
+		fraction      dynamicconfig.FloatPropertyFn
+		checkInterval dynamicconfig.DurationPropertyFn
+		memoryLimit   func() uint64
+		heapSize      func() uint64
+		logger        log.Logger
+		metricsScope  metrics.Scope
+	}
+
+	noopMemoryBudget struct{}
+)
+
+const (
+	// minCapacityScale is the lower bound on how far caches are shrunk
+	minCapacityScale = 0.1
+	// capacityScaleGrowth is the factor used to grow caches back once heap drops below budget
+	capacityScaleGrowth = 1.1
+	// budgetLowWatermark is the fraction of the budget below which caches are grown back
+	budgetLowWatermark = 0.8
+
+	cgroupV2MemoryLimitFile = "/sys/fs/cgroup/memory.max"
+	cgroupV1MemoryLimitFile = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
+)
+
+var _ MemoryBudget = (*memoryBudget)(nil)
+var _ MemoryBudget = (*noopMemoryBudget)(nil)
+
+// NewMemoryBudget creates a memory budget which keeps the heap within
+// fraction of the container memory limit. A fraction of zero disables it.
+func NewMemoryBudget(
+	fraction dynamicconfig.FloatPropertyFn,
+	checkInterval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) MemoryBudget {
+	return newMemoryBudget(fraction, checkInterval, containerMemoryLimit, heapAlloc, logger, metricsClient)
+}
+
+func newMemoryBudget(
+	fraction dynamicconfig.FloatPropertyFn,
+	checkInterval dynamicconfig.DurationPropertyFn,
+	memoryLimit func() uint64,
+	heapSize func() uint64,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *memoryBudget {
+	return &memoryBudget{
+		status:        common.DaemonStatusInitialized,
+		shutdownCh:    make(chan struct{}),
+		scale:         math.Float64bits(1),
+		fraction:      fraction,
+		checkInterval: checkInterval,
+		memoryLimit:   memoryLimit,
+		heapSize:      heapSize,
+		logger:        logger,
+		metricsScope:  metricsClient.Scope(metrics.CacheMemoryBudgetScope),
+	}
+}
+
+// NewNoopMemoryBudget creates a memory budget which never shrinks caches
+func NewNoopMemoryBudget() MemoryBudget {
+	return &noopMemoryBudget{}
+}
+
+// Start starts the background heap observation
+func (b *memoryBudget) Start() {
+	if !atomic.CompareAndSwapInt32(&b.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go b.checkLoop()
+}
+
+// Stop stops the background heap observation
+func (b *memoryBudget) Stop() {
+	if !atomic.CompareAndSwapInt32(&b.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(b.shutdownCh)
+}
+
+func (b *memoryBudget) CapacityScale() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&b.scale))
+}
+
+func (b *memoryBudget) checkLoop() {
+	timer := time.NewTimer(b.checkInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-b.shutdownCh:
+			return
+		case <-timer.C:
+			b.check()
+			timer.Reset(b.checkInterval())
+		}
+	}
+}
+
+func (b *memoryBudget) check() {
+	scale := b.CapacityScale()
+	newScale := 1.0
+
+	fraction := b.fraction()
+	limit := b.memoryLimit()
+	heap := b.heapSize()
+	if fraction > 0 && limit > 0 {
+		budget := fraction * float64(limit)
+		switch {
+		case float64(heap) > budget:
+			newScale = math.Max(minCapacityScale, scale*budget/float64(heap))
+		case float64(heap) < budget*budgetLowWatermark:
+			newScale = math.Min(1, scale*capacityScaleGrowth)
+		default:
+			newScale = scale
+		}
+	}
+
+	if newScale != scale {
+		b.logger.Info("Adjusting cache capacity scale.",
+			tag.Key("cache-capacity-scale"),
+			tag.Value(newScale),
+		)
+	}
+	atomic.StoreUint64(&b.scale, math.Float64bits(newScale))
+	b.metricsScope.UpdateGauge(metrics.CacheMemoryBudgetHeapSizeGauge, float64(heap))
+	b.metricsScope.UpdateGauge(metrics.CacheMemoryBudgetCapacityScaleGauge, newScale)
+}
+
+func (b *noopMemoryBudget) Start() {}
+
+func (b *noopMemoryBudget) Stop() {}
+
+func (b *noopMemoryBudget) CapacityScale() float64 {
+	return 1
+}
+
+func heapAlloc() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// containerMemoryLimit returns the cgroup memory limit, or 0 if there is none
+func containerMemoryLimit() uint64 {
+	for _, file := range []string{cgroupV2MemoryLimitFile, cgroupV1MemoryLimitFile} {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		value := strings.TrimSpace(string(content))
+		if value == "max" {
+			return 0
+		}
+		limit, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		return limit
+	}
+	return 0
+}
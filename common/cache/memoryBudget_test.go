@@ -0,0 +1,80 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestMemoryBudget_ShrinkAndGrow(t *testing.T) {
+	heap := uint64(0)
+	budget := newMemoryBudget(
+		dynamicconfig.GetFloatPropertyFn(0.5),
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		func() uint64 { return 1000 },
+		func() uint64 { return heap },
+		loggerimpl.NewNopLogger(),
+		metrics.NewClient(tally.NoopScope, metrics.History),
+	)
+	assert.Equal(t, 1.0, budget.CapacityScale())
+
+	heap = 1000
+	budget.check()
+	assert.Equal(t, 0.5, budget.CapacityScale())
+
+	heap = 10000
+	budget.check()
+	assert.Equal(t, minCapacityScale, budget.CapacityScale())
+
+	// within the watermark, the scale is kept
+	heap = 450
+	budget.check()
+	assert.Equal(t, minCapacityScale, budget.CapacityScale())
+
+	heap = 100
+	for i := 0; i < 100; i++ {
+		budget.check()
+	}
+	assert.Equal(t, 1.0, budget.CapacityScale())
+}
+
+func TestMemoryBudget_Disabled(t *testing.T) {
+	budget := newMemoryBudget(
+		dynamicconfig.GetFloatPropertyFn(0),
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		func() uint64 { return 1000 },
+		func() uint64 { return 10000 },
+		loggerimpl.NewNopLogger(),
+		metrics.NewClient(tally.NoopScope, metrics.History),
+	)
+	budget.check()
+	assert.Equal(t, 1.0, budget.CapacityScale())
+
+	assert.Equal(t, 1.0, NewNoopMemoryBudget().CapacityScale())
+}
@@ -0,0 +1,393 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"container/heap"
+	"container/list"
+	"fmt"
+)
+
+const (
+	// EvictionPolicyLRU evicts the least recently used entry, it is the default order of the cache
+	// and has no EvictionPolicy instance
+	EvictionPolicyLRU = "lru"
+	// EvictionPolicyLFU evicts the least frequently used entry, ties are broken by recency
+	EvictionPolicyLFU = "lfu"
+	// EvictionPolicyARC balances recency and frequency with the adaptive replacement cache algorithm
+	EvictionPolicyARC = "arc"
+	// EvictionPolicySizeAware evicts the entry with the lowest frequency per size, aged over time (GDSF)
+	EvictionPolicySizeAware = "size-aware"
+)
+
+type (
+	// EvictionPolicy decides which entry is evicted when the cache is full. All methods are called
+	// with the cache lock held, so implementations don't need to be thread safe.
+	EvictionPolicy interface {
+		// Add is called when a key is inserted into the cache
+		Add(key interface{}, value interface{})
+		// Access is called when the value of a key is read or updated
+		Access(key interface{}, value interface{})
+		// Remove is called when a key is evicted or deleted from the cache
+		Remove(key interface{})
+		// Victim returns the next key to evict among the keys evictable returns true for,
+		// pinned entries and the entry being inserted are not evictable
+		Victim(evictable func(key interface{}) bool) (interface{}, bool)
+	}
+
+	lfuPolicy struct {
+		// buckets of keys with the same frequency, in ascending frequency order
+		buckets *list.List
+		entries map[interface{}]*lfuEntry
+	}
+
+	lfuBucket struct {
+		frequency int
+		// most recently used key first
+		keys *list.List
+	}
+
+	lfuEntry struct {
+		bucket  *list.Element
+		element *list.Element
+	}
+
+	arcPolicy struct {
+		capacity int
+		// target size of t1, adapted on ghost hits
+		target int
+		// t1 holds keys seen once recently, t2 keys seen at least twice,
+		// b1 and b2 are the ghosts of keys evicted from t1 and t2
+		t1, t2, b1, b2 *list.List
+		entries        map[interface{}]*arcEntry
+	}
+
+	arcEntry struct {
+		list    *list.List
+		element *list.Element
+	}
+
+	sizeAwarePolicy struct {
+		sizeFunc  GetCacheItemSizeFunc
+		inflation float64
+		queue     sizeAwareQueue
+		entries   map[interface{}]*sizeAwareEntry
+	}
+
+	sizeAwareEntry struct {
+		key       interface{}
+		frequency int
+		priority  float64
+		index     int
+	}
+
+	sizeAwareQueue []*sizeAwareEntry
+)
+
+var _ EvictionPolicy = (*lfuPolicy)(nil)
+var _ EvictionPolicy = (*arcPolicy)(nil)
+var _ EvictionPolicy = (*sizeAwarePolicy)(nil)
+
+// NewEvictionPolicy creates the eviction policy with the given name for a cache of maxCount entries,
+// sizeFunc is only used by the size-aware policy. The LRU policy is returned as nil.
+func NewEvictionPolicy(
+	name string,
+	maxCount int,
+	sizeFunc GetCacheItemSizeFunc,
+) (EvictionPolicy, error) {
+	switch name {
+	case "", EvictionPolicyLRU:
+		return nil, nil
+	case EvictionPolicyLFU:
+		return NewLFUPolicy(), nil
+	case EvictionPolicyARC:
+		if maxCount <= 0 {
+			return nil, fmt.Errorf("arc eviction policy requires a positive max count, got %v", maxCount)
+		}
+		return NewARCPolicy(maxCount), nil
+	case EvictionPolicySizeAware:
+		return NewSizeAwarePolicy(sizeFunc), nil
+	default:
+		return nil, fmt.Errorf("unknown cache eviction policy %q", name)
+	}
+}
+
+// NewLFUPolicy creates a least frequently used eviction policy
+func NewLFUPolicy() EvictionPolicy {
+	return &lfuPolicy{
+		buckets: list.New(),
+		entries: make(map[interface{}]*lfuEntry),
+	}
+}
+
+// NewARCPolicy creates an adaptive replacement eviction policy for a cache of capacity entries
+func NewARCPolicy(capacity int) EvictionPolicy {
+	return &arcPolicy{
+		capacity: capacity,
+		t1:       list.New(),
+		t2:       list.New(),
+		b1:       list.New(),
+		b2:       list.New(),
+		entries:  make(map[interface{}]*arcEntry),
+	}
+}
+
+// NewSizeAwarePolicy creates a greedy dual size frequency eviction policy, the size of a value
+// is re-evaluated on every access. A nil sizeFunc treats all values as the same size.
+func NewSizeAwarePolicy(sizeFunc GetCacheItemSizeFunc) EvictionPolicy {
+	return &sizeAwarePolicy{
+		sizeFunc: sizeFunc,
+		entries:  make(map[interface{}]*sizeAwareEntry),
+	}
+}
+
+func (p *lfuPolicy) Add(key interface{}, _ interface{}) {
+	if _, ok := p.entries[key]; ok {
+		p.Access(key, nil)
+		return
+	}
+
+	front := p.buckets.Front()
+	if front == nil || front.Value.(*lfuBucket).frequency != 1 {
+		front = p.buckets.PushFront(&lfuBucket{frequency: 1, keys: list.New()})
+	}
+	p.entries[key] = &lfuEntry{
+		bucket:  front,
+		element: front.Value.(*lfuBucket).keys.PushFront(key),
+	}
+}
+
+func (p *lfuPolicy) Access(key interface{}, _ interface{}) {
+	entry, ok := p.entries[key]
+	if !ok {
+		return
+	}
+
+	current := entry.bucket.Value.(*lfuBucket)
+	next := entry.bucket.Next()
+	if next == nil || next.Value.(*lfuBucket).frequency != current.frequency+1 {
+		next = p.buckets.InsertAfter(&lfuBucket{frequency: current.frequency + 1, keys: list.New()}, entry.bucket)
+	}
+	p.removeFromBucket(entry)
+	entry.bucket = next
+	entry.element = next.Value.(*lfuBucket).keys.PushFront(key)
+}
+
+func (p *lfuPolicy) Remove(key interface{}) {
+	entry, ok := p.entries[key]
+	if !ok {
+		return
+	}
+	p.removeFromBucket(entry)
+	delete(p.entries, key)
+}
+
+func (p *lfuPolicy) Victim(evictable func(key interface{}) bool) (interface{}, bool) {
+	for bucket := p.buckets.Front(); bucket != nil; bucket = bucket.Next() {
+		for element := bucket.Value.(*lfuBucket).keys.Back(); element != nil; element = element.Prev() {
+			if evictable(element.Value) {
+				return element.Value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (p *lfuPolicy) removeFromBucket(entry *lfuEntry) {
+	bucket := entry.bucket.Value.(*lfuBucket)
+	bucket.keys.Remove(entry.element)
+	if bucket.keys.Len() == 0 {
+		p.buckets.Remove(entry.bucket)
+	}
+}
+
+func (p *arcPolicy) Add(key interface{}, _ interface{}) {
+	entry, ok := p.entries[key]
+	switch {
+	case !ok:
+		p.entries[key] = &arcEntry{list: p.t1, element: p.t1.PushFront(key)}
+	case entry.list == p.b1:
+		// recently evicted from t1, so t1 deserves more room
+		p.target = minInt(p.capacity, p.target+maxInt(p.b2.Len()/p.b1.Len(), 1))
+		p.move(entry, p.t2)
+	case entry.list == p.b2:
+		// recently evicted from t2, so t2 deserves more room
+		p.target = maxInt(0, p.target-maxInt(p.b1.Len()/p.b2.Len(), 1))
+		p.move(entry, p.t2)
+	default:
+		p.move(entry, p.t2)
+	}
+	p.trimGhosts()
+}
+
+func (p *arcPolicy) Access(key interface{}, _ interface{}) {
+	if entry, ok := p.entries[key]; ok && (entry.list == p.t1 || entry.list == p.t2) {
+		p.move(entry, p.t2)
+	}
+}
+
+func (p *arcPolicy) Remove(key interface{}) {
+	entry, ok := p.entries[key]
+	if !ok {
+		return
+	}
+	switch entry.list {
+	case p.t1:
+		p.move(entry, p.b1)
+	case p.t2:
+		p.move(entry, p.b2)
+	}
+	p.trimGhosts()
+}
+
+func (p *arcPolicy) Victim(evictable func(key interface{}) bool) (interface{}, bool) {
+	first, second := p.t2, p.t1
+	if p.t1.Len() > p.target {
+		first, second = p.t1, p.t2
+	}
+	for _, l := range []*list.List{first, second} {
+		for element := l.Back(); element != nil; element = element.Prev() {
+			if evictable(element.Value) {
+				return element.Value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (p *arcPolicy) move(entry *arcEntry, to *list.List) {
+	key := entry.list.Remove(entry.element)
+	entry.list = to
+	entry.element = to.PushFront(key)
+}
+
+func (p *arcPolicy) trimGhosts() {
+	for p.b1.Len() > 0 && p.t1.Len()+p.b1.Len() > p.capacity {
+		delete(p.entries, p.b1.Remove(p.b1.Back()))
+	}
+	for p.b2.Len() > 0 && p.t1.Len()+p.t2.Len()+p.b1.Len()+p.b2.Len() > 2*p.capacity {
+		delete(p.entries, p.b2.Remove(p.b2.Back()))
+	}
+}
+
+func (p *sizeAwarePolicy) Add(key interface{}, value interface{}) {
+	if _, ok := p.entries[key]; ok {
+		p.Access(key, value)
+		return
+	}
+
+	entry := &sizeAwareEntry{key: key, frequency: 1}
+	entry.priority = p.priority(entry, value)
+	p.entries[key] = entry
+	heap.Push(&p.queue, entry)
+}
+
+func (p *sizeAwarePolicy) Access(key interface{}, value interface{}) {
+	entry, ok := p.entries[key]
+	if !ok {
+		return
+	}
+	entry.frequency++
+	entry.priority = p.priority(entry, value)
+	heap.Fix(&p.queue, entry.index)
+}
+
+func (p *sizeAwarePolicy) Remove(key interface{}) {
+	entry, ok := p.entries[key]
+	if !ok {
+		return
+	}
+	heap.Remove(&p.queue, entry.index)
+	delete(p.entries, key)
+}
+
+func (p *sizeAwarePolicy) Victim(evictable func(key interface{}) bool) (interface{}, bool) {
+	var skipped []*sizeAwareEntry
+	defer func() {
+		for _, entry := range skipped {
+			heap.Push(&p.queue, entry)
+		}
+	}()
+
+	for p.queue.Len() > 0 {
+		entry := heap.Pop(&p.queue).(*sizeAwareEntry)
+		skipped = append(skipped, entry)
+		if evictable(entry.key) {
+			// age the remaining entries so entries which were popular long ago are evicted eventually
+			p.inflation = entry.priority
+			return entry.key, true
+		}
+	}
+	return nil, false
+}
+
+func (p *sizeAwarePolicy) priority(entry *sizeAwareEntry, value interface{}) float64 {
+	size := uint64(1)
+	if p.sizeFunc != nil && value != nil {
+		if valueSize := p.sizeFunc(value); valueSize > 0 {
+			size = valueSize
+		}
+	}
+	return p.inflation + float64(entry.frequency)/float64(size)
+}
+
+func (q sizeAwareQueue) Len() int {
+	return len(q)
+}
+
+func (q sizeAwareQueue) Less(i, j int) bool {
+	return q[i].priority < q[j].priority
+}
+
+func (q sizeAwareQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *sizeAwareQueue) Push(x interface{}) {
+	entry := x.(*sizeAwareEntry)
+	entry.index = len(*q)
+	*q = append(*q, entry)
+}
+
+func (q *sizeAwareQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return entry
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
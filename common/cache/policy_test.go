@@ -0,0 +1,166 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEvictionPolicy(t *testing.T) {
+	policy, err := NewEvictionPolicy("", 10, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = NewEvictionPolicy(EvictionPolicyLRU, 10, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = NewEvictionPolicy(EvictionPolicyLFU, 10, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &lfuPolicy{}, policy)
+
+	policy, err = NewEvictionPolicy(EvictionPolicyARC, 10, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &arcPolicy{}, policy)
+
+	policy, err = NewEvictionPolicy(EvictionPolicySizeAware, 10, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &sizeAwarePolicy{}, policy)
+
+	_, err = NewEvictionPolicy(EvictionPolicyARC, 0, nil)
+	assert.Error(t, err)
+
+	_, err = NewEvictionPolicy("mru", 10, nil)
+	assert.Error(t, err)
+}
+
+func TestLFUPolicy(t *testing.T) {
+	cache := New(&Options{MaxCount: 4, EvictionPolicy: NewLFUPolicy()})
+
+	cache.Put("A", "Foo")
+	cache.Put("B", "Bar")
+	cache.Put("C", "Cid")
+	cache.Get("A")
+	cache.Get("A")
+	cache.Get("B")
+
+	// C is the least frequently used although A was inserted first
+	cache.Put("D", "Delt")
+	assert.Equal(t, 3, cache.Size())
+	assert.Nil(t, cache.Get("C"))
+
+	// D is used once, the most recently inserted entry is never the victim
+	cache.Put("E", "Epsi")
+	assert.Nil(t, cache.Get("D"))
+	assert.Equal(t, "Foo", cache.Get("A"))
+	assert.Equal(t, "Bar", cache.Get("B"))
+	assert.Equal(t, "Epsi", cache.Get("E"))
+
+	cache.Delete("A")
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 2, cache.Size())
+}
+
+func TestARCPolicy(t *testing.T) {
+	policy := NewARCPolicy(2)
+	evictAll := func(interface{}) bool { return true }
+
+	policy.Add("A", nil)
+	policy.Add("B", nil)
+	policy.Access("A", nil)
+
+	// A was seen twice, B only once
+	key, ok := policy.Victim(evictAll)
+	assert.True(t, ok)
+	assert.Equal(t, "B", key)
+	policy.Remove("B")
+
+	// B is re-added while in the ghost list, so it is promoted to frequent
+	// and recent entries get more room
+	policy.Add("B", nil)
+	arc := policy.(*arcPolicy)
+	assert.Equal(t, 1, arc.target)
+	assert.Equal(t, 2, arc.t2.Len())
+
+	key, ok = policy.Victim(evictAll)
+	assert.True(t, ok)
+	assert.Equal(t, "A", key)
+
+	key, ok = policy.Victim(func(key interface{}) bool { return key != "A" })
+	assert.True(t, ok)
+	assert.Equal(t, "B", key)
+
+	_, ok = policy.Victim(func(interface{}) bool { return false })
+	assert.False(t, ok)
+}
+
+func TestARCPolicy_GhostsBounded(t *testing.T) {
+	policy := NewARCPolicy(2).(*arcPolicy)
+	for i := 0; i < 10; i++ {
+		policy.Add(i, nil)
+		policy.Remove(i)
+	}
+	assert.True(t, policy.t1.Len()+policy.b1.Len() <= 2)
+	assert.Equal(t, policy.b1.Len(), len(policy.entries))
+}
+
+func TestSizeAwarePolicy(t *testing.T) {
+	sizeFunc := func(value interface{}) uint64 {
+		return uint64(value.(int))
+	}
+	cache := New(&Options{MaxCount: 3, EvictionPolicy: NewSizeAwarePolicy(sizeFunc)})
+
+	cache.Put("small", 1)
+	cache.Put("large", 100)
+	cache.Get("large")
+
+	// frequency per size of large is still below the one of small
+	cache.Put("new", 1)
+	assert.Nil(t, cache.Get("large"))
+	assert.Equal(t, 1, cache.Get("small"))
+	assert.Equal(t, 1, cache.Get("new"))
+}
+
+func TestEvictionPolicy_Pinned(t *testing.T) {
+	cache := New(&Options{MaxCount: 3, Pin: true, EvictionPolicy: NewLFUPolicy()})
+
+	_, err := cache.PutIfNotExist("A", "Foo")
+	assert.NoError(t, err)
+	_, err = cache.PutIfNotExist("B", "Bar")
+	assert.NoError(t, err)
+	cache.Get("B")
+	cache.Release("B")
+	cache.Release("B")
+
+	// A is the least frequently used but pinned
+	_, err = cache.PutIfNotExist("C", "Cid")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, "Foo", cache.Get("A"))
+	cache.Release("A")
+
+	// A and C are pinned
+	_, err = cache.PutIfNotExist("D", "Delt")
+	assert.Equal(t, ErrCacheFull, err)
+	assert.Equal(t, 2, cache.Size())
+}
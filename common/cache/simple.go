@@ -166,6 +166,16 @@ func (c *simple) Size() int {
 	return len(c.accessMap)
 }
 
+// SizeBytes always returns 0 as the simple cache is not size based
+func (c *simple) SizeBytes() uint64 {
+	return 0
+}
+
+// PartitionUsage always returns 0 as the simple cache is not partitioned
+func (c *simple) PartitionUsage(string) (int, uint64) {
+	return 0, 0
+}
+
 func (c *simple) Iterator() Iterator {
 	c.RLock()
 	iterator := &simpleItr{
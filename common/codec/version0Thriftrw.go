@@ -22,8 +22,10 @@ package codec
 
 import (
 	"bytes"
+	"sync"
 
 	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
 )
 
 type (
@@ -35,6 +37,25 @@ type (
 
 var _ BinaryEncoder = (*ThriftRWEncoder)(nil)
 
+const (
+	// maxPooledBufferSize bounds the buffers returned to the pool,
+	// so that an occasional large payload is not retained forever
+	maxPooledBufferSize = 1024 * 1024
+)
+
+var (
+	bufferPool = sync.Pool{
+		New: func() interface{} {
+			return &bytes.Buffer{}
+		},
+	}
+	readerPool = sync.Pool{
+		New: func() interface{} {
+			return bytes.NewReader(nil)
+		},
+	}
+)
+
 // NewThriftRWEncoder generate a new ThriftRWEncoder
 func NewThriftRWEncoder() *ThriftRWEncoder {
 	return &ThriftRWEncoder{}
@@ -45,19 +66,24 @@ func (t *ThriftRWEncoder) Encode(obj ThriftObject) ([]byte, error) {
 	if obj == nil {
 		return nil, MsgPayloadNotThriftEncoded
 	}
-	var writer bytes.Buffer
+	writer := getBuffer()
+	defer putBuffer(writer)
+
 	// use the first byte to version the serialization
 	err := writer.WriteByte(preambleVersion0)
 	if err != nil {
 		return nil, err
 	}
 
-	sw := binary.Default.Writer(&writer)
+	sw := binary.Default.Writer(writer)
 	defer sw.Close()
 	if err := obj.Encode(sw); err != nil {
 		return nil, err
 	}
-	return writer.Bytes(), nil
+	// the pooled buffer is reused, so the result has to be copied out
+	result := make([]byte, writer.Len())
+	copy(result, writer.Bytes())
+	return result, nil
 }
 
 // Decode decode the object
@@ -71,7 +97,60 @@ func (t *ThriftRWEncoder) Decode(b []byte, val ThriftObject) error {
 		return InvalidBinaryEncodingVersion
 	}
 
-	reader := bytes.NewReader(b[1:])
+	reader := getReader(b[1:])
+	defer putReader(reader)
+
 	sr := binary.Default.Reader(reader)
 	return val.Decode(sr)
 }
+
+// DecodeStream validates the encoding version of the payload and hands a stream reader of the
+// encoded object to decodeFn, so that the object can be partially decoded.
+// offsetFn returns the offset in b of the next byte to be read.
+func (t *ThriftRWEncoder) DecodeStream(
+	b []byte,
+	decodeFn func(sr stream.Reader, offsetFn func() int) error,
+) error {
+	if len(b) < 1 {
+		return MissingBinaryEncodingVersion
+	}
+
+	version := b[0]
+	if version != preambleVersion0 {
+		return InvalidBinaryEncodingVersion
+	}
+
+	reader := getReader(b[1:])
+	defer putReader(reader)
+
+	sr := binary.Default.Reader(reader)
+	defer sr.Close()
+	return decodeFn(sr, func() int {
+		return len(b) - reader.Len()
+	})
+}
+
+func getBuffer() *bytes.Buffer {
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	return buffer
+}
+
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(buffer)
+}
+
+func getReader(b []byte) *bytes.Reader {
+	reader := readerPool.Get().(*bytes.Reader)
+	reader.Reset(b)
+	return reader
+}
+
+func putReader(reader *bytes.Reader) {
+	// drop the reference to the payload so it can be garbage collected
+	reader.Reset(nil)
+	readerPool.Put(reader)
+}
@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/thriftrw/protocol/stream"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 )
@@ -108,6 +109,65 @@ func (s *thriftRWEncoderSuite) TestDecode_InvalidVersion() {
 	s.Equal(InvalidBinaryEncodingVersion, err)
 }
 
+func (s *thriftRWEncoderSuite) TestDecodeStream() {
+	var val workflow.HistoryEvent
+	var offset int
+	err := s.encoder.DecodeStream(thriftEncodedBinary, func(sr stream.Reader, offsetFn func() int) error {
+		s.Equal(1, offsetFn())
+		if err := val.Decode(sr); err != nil {
+			return err
+		}
+		offset = offsetFn()
+		return nil
+	})
+	s.Nil(err)
+	s.True(val.Equals(thriftObject))
+	s.Equal(len(thriftEncodedBinary), offset)
+}
+
+func (s *thriftRWEncoderSuite) TestDecodeStream_InvalidVersion() {
+	binary := []byte{}
+	binary = append(binary, thriftEncodedBinary...)
+	binary[0] = preambleVersion0 - 1
+
+	err := s.encoder.DecodeStream(binary, func(stream.Reader, func() int) error {
+		s.Fail("decodeFn should not be called")
+		return nil
+	})
+	s.Equal(InvalidBinaryEncodingVersion, err)
+}
+
+func (s *thriftRWEncoderSuite) TestEncode_ResultNotReused() {
+	binary, err := s.encoder.Encode(thriftObject)
+	s.Nil(err)
+
+	// encoding another object reuses the pooled buffer, which must not alter earlier results
+	_, err = s.encoder.Encode(&workflow.HistoryEvent{EventId: int64Ptr(1)})
+	s.Nil(err)
+	s.Equal(thriftEncodedBinary, binary)
+}
+
+func BenchmarkThriftRWEncoder_Encode(b *testing.B) {
+	encoder := NewThriftRWEncoder()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := encoder.Encode(thriftObject); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkThriftRWEncoder_Decode(b *testing.B) {
+	encoder := NewThriftRWEncoder()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var val workflow.HistoryEvent
+		if err := encoder.Decode(thriftEncodedBinary, &val); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func int64Ptr(v int64) *int64 {
 	return &v
 }
@@ -31,6 +31,9 @@ import (
 	"go.uber.org/multierr"
 )
 
+// GzipCompression is the rpcCompression value enabling gzip for cross DC traffic
+const GzipCompression = "gzip"
+
 type (
 	// ClusterGroupMetadata contains all the clusters participating in a replication group(aka XDC/GlobalDomain)
 	ClusterGroupMetadata struct {
@@ -67,6 +70,10 @@ type (
 		// Allowed values: tchannel|grpc
 		// Default: tchannel
 		RPCTransport string `yaml:"rpcTransport"`
+		// RPCCompression specifies compression to use for replication traffic.
+		// Allowed values: gzip (grpc transport only)
+		// Default: no compression
+		RPCCompression string `yaml:"rpcCompression"`
 		// AuthorizationProvider contains the information to authorize the cluster
 		AuthorizationProvider AuthorizationProvider `yaml:"authorizationProvider"`
 		// TLS configures client TLS/SSL authentication for connections to this cluster
@@ -133,6 +140,10 @@ func (m *ClusterGroupMetadata) Validate() error {
 			errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc transport must %v or %v",
 				clusterName, tchannel.TransportName, grpc.TransportName))
 		}
+		if info.RPCCompression != "" && (info.RPCCompression != GzipCompression || info.RPCTransport != grpc.TransportName) {
+			errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc compression must be empty or %v with %v transport",
+				clusterName, GzipCompression, grpc.TransportName))
+		}
 	}
 	if len(versionToClusterName) != len(m.ClusterGroup) {
 		errs = multierr.Append(errs, errors.New("initial versions of the cluster group have duplicates"))
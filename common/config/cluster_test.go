@@ -144,6 +144,16 @@ func TestClusterGroupMetadataValidate(t *testing.T) {
 			}),
 			err: "cluster active: rpc transport must tchannel or grpc",
 		},
+		{
+			msg: "rpc compression with tchannel transport",
+			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
+				active := m.ClusterGroup["active"]
+				active.RPCTransport = "tchannel"
+				active.RPCCompression = "gzip"
+				m.ClusterGroup["active"] = active
+			}),
+			err: "cluster active: rpc compression must be empty or gzip with grpc transport",
+		},
 		{
 			msg: "initial version duplicated",
 			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
@@ -72,6 +72,14 @@ type (
 		Blobstore Blobstore `yaml:"blobstore"`
 		// Authorization is the config for setting up authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Drain is the config for the drain endpoint of the server process
+		Drain Drain `yaml:"drain"`
+	}
+
+	// Drain contains the config items for the drain endpoint of the server process
+	Drain struct {
+		// Port is the port the drain endpoint binds to on localhost, the endpoint is disabled if not set
+		Port int `yaml:"port"`
 	}
 
 	Authorization struct {
@@ -112,6 +120,16 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// GraphQL is the GraphQL endpoint configuration, only used by the frontend
+		GraphQL GraphQL `yaml:"graphql"`
+	}
+
+	// GraphQL contains the config items for the read-only GraphQL endpoint
+	GraphQL struct {
+		// Port is the port the GraphQL endpoint will bind to, the endpoint is disabled if not set
+		Port int `yaml:"port"`
+		// BindOnLocalHost is true if localhost is the bind address
+		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 	}
 
 	// PProf contains the rpc config items
@@ -140,6 +158,19 @@ type (
 		GRPCMaxMsgSize int `yaml:"grpcMaxMsgSize"`
 		// TLS allows configuring optional TLS/SSL authentication on the server (only on gRPC port)
 		TLS TLS `yaml:"tls"`
+		// Keepalive configures gRPC keepalive for internode client connections
+		Keepalive GRPCKeepalive `yaml:"keepalive"`
+	}
+
+	// GRPCKeepalive contains the client side gRPC keepalive config
+	GRPCKeepalive struct {
+		// Time after which the client pings the server if there is no activity on the connection,
+		// keepalive is disabled when it is zero
+		Time time.Duration `yaml:"time"`
+		// Timeout is how long the client waits for a ping ack before closing the connection
+		Timeout time.Duration `yaml:"timeout"`
+		// PermitWithoutStream allows pings to be sent even if there are no active RPCs
+		PermitWithoutStream bool `yaml:"permitWithoutStream"`
 	}
 
 	// Blobstore contains the config for blobstore
@@ -224,6 +255,13 @@ type (
 		TLS *TLS `yaml:"tls"`
 		// ProtoVersion
 		ProtoVersion int `yaml:"protoVersion"`
+		// DisableTokenAwareRouting makes the client route queries round robin instead of directly to a replica of the queried partition
+		DisableTokenAwareRouting bool `yaml:"disableTokenAwareRouting"`
+		// SpeculativeExecution makes the client retry reads on another host before the previous attempt fails, disabled if not set
+		SpeculativeExecution *SpeculativeExecution `yaml:"speculativeExecution"`
+		// ConsistencyOverrides overrides the consistency level of all queries against a table, keyed by table name.
+		// Values are consistency level names, e.g. LOCAL_ONE. Overriding a table used by conditional updates may break correctness.
+		ConsistencyOverrides map[string]string `yaml:"consistencyOverrides"`
 		// ConnectAttributes is a set of key-value attributes as a supplement/extension to the above common fields
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
@@ -231,6 +269,14 @@ type (
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 	}
 
+	// SpeculativeExecution is the configuration for speculatively retrying NoSQL reads
+	SpeculativeExecution struct {
+		// MaxAttempts is the max number of additional attempts of a read
+		MaxAttempts int `yaml:"maxAttempts"`
+		// Delay is the time to wait for an attempt before starting the next one
+		Delay time.Duration `yaml:"delay"`
+	}
+
 	// SQL is the configuration for connecting to a SQL backed datastore
 	SQL struct {
 		// User is the username to be used for the conn
@@ -86,6 +86,10 @@ const (
 const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
+	// SignalGatewayAppName is used to find kafka topics of the signal gateway
+	SignalGatewayAppName = "signal-gateway"
+	// CloudEventsAppName is used to find kafka topics of workflow lifecycle cloudevents
+	CloudEventsAppName = "cloudevents"
 )
 
 // This was flagged by salus as potentially hardcoded credentials. This is a false positive by the scanner and should be
@@ -160,6 +164,10 @@ const (
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
+	// DomainDataKeyForWebhookURL is the key of DomainData for the https endpoint notified when a workflow closes
+	DomainDataKeyForWebhookURL = "WebhookURL"
+	// DomainDataKeyForWebhookSigningKey is the key of DomainData for the key used to sign webhook payloads
+	DomainDataKeyForWebhookSigningKey = "WebhookSigningKey"
 )
 
 type (
@@ -650,7 +650,7 @@ func (d *handlerImpl) createResponse(
 		Status:      getDomainStatus(info),
 		Description: info.Description,
 		OwnerEmail:  info.OwnerEmail,
-		Data:        info.Data,
+		Data:        redactDomainData(info.Data),
 		UUID:        info.ID,
 	}
 
@@ -697,6 +697,20 @@ func (d *handlerImpl) mergeBadBinaries(
 	}
 }
 
+// redactDomainData strips the secrets kept in the domain data so that they are never returned by the domain APIs
+func redactDomainData(data map[string]string) map[string]string {
+	if _, ok := data[common.DomainDataKeyForWebhookSigningKey]; !ok {
+		return data
+	}
+	redacted := make(map[string]string, len(data))
+	for k, v := range data {
+		if k != common.DomainDataKeyForWebhookSigningKey {
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
+
 func (d *handlerImpl) mergeDomainData(
 	old map[string]string,
 	new map[string]string,
@@ -188,6 +188,24 @@ func (s *domainHandlerCommonSuite) TestMergeDomainData_Nil() {
 	}, out)
 }
 
+func TestRedactDomainData(t *testing.T) {
+	data := map[string]string{
+		"k0":                                     "v0",
+		common.DomainDataKeyForWebhookURL:        "https://example.com/hook",
+		common.DomainDataKeyForWebhookSigningKey: "secret",
+	}
+
+	out := redactDomainData(data)
+	assert.Equal(t, map[string]string{
+		"k0":                              "v0",
+		common.DomainDataKeyForWebhookURL: "https://example.com/hook",
+	}, out)
+	// the stored domain data must be left untouched
+	assert.Equal(t, "secret", data[common.DomainDataKeyForWebhookSigningKey])
+
+	assert.Nil(t, redactDomainData(nil))
+}
+
 // test merging bad binaries
 func (s *domainHandlerCommonSuite) TestMergeBadBinaries_Overriding() {
 	out := s.handler.mergeBadBinaries(
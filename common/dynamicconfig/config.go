@@ -113,6 +113,9 @@ type IntPropertyFn func(opts ...FilterOption) int
 // IntPropertyFnWithDomainFilter is a wrapper to get int property from dynamic config with domain as filter
 type IntPropertyFnWithDomainFilter func(domain string) int
 
+// IntPropertyFnWithDomainIDFilter is a wrapper to get int property from dynamic config with domainID as filter
+type IntPropertyFnWithDomainIDFilter func(domainID string) int
+
 // IntPropertyFnWithTaskListInfoFilters is a wrapper to get int property from dynamic config with three filters: domain, taskList, taskType
 type IntPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) int
 
@@ -161,6 +164,9 @@ type BoolPropertyFnWithDomainIDFilter func(domainID string) bool
 // BoolPropertyFnWithDomainIDAndWorkflowIDFilter is a wrapper to get bool property from dynamic config with domainID and workflowID as filter
 type BoolPropertyFnWithDomainIDAndWorkflowIDFilter func(domainID string, workflowID string) bool
 
+// BoolPropertyFnWithShardIDFilter is a wrapper to get bool property from dynamic config with shardID as filter
+type BoolPropertyFnWithShardIDFilter func(shardID int) bool
+
 // BoolPropertyFnWithTaskListInfoFilters is a wrapper to get bool property from dynamic config with three filters: domain, taskList, taskType
 type BoolPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) bool
 
@@ -217,6 +223,23 @@ func (c *Collection) GetIntPropertyFilteredByDomain(key IntKey) IntPropertyFnWit
 	}
 }
 
+// GetIntPropertyFilteredByDomainID gets property with domainID filter and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByDomainID(key IntKey) IntPropertyFnWithDomainIDFilter {
+	return func(domainID string) int {
+		filters := c.toFilterMap(DomainIDFilter(domainID))
+		val, err := c.client.GetIntValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultInt()
+		}
+		c.logValue(key, filters, val, key.DefaultValue(), intCompareEquals)
+		return val
+	}
+}
+
 // GetIntPropertyFilteredByWorkflowType gets property with workflow type filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByWorkflowType(key IntKey) IntPropertyFnWithWorkflowTypeFilter {
 	return func(domainName string, workflowType string) int {
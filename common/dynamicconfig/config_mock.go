@@ -74,6 +74,16 @@ func GetBoolPropertyFnFilteredByDomainID(value bool) func(domainID string) bool
 	return func(domainID string) bool { return value }
 }
 
+// GetBoolPropertyFnFilteredByShardID returns value as BoolPropertyFnWithShardIDFilter
+func GetBoolPropertyFnFilteredByShardID(value bool) func(shardID int) bool {
+	return func(shardID int) bool { return value }
+}
+
+// GetBoolPropertyFnFilteredByTaskListInfo returns value as BoolPropertyFnWithTaskListInfoFilters
+func GetBoolPropertyFnFilteredByTaskListInfo(value bool) func(domain string, taskList string, taskType int) bool {
+	return func(domain string, taskList string, taskType int) bool { return value }
+}
+
 // GetDurationPropertyFnFilteredByDomain returns value as DurationPropertyFnFilteredByDomain
 func GetDurationPropertyFnFilteredByDomain(value time.Duration) func(domain string) time.Duration {
 	return func(domain string) time.Duration { return value }
@@ -99,6 +109,11 @@ func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 	return func(...FilterOption) string { return value }
 }
 
+// GetStringPropertyFnFilteredByDomain returns value as StringPropertyFnWithDomainFilter
+func GetStringPropertyFnFilteredByDomain(value string) func(domain string) string {
+	return func(domain string) string { return value }
+}
+
 // GetMapPropertyFn returns value as MapPropertyFn
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
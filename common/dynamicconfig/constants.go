@@ -1247,6 +1247,192 @@ const (
 	// Value type: Int
 	// Default value: 1 (no jittering)
 	WorkflowDeletionJitterRange
+	// QueueProcessorSplitRolloutPercentage is the percentage of shards for which processing queue split policy is enabled when QueueProcessorEnableSplit is true
+	// KeyName: history.queueProcessorSplitRolloutPercentage
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: ShardID
+	QueueProcessorSplitRolloutPercentage
+	// MatchingSyncMatchRolloutPercentage is the percentage of task lists for which sync match is enabled when MatchingEnableSyncMatch is true
+	// KeyName: matching.syncMatchRolloutPercentage
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingSyncMatchRolloutPercentage
+	// WorkerSignalGatewayConcurrency is the number of workflows the signal gateway delivers signals to concurrently
+	// KeyName: worker.signalGatewayConcurrency
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	WorkerSignalGatewayConcurrency
+	// WorkflowCloseWebhookMaxConcurrentDeliveries is the max number of in-flight workflow close webhook deliveries per shard
+	// KeyName: history.workflowCloseWebhookMaxConcurrentDeliveries
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	WorkflowCloseWebhookMaxConcurrentDeliveries
+	// CloudEventsMaxConcurrentEmits is the max number of in-flight workflow lifecycle CloudEvents per shard, events above it are dropped
+	// KeyName: history.cloudEventsMaxConcurrentEmits
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	CloudEventsMaxConcurrentEmits
+	// MatchingBacklogAlertCountThreshold is the task list backlog count at or above which a backlog alert is published, 0 disables the check
+	// KeyName: matching.backlogAlertCountThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingBacklogAlertCountThreshold
+	// WorkerBlobReencoderRPS is the max number of domain records re-encoded per second by the blob re-encoder
+	// KeyName: worker.blobReencoderRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	WorkerBlobReencoderRPS
+	// FrontendHistoryPrefetchCacheSize is the max number of prefetched history pages a frontend host keeps
+	// KeyName: frontend.historyPrefetchCacheSize
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: N/A
+	FrontendHistoryPrefetchCacheSize
+
+	// MatchingAdaptiveLongPollMaxOutstandingPolls is the number of outstanding polls on a matching host at which long polls are held for the min interval
+	// KeyName: matching.adaptiveLongPollMaxOutstandingPolls
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: N/A
+	MatchingAdaptiveLongPollMaxOutstandingPolls
+
+	// MaxSignalRequestIDsCarriedOver is the max number of the most recent signal requestIDs carried over to the new run on continue-as-new to deduplicate retried signals, 0 disables it
+	// KeyName: history.maxSignalRequestIDsCarriedOver
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	MaxSignalRequestIDsCarriedOver
+
+	// MutableStateInvariantCheckProbability is the probability [0-100] that invariants will be checked when a mutable state transaction is closed
+	// KeyName: history.mutableStateInvariantCheckProbability
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	MutableStateInvariantCheckProbability
+
+	// DecisionQuarantineThreshold is the number of server side failures of the same decision after which the workflow is quarantined and its decisions are no longer dispatched, 0 disables quarantine
+	// KeyName: history.decisionQuarantineThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	DecisionQuarantineThreshold
+
+	// PendingActivitiesCountLimit is the max number of pending activities of a workflow execution, decisions scheduling more activities are failed, 0 means no limit
+	// KeyName: history.pendingActivitiesCountLimit
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PendingActivitiesCountLimit
+
+	// PendingChildWorkflowsCountLimit is the max number of pending child workflows of a workflow execution, decisions starting more child workflows are failed, 0 means no limit
+	// KeyName: history.pendingChildWorkflowsCountLimit
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PendingChildWorkflowsCountLimit
+
+	// ActivityDispatchRPSByDomain is the cluster wide rate at which activity tasks of a domain are dispatched to matching, tasks exceeding it are retried later, 0 means no limit
+	// KeyName: history.activityDispatchRPSByDomain
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	ActivityDispatchRPSByDomain
+
+	// ChildWorkflowStartRPSPerParent is the rate at which child workflows of a single parent workflow are started, transfer tasks exceeding it are retried later, 0 means no limit
+	// KeyName: history.childWorkflowStartRPSPerParent
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	ChildWorkflowStartRPSPerParent
+
+	// PersistenceHedgedReadMaxRPS is the per host budget of hedged persistence read attempts per second
+	// KeyName: system.persistenceHedgedReadMaxRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	PersistenceHedgedReadMaxRPS
+
+	// RPCShedBackgroundInflightThreshold is the number of in-flight inbound requests on a host from which background class requests (e.g. from the worker service) are rejected, 0 disables shedding
+	// KeyName: system.rpcShedBackgroundInflightThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	RPCShedBackgroundInflightThreshold
+
+	// RPCShedReplicationInflightThreshold is the number of in-flight inbound requests on a host from which replication class requests are rejected, 0 disables shedding
+	// KeyName: system.rpcShedReplicationInflightThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	RPCShedReplicationInflightThreshold
+
+	// FrontendStorageMeteringRPS is the rate of persistence reads the storage meter issues while aggregating per-domain storage usage
+	// KeyName: frontend.storageMeteringRPS
+	// Value type: Int
+	// Default value: 50
+	// Allowed filters: N/A
+	FrontendStorageMeteringRPS
+
+	// FrontendDomainStorageBudgetBytes is the storage budget of a domain in bytes, the storage meter reports the budget utilization and warns about domains exceeding it, 0 means no budget
+	// KeyName: frontend.domainStorageBudgetBytes
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendDomainStorageBudgetBytes
+
+	// WorkerStuckWorkflowDetectionRPS is the rate of persistence reads the stuck workflow detector issues while scanning open workflows
+	// KeyName: worker.stuckWorkflowDetectionRPS
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	WorkerStuckWorkflowDetectionRPS
+
+	// HistoryBusyPendingTaskThreshold is the number of pending tasks in a shard's queues above which the shard rejects new workflow starts and signals with a service busy error, 0 disables the check
+	// KeyName: history.busyPendingTaskThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryBusyPendingTaskThreshold
+
+	// HistoryExportQueueSize is the max number of pending history exports buffered per history host, exports beyond it are dropped
+	// KeyName: history.historyExportQueueSize
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: N/A
+	HistoryExportQueueSize
+
+	// HistoryExportConcurrency is the number of workers uploading exported histories to the blobstore per history host
+	// KeyName: history.historyExportConcurrency
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	HistoryExportConcurrency
+
+	// HistoryCacheMaxSizeBytes is the max estimated size in bytes of the mutable states in the history cache of a shard, 0 disables the limit
+	// KeyName: history.cacheMaxSizeBytes
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryCacheMaxSizeBytes
+	// HistoryCacheDomainQuotaPercent is the percentage of the history cache capacity, in entries and bytes, a single domain can use
+	// KeyName: history.cacheDomainQuotaPercent
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainID
+	HistoryCacheDomainQuotaPercent
+	// HistoryShardCacheWarmUpMaxExecutions is the max number of recently updated executions loaded into the execution cache when a shard is acquired, 0 disables the warm-up
+	// KeyName: history.shardCacheWarmUpMaxExecutions
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryShardCacheWarmUpMaxExecutions
 
 	// LastIntKey must be the last one in this const group
 	LastIntKey
@@ -1372,6 +1558,12 @@ const (
 	// Default value: true
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingEnableSyncMatch
+	// MatchingTaskListDraining marks a task list as draining, tasks forwarded from child partitions are no longer sync matched
+	// KeyName: matching.taskListDraining
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskListDraining
 	// MatchingEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID
 	// KeyName: matching.enableTaskInfoLogByDomainID
 	// Value type: Bool
@@ -1423,6 +1615,12 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	QueueProcessorEnableLoadQueueStates
+	// QueueProcessorEnableCachePrefetch is indicates whether queue processors should prefetch the mutable state of loaded tasks into the execution cache
+	// KeyName: history.queueProcessorEnableCachePrefetch
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	QueueProcessorEnableCachePrefetch
 	// TransferProcessorEnableValidator is whether validator should be enabled for transferQueueProcessor
 	// KeyName: history.transferProcessorEnableValidator
 	// Value type: Bool
@@ -1677,6 +1875,117 @@ const (
 	// Value type: bool
 	// Default value: false
 	Lockdown
+	// EnableDynamicConfigDriftDetection decides whether to periodically compare dynamic config with remote clusters and report drift
+	// KeyName: worker.enableDynamicConfigDriftDetection
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableDynamicConfigDriftDetection
+	// EnableSignalGateway decides whether to deliver signals consumed from the signal-gateway kafka topic
+	// KeyName: worker.enableSignalGateway
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableSignalGateway
+	// EnableWorkflowCloseWebhook decides whether to notify the domain's webhook endpoint when a workflow closes
+	// KeyName: history.enableWorkflowCloseWebhook
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowCloseWebhook
+	// EnableCloudEvents decides whether to emit CloudEvents for the workflow lifecycle transitions of a domain
+	// KeyName: history.enableCloudEvents
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableCloudEvents
+	// EnableBlobReencoder decides whether to periodically rewrite persisted blobs with the encodings configured by SQLBlobEncodings
+	// KeyName: worker.enableBlobReencoder
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableBlobReencoder
+	// EnableWorkflowReadSnapshot decides whether read only history APIs are served from a snapshot of the last persisted mutable state instead of waiting on the workflow lock
+	// KeyName: history.enableWorkflowReadSnapshot
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowReadSnapshot
+	// HistoryCacheEnableDomainMetrics decides whether the history cache emits its occupancy per domain
+	// KeyName: history.cacheEnableDomainMetrics
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	HistoryCacheEnableDomainMetrics
+	// FrontendEnableHistoryPrefetch decides whether frontend reads ahead the next page of a paginated workflow history
+	// KeyName: frontend.enableHistoryPrefetch
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendEnableHistoryPrefetch
+
+	// MatchingEnableAdaptiveLongPoll decides whether matching shortens long poll hold durations when the host is loaded
+	// KeyName: matching.enableAdaptiveLongPoll
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableAdaptiveLongPoll
+
+	// EnableShardFencingAudit decides whether history cross-checks the rangeID of conditional writes against the shard owner and reports fencing violations
+	// KeyName: history.enableShardFencingAudit
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableShardFencingAudit
+
+	// FrontendClusterReadOnly puts the cluster in read-only mode, rejecting workflow starts, signals, cancellations, terminations, resets and decision completions while reads, queries and replication keep working
+	// KeyName: frontend.clusterReadOnly
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	FrontendClusterReadOnly
+
+	// EnableQueryResultCache enables caching of eventually consistent query results per run, query type and args until the next decision completes
+	// KeyName: history.enableQueryResultCache
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableQueryResultCache
+
+	// FrontendEnableStorageMetering enables the periodic aggregation of persisted bytes per domain, emitted as metrics by a single frontend host
+	// KeyName: frontend.enableStorageMetering
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	FrontendEnableStorageMetering
+
+	// EnableStuckWorkflowDetection decides whether to start the stuck workflow detector in the worker service
+	// KeyName: worker.enableStuckWorkflowDetection
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableStuckWorkflowDetection
+
+	// WorkerStuckWorkflowAlertEnabled decides whether the stuck workflow detector alerts, by warning log and metric, on workflows newly found stuck in a domain
+	// KeyName: worker.stuckWorkflowAlertEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	WorkerStuckWorkflowAlertEnabled
+
+	// EnableReplicationLagTracker decides whether each history shard periodically computes and emits the replication lag of its domains to each remote cluster
+	// KeyName: history.enableReplicationLagTracker
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableReplicationLagTracker
+
+	// EnableStickyDecisionPinning decides whether the workflow context of a sticky decision task is pinned in the history cache from the decision being scheduled until it is completed, so it is not evicted and reloaded for chatty workflows
+	// KeyName: history.enableStickyDecisionPinning
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableStickyDecisionPinning
 
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
@@ -1871,6 +2180,19 @@ const (
 	// Default value: N/A
 	// TODO: https://github.com/uber/cadence/issues/3861
 	WorkerBlobIntegrityCheckProbability
+	// HistoryCacheMemoryBudgetFraction is the fraction of container memory the history caches are sized to stay within, 0 disables memory based sizing
+	// KeyName: history.cacheMemoryBudgetFraction
+	// Value type: Float64
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryCacheMemoryBudgetFraction
+
+	// FrontendShadowTrafficRate is the fraction (0 to 1) of read-only frontend requests mirrored to the shadow traffic cluster
+	// KeyName: frontend.shadowTrafficRate
+	// Value type: Float
+	// Default value: 0
+	// Allowed filters: N/A
+	FrontendShadowTrafficRate
 
 	// LastFloatKey must be the last one in this const group
 	LastFloatKey
@@ -1929,6 +2251,59 @@ const (
 	// Value type: string [{"DomainName":"<domain>", "WorkflowType":"<workflowType>", "Threshold":"<duration>", "Refresh":<shouldRefresh>, "MaxNumWorkflows":<maxNumber>}]
 	// Default value: ""
 	ESAnalyzerWorkflowDurationWarnThresholds
+	// MatchingBacklogAlertDestination is where task list backlog alerts of a domain are published, either sqs:<queue url> or pubsub:projects/<project>/topics/<topic>
+	// KeyName: matching.backlogAlertDestination
+	// Value type: String
+	// Default value: "" => backlog alerts are not published
+	// Allowed filters: DomainName
+	MatchingBacklogAlertDestination
+	// CloudEventsSink is where workflow lifecycle CloudEvents are emitted to, either "kafka" to publish to the topic of the cloudevents kafka application or an http(s) url to post them to
+	// KeyName: history.cloudEventsSink
+	// Value type: String
+	// Default value: "" => CloudEvents are not emitted
+	// Allowed filters: N/A
+	CloudEventsSink
+
+	// DomainNotActiveForwardingDisabledAPIs is a comma separated list of APIs which are never forwarded to the active cluster, e.g. PollForDecisionTask
+	// KeyName: frontend.domainNotActiveForwardingDisabledAPIs
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	DomainNotActiveForwardingDisabledAPIs
+
+	// FrontendShadowTrafficCluster is the name of the cluster read-only frontend traffic is mirrored to, shadowing is disabled when empty
+	// KeyName: frontend.shadowTrafficCluster
+	// Value type: String
+	// Default value: empty string
+	// Allowed filters: N/A
+	FrontendShadowTrafficCluster
+
+	// HistoryExportMode is how workflow histories of a domain are exported to the blobstore, either "disabled", "closed" to export the full history once the workflow closes or "continuous" to export every event batch as it is written
+	// KeyName: history.historyExportMode
+	// Value type: String
+	// Default value: "disabled"
+	// Allowed filters: DomainName
+	HistoryExportMode
+
+	// HistoryCacheEvictionPolicy is the policy deciding which workflow execution is evicted from a full history cache, one of "lru", "lfu", "arc" or "size-aware"
+	// KeyName: history.cacheEvictionPolicy
+	// Value type: String
+	// Default value: "lru"
+	// Allowed filters: N/A
+	HistoryCacheEvictionPolicy
+
+	// NDCConflictResolutionPolicy is the policy selecting the current branch among the conflicting branches of a workflow of a domain, one of "highest-version", "longest-branch" or "preferred-cluster". It must be the same in all the clusters of the domain
+	// KeyName: history.ndcConflictResolutionPolicy
+	// Value type: String
+	// Default value: "highest-version"
+	// Allowed filters: DomainName
+	NDCConflictResolutionPolicy
+	// NDCConflictResolutionPreferredCluster is the cluster whose branches are selected by the "preferred-cluster" conflict resolution policy
+	// KeyName: history.ndcConflictResolutionPreferredCluster
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	NDCConflictResolutionPreferredCluster
 
 	// LastStringKey must be the last one in this const group
 	LastStringKey
@@ -2354,6 +2729,152 @@ const (
 	// Value type: Duration
 	// Default value: 30 minutes
 	ESAnalyzerBufferWaitTime
+	// DynamicConfigDriftDetectionInterval is the interval between dynamic config drift checks against remote clusters
+	// KeyName: worker.dynamicConfigDriftDetectionInterval
+	// Value type: Duration
+	// Default value: 10m (10*time.Minute)
+	// Allowed filters: N/A
+	DynamicConfigDriftDetectionInterval
+	// WorkerSignalGatewayMaxRetryDuration is the max duration the signal gateway retries a signal before sending it to the DLQ
+	// KeyName: worker.signalGatewayMaxRetryDuration
+	// Value type: Duration
+	// Default value: 1m (time.Minute)
+	// Allowed filters: N/A
+	WorkerSignalGatewayMaxRetryDuration
+	// WorkflowCloseWebhookRequestTimeout is the timeout of a single workflow close webhook request
+	// KeyName: history.workflowCloseWebhookRequestTimeout
+	// Value type: Duration
+	// Default value: 5s (5*time.Second)
+	// Allowed filters: N/A
+	WorkflowCloseWebhookRequestTimeout
+	// WorkflowCloseWebhookMaxRetryDuration is the max duration a workflow close webhook delivery is retried
+	// KeyName: history.workflowCloseWebhookMaxRetryDuration
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: N/A
+	WorkflowCloseWebhookMaxRetryDuration
+	// MatchingBacklogAlertAgeThreshold is the age of the oldest backlog task at or above which a backlog alert is published, 0 disables the check
+	// KeyName: matching.backlogAlertAgeThreshold
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingBacklogAlertAgeThreshold
+	// WorkerBlobReencoderInterval is the interval between blob re-encoder runs
+	// KeyName: worker.blobReencoderInterval
+	// Value type: Duration
+	// Default value: 24h (24*time.Hour)
+	// Allowed filters: N/A
+	WorkerBlobReencoderInterval
+	// ShardUpdateMaxStaleness is the max time a shard info update coalesced with later updates can stay unpersisted, 0 means ShardUpdateMinInterval
+	// KeyName: history.shardUpdateMaxStaleness
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	ShardUpdateMaxStaleness
+	// HistoryCacheMemoryBudgetCheckInterval is how often the heap size is checked against the history cache memory budget
+	// KeyName: history.cacheMemoryBudgetCheckInterval
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	HistoryCacheMemoryBudgetCheckInterval
+
+	// MatchingAdaptiveLongPollMinInterval is the shortest long poll hold duration used when the host is saturated
+	// KeyName: matching.adaptiveLongPollMinInterval
+	// Value type: Duration
+	// Default value: 5s
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingAdaptiveLongPollMinInterval
+
+	// DecisionQuarantineTTL is how long a workflow stays quarantined after its decision kept failing server side
+	// KeyName: history.decisionQuarantineTTL
+	// Value type: Duration
+	// Default value: 24h
+	// Allowed filters: N/A
+	DecisionQuarantineTTL
+
+	// TimerProcessorOverdueTaskThreshold is the delay after which an active timer task keeps high priority even if its domain is throttled, 0 disables it
+	// KeyName: history.timerProcessorOverdueTaskThreshold
+	// Value type: Duration
+	// Default value: 5s (5*time.Second)
+	// Allowed filters: N/A
+	TimerProcessorOverdueTaskThreshold
+
+	// DomainNotActiveForwardingMaxLatency is the average latency of the forwarded calls of an API above which calls to that API are rejected locally instead of forwarded to the active cluster, long polls and queries are not checked, 0 disables it
+	// KeyName: frontend.domainNotActiveForwardingMaxLatency
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	DomainNotActiveForwardingMaxLatency
+
+	// ActivityHeartbeatCoalescingInterval is the minimal interval between two persisted heartbeats of an activity, heartbeats arriving sooner only update the in-memory details. It is capped at half of the activity heartbeat timeout, 0 disables coalescing
+	// KeyName: history.activityHeartbeatCoalescingInterval
+	// Value type: Duration
+	// Default value: 0s
+	// Allowed filters: DomainName
+	ActivityHeartbeatCoalescingInterval
+
+	// PersistenceHedgedReadDelay is how long an idempotent persistence read (GetWorkflowExecution, ReadHistoryBranch) waits before a second hedged attempt is sent, set it around the store's tail latency, 0 disables hedging
+	// KeyName: system.persistenceHedgedReadDelay
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	PersistenceHedgedReadDelay
+
+	// FrontendStorageMeteringInterval is the interval between two storage metering runs
+	// KeyName: frontend.storageMeteringInterval
+	// Value type: Duration
+	// Default value: 24h
+	// Allowed filters: N/A
+	FrontendStorageMeteringInterval
+
+	// WorkerStuckWorkflowDetectionInterval is the interval between two stuck workflow detection runs
+	// KeyName: worker.stuckWorkflowDetectionInterval
+	// Value type: Duration
+	// Default value: 1h
+	// Allowed filters: N/A
+	WorkerStuckWorkflowDetectionInterval
+
+	// WorkerStuckWorkflowThreshold is how long an open workflow may go without a state transition, pending activity progress or a timer due before it is reported as stuck, 0 disables detection for the domain
+	// KeyName: worker.stuckWorkflowThreshold
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	WorkerStuckWorkflowThreshold
+
+	// HistoryBusyLockWaitThreshold is the average workflow lock wait time in a shard above which the shard rejects new workflow starts and signals with a service busy error, 0 disables the check
+	// KeyName: history.busyLockWaitThreshold
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryBusyLockWaitThreshold
+
+	// HistoryBusyRetryAfter is the base retry-after hint returned with a shard's service busy error, it is scaled by how far the shard is over its threshold
+	// KeyName: history.busyRetryAfter
+	// Value type: Duration
+	// Default value: 1s
+	// Allowed filters: N/A
+	HistoryBusyRetryAfter
+
+	// HistoryCacheLockReleaseDelay is the delay injected before a workflow lock acquired through the history cache is released, used to simulate lock contention in latency and chaos testing, 0 disables the injection
+	// KeyName: history.cacheLockReleaseDelay
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	HistoryCacheLockReleaseDelay
+
+	// ReplicationLagTrackerInterval is the interval between two replication lag computations of a history shard
+	// KeyName: history.replicationLagTrackerInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: N/A
+	ReplicationLagTrackerInterval
+
+	// ReplicationLagSLO is the replication lag to a remote cluster above which an alert is raised for the domain, 0 disables the alert
+	// KeyName: history.replicationLagSLO
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	ReplicationLagSLO
 
 	// LastDurationKey must be the last one in this const group
 	LastDurationKey
@@ -2403,6 +2924,28 @@ const (
 	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold) in code base
 	// Allowed filters: N/A
 	QueueProcessorStuckTaskSplitThreshold
+	// HistoryCacheLockReleaseDelayScopes is the set of history cache operations (e.g. HistoryCacheGetOrCreate) the lock release delay is injected for, keyed by operation name with a true value, an empty map injects it for all operations
+	// KeyName: history.cacheLockReleaseDelayScopes
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: N/A
+	HistoryCacheLockReleaseDelayScopes
+	// WorkflowLockPriorities is the priority of history API callers (e.g. RespondDecisionTaskCompleted) when acquiring a workflow lock, keyed by operation name, callers with a lower value acquire the lock first and callers not in the map, including background task processing, use priority 1. A waiter is overtaken at most 8 times, so lower priority callers are delayed but never starved
+	// KeyName: history.workflowLockPriorities
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: N/A
+	WorkflowLockPriorities
+
+	// key for persistence
+
+	// SQLBlobEncodings is the encoding used to write each type of sql persistence blob, keyed by blob type (e.g. DomainInfo).
+	// Blob types not in the map are written with the encodingType of the sql store config. Blobs of every encoding are still readable.
+	// KeyName: system.sqlBlobEncodings
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: N/A
+	SQLBlobEncodings
 
 	// LastMapKey must be the last one in this const group
 	LastMapKey
@@ -3269,6 +3812,146 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "WorkflowDeletionJitterRange defines the duration in minutes for workflow close tasks jittering",
 		DefaultValue: 1,
 	},
+	QueueProcessorSplitRolloutPercentage: DynamicInt{
+		KeyName:      "history.queueProcessorSplitRolloutPercentage",
+		Description:  "QueueProcessorSplitRolloutPercentage is the percentage of shards for which processing queue split policy is enabled when QueueProcessorEnableSplit is true",
+		DefaultValue: 100,
+	},
+	MatchingSyncMatchRolloutPercentage: DynamicInt{
+		KeyName:      "matching.syncMatchRolloutPercentage",
+		Description:  "MatchingSyncMatchRolloutPercentage is the percentage of task lists for which sync match is enabled when MatchingEnableSyncMatch is true",
+		DefaultValue: 100,
+	},
+	WorkerSignalGatewayConcurrency: DynamicInt{
+		KeyName:      "worker.signalGatewayConcurrency",
+		Description:  "WorkerSignalGatewayConcurrency is the number of workflows the signal gateway delivers signals to concurrently",
+		DefaultValue: 100,
+	},
+	WorkflowCloseWebhookMaxConcurrentDeliveries: DynamicInt{
+		KeyName:      "history.workflowCloseWebhookMaxConcurrentDeliveries",
+		Description:  "WorkflowCloseWebhookMaxConcurrentDeliveries is the max number of in-flight workflow close webhook deliveries per shard",
+		DefaultValue: 10,
+	},
+	CloudEventsMaxConcurrentEmits: DynamicInt{
+		KeyName:      "history.cloudEventsMaxConcurrentEmits",
+		Description:  "CloudEventsMaxConcurrentEmits is the max number of in-flight workflow lifecycle CloudEvents per shard, events above it are dropped",
+		DefaultValue: 100,
+	},
+	MatchingBacklogAlertCountThreshold: DynamicInt{
+		KeyName:      "matching.backlogAlertCountThreshold",
+		Description:  "MatchingBacklogAlertCountThreshold is the task list backlog count at or above which a backlog alert is published, 0 disables the check",
+		DefaultValue: 0,
+	},
+	WorkerBlobReencoderRPS: DynamicInt{
+		KeyName:      "worker.blobReencoderRPS",
+		Description:  "WorkerBlobReencoderRPS is the max number of domain records re-encoded per second by the blob re-encoder",
+		DefaultValue: 10,
+	},
+	FrontendHistoryPrefetchCacheSize: DynamicInt{
+		KeyName:      "frontend.historyPrefetchCacheSize",
+		Description:  "FrontendHistoryPrefetchCacheSize is the max number of prefetched history pages a frontend host keeps",
+		DefaultValue: 1000,
+	},
+	MatchingAdaptiveLongPollMaxOutstandingPolls: DynamicInt{
+		KeyName:      "matching.adaptiveLongPollMaxOutstandingPolls",
+		Description:  "MatchingAdaptiveLongPollMaxOutstandingPolls is the number of outstanding polls on a matching host at which long polls are held for the min interval",
+		DefaultValue: 10000,
+	},
+	MaxSignalRequestIDsCarriedOver: DynamicInt{
+		KeyName:      "history.maxSignalRequestIDsCarriedOver",
+		Description:  "MaxSignalRequestIDsCarriedOver is the max number of the most recent signal requestIDs carried over to the new run on continue-as-new to deduplicate retried signals, 0 disables it",
+		DefaultValue: 0,
+	},
+	MutableStateInvariantCheckProbability: DynamicInt{
+		KeyName:      "history.mutableStateInvariantCheckProbability",
+		Description:  "MutableStateInvariantCheckProbability is the probability [0-100] that invariants will be checked when a mutable state transaction is closed",
+		DefaultValue: 0,
+	},
+	DecisionQuarantineThreshold: DynamicInt{
+		KeyName:      "history.decisionQuarantineThreshold",
+		Description:  "DecisionQuarantineThreshold is the number of server side failures of the same decision after which the workflow is quarantined and its decisions are no longer dispatched, 0 disables quarantine",
+		DefaultValue: 0,
+	},
+	PendingActivitiesCountLimit: DynamicInt{
+		KeyName:      "history.pendingActivitiesCountLimit",
+		Description:  "PendingActivitiesCountLimit is the max number of pending activities of a workflow execution, decisions scheduling more activities are failed, 0 means no limit",
+		DefaultValue: 0,
+	},
+	PendingChildWorkflowsCountLimit: DynamicInt{
+		KeyName:      "history.pendingChildWorkflowsCountLimit",
+		Description:  "PendingChildWorkflowsCountLimit is the max number of pending child workflows of a workflow execution, decisions starting more child workflows are failed, 0 means no limit",
+		DefaultValue: 0,
+	},
+	ActivityDispatchRPSByDomain: DynamicInt{
+		KeyName:      "history.activityDispatchRPSByDomain",
+		Description:  "ActivityDispatchRPSByDomain is the cluster wide rate at which activity tasks of a domain are dispatched to matching, tasks exceeding it are retried later, 0 means no limit",
+		DefaultValue: 0,
+	},
+	ChildWorkflowStartRPSPerParent: DynamicInt{
+		KeyName:      "history.childWorkflowStartRPSPerParent",
+		Description:  "ChildWorkflowStartRPSPerParent is the rate at which child workflows of a single parent workflow are started, transfer tasks exceeding it are retried later, 0 means no limit",
+		DefaultValue: 0,
+	},
+	PersistenceHedgedReadMaxRPS: DynamicInt{
+		KeyName:      "system.persistenceHedgedReadMaxRPS",
+		Description:  "PersistenceHedgedReadMaxRPS is the per host budget of hedged persistence read attempts per second",
+		DefaultValue: 10,
+	},
+	RPCShedBackgroundInflightThreshold: DynamicInt{
+		KeyName:      "system.rpcShedBackgroundInflightThreshold",
+		Description:  "RPCShedBackgroundInflightThreshold is the number of in-flight inbound requests on a host from which background class requests (e.g. from the worker service) are rejected, 0 disables shedding",
+		DefaultValue: 0,
+	},
+	RPCShedReplicationInflightThreshold: DynamicInt{
+		KeyName:      "system.rpcShedReplicationInflightThreshold",
+		Description:  "RPCShedReplicationInflightThreshold is the number of in-flight inbound requests on a host from which replication class requests are rejected, 0 disables shedding",
+		DefaultValue: 0,
+	},
+	FrontendStorageMeteringRPS: DynamicInt{
+		KeyName:      "frontend.storageMeteringRPS",
+		Description:  "FrontendStorageMeteringRPS is the rate of persistence reads the storage meter issues while aggregating per-domain storage usage",
+		DefaultValue: 50,
+	},
+	FrontendDomainStorageBudgetBytes: DynamicInt{
+		KeyName:      "frontend.domainStorageBudgetBytes",
+		Description:  "FrontendDomainStorageBudgetBytes is the storage budget of a domain in bytes, the storage meter reports the budget utilization and warns about domains exceeding it, 0 means no budget",
+		DefaultValue: 0,
+	},
+	WorkerStuckWorkflowDetectionRPS: DynamicInt{
+		KeyName:      "worker.stuckWorkflowDetectionRPS",
+		Description:  "WorkerStuckWorkflowDetectionRPS is the rate of persistence reads the stuck workflow detector issues while scanning open workflows",
+		DefaultValue: 100,
+	},
+	HistoryBusyPendingTaskThreshold: DynamicInt{
+		KeyName:      "history.busyPendingTaskThreshold",
+		Description:  "HistoryBusyPendingTaskThreshold is the number of pending tasks in a shard's queues above which the shard rejects new workflow starts and signals with a service busy error, 0 disables the check",
+		DefaultValue: 0,
+	},
+	HistoryExportQueueSize: DynamicInt{
+		KeyName:      "history.historyExportQueueSize",
+		Description:  "HistoryExportQueueSize is the max number of pending history exports buffered per history host, exports beyond it are dropped",
+		DefaultValue: 10000,
+	},
+	HistoryExportConcurrency: DynamicInt{
+		KeyName:      "history.historyExportConcurrency",
+		Description:  "HistoryExportConcurrency is the number of workers uploading exported histories to the blobstore per history host",
+		DefaultValue: 10,
+	},
+	HistoryCacheMaxSizeBytes: DynamicInt{
+		KeyName:      "history.cacheMaxSizeBytes",
+		Description:  "HistoryCacheMaxSizeBytes is the max estimated size in bytes of the mutable states in the history cache of a shard, 0 disables the limit",
+		DefaultValue: 0,
+	},
+	HistoryCacheDomainQuotaPercent: DynamicInt{
+		KeyName:      "history.cacheDomainQuotaPercent",
+		Description:  "HistoryCacheDomainQuotaPercent is the percentage of the history cache capacity, in entries and bytes, a single domain can use",
+		DefaultValue: 100,
+	},
+	HistoryShardCacheWarmUpMaxExecutions: DynamicInt{
+		KeyName:      "history.shardCacheWarmUpMaxExecutions",
+		Description:  "HistoryShardCacheWarmUpMaxExecutions is the max number of recently updated executions loaded into the execution cache when a shard is acquired, 0 disables the warm-up",
+		DefaultValue: 0,
+	},
 }
 
 var BoolKeys = map[BoolKey]DynamicBool{
@@ -3372,6 +4055,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableSyncMatch is to enable sync match",
 		DefaultValue: true,
 	},
+	MatchingTaskListDraining: DynamicBool{
+		KeyName:      "matching.taskListDraining",
+		Description:  "MatchingTaskListDraining marks a task list as draining, tasks forwarded from child partitions are no longer sync matched",
+		DefaultValue: false,
+	},
 	MatchingEnableTaskInfoLogByDomainID: DynamicBool{
 		KeyName:      "matching.enableTaskInfoLogByDomainID",
 		Description:  "MatchingEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID",
@@ -3412,6 +4100,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "QueueProcessorEnableLoadQueueStates is indicates whether processing queue states should be loaded",
 		DefaultValue: true,
 	},
+	QueueProcessorEnableCachePrefetch: DynamicBool{
+		KeyName:      "history.queueProcessorEnableCachePrefetch",
+		Description:  "QueueProcessorEnableCachePrefetch is indicates whether queue processors should prefetch the mutable state of loaded tasks into the execution cache",
+		DefaultValue: false,
+	},
 	TransferProcessorEnableValidator: DynamicBool{
 		KeyName:      "history.transferProcessorEnableValidator",
 		Description:  "TransferProcessorEnableValidator is whether validator should be enabled for transferQueueProcessor",
@@ -3627,6 +4320,91 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "Lockdown defines if we want to allow failovers of domains to this cluster",
 		DefaultValue: false,
 	},
+	EnableDynamicConfigDriftDetection: DynamicBool{
+		KeyName:      "worker.enableDynamicConfigDriftDetection",
+		Description:  "EnableDynamicConfigDriftDetection decides whether to periodically compare dynamic config with remote clusters and report drift",
+		DefaultValue: false,
+	},
+	EnableSignalGateway: DynamicBool{
+		KeyName:      "worker.enableSignalGateway",
+		Description:  "EnableSignalGateway decides whether to deliver signals consumed from the signal-gateway kafka topic",
+		DefaultValue: false,
+	},
+	EnableWorkflowCloseWebhook: DynamicBool{
+		KeyName:      "history.enableWorkflowCloseWebhook",
+		Description:  "EnableWorkflowCloseWebhook decides whether to notify the domain's webhook endpoint when a workflow closes",
+		DefaultValue: false,
+	},
+	EnableCloudEvents: DynamicBool{
+		KeyName:      "history.enableCloudEvents",
+		Description:  "EnableCloudEvents decides whether to emit CloudEvents for the workflow lifecycle transitions of a domain",
+		DefaultValue: false,
+	},
+	EnableBlobReencoder: DynamicBool{
+		KeyName:      "worker.enableBlobReencoder",
+		Description:  "EnableBlobReencoder decides whether to periodically rewrite persisted blobs with the encodings configured by SQLBlobEncodings",
+		DefaultValue: false,
+	},
+	EnableWorkflowReadSnapshot: DynamicBool{
+		KeyName:      "history.enableWorkflowReadSnapshot",
+		Description:  "EnableWorkflowReadSnapshot decides whether read only history APIs are served from a snapshot of the last persisted mutable state instead of waiting on the workflow lock",
+		DefaultValue: false,
+	},
+	HistoryCacheEnableDomainMetrics: DynamicBool{
+		KeyName:      "history.cacheEnableDomainMetrics",
+		Description:  "HistoryCacheEnableDomainMetrics decides whether the history cache emits its occupancy per domain",
+		DefaultValue: false,
+	},
+	FrontendEnableHistoryPrefetch: DynamicBool{
+		KeyName:      "frontend.enableHistoryPrefetch",
+		Description:  "FrontendEnableHistoryPrefetch decides whether frontend reads ahead the next page of a paginated workflow history",
+		DefaultValue: false,
+	},
+	MatchingEnableAdaptiveLongPoll: DynamicBool{
+		KeyName:      "matching.enableAdaptiveLongPoll",
+		Description:  "MatchingEnableAdaptiveLongPoll decides whether matching shortens long poll hold durations when the host is loaded",
+		DefaultValue: false,
+	},
+	EnableShardFencingAudit: DynamicBool{
+		KeyName:      "history.enableShardFencingAudit",
+		Description:  "EnableShardFencingAudit decides whether history cross-checks the rangeID of conditional writes against the shard owner and reports fencing violations",
+		DefaultValue: false,
+	},
+	FrontendClusterReadOnly: DynamicBool{
+		KeyName:      "frontend.clusterReadOnly",
+		Description:  "FrontendClusterReadOnly puts the cluster in read-only mode, rejecting workflow starts, signals, cancellations, terminations, resets and decision completions while reads, queries and replication keep working",
+		DefaultValue: false,
+	},
+	EnableQueryResultCache: DynamicBool{
+		KeyName:      "history.enableQueryResultCache",
+		Description:  "EnableQueryResultCache enables caching of eventually consistent query results per run, query type and args until the next decision completes",
+		DefaultValue: false,
+	},
+	FrontendEnableStorageMetering: DynamicBool{
+		KeyName:      "frontend.enableStorageMetering",
+		Description:  "FrontendEnableStorageMetering enables the periodic aggregation of persisted bytes per domain, emitted as metrics by a single frontend host",
+		DefaultValue: false,
+	},
+	EnableStuckWorkflowDetection: DynamicBool{
+		KeyName:      "worker.enableStuckWorkflowDetection",
+		Description:  "EnableStuckWorkflowDetection decides whether to start the stuck workflow detector in the worker service",
+		DefaultValue: false,
+	},
+	WorkerStuckWorkflowAlertEnabled: DynamicBool{
+		KeyName:      "worker.stuckWorkflowAlertEnabled",
+		Description:  "WorkerStuckWorkflowAlertEnabled decides whether the stuck workflow detector alerts, by warning log and metric, on workflows newly found stuck in a domain",
+		DefaultValue: false,
+	},
+	EnableReplicationLagTracker: DynamicBool{
+		KeyName:      "history.enableReplicationLagTracker",
+		Description:  "EnableReplicationLagTracker decides whether each history shard periodically computes and emits the replication lag of its domains to each remote cluster",
+		DefaultValue: false,
+	},
+	EnableStickyDecisionPinning: DynamicBool{
+		KeyName:      "history.enableStickyDecisionPinning",
+		Description:  "EnableStickyDecisionPinning decides whether the workflow context of a sticky decision task is pinned in the history cache from the decision being scheduled until it is completed, so it is not evicted and reloaded for chatty workflows",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -3780,6 +4558,16 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival",
 		DefaultValue: 0.002,
 	},
+	HistoryCacheMemoryBudgetFraction: DynamicFloat{
+		KeyName:      "history.cacheMemoryBudgetFraction",
+		Description:  "HistoryCacheMemoryBudgetFraction is the fraction of container memory the history caches are sized to stay within, 0 disables memory based sizing",
+		DefaultValue: 0,
+	},
+	FrontendShadowTrafficRate: DynamicFloat{
+		KeyName:      "frontend.shadowTrafficRate",
+		Description:  "FrontendShadowTrafficRate is the fraction (0 to 1) of read-only frontend requests mirrored to the shadow traffic cluster",
+		DefaultValue: 0,
+	},
 }
 
 var StringKeys = map[StringKey]DynamicString{
@@ -3828,6 +4616,46 @@ var StringKeys = map[StringKey]DynamicString{
 		Description:  "ESAnalyzerWorkflowDurationWarnThresholds defines the warning execution thresholds for workflow types",
 		DefaultValue: "",
 	},
+	MatchingBacklogAlertDestination: DynamicString{
+		KeyName:      "matching.backlogAlertDestination",
+		Description:  "MatchingBacklogAlertDestination is where task list backlog alerts of a domain are published, either sqs:<queue url> or pubsub:projects/<project>/topics/<topic>",
+		DefaultValue: "",
+	},
+	CloudEventsSink: DynamicString{
+		KeyName:      "history.cloudEventsSink",
+		Description:  "CloudEventsSink is where workflow lifecycle CloudEvents are emitted to, either \"kafka\" to publish to the topic of the cloudevents kafka application or an http(s) url to post them to",
+		DefaultValue: "",
+	},
+	DomainNotActiveForwardingDisabledAPIs: DynamicString{
+		KeyName:      "frontend.domainNotActiveForwardingDisabledAPIs",
+		Description:  "DomainNotActiveForwardingDisabledAPIs is a comma separated list of APIs which are never forwarded to the active cluster, e.g. PollForDecisionTask",
+		DefaultValue: "",
+	},
+	FrontendShadowTrafficCluster: DynamicString{
+		KeyName:      "frontend.shadowTrafficCluster",
+		Description:  "FrontendShadowTrafficCluster is the name of the cluster read-only frontend traffic is mirrored to, shadowing is disabled when empty",
+		DefaultValue: "",
+	},
+	HistoryExportMode: DynamicString{
+		KeyName:      "history.historyExportMode",
+		Description:  "HistoryExportMode is how workflow histories of a domain are exported to the blobstore, either \"disabled\", \"closed\" to export the full history once the workflow closes or \"continuous\" to export every event batch as it is written",
+		DefaultValue: "disabled",
+	},
+	HistoryCacheEvictionPolicy: DynamicString{
+		KeyName:      "history.cacheEvictionPolicy",
+		Description:  "HistoryCacheEvictionPolicy is the policy deciding which workflow execution is evicted from a full history cache, one of \"lru\", \"lfu\", \"arc\" or \"size-aware\"",
+		DefaultValue: "lru",
+	},
+	NDCConflictResolutionPolicy: DynamicString{
+		KeyName:      "history.ndcConflictResolutionPolicy",
+		Description:  "NDCConflictResolutionPolicy is the policy selecting the current branch among the conflicting branches of a workflow of a domain, one of \"highest-version\", \"longest-branch\" or \"preferred-cluster\". It must be the same in all the clusters of the domain",
+		DefaultValue: "highest-version",
+	},
+	NDCConflictResolutionPreferredCluster: DynamicString{
+		KeyName:      "history.ndcConflictResolutionPreferredCluster",
+		Description:  "NDCConflictResolutionPreferredCluster is the cluster whose branches are selected by the \"preferred-cluster\" conflict resolution policy",
+		DefaultValue: "",
+	},
 }
 
 var DurationKeys = map[DurationKey]DynamicDuration{
@@ -4181,6 +5009,116 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ESAnalyzerBufferWaitTime controls min time required to consider a worklow stuck",
 		DefaultValue: time.Minute * 30,
 	},
+	DynamicConfigDriftDetectionInterval: DynamicDuration{
+		KeyName:      "worker.dynamicConfigDriftDetectionInterval",
+		Description:  "DynamicConfigDriftDetectionInterval is the interval between dynamic config drift checks against remote clusters",
+		DefaultValue: time.Minute * 10,
+	},
+	WorkerSignalGatewayMaxRetryDuration: DynamicDuration{
+		KeyName:      "worker.signalGatewayMaxRetryDuration",
+		Description:  "WorkerSignalGatewayMaxRetryDuration is the max duration the signal gateway retries a signal before sending it to the DLQ",
+		DefaultValue: time.Minute,
+	},
+	WorkflowCloseWebhookRequestTimeout: DynamicDuration{
+		KeyName:      "history.workflowCloseWebhookRequestTimeout",
+		Description:  "WorkflowCloseWebhookRequestTimeout is the timeout of a single workflow close webhook request",
+		DefaultValue: time.Second * 5,
+	},
+	WorkflowCloseWebhookMaxRetryDuration: DynamicDuration{
+		KeyName:      "history.workflowCloseWebhookMaxRetryDuration",
+		Description:  "WorkflowCloseWebhookMaxRetryDuration is the max duration a workflow close webhook delivery is retried",
+		DefaultValue: time.Minute * 5,
+	},
+	MatchingBacklogAlertAgeThreshold: DynamicDuration{
+		KeyName:      "matching.backlogAlertAgeThreshold",
+		Description:  "MatchingBacklogAlertAgeThreshold is the age of the oldest backlog task at or above which a backlog alert is published, 0 disables the check",
+		DefaultValue: 0,
+	},
+	WorkerBlobReencoderInterval: DynamicDuration{
+		KeyName:      "worker.blobReencoderInterval",
+		Description:  "WorkerBlobReencoderInterval is the interval between blob re-encoder runs",
+		DefaultValue: time.Hour * 24,
+	},
+	ShardUpdateMaxStaleness: DynamicDuration{
+		KeyName:      "history.shardUpdateMaxStaleness",
+		Description:  "ShardUpdateMaxStaleness is the max time a shard info update coalesced with later updates can stay unpersisted, 0 means ShardUpdateMinInterval",
+		DefaultValue: 0,
+	},
+	HistoryCacheMemoryBudgetCheckInterval: DynamicDuration{
+		KeyName:      "history.cacheMemoryBudgetCheckInterval",
+		Description:  "HistoryCacheMemoryBudgetCheckInterval is how often the heap size is checked against the history cache memory budget",
+		DefaultValue: time.Second * 10,
+	},
+	MatchingAdaptiveLongPollMinInterval: DynamicDuration{
+		KeyName:      "matching.adaptiveLongPollMinInterval",
+		Description:  "MatchingAdaptiveLongPollMinInterval is the shortest long poll hold duration used when the host is saturated",
+		DefaultValue: time.Second * 5,
+	},
+	DecisionQuarantineTTL: DynamicDuration{
+		KeyName:      "history.decisionQuarantineTTL",
+		Description:  "DecisionQuarantineTTL is how long a workflow stays quarantined after its decision kept failing server side",
+		DefaultValue: time.Hour * 24,
+	},
+	TimerProcessorOverdueTaskThreshold: DynamicDuration{
+		KeyName:      "history.timerProcessorOverdueTaskThreshold",
+		Description:  "TimerProcessorOverdueTaskThreshold is the delay after which an active timer task keeps high priority even if its domain is throttled, 0 disables it",
+		DefaultValue: time.Second * 5,
+	},
+	DomainNotActiveForwardingMaxLatency: DynamicDuration{
+		KeyName:      "frontend.domainNotActiveForwardingMaxLatency",
+		Description:  "DomainNotActiveForwardingMaxLatency is the average latency of the forwarded calls of an API above which calls to that API are rejected locally instead of forwarded to the active cluster, long polls and queries are not checked, 0 disables it",
+		DefaultValue: 0,
+	},
+	ActivityHeartbeatCoalescingInterval: DynamicDuration{
+		KeyName:      "history.activityHeartbeatCoalescingInterval",
+		Description:  "ActivityHeartbeatCoalescingInterval is the minimal interval between two persisted heartbeats of an activity, heartbeats arriving sooner only update the in-memory details. It is capped at half of the activity heartbeat timeout, 0 disables coalescing",
+		DefaultValue: 0,
+	},
+	PersistenceHedgedReadDelay: DynamicDuration{
+		KeyName:      "system.persistenceHedgedReadDelay",
+		Description:  "PersistenceHedgedReadDelay is how long an idempotent persistence read (GetWorkflowExecution, ReadHistoryBranch) waits before a second hedged attempt is sent, set it around the store's tail latency, 0 disables hedging",
+		DefaultValue: 0,
+	},
+	FrontendStorageMeteringInterval: DynamicDuration{
+		KeyName:      "frontend.storageMeteringInterval",
+		Description:  "FrontendStorageMeteringInterval is the interval between two storage metering runs",
+		DefaultValue: time.Hour * 24,
+	},
+	WorkerStuckWorkflowDetectionInterval: DynamicDuration{
+		KeyName:      "worker.stuckWorkflowDetectionInterval",
+		Description:  "WorkerStuckWorkflowDetectionInterval is the interval between two stuck workflow detection runs",
+		DefaultValue: time.Hour,
+	},
+	WorkerStuckWorkflowThreshold: DynamicDuration{
+		KeyName:      "worker.stuckWorkflowThreshold",
+		Description:  "WorkerStuckWorkflowThreshold is how long an open workflow may go without a state transition, pending activity progress or a timer due before it is reported as stuck, 0 disables detection for the domain",
+		DefaultValue: 0,
+	},
+	HistoryBusyLockWaitThreshold: DynamicDuration{
+		KeyName:      "history.busyLockWaitThreshold",
+		Description:  "HistoryBusyLockWaitThreshold is the average workflow lock wait time in a shard above which the shard rejects new workflow starts and signals with a service busy error, 0 disables the check",
+		DefaultValue: 0,
+	},
+	HistoryBusyRetryAfter: DynamicDuration{
+		KeyName:      "history.busyRetryAfter",
+		Description:  "HistoryBusyRetryAfter is the base retry-after hint returned with a shard's service busy error, it is scaled by how far the shard is over its threshold",
+		DefaultValue: time.Second,
+	},
+	HistoryCacheLockReleaseDelay: DynamicDuration{
+		KeyName:      "history.cacheLockReleaseDelay",
+		Description:  "HistoryCacheLockReleaseDelay is the delay injected before a workflow lock acquired through the history cache is released, used to simulate lock contention in latency and chaos testing, 0 disables the injection",
+		DefaultValue: 0,
+	},
+	ReplicationLagTrackerInterval: DynamicDuration{
+		KeyName:      "history.replicationLagTrackerInterval",
+		Description:  "ReplicationLagTrackerInterval is the interval between two replication lag computations of a history shard",
+		DefaultValue: time.Minute,
+	},
+	ReplicationLagSLO: DynamicDuration{
+		KeyName:      "history.replicationLagSLO",
+		Description:  "ReplicationLagSLO is the replication lag to a remote cluster above which an alert is raised for the domain, 0 disables the alert",
+		DefaultValue: 0,
+	},
 }
 
 var MapKeys = map[MapKey]DynamicMap{
@@ -4218,6 +5156,21 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "QueueProcessorStuckTaskSplitThreshold is the threshold for the number of attempts of a task",
 		DefaultValue: common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 100, 1: 10000}),
 	},
+	SQLBlobEncodings: DynamicMap{
+		KeyName:      "system.sqlBlobEncodings",
+		Description:  "SQLBlobEncodings is the encoding used to write each type of sql persistence blob, keyed by blob type (e.g. DomainInfo)",
+		DefaultValue: map[string]interface{}{},
+	},
+	HistoryCacheLockReleaseDelayScopes: DynamicMap{
+		KeyName:      "history.cacheLockReleaseDelayScopes",
+		Description:  "HistoryCacheLockReleaseDelayScopes is the set of history cache operations (e.g. HistoryCacheGetOrCreate) the lock release delay is injected for, keyed by operation name with a true value, an empty map injects it for all operations",
+		DefaultValue: map[string]interface{}{},
+	},
+	WorkflowLockPriorities: DynamicMap{
+		KeyName:      "history.workflowLockPriorities",
+		Description:  "WorkflowLockPriorities is the priority of history API callers (e.g. RespondDecisionTaskCompleted) when acquiring a workflow lock, keyed by operation name, callers with a lower value acquire the lock first and callers not in the map, including background task processing, use priority 1. A waiter is overtaken at most 8 times, so lower priority callers are delayed but never starved",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var _keyNames map[string]Key
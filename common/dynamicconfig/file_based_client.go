@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -53,6 +54,9 @@ type constrainedValue struct {
 type FileBasedClientConfig struct {
 	Filepath     string        `yaml:"filepath"`
 	PollInterval time.Duration `yaml:"pollInterval"`
+	// StrictValidation rejects config files containing unknown keys, unknown filters,
+	// wrong value types or out of range values, instead of only logging them.
+	StrictValidation bool `yaml:"strictValidation"`
 }
 
 type fileBasedClient struct {
@@ -223,7 +227,23 @@ func (fc *fileBasedClient) RestoreValue(name Key, filters map[Filter]interface{}
 }
 
 func (fc *fileBasedClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
-	return nil, errors.New("not supported for file based client")
+	values := fc.values.Load().(map[string][]*constrainedValue)
+
+	var entries []*types.DynamicConfigEntry
+	for keyName, constrainedValues := range values {
+		if name != nil && name.String() != keyName {
+			continue
+		}
+		entry, err := convertToDynamicConfigEntry(keyName, constrainedValues)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
 }
 
 func (fc *fileBasedClient) update() error {
@@ -268,6 +288,13 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 		}
 	}
 
+	if report := ValidateValues(newValues); report.HasIssues() {
+		if fc.config.StrictValidation {
+			return report
+		}
+		fc.logger.Warn("Dynamic config contains invalid values, defaults will be used at call sites", tag.Error(report))
+	}
+
 	fc.values.Store(newValues)
 	fc.logger.Info("Updated dynamic config")
 	return nil
@@ -348,6 +375,35 @@ func convertKeyTypeToStringSlice(s []interface{}) ([]interface{}, error) {
 	return stringKeySlice, nil
 }
 
+func convertToDynamicConfigEntry(keyName string, constrainedValues []*constrainedValue) (*types.DynamicConfigEntry, error) {
+	entry := &types.DynamicConfigEntry{
+		Name:   keyName,
+		Values: make([]*types.DynamicConfigValue, 0, len(constrainedValues)),
+	}
+	for _, cv := range constrainedValues {
+		valueBlob, err := newJSONBlob(cv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode value of %v: %v", keyName, err)
+		}
+		value := &types.DynamicConfigValue{Value: valueBlob}
+		for filterName, filterValue := range cv.Constraints {
+			filterBlob, err := newJSONBlob(filterValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode filter %v of %v: %v", filterName, keyName, err)
+			}
+			value.Filters = append(value.Filters, &types.DynamicConfigFilter{
+				Name:  filterName,
+				Value: filterBlob,
+			})
+		}
+		sort.Slice(value.Filters, func(i, j int) bool {
+			return value.Filters[i].Name < value.Filters[j].Name
+		})
+		entry.Values = append(entry.Values, value)
+	}
+	return entry, nil
+}
+
 func validateConfig(config *FileBasedClientConfig) error {
 	if config == nil {
 		return errors.New("no config found for file based dynamic config client")
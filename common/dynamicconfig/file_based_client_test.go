@@ -107,6 +107,24 @@ func (s *fileBasedClientSuite) TestGetValueWithFilters_UnknownFilter() {
 	s.Equal(false, v)
 }
 
+func (s *fileBasedClientSuite) TestListValue() {
+	entries, err := s.client.ListValue(TestGetBoolPropertyKey)
+	s.NoError(err)
+	s.Len(entries, 1)
+	s.Equal("testGetBoolPropertyKey", entries[0].Name)
+	s.Len(entries[0].Values, 3)
+	s.Equal("false", string(entries[0].Values[0].Value.Data))
+	s.Empty(entries[0].Values[0].Filters)
+	s.Equal("true", string(entries[0].Values[1].Value.Data))
+	s.Equal("domainName", entries[0].Values[1].Filters[0].Name)
+	s.Equal(`"global-samples-domain"`, string(entries[0].Values[1].Filters[0].Value.Data))
+
+	entries, err = s.client.ListValue(nil)
+	s.NoError(err)
+	s.Len(entries, 7)
+	s.Equal("frontend.validSearchAttributes", entries[0].Name)
+}
+
 func (s *fileBasedClientSuite) TestGetIntValue() {
 	v, err := s.client.GetIntValue(TestGetIntPropertyKey, nil)
 	s.NoError(err)
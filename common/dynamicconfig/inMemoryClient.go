@@ -22,6 +22,7 @@ package dynamicconfig
 
 import (
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -138,5 +139,25 @@ func (mc *inMemoryClient) RestoreValue(name Key, filters map[Filter]interface{})
 }
 
 func (mc *inMemoryClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
-	return nil, errors.New("not supported for in-memory client")
+	mc.RLock()
+	defer mc.RUnlock()
+
+	var entries []*types.DynamicConfigEntry
+	for key, val := range mc.globalValues {
+		if name != nil && name != key {
+			continue
+		}
+		blob, err := newJSONBlob(val)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.DynamicConfigEntry{
+			Name:   key.String(),
+			Values: []*types.DynamicConfigValue{{Value: blob}},
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
 }
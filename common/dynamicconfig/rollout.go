@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dgryski/go-farm"
+)
+
+const (
+	// MinRolloutPercentage disables a feature for all entities
+	MinRolloutPercentage = 0
+	// MaxRolloutPercentage enables a feature for all entities
+	MaxRolloutPercentage = 100
+)
+
+// rolloutKeys contains all int keys whose value is a rollout percentage,
+// their values are validated to be within [MinRolloutPercentage, MaxRolloutPercentage]
+var rolloutKeys = map[IntKey]struct{}{
+	QueueProcessorSplitRolloutPercentage: {},
+	MatchingSyncMatchRolloutPercentage:   {},
+}
+
+// IsRolloutKey returns true if the value of the key is a rollout percentage
+func IsRolloutKey(key Key) bool {
+	intKey, ok := key.(IntKey)
+	if !ok {
+		return false
+	}
+	_, ok = rolloutKeys[intKey]
+	return ok
+}
+
+// IsInRollout returns true if the entity falls into the first percentage of the rollout.
+// The result is deterministic for a given key and entity, and an entity which is part of a rollout
+// remains part of it when the percentage is increased. The key is mixed into the hash so that
+// different features are rolled out to different subsets of entities.
+func IsInRollout(key Key, percentage int, entity string) bool {
+	if percentage <= MinRolloutPercentage {
+		return false
+	}
+	if percentage >= MaxRolloutPercentage {
+		return true
+	}
+	hash := farm.Fingerprint32([]byte(key.String() + "/" + entity))
+	return int(hash%MaxRolloutPercentage) < percentage
+}
+
+// GetRolloutPropertyFilteredByDomain gets the rollout percentage with domain filter and returns
+// whether the domain is part of the rollout. A percentage configured for the domain takes precedence.
+func (c *Collection) GetRolloutPropertyFilteredByDomain(key IntKey) BoolPropertyFnWithDomainFilter {
+	percentage := c.GetIntPropertyFilteredByDomain(key)
+	return func(domain string) bool {
+		return IsInRollout(key, percentage(domain), domain)
+	}
+}
+
+// GetRolloutPropertyFilteredByShardID gets the rollout percentage with shardID filter and returns
+// whether the shard is part of the rollout. A percentage configured for the shard takes precedence.
+func (c *Collection) GetRolloutPropertyFilteredByShardID(key IntKey) BoolPropertyFnWithShardIDFilter {
+	percentage := c.GetIntPropertyFilteredByShardID(key)
+	return func(shardID int) bool {
+		return IsInRollout(key, percentage(shardID), strconv.Itoa(shardID))
+	}
+}
+
+// GetRolloutPropertyFilteredByTaskListInfo gets the rollout percentage with taskListInfo as filters and returns
+// whether the task list is part of the rollout. A percentage configured for the task list takes precedence.
+func (c *Collection) GetRolloutPropertyFilteredByTaskListInfo(key IntKey) BoolPropertyFnWithTaskListInfoFilters {
+	percentage := c.GetIntPropertyFilteredByTaskListInfo(key)
+	return func(domain string, taskList string, taskType int) bool {
+		return IsInRollout(key, percentage(domain, taskList, taskType), fmt.Sprintf("%v/%v/%v", domain, taskList, taskType))
+	}
+}
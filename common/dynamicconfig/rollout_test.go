@@ -0,0 +1,87 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/log"
+)
+
+func TestIsInRollout(t *testing.T) {
+	key := QueueProcessorSplitRolloutPercentage
+	assert.False(t, IsInRollout(key, 0, "entity"))
+	assert.False(t, IsInRollout(key, -10, "entity"))
+	assert.True(t, IsInRollout(key, 100, "entity"))
+	assert.True(t, IsInRollout(key, 200, "entity"))
+
+	const numEntities = 10000
+	previous := make(map[int]bool)
+	for _, percentage := range []int{10, 50, 90} {
+		inRollout := 0
+		for i := 0; i < numEntities; i++ {
+			if IsInRollout(key, percentage, strconv.Itoa(i)) {
+				inRollout++
+				previous[i] = true
+			} else {
+				assert.False(t, previous[i], "entity must stay in rollout when percentage increases")
+			}
+		}
+		assert.InDelta(t, percentage*numEntities/100, inRollout, numEntities*0.02)
+	}
+}
+
+func TestIsInRollout_Deterministic(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		entity := strconv.Itoa(i)
+		assert.Equal(t, IsInRollout(MatchingSyncMatchRolloutPercentage, 50, entity), IsInRollout(MatchingSyncMatchRolloutPercentage, 50, entity))
+	}
+}
+
+func TestGetRolloutProperty(t *testing.T) {
+	client := NewInMemoryClient().(*inMemoryClient)
+	cln := NewCollection(client, log.NewNoop())
+
+	byShard := cln.GetRolloutPropertyFilteredByShardID(QueueProcessorSplitRolloutPercentage)
+	byTaskList := cln.GetRolloutPropertyFilteredByTaskListInfo(MatchingSyncMatchRolloutPercentage)
+	byDomain := cln.GetRolloutPropertyFilteredByDomain(MatchingSyncMatchRolloutPercentage)
+	assert.True(t, byShard(1))
+	assert.True(t, byTaskList("domain", "tasklist", 0))
+	assert.True(t, byDomain("domain"))
+
+	client.SetValue(QueueProcessorSplitRolloutPercentage, 0)
+	client.SetValue(MatchingSyncMatchRolloutPercentage, 0)
+	assert.False(t, byShard(1))
+	assert.False(t, byTaskList("domain", "tasklist", 0))
+	assert.False(t, byDomain("domain"))
+}
+
+func TestValidateValues_RolloutPercentage(t *testing.T) {
+	report := ValidateValues(map[string][]*constrainedValue{
+		QueueProcessorSplitRolloutPercentage.String(): {{Value: 101}},
+		MatchingSyncMatchRolloutPercentage.String():   {{Value: 50}},
+	})
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, QueueProcessorSplitRolloutPercentage.String(), report.Issues[0].KeyName)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Drift describes a dynamic config value which differs between two snapshots.
+	// An empty LocalValue or RemoteValue means the value is absent on that side.
+	Drift struct {
+		Name        string
+		Filters     string
+		LocalValue  string
+		RemoteValue string
+	}
+)
+
+// ResolveSnapshot returns the fully resolved dynamic config given the configured entries:
+// every registered key is present, and keys without an unfiltered value get their default value.
+// Entries are sorted by key name.
+func ResolveSnapshot(configured []*types.DynamicConfigEntry) ([]*types.DynamicConfigEntry, error) {
+	entries := make(map[string]*types.DynamicConfigEntry, len(_keyNames))
+	for _, entry := range configured {
+		if entry == nil {
+			continue
+		}
+		entries[entry.Name] = entry
+	}
+
+	for keyName, key := range _keyNames {
+		entry, ok := entries[keyName]
+		if !ok {
+			entry = &types.DynamicConfigEntry{Name: keyName}
+			entries[keyName] = entry
+		}
+		if hasUnfilteredValue(entry) {
+			continue
+		}
+		blob, err := newJSONBlob(key.DefaultValue())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode default value of %v: %v", keyName, err)
+		}
+		entry.Values = append(entry.Values, &types.DynamicConfigValue{Value: blob})
+	}
+
+	result := make([]*types.DynamicConfigEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// CompareSnapshots returns all values which differ between the local and remote snapshots.
+// Values are matched by key name and filters, the result is sorted by key name and filters.
+func CompareSnapshots(local, remote []*types.DynamicConfigEntry) []Drift {
+	localValues := flattenSnapshot(local)
+	remoteValues := flattenSnapshot(remote)
+
+	var drifts []Drift
+	for id, localValue := range localValues {
+		if remoteValue := remoteValues[id]; remoteValue != localValue {
+			drifts = append(drifts, Drift{Name: id.name, Filters: id.filters, LocalValue: localValue, RemoteValue: remoteValue})
+		}
+	}
+	for id, remoteValue := range remoteValues {
+		if _, ok := localValues[id]; !ok {
+			drifts = append(drifts, Drift{Name: id.name, Filters: id.filters, RemoteValue: remoteValue})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Name != drifts[j].Name {
+			return drifts[i].Name < drifts[j].Name
+		}
+		return drifts[i].Filters < drifts[j].Filters
+	})
+	return drifts
+}
+
+type snapshotValueID struct {
+	name    string
+	filters string
+}
+
+func flattenSnapshot(entries []*types.DynamicConfigEntry) map[snapshotValueID]string {
+	values := make(map[snapshotValueID]string)
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		for _, value := range entry.Values {
+			if value == nil || value.Value == nil {
+				continue
+			}
+			id := snapshotValueID{name: entry.Name, filters: filtersToString(value.Filters)}
+			values[id] = string(value.Value.Data)
+		}
+	}
+	return values
+}
+
+func filtersToString(filters []*types.DynamicConfigFilter) string {
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+		var data []byte
+		if filter.Value != nil {
+			data = filter.Value.Data
+		}
+		parts = append(parts, fmt.Sprintf("%v=%s", filter.Name, data))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func hasUnfilteredValue(entry *types.DynamicConfigEntry) bool {
+	for _, value := range entry.Values {
+		if value != nil && len(value.Filters) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func newJSONBlob(v interface{}) (*types.DataBlob, error) {
+	if d, ok := v.(time.Duration); ok {
+		// durations are configured as strings, keep defaults comparable with configured values
+		v = d.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestResolveSnapshot(t *testing.T) {
+	configured := []*types.DynamicConfigEntry{
+		{
+			Name: TestGetIntPropertyKey.String(),
+			Values: []*types.DynamicConfigValue{
+				{Value: mustNewJSONBlob(t, 10)},
+			},
+		},
+		{
+			Name: TestGetBoolPropertyKey.String(),
+			Values: []*types.DynamicConfigValue{
+				{
+					Value:   mustNewJSONBlob(t, true),
+					Filters: []*types.DynamicConfigFilter{{Name: "domainName", Value: mustNewJSONBlob(t, "samples-domain")}},
+				},
+			},
+		},
+	}
+
+	snapshot, err := ResolveSnapshot(configured)
+	require.NoError(t, err)
+	require.Len(t, snapshot, len(_keyNames))
+
+	byName := make(map[string]*types.DynamicConfigEntry)
+	for i, entry := range snapshot {
+		if i > 0 {
+			assert.True(t, snapshot[i-1].Name < entry.Name)
+		}
+		byName[entry.Name] = entry
+	}
+
+	intEntry := byName[TestGetIntPropertyKey.String()]
+	require.Len(t, intEntry.Values, 1)
+	assert.Equal(t, "10", string(intEntry.Values[0].Value.Data))
+
+	boolEntry := byName[TestGetBoolPropertyKey.String()]
+	require.Len(t, boolEntry.Values, 2)
+	assert.Equal(t, "false", string(boolEntry.Values[1].Value.Data))
+	assert.Empty(t, boolEntry.Values[1].Filters)
+
+	durationEntry := byName[TestGetDurationPropertyKey.String()]
+	require.Len(t, durationEntry.Values, 1)
+	assert.Equal(t, `"0s"`, string(durationEntry.Values[0].Value.Data))
+}
+
+func TestCompareSnapshots(t *testing.T) {
+	domainFilter := []*types.DynamicConfigFilter{{Name: "domainName", Value: mustNewJSONBlob(t, "samples-domain")}}
+	local := []*types.DynamicConfigEntry{
+		{
+			Name: "a",
+			Values: []*types.DynamicConfigValue{
+				{Value: mustNewJSONBlob(t, 1)},
+				{Value: mustNewJSONBlob(t, 2), Filters: domainFilter},
+			},
+		},
+		{
+			Name:   "b",
+			Values: []*types.DynamicConfigValue{{Value: mustNewJSONBlob(t, "x")}},
+		},
+	}
+	remote := []*types.DynamicConfigEntry{
+		{
+			Name: "a",
+			Values: []*types.DynamicConfigValue{
+				{Value: mustNewJSONBlob(t, 1)},
+				{Value: mustNewJSONBlob(t, 3), Filters: domainFilter},
+			},
+		},
+		{
+			Name:   "c",
+			Values: []*types.DynamicConfigValue{{Value: mustNewJSONBlob(t, true)}},
+		},
+	}
+
+	drifts := CompareSnapshots(local, remote)
+	assert.Equal(t, []Drift{
+		{Name: "a", Filters: `domainName="samples-domain"`, LocalValue: "2", RemoteValue: "3"},
+		{Name: "b", LocalValue: `"x"`},
+		{Name: "c", RemoteValue: "true"},
+	}, drifts)
+
+	assert.Empty(t, CompareSnapshots(local, local))
+}
+
+func mustNewJSONBlob(t *testing.T, v interface{}) *types.DataBlob {
+	blob, err := newJSONBlob(v)
+	require.NoError(t, err)
+	return blob
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	// ValidationIssue describes a single problem found in a dynamic config source
+	ValidationIssue struct {
+		KeyName     string
+		Constraints map[string]interface{}
+		Reason      string
+	}
+
+	// ValidationReport is the consolidated result of validating a dynamic config source
+	ValidationReport struct {
+		Issues []ValidationIssue
+	}
+)
+
+// HasIssues returns true if at least one issue was found
+func (r *ValidationReport) HasIssues() bool {
+	return r != nil && len(r.Issues) > 0
+}
+
+// Error implements the error interface so that a report can be surfaced directly
+func (r *ValidationReport) Error() string {
+	lines := make([]string, 0, len(r.Issues))
+	for _, issue := range r.Issues {
+		lines = append(lines, issue.String())
+	}
+	return fmt.Sprintf("dynamic config validation failed with %d issue(s): %s", len(r.Issues), strings.Join(lines, "; "))
+}
+
+func (i ValidationIssue) String() string {
+	if len(i.Constraints) == 0 {
+		return fmt.Sprintf("%s: %s", i.KeyName, i.Reason)
+	}
+	return fmt.Sprintf("%s%v: %s", i.KeyName, i.Constraints, i.Reason)
+}
+
+// ValidateValues checks every key and value of a dynamic config source against the registered keys.
+// Unknown keys, unknown filters, values of the wrong type and out of range values are all reported.
+// Issues are sorted by key name so that the report is stable between reloads.
+func ValidateValues(values map[string][]*constrainedValue) *ValidationReport {
+	report := &ValidationReport{}
+	for keyName, constrainedValues := range values {
+		key, err := GetKeyFromKeyName(keyName)
+		if err != nil {
+			report.Issues = append(report.Issues, ValidationIssue{
+				KeyName: keyName,
+				Reason:  "unknown key",
+			})
+			continue
+		}
+		for _, cv := range constrainedValues {
+			if cv == nil {
+				continue
+			}
+			for filterName := range cv.Constraints {
+				if ParseFilter(filterName) == UnknownFilter {
+					report.Issues = append(report.Issues, ValidationIssue{
+						KeyName:     keyName,
+						Constraints: cv.Constraints,
+						Reason:      fmt.Sprintf("unknown filter %q", filterName),
+					})
+				}
+			}
+			if err := validateValueForKey(key, cv.Value); err != nil {
+				report.Issues = append(report.Issues, ValidationIssue{
+					KeyName:     keyName,
+					Constraints: cv.Constraints,
+					Reason:      err.Error(),
+				})
+			}
+		}
+	}
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return report.Issues[i].KeyName < report.Issues[j].KeyName
+	})
+	return report
+}
+
+// validateValueForKey validates a value as decoded from a config source,
+// which differs from ValidateKeyValuePair as durations are stored as strings.
+func validateValueForKey(key Key, value interface{}) error {
+	switch key.(type) {
+	case IntKey:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("expected int value but got %T", value)
+		}
+		if IsRolloutKey(key) && (v < MinRolloutPercentage || v > MaxRolloutPercentage) {
+			return fmt.Errorf("rollout percentage %v is out of range", v)
+		}
+	case BoolKey:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected bool value but got %T", value)
+		}
+	case FloatKey:
+		switch v := value.(type) {
+		case int:
+		case float64:
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return fmt.Errorf("float value %v is out of range", v)
+			}
+		default:
+			return fmt.Errorf("expected float value but got %T", value)
+		}
+	case StringKey:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("expected string value but got %T", value)
+		}
+	case DurationKey:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected duration string but got %T", value)
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("failed to parse duration: %v", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("duration value %v is out of range", d)
+		}
+	case MapKey:
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("expected map value but got %T", value)
+		}
+	default:
+		return fmt.Errorf("unknown key type: %T", key)
+	}
+	return nil
+}
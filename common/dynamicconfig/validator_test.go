@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/log"
+)
+
+func TestValidateValues(t *testing.T) {
+	values := map[string][]*constrainedValue{
+		"testGetIntPropertyKey": {
+			{Value: 10},
+			{Value: "10", Constraints: map[string]interface{}{"domainName": "samples-domain"}},
+		},
+		"testGetDurationPropertyKey": {
+			{Value: "1m"},
+			{Value: "-1m", Constraints: map[string]interface{}{"domainName": "samples-domain"}},
+		},
+		"testGetBoolPropertyKey": {
+			{Value: true, Constraints: map[string]interface{}{"noSuchFilter": "x"}},
+		},
+		"testGetFloat64PropertyKey": {
+			{Value: 1},
+			{Value: 0.5},
+		},
+		"no.such.key": {
+			{Value: true},
+		},
+	}
+
+	report := ValidateValues(values)
+	require.True(t, report.HasIssues())
+	require.Len(t, report.Issues, 4)
+
+	reasons := make(map[string]string)
+	for _, issue := range report.Issues {
+		reasons[issue.KeyName] = issue.Reason
+	}
+	assert.Equal(t, "unknown key", reasons["no.such.key"])
+	assert.Contains(t, reasons["testGetIntPropertyKey"], "expected int value")
+	assert.Contains(t, reasons["testGetDurationPropertyKey"], "out of range")
+	assert.Contains(t, reasons["testGetBoolPropertyKey"], "unknown filter")
+	assert.Contains(t, report.Error(), "4 issue(s)")
+}
+
+func TestValidateValues_NoIssues(t *testing.T) {
+	values := map[string][]*constrainedValue{
+		"testGetMapPropertyKey": {
+			{Value: map[string]interface{}{"key": 1}},
+		},
+		"testGetStringPropertyKey": {
+			{Value: "value", Constraints: map[string]interface{}{"taskListName": "tl"}},
+		},
+	}
+	assert.False(t, ValidateValues(values).HasIssues())
+}
+
+func TestFileBasedClient_StrictValidation(t *testing.T) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	_, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:         "config/testConfig.yaml",
+		PollInterval:     time.Second * 5,
+		StrictValidation: true,
+	}, log.NewNoop(), doneCh)
+	require.Error(t, err)
+	_, ok := err.(*ValidationReport)
+	assert.True(t, ok)
+
+	_, err = NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     "config/testConfig.yaml",
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), doneCh)
+	assert.NoError(t, err)
+}
+
+func TestDevelopmentConfigIsValid(t *testing.T) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	for _, file := range []string{
+		"../../config/dynamicconfig/development.yaml",
+		"../../config/dynamicconfig/development_es.yaml",
+	} {
+		_, err := NewFileBasedClient(&FileBasedClientConfig{
+			Filepath:         file,
+			PollInterval:     time.Second * 5,
+			StrictValidation: true,
+		}, log.NewNoop(), doneCh)
+		assert.NoError(t, err, file)
+	}
+}
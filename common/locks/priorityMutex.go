@@ -0,0 +1,142 @@
+// Copyright (c) 2022 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package locks
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+type (
+	// PriorityMutex accepts a context and a priority in its Lock method.
+	// It blocks the goroutine until either the lock is acquired or the context
+	// is closed. When the lock is released it is handed to the waiter with the
+	// lowest priority value, waiters with the same priority acquire it in FIFO order.
+	// A waiter is overtaken by at most priorityMutexMaxBypasses waiters with a lower
+	// priority value, so a steady stream of high priority callers cannot starve it.
+	PriorityMutex interface {
+		Lock(ctx context.Context, priority int) error
+		Unlock()
+	}
+
+	priorityMutexImpl struct {
+		sync.Mutex
+		locked  bool
+		waiters *list.List
+	}
+
+	priorityWaiter struct {
+		priority   int
+		acquiredCh chan struct{}
+		// bypasses is the number of waiters queued ahead of this one after it started waiting
+		bypasses int
+	}
+)
+
+// priorityMutexMaxBypasses is the number of times a waiter can be overtaken before it keeps its place in the queue
+const priorityMutexMaxBypasses = 8
+
+// NewPriorityMutex creates a new PriorityMutex
+func NewPriorityMutex() PriorityMutex {
+	return &priorityMutexImpl{
+		waiters: list.New(),
+	}
+}
+
+func (m *priorityMutexImpl) Lock(ctx context.Context, priority int) error {
+	m.Mutex.Lock()
+	if !m.locked {
+		m.locked = true
+		m.Mutex.Unlock()
+		return nil
+	}
+
+	waiter := &priorityWaiter{
+		priority:   priority,
+		acquiredCh: make(chan struct{}),
+	}
+	element := m.enqueue(waiter)
+	m.Mutex.Unlock()
+
+	select {
+	case <-waiter.acquiredCh:
+		return nil
+	case <-ctx.Done():
+		m.Mutex.Lock()
+		defer m.Mutex.Unlock()
+		select {
+		case <-waiter.acquiredCh:
+			// the lock was handed over before the waiter could bail
+			return nil
+		default:
+			m.waiters.Remove(element)
+			return ctx.Err()
+		}
+	}
+}
+
+func (m *priorityMutexImpl) Unlock() {
+	m.Mutex.Lock()
+	defer m.Mutex.Unlock()
+
+	if !m.locked {
+		panic("unlock of unlocked priority mutex")
+	}
+	front := m.waiters.Front()
+	if front == nil {
+		m.locked = false
+		return
+	}
+	// the lock stays locked and is handed over to the next waiter
+	m.waiters.Remove(front)
+	close(front.Value.(*priorityWaiter).acquiredCh)
+}
+
+// enqueue inserts the waiter after all waiters with the same or a lower priority value,
+// and after the waiters which have already been overtaken priorityMutexMaxBypasses times
+func (m *priorityMutexImpl) enqueue(waiter *priorityWaiter) *list.Element {
+	element := m.waiters.Back()
+	for ; element != nil; element = element.Prev() {
+		queued := element.Value.(*priorityWaiter)
+		if queued.priority <= waiter.priority || queued.bypasses >= priorityMutexMaxBypasses {
+			break
+		}
+	}
+
+	var inserted *list.Element
+	if element == nil {
+		inserted = m.waiters.PushFront(waiter)
+	} else {
+		inserted = m.waiters.InsertAfter(waiter, element)
+	}
+	for bypassed := inserted.Next(); bypassed != nil; bypassed = bypassed.Next() {
+		bypassed.Value.(*priorityWaiter).bypasses++
+	}
+	return inserted
+}
+
+func (m *priorityMutexImpl) numWaiters() int {
+	m.Mutex.Lock()
+	defer m.Mutex.Unlock()
+
+	return m.waiters.Len()
+}
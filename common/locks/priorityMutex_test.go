@@ -0,0 +1,155 @@
+// Copyright (c) 2022 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package locks
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	PriorityMutexSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestPriorityMutexSuite(t *testing.T) {
+	suite.Run(t, new(PriorityMutexSuite))
+}
+
+func (s *PriorityMutexSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *PriorityMutexSuite) TestBasicLocking() {
+	lock := NewPriorityMutex()
+	s.NoError(lock.Lock(context.Background(), 0))
+	lock.Unlock()
+	s.NoError(lock.Lock(context.Background(), 1))
+	lock.Unlock()
+}
+
+func (s *PriorityMutexSuite) TestExpiredContext() {
+	lock := NewPriorityMutex()
+	s.NoError(lock.Lock(context.Background(), 0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err := lock.Lock(ctx, 0)
+	s.Equal(ctx.Err(), err)
+
+	lock.Unlock()
+	s.NoError(lock.Lock(context.Background(), 0))
+	lock.Unlock()
+}
+
+func (s *PriorityMutexSuite) TestPriorityOrder() {
+	lock := NewPriorityMutex()
+	s.NoError(lock.Lock(context.Background(), 0))
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i, priority := range []int{2, 1, 2, 0} {
+		wg.Add(1)
+		go func(id, priority int) {
+			defer wg.Done()
+			s.NoError(lock.Lock(context.Background(), priority))
+			mu.Lock()
+			order = append(order, id)
+			mu.Unlock()
+			lock.Unlock()
+		}(i, priority)
+		s.Eventually(func() bool {
+			return lock.(*priorityMutexImpl).numWaiters() == i+1
+		}, time.Second, time.Millisecond)
+	}
+
+	lock.Unlock()
+	wg.Wait()
+	s.Equal([]int{3, 1, 0, 2}, order)
+}
+
+func (s *PriorityMutexSuite) TestNoStarvation() {
+	lock := NewPriorityMutex()
+	s.NoError(lock.Lock(context.Background(), 0))
+
+	acquiredCh := make(chan int, 2*priorityMutexMaxBypasses)
+	go func() {
+		s.NoError(lock.Lock(context.Background(), 1))
+		acquiredCh <- 1
+	}()
+	s.Eventually(func() bool {
+		return lock.(*priorityMutexImpl).numWaiters() == 1
+	}, time.Second, time.Millisecond)
+
+	// a high priority waiter keeps arriving before each release of the lock
+	highPriorityAcquired := 0
+	for {
+		go func() {
+			s.NoError(lock.Lock(context.Background(), 0))
+			acquiredCh <- 0
+		}()
+		s.Eventually(func() bool {
+			return lock.(*priorityMutexImpl).numWaiters() == 2
+		}, time.Second, time.Millisecond)
+
+		lock.Unlock()
+		if <-acquiredCh == 1 {
+			break
+		}
+		highPriorityAcquired++
+		s.True(highPriorityAcquired <= priorityMutexMaxBypasses)
+	}
+	s.Equal(priorityMutexMaxBypasses, highPriorityAcquired)
+
+	// release the lock to the high priority waiter left in the queue
+	lock.Unlock()
+	s.Equal(0, <-acquiredCh)
+	lock.Unlock()
+}
+
+func (s *PriorityMutexSuite) TestCancelWhileWaiting() {
+	lock := NewPriorityMutex()
+	s.NoError(lock.Lock(context.Background(), 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- lock.Lock(ctx, 0)
+	}()
+	s.Eventually(func() bool {
+		return lock.(*priorityMutexImpl).numWaiters() == 1
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	s.Equal(context.Canceled, <-errCh)
+	s.Equal(0, lock.(*priorityMutexImpl).numWaiters())
+	lock.Unlock()
+	s.NoError(lock.Lock(context.Background(), 0))
+	lock.Unlock()
+}
@@ -464,6 +464,11 @@ func ShardRangeID(id int64) Tag {
 	return newInt64("shard-range-id", id)
 }
 
+// RequestShardRangeID returns tag for the shard RangeID of a persistence request
+func RequestShardRangeID(id int64) Tag {
+	return newInt64("request-shard-range-id", id)
+}
+
 // ReadLevel returns tag for ReadLevel
 func ReadLevel(lv int64) Tag {
 	return newInt64("read-level", lv)
@@ -678,6 +683,21 @@ func FailoverVersion(version int64) Tag {
 	return newInt64("xdc-failover-version", version)
 }
 
+// FailoverFirstStartLatency returns tag for the time from failover to the first workflow start
+func FailoverFirstStartLatency(latency time.Duration) Tag {
+	return newDurationTag("xdc-failover-first-start-latency", latency)
+}
+
+// ReplicationLag returns tag for the replication lag of a domain to a remote cluster
+func ReplicationLag(lag time.Duration) Tag {
+	return newDurationTag("xdc-replication-lag", lag)
+}
+
+// ReplicationTaskIDLag returns tag for the replication task ID lag of a domain to a remote cluster
+func ReplicationTaskIDLag(lag int64) Tag {
+	return newInt64("xdc-replication-task-id-lag", lag)
+}
+
 // CurrentVersion returns tag for CurrentVersion
 func CurrentVersion(currentVersion int64) Tag {
 	return newInt64("xdc-current-version", currentVersion)
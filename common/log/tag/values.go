@@ -131,6 +131,16 @@ var (
 	ComponentCrossClusterTaskFetcher    = component("cross-cluster-task-fetcher")
 	ComponentShardScanner               = component("shardscanner-scanner")
 	ComponentShardFixer                 = component("shardscanner-fixer")
+	ComponentDynamicConfigDriftDetector = component("dynamic-config-drift-detector")
+	ComponentSignalGateway              = component("signal-gateway")
+	ComponentWorkflowCloseWebhook       = component("workflow-close-webhook")
+	ComponentGraphQL                    = component("graphql")
+	ComponentBlobReencoder              = component("blob-reencoder")
+	ComponentStorageMeter               = component("storage-meter")
+	ComponentHistoryExporter            = component("history-exporter")
+	ComponentStuckWorkflowDetector      = component("stuck-workflow-detector")
+	ComponentReplicationLagTracker      = component("replication-lag-tracker")
+	ComponentShardCacheWarmer           = component("shard-cache-warmer")
 )
 
 // Pre-defined values for TagSysLifecycle
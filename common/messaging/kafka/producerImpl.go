@@ -104,6 +104,9 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *sarama.ProducerMessage:
+		message.Topic = p.topic
+		return message, nil
 	case *sarama.ConsumerMessage:
 		msg := &sarama.ProducerMessage{
 			Topic: p.topic,
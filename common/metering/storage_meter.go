@@ -0,0 +1,396 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination storage_meter_mock.go
+
+package metering
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ownershipKey is used to pick a single frontend host emitting the usage metrics
+	ownershipKey = "storage-meter"
+	pageSize     = 1000
+	// visibilityRecordOverhead estimates the bytes of the fixed size columns of a visibility record
+	visibilityRecordOverhead = 64
+)
+
+type (
+	// Config is the config for the storage meter
+	Config struct {
+		NumHistoryShards  int
+		MeteringInterval  dynamicconfig.DurationPropertyFn
+		MeteringRPS       dynamicconfig.IntPropertyFn
+		DomainBudgetBytes dynamicconfig.IntPropertyFnWithDomainFilter
+	}
+
+	// ExecutionManagerProvider returns the execution manager of a shard
+	ExecutionManagerProvider func(shardID int) (persistence.ExecutionManager, error)
+
+	// StorageMeter periodically aggregates the bytes persisted per domain in the history, execution
+	// and visibility stores and emits them as metrics. Only the owner of the storage meter in the
+	// frontend ring meters, so that the stores are scanned and the usage is reported once.
+	StorageMeter interface {
+		common.Daemon
+	}
+
+	// domainUsage is the bytes persisted for a domain. archivalBytes is the history size of the closed
+	// workflows of domains with history archival enabled, histories archived after their workflows were
+	// deleted from the execution store are not included. budgetBytes is zero if the domain has no budget.
+	domainUsage struct {
+		domain            string
+		workflowCount     int64
+		historyBytes      int64
+		mutableStateBytes int64
+		visibilityBytes   int64
+		archivalBytes     int64
+		budgetBytes       int64
+	}
+
+	storageMeterImpl struct {
+		status     int32
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownCh chan struct{}
+
+		config                   *Config
+		executionManagerProvider ExecutionManagerProvider
+		visibilityManager        persistence.VisibilityManager
+		domainCache              cache.DomainCache
+		membershipResolver       membership.Resolver
+		hostInfo                 membership.HostInfo
+		timeSource               clock.TimeSource
+		scope                    metrics.Scope
+		logger                   log.Logger
+	}
+)
+
+var _ StorageMeter = (*storageMeterImpl)(nil)
+
+// NewStorageMeter creates a new storage meter
+func NewStorageMeter(
+	config *Config,
+	executionManagerProvider ExecutionManagerProvider,
+	visibilityManager persistence.VisibilityManager,
+	domainCache cache.DomainCache,
+	membershipResolver membership.Resolver,
+	hostInfo membership.HostInfo,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) StorageMeter {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &storageMeterImpl{
+		status:                   common.DaemonStatusInitialized,
+		ctx:                      ctx,
+		cancel:                   cancel,
+		shutdownCh:               make(chan struct{}),
+		config:                   config,
+		executionManagerProvider: executionManagerProvider,
+		visibilityManager:        visibilityManager,
+		domainCache:              domainCache,
+		membershipResolver:       membershipResolver,
+		hostInfo:                 hostInfo,
+		timeSource:               timeSource,
+		scope:                    metricsClient.Scope(metrics.StorageMeteringScope),
+		logger:                   logger.WithTags(tag.ComponentStorageMeter),
+	}
+}
+
+// Start starts the storage meter
+func (m *storageMeterImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go m.meterLoop()
+	m.logger.Info("storage meter started")
+}
+
+// Stop stops the storage meter
+func (m *storageMeterImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	m.cancel()
+	close(m.shutdownCh)
+	m.logger.Info("storage meter stopped")
+}
+
+func (m *storageMeterImpl) meterLoop() {
+	// meter right away, so that the usage is emitted without waiting for a full interval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			m.meter(m.ctx)
+			timer.Reset(m.config.MeteringInterval())
+		case <-m.shutdownCh:
+			return
+		}
+	}
+}
+
+func (m *storageMeterImpl) meter(ctx context.Context) {
+	if !m.isOwner() {
+		return
+	}
+
+	sw := m.scope.StartTimer(metrics.StorageMeteringLatency)
+	defer sw.Stop()
+
+	usage, err := m.aggregate(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			m.logger.Warn("Failed to meter domain storage usage", tag.Error(err))
+			m.scope.IncCounter(metrics.StorageMeteringFailures)
+		}
+		return
+	}
+	m.emit(usage)
+}
+
+func (m *storageMeterImpl) aggregate(ctx context.Context) ([]*domainUsage, error) {
+	limiter := quotas.NewDynamicRateLimiter(func() float64 {
+		return float64(m.config.MeteringRPS())
+	})
+	usages := make(map[string]*domainUsage)
+
+	for shardID := 0; shardID < m.config.NumHistoryShards; shardID++ {
+		if err := m.aggregateShard(ctx, shardID, limiter, usages); err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range m.domainCache.GetAllDomain() {
+		if err := m.aggregateVisibility(ctx, entry, limiter, usages); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]*domainUsage, 0, len(usages))
+	for _, u := range usages {
+		u.budgetBytes = int64(m.config.DomainBudgetBytes(u.domain))
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+func (m *storageMeterImpl) aggregateShard(
+	ctx context.Context,
+	shardID int,
+	limiter quotas.Limiter,
+	usages map[string]*domainUsage,
+) error {
+
+	executionManager, err := m.executionManagerProvider(shardID)
+	if err != nil {
+		return err
+	}
+	request := &persistence.ListConcreteExecutionsRequest{PageSize: pageSize}
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := executionManager.ListConcreteExecutions(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, execution := range resp.Executions {
+			info := execution.ExecutionInfo
+			if info == nil {
+				continue
+			}
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+			ms, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+				DomainID:  info.DomainID,
+				Execution: types.WorkflowExecution{WorkflowID: info.WorkflowID, RunID: info.RunID},
+			})
+			if err != nil {
+				if _, ok := err.(*types.EntityNotExistsError); ok {
+					// the execution was deleted since it was listed
+					continue
+				}
+				return err
+			}
+
+			u := m.getDomainUsage(usages, info.DomainID)
+			u.workflowCount++
+			var historySize int64
+			if execution.ExecutionStats != nil {
+				historySize = execution.ExecutionStats.HistorySize
+			}
+			u.historyBytes += historySize
+			if ms.MutableStateStats != nil {
+				u.mutableStateBytes += int64(ms.MutableStateStats.MutableStateSize)
+			}
+			if info.State == persistence.WorkflowStateCompleted && m.isHistoryArchivalEnabled(info.DomainID) {
+				u.archivalBytes += historySize
+			}
+		}
+		if len(resp.PageToken) == 0 {
+			return nil
+		}
+		request.PageToken = resp.PageToken
+	}
+}
+
+func (m *storageMeterImpl) aggregateVisibility(
+	ctx context.Context,
+	entry *cache.DomainCacheEntry,
+	limiter quotas.Limiter,
+	usages map[string]*domainUsage,
+) error {
+
+	domainInfo := entry.GetInfo()
+	request := &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:   domainInfo.ID,
+		Domain:       domainInfo.Name,
+		EarliestTime: 0,
+		LatestTime:   m.timeSource.Now().UnixNano(),
+		PageSize:     pageSize,
+	}
+	listFns := []func(context.Context, *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error){
+		m.visibilityManager.ListOpenWorkflowExecutions,
+		m.visibilityManager.ListClosedWorkflowExecutions,
+	}
+	for _, listFn := range listFns {
+		request.NextPageToken = nil
+		for {
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+			resp, err := listFn(ctx, request)
+			if err != nil {
+				return err
+			}
+			if len(resp.Executions) > 0 {
+				u := m.getDomainUsage(usages, domainInfo.ID)
+				for _, execution := range resp.Executions {
+					u.visibilityBytes += visibilityRecordSize(execution)
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			request.NextPageToken = resp.NextPageToken
+		}
+	}
+	return nil
+}
+
+func (m *storageMeterImpl) getDomainUsage(
+	usages map[string]*domainUsage,
+	domainID string,
+) *domainUsage {
+
+	if u, ok := usages[domainID]; ok {
+		return u
+	}
+	// executions of deleted domains are reported under the domain ID
+	domainName := domainID
+	if name, err := m.domainCache.GetDomainName(domainID); err == nil {
+		domainName = name
+	}
+	u := &domainUsage{domain: domainName}
+	usages[domainID] = u
+	return u
+}
+
+func (m *storageMeterImpl) isHistoryArchivalEnabled(domainID string) bool {
+	entry, err := m.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return false
+	}
+	return entry.GetConfig().HistoryArchivalStatus == types.ArchivalStatusEnabled
+}
+
+func (m *storageMeterImpl) isOwner() bool {
+	info, err := m.membershipResolver.Lookup(service.Frontend, ownershipKey)
+	if err != nil {
+		m.logger.Info("Failed to lookup host info. Skip emitting usage.", tag.Error(err))
+		return false
+	}
+	return info.Identity() == m.hostInfo.Identity()
+}
+
+func (m *storageMeterImpl) emit(usages []*domainUsage) {
+	for _, u := range usages {
+		scope := m.scope.Tagged(metrics.DomainTag(u.domain))
+		scope.UpdateGauge(metrics.DomainHistoryBytesGauge, float64(u.historyBytes))
+		scope.UpdateGauge(metrics.DomainMutableStateBytesGauge, float64(u.mutableStateBytes))
+		scope.UpdateGauge(metrics.DomainVisibilityBytesGauge, float64(u.visibilityBytes))
+		scope.UpdateGauge(metrics.DomainArchivalBytesGauge, float64(u.archivalBytes))
+		if u.budgetBytes <= 0 {
+			continue
+		}
+		utilization := float64(u.totalBytes()) / float64(u.budgetBytes)
+		scope.UpdateGauge(metrics.DomainStorageBudgetUtilizationGauge, utilization)
+		if utilization > 1 {
+			m.logger.Warn("Domain storage usage exceeds its budget",
+				tag.WorkflowDomainName(u.domain),
+				tag.Number(u.totalBytes()),
+				tag.Value(u.budgetBytes),
+			)
+		}
+	}
+}
+
+// totalBytes returns the bytes persisted for the domain across all stores
+func (u *domainUsage) totalBytes() int64 {
+	return u.historyBytes + u.mutableStateBytes + u.visibilityBytes + u.archivalBytes
+}
+
+func visibilityRecordSize(info *types.WorkflowExecutionInfo) int64 {
+	size := visibilityRecordOverhead +
+		len(info.GetExecution().GetWorkflowID()) +
+		len(info.GetExecution().GetRunID()) +
+		len(info.GetType().GetName()) +
+		len(info.TaskList)
+	for key, value := range info.Memo.GetFields() {
+		size += len(key) + len(value)
+	}
+	for key, value := range info.SearchAttributes.GetIndexedFields() {
+		size += len(key) + len(value)
+	}
+	return int64(size)
+}
@@ -0,0 +1,241 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestStorageMeter_Meter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	executionManager := &mocks.ExecutionManager{}
+	visibilityManager := &mocks.VisibilityManager{}
+	domainCache := cache.NewMockDomainCache(controller)
+	resolver := membership.NewMockResolver(controller)
+	hostInfo := membership.NewHostInfo("10.0.0.1:7933")
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Unix(0, 100))
+	testScope := tally.NewTestScope("", nil)
+
+	archivedDomain := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-a-id", Name: "domain-a"},
+		&persistence.DomainConfig{HistoryArchivalStatus: types.ArchivalStatusEnabled},
+		"active",
+	)
+	domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{"domain-a": archivedDomain}).Times(1)
+	domainCache.EXPECT().GetDomainName("domain-a-id").Return("domain-a", nil).AnyTimes()
+	domainCache.EXPECT().GetDomainName("deleted-domain-id").Return("", &types.EntityNotExistsError{}).AnyTimes()
+	domainCache.EXPECT().GetDomainByID("domain-a-id").Return(archivedDomain, nil).AnyTimes()
+	domainCache.EXPECT().GetDomainByID("deleted-domain-id").Return(nil, &types.EntityNotExistsError{}).AnyTimes()
+	resolver.EXPECT().Lookup(service.Frontend, ownershipKey).Return(hostInfo, nil).Times(1)
+
+	executionManager.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: pageSize}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				{
+					ExecutionInfo:  &persistence.WorkflowExecutionInfo{DomainID: "domain-a-id", WorkflowID: "wid-1", RunID: "rid-1", State: persistence.WorkflowStateRunning},
+					ExecutionStats: &persistence.ExecutionStats{HistorySize: 100},
+				},
+				{
+					ExecutionInfo:  &persistence.WorkflowExecutionInfo{DomainID: "domain-a-id", WorkflowID: "wid-2", RunID: "rid-2", State: persistence.WorkflowStateCompleted},
+					ExecutionStats: &persistence.ExecutionStats{HistorySize: 200},
+				},
+				{
+					ExecutionInfo:  &persistence.WorkflowExecutionInfo{DomainID: "deleted-domain-id", WorkflowID: "wid-3", RunID: "rid-3", State: persistence.WorkflowStateCompleted},
+					ExecutionStats: &persistence.ExecutionStats{HistorySize: 300},
+				},
+				{
+					ExecutionInfo:  &persistence.WorkflowExecutionInfo{DomainID: "domain-a-id", WorkflowID: "wid-4", RunID: "rid-4"},
+					ExecutionStats: &persistence.ExecutionStats{HistorySize: 400},
+				},
+			},
+		}, nil).Once()
+	for runID, size := range map[string]int{"rid-1": 10, "rid-2": 20, "rid-3": 30} {
+		runID := runID
+		executionManager.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+			return req.Execution.RunID == runID
+		})).Return(&persistence.GetWorkflowExecutionResponse{
+			MutableStateStats: &persistence.MutableStateStats{MutableStateSize: size},
+		}, nil).Once()
+	}
+	// deleted since it was listed
+	executionManager.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+		return req.Execution.RunID == "rid-4"
+	})).Return(nil, &types.EntityNotExistsError{}).Once()
+
+	visibilityManager.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{
+				Execution: &types.WorkflowExecution{WorkflowID: "wid-1", RunID: "rid-1"},
+				Type:      &types.WorkflowType{Name: "type"},
+				Memo:      &types.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+			},
+		},
+	}, nil).Once()
+	visibilityManager.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{
+				Execution:        &types.WorkflowExecution{WorkflowID: "wid-2", RunID: "rid-2"},
+				SearchAttributes: &types.SearchAttributes{IndexedFields: map[string][]byte{"attr": []byte("1")}},
+			},
+		},
+	}, nil).Once()
+
+	meter := NewStorageMeter(
+		&Config{
+			NumHistoryShards: 1,
+			MeteringInterval: dynamicconfig.GetDurationPropertyFn(time.Hour),
+			MeteringRPS:      dynamicconfig.GetIntPropertyFn(1000),
+			DomainBudgetBytes: func(domain string) int {
+				if domain == "domain-a" {
+					return 500
+				}
+				return 0
+			},
+		},
+		func(shardID int) (persistence.ExecutionManager, error) {
+			return executionManager, nil
+		},
+		visibilityManager,
+		domainCache,
+		resolver,
+		hostInfo,
+		timeSource,
+		metrics.NewClient(testScope, metrics.Frontend),
+		loggerimpl.NewNopLogger(),
+	).(*storageMeterImpl)
+
+	meter.meter(context.Background())
+	executionManager.AssertExpectations(t)
+	visibilityManager.AssertExpectations(t)
+
+	visibilityBytes := 2*visibilityRecordOverhead + len("wid-1rid-1typekeyvalue") + len("wid-2rid-2attr1")
+	gauges := testScope.Snapshot().Gauges()
+	for name, expected := range map[string]float64{
+		"domain_history_bytes+domain=domain-a,operation=StorageMetering":                300,
+		"domain_mutable_state_bytes+domain=domain-a,operation=StorageMetering":          30,
+		"domain_visibility_bytes+domain=domain-a,operation=StorageMetering":             float64(visibilityBytes),
+		"domain_archival_bytes+domain=domain-a,operation=StorageMetering":               200,
+		"domain_storage_budget_utilization+domain=domain-a,operation=StorageMetering":   float64(300+30+visibilityBytes+200) / 500,
+		"domain_history_bytes+domain=deleted-domain-id,operation=StorageMetering":       300,
+		"domain_mutable_state_bytes+domain=deleted-domain-id,operation=StorageMetering": 30,
+	} {
+		gauge, ok := gauges[name]
+		require.True(t, ok, name)
+		require.Equal(t, expected, gauge.Value(), name)
+	}
+	_, ok := gauges["domain_storage_budget_utilization+domain=deleted-domain-id,operation=StorageMetering"]
+	require.False(t, ok)
+}
+
+func TestStorageMeter_NotOwner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	resolver := membership.NewMockResolver(controller)
+	resolver.EXPECT().Lookup(service.Frontend, ownershipKey).Return(membership.NewHostInfo("10.0.0.2:7933"), nil).Times(1)
+	// the stores are not scanned by hosts other than the owner
+	executionManager := &mocks.ExecutionManager{}
+
+	meter := NewStorageMeter(
+		&Config{
+			NumHistoryShards:  1,
+			MeteringInterval:  dynamicconfig.GetDurationPropertyFn(time.Hour),
+			MeteringRPS:       dynamicconfig.GetIntPropertyFn(1000),
+			DomainBudgetBytes: dynamicconfig.GetIntPropertyFilteredByDomain(0),
+		},
+		func(shardID int) (persistence.ExecutionManager, error) {
+			return executionManager, nil
+		},
+		&mocks.VisibilityManager{},
+		cache.NewMockDomainCache(controller),
+		resolver,
+		membership.NewHostInfo("10.0.0.1:7933"),
+		clock.NewRealTimeSource(),
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+	).(*storageMeterImpl)
+
+	meter.meter(context.Background())
+	executionManager.AssertExpectations(t)
+}
+
+func TestStorageMeter_MeterFailure(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	hostInfo := membership.NewHostInfo("10.0.0.1:7933")
+	resolver := membership.NewMockResolver(controller)
+	resolver.EXPECT().Lookup(service.Frontend, ownershipKey).Return(hostInfo, nil).Times(1)
+	executionManager := &mocks.ExecutionManager{}
+	executionManager.On("ListConcreteExecutions", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable")).Once()
+	testScope := tally.NewTestScope("", nil)
+
+	meter := NewStorageMeter(
+		&Config{
+			NumHistoryShards:  1,
+			MeteringInterval:  dynamicconfig.GetDurationPropertyFn(time.Hour),
+			MeteringRPS:       dynamicconfig.GetIntPropertyFn(1000),
+			DomainBudgetBytes: dynamicconfig.GetIntPropertyFilteredByDomain(0),
+		},
+		func(shardID int) (persistence.ExecutionManager, error) {
+			return executionManager, nil
+		},
+		&mocks.VisibilityManager{},
+		cache.NewMockDomainCache(controller),
+		resolver,
+		hostInfo,
+		clock.NewRealTimeSource(),
+		metrics.NewClient(testScope, metrics.Frontend),
+		loggerimpl.NewNopLogger(),
+	).(*storageMeterImpl)
+
+	meter.meter(context.Background())
+	executionManager.AssertExpectations(t)
+	snapshot := testScope.Snapshot()
+	require.Empty(t, snapshot.Gauges())
+	failures, ok := snapshot.Counters()["storage_metering_failures+operation=StorageMetering"]
+	require.True(t, ok)
+	require.Equal(t, int64(1), failures.Value())
+}
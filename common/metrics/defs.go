@@ -723,6 +723,10 @@ const (
 	DomainFailoverScope
 	// DomainReplicationQueueScope is used in domainreplication queue
 	DomainReplicationQueueScope
+	// CacheMemoryBudgetScope is used by the cache memory budget
+	CacheMemoryBudgetScope
+	// StorageMeteringScope is used by the per-domain storage meter
+	StorageMeteringScope
 
 	NumCommonScopes
 )
@@ -1097,6 +1101,10 @@ const (
 	HistoryCacheGetAndCreateScope
 	// HistoryCacheGetOrCreateScope is the scope used by history cache
 	HistoryCacheGetOrCreateScope
+	// HistoryCacheGetForReadScope is the scope used by history cache for read only access
+	HistoryCacheGetForReadScope
+	// HistoryCachePrefetchScope is the scope used by history cache for prefetching mutable state
+	HistoryCachePrefetchScope
 	// HistoryCacheGetOrCreateCurrentScope is the scope used by history cache
 	HistoryCacheGetOrCreateCurrentScope
 	// HistoryCacheGetCurrentExecutionScope is the scope used by history cache for getting current execution
@@ -1137,6 +1145,18 @@ const (
 	HistoryReplicationV2TaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// WorkflowCloseWebhookScope is the scope used by workflow close webhook deliveries
+	WorkflowCloseWebhookScope
+	// CloudEventsEmitterScope is the scope used by workflow lifecycle CloudEvents emission
+	CloudEventsEmitterScope
+	// HistoryExportScope is the scope used by the history export to the blobstore
+	HistoryExportScope
+	// HistoryCacheScope is the scope used by the state of the history cache of a shard
+	HistoryCacheScope
+	// ReplicationLagTrackerScope is the scope used by the replication lag tracker of a shard
+	ReplicationLagTrackerScope
+	// ShardCacheWarmUpScope is the scope used by the execution cache warm-up of a newly acquired shard
+	ShardCacheWarmUpScope
 
 	NumHistoryScopes
 )
@@ -1211,6 +1231,14 @@ const (
 	ESAnalyzerScope
 	// WatchDogScope is scope used by WatchDog workflow
 	WatchDogScope
+	// DynamicConfigDriftDetectorScope is scope used by the dynamic config drift detector
+	DynamicConfigDriftDetectorScope
+	// SignalGatewayScope is scope used by the signal gateway
+	SignalGatewayScope
+	// BlobReencoderScope is scope used by the persistence blob re-encoder
+	BlobReencoderScope
+	// StuckWorkflowDetectorScope is scope used by the stuck workflow detector
+	StuckWorkflowDetectorScope
 
 	NumWorkerScopes
 )
@@ -1514,6 +1542,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 
 		DomainFailoverScope:         {operation: "DomainFailover"},
 		DomainReplicationQueueScope: {operation: "DomainReplicationQueue"},
+		CacheMemoryBudgetScope:      {operation: "CacheMemoryBudget"},
+		StorageMeteringScope:        {operation: "StorageMetering"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1703,6 +1733,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		WorkflowContextScope:                                            {operation: "WorkflowContext"},
 		HistoryCacheGetAndCreateScope:                                   {operation: "HistoryCacheGetAndCreate", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		HistoryCacheGetOrCreateScope:                                    {operation: "HistoryCacheGetOrCreate", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
+		HistoryCacheGetForReadScope:                                     {operation: "HistoryCacheGetForRead", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
+		HistoryCachePrefetchScope:                                       {operation: "HistoryCachePrefetch", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		HistoryCacheGetOrCreateCurrentScope:                             {operation: "HistoryCacheGetOrCreateCurrent", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		HistoryCacheGetCurrentExecutionScope:                            {operation: "HistoryCacheGetCurrentExecution", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		EventsCacheGetEventScope:                                        {operation: "EventsCacheGetEvent", tags: map[string]string{CacheTypeTagName: EventsCacheTypeTagValue}},
@@ -1720,6 +1752,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FailoverMarkerScope:                                             {operation: "FailoverMarker"},
 		HistoryReplicationV2TaskScope:                                   {operation: "HistoryReplicationV2Task"},
 		SyncActivityTaskScope:                                           {operation: "SyncActivityTask"},
+		WorkflowCloseWebhookScope:                                       {operation: "WorkflowCloseWebhook"},
+		CloudEventsEmitterScope:                                         {operation: "CloudEventsEmitter"},
+		HistoryExportScope:                                              {operation: "HistoryExport"},
+		HistoryCacheScope:                                               {operation: "HistoryCache"},
+		ReplicationLagTrackerScope:                                      {operation: "ReplicationLagTracker"},
+		ShardCacheWarmUpScope:                                           {operation: "ShardCacheWarmUp"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -1757,6 +1795,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
 		WatchDogScope:                          {operation: "WatchDog"},
+		DynamicConfigDriftDetectorScope:        {operation: "DynamicConfigDriftDetector"},
+		SignalGatewayScope:                     {operation: "SignalGateway"},
+		BlobReencoderScope:                     {operation: "BlobReencoder"},
+		StuckWorkflowDetectorScope:             {operation: "StuckWorkflowDetector"},
 	},
 }
 
@@ -1800,6 +1842,10 @@ const (
 	PersistenceFailures
 	PersistenceLatency
 	PersistenceLatencyHistogram
+	PersistenceTaskBatchSize
+	PersistenceFencingViolationCounter
+	PersistenceHedgedRequestsCounter
+	PersistenceHedgedRequestWinsCounter
 	PersistenceErrShardExistsCounter
 	PersistenceErrShardOwnershipLostCounter
 	PersistenceErrConditionFailedCounter
@@ -1826,6 +1872,7 @@ const (
 	DomainCachePrepareCallbacksLatency
 	DomainCacheCallbacksLatency
 	DomainCacheCallbacksCount
+	DomainCacheVersionRefreshCount
 
 	HistorySize
 	HistoryCount
@@ -1922,6 +1969,24 @@ const (
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 
+	HistoryPagePrefetchHitCounter
+	HistoryPagePrefetchMissCounter
+
+	CacheMemoryBudgetHeapSizeGauge
+	CacheMemoryBudgetCapacityScaleGauge
+
+	ShadowRequestsCounter
+	ShadowRequestMismatchCounter
+	ShadowRequestLatency
+
+	DomainHistoryBytesGauge
+	DomainMutableStateBytesGauge
+	DomainVisibilityBytesGauge
+	DomainArchivalBytesGauge
+	DomainStorageBudgetUtilizationGauge
+	StorageMeteringFailures
+	StorageMeteringLatency
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -2015,7 +2080,12 @@ const (
 	StaleMutableStateCounter
 	DataInconsistentCounter
 	TimerResurrectionCounter
+	UserTimerFireSkew
 	ActivityResurrectionCounter
+	DuplicateActivityCompletionCounter
+	DecisionPanicCounter
+	WorkflowQuarantinedCounter
+	QuarantinedDecisionCounter
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
 	ConcurrencyUpdateFailureCounter
@@ -2049,6 +2119,7 @@ const (
 	ShardInfoTimerFailoverInProgressTimer
 	ShardInfoTransferFailoverLatencyTimer
 	ShardInfoTimerFailoverLatencyTimer
+	ShardInfoUpdateCoalescedCounter
 	SyncShardFromRemoteCounter
 	SyncShardFromRemoteFailure
 	MembershipChangedCounter
@@ -2076,7 +2147,12 @@ const (
 	CacheLatency
 	CacheMissCounter
 	AcquireLockFailedCounter
+	ReadSnapshotHitCounter
 	WorkflowContextCleared
+	HistoryCacheSizeBytes
+	HistoryCacheDomainEntries
+	HistoryCacheDomainSizeBytes
+	LockWaitLatency
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -2151,6 +2227,7 @@ const (
 	DecisionTaskQueryLatency
 	ConsistentQueryTimeoutCount
 	QueryBeforeFirstDecisionCount
+	QueryResultCacheHitCount
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
 	WorkerNotSupportsConsistentQueryCount
@@ -2160,6 +2237,7 @@ const (
 	ReplicationTaskLatency
 	MutableStateChecksumMismatch
 	MutableStateChecksumInvalidated
+	MutableStateInvariantViolation
 	FailoverMarkerCount
 	FailoverMarkerReplicationLatency
 	FailoverMarkerInsertFailure
@@ -2167,6 +2245,26 @@ const (
 	FailoverMarkerUpdateShardFailure
 	FailoverMarkerCallbackCount
 	HistoryFailoverCallbackCount
+	DomainFailoverFirstStartLatency
+	ActivityHeartbeatCoalescedCounter
+	WebhookDeliverySuccess
+	WebhookDeliveryFailures
+	WebhookDeliveryDropped
+	WebhookDeliveryLatency
+	CloudEventsEmitted
+	CloudEventsEmitFailures
+	CloudEventsEmitDropped
+	HistoryExportBlobsUploaded
+	HistoryExportFailures
+	HistoryExportDropped
+	ReplicationDomainPendingTasks
+	ReplicationDomainTaskIDLag
+	ReplicationDomainLag
+	ReplicationLagSLOViolations
+	ReplicationLagTrackerFailures
+	ShardCacheWarmUpExecutions
+	ShardCacheWarmUpFailures
+	ShardCacheWarmUpLatency
 
 	NumHistoryMetrics
 )
@@ -2203,6 +2301,9 @@ const (
 	TaskListManagersGauge
 	TaskLagPerTaskListGauge
 	TaskBacklogPerTaskListGauge
+	BacklogAlertPublishedPerTaskListCounter
+	BacklogAlertFailedPerTaskListCounter
+	ShortenedLongPollPerTaskListCounter
 
 	NumMatchingMetrics
 )
@@ -2217,6 +2318,7 @@ const (
 	ESProcessorRequests
 	ESProcessorRetries
 	ESProcessorFailures
+	ESProcessorNackedMessages
 	ESProcessorCorruptedData
 	ESProcessorProcessMsgLatency
 	IndexProcessorCorruptedData
@@ -2288,6 +2390,18 @@ const (
 	WatchDogNumDeletedCorruptWorkflows
 	WatchDogNumFailedToDeleteCorruptWorkflows
 	WatchDogNumCorruptWorkflowProcessed
+	DynamicConfigDriftCount
+	DynamicConfigDriftDetectionFailures
+	SignalGatewaySignalsDelivered
+	SignalGatewayCorruptedMessages
+	SignalGatewayMessagesSentToDLQ
+	SignalGatewayDeliveryLatency
+	BlobReencoderDomainsReencoded
+	BlobReencoderFailures
+	StuckWorkflowsGauge
+	StuckWorkflowAlerts
+	StuckWorkflowDetectionFailures
+	StuckWorkflowDetectionLatency
 
 	NumWorkerMetrics
 )
@@ -2333,6 +2447,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
 		PersistenceLatencyHistogram:                         {metricName: "persistence_latency_histogram", metricType: Histogram, buckets: PersistenceLatencyBuckets},
+		PersistenceTaskBatchSize:                            {metricName: "persistence_task_batch_size", metricType: Histogram, buckets: PersistenceTaskBatchSizeBuckets},
+		PersistenceFencingViolationCounter:                  {metricName: "persistence_fencing_violation", metricType: Counter},
+		PersistenceHedgedRequestsCounter:                    {metricName: "persistence_hedged_requests", metricType: Counter},
+		PersistenceHedgedRequestWinsCounter:                 {metricName: "persistence_hedged_request_wins", metricType: Counter},
 		PersistenceErrShardExistsCounter:                    {metricName: "persistence_errors_shard_exists", metricType: Counter},
 		PersistenceErrShardOwnershipLostCounter:             {metricName: "persistence_errors_shard_ownership_lost", metricType: Counter},
 		PersistenceErrConditionFailedCounter:                {metricName: "persistence_errors_condition_failed", metricType: Counter},
@@ -2355,6 +2473,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DomainCachePrepareCallbacksLatency:                  {metricName: "domain_cache_prepare_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksLatency:                         {metricName: "domain_cache_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksCount:                           {metricName: "domain_cache_callbacks_count", metricType: Counter},
+		DomainCacheVersionRefreshCount:                      {metricName: "domain_cache_version_refresh_count", metricType: Counter},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
@@ -2476,6 +2595,20 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DomainReplicationQueueSizeErrorCount: {metricName: "domain_replication_queue_failed", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:    {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:   {metricName: "parent_close_policy_processor_errors", metricType: Counter},
+		HistoryPagePrefetchHitCounter:        {metricName: "history_page_prefetch_hit", metricType: Counter},
+		HistoryPagePrefetchMissCounter:       {metricName: "history_page_prefetch_miss", metricType: Counter},
+		CacheMemoryBudgetHeapSizeGauge:       {metricName: "cache_memory_budget_heap_size", metricType: Gauge},
+		CacheMemoryBudgetCapacityScaleGauge:  {metricName: "cache_memory_budget_capacity_scale", metricType: Gauge},
+		ShadowRequestsCounter:                {metricName: "shadow_requests", metricType: Counter},
+		ShadowRequestMismatchCounter:         {metricName: "shadow_request_mismatch", metricType: Counter},
+		ShadowRequestLatency:                 {metricName: "shadow_request_latency", metricType: Timer},
+		DomainHistoryBytesGauge:              {metricName: "domain_history_bytes", metricType: Gauge},
+		DomainMutableStateBytesGauge:         {metricName: "domain_mutable_state_bytes", metricType: Gauge},
+		DomainVisibilityBytesGauge:           {metricName: "domain_visibility_bytes", metricType: Gauge},
+		DomainArchivalBytesGauge:             {metricName: "domain_archival_bytes", metricType: Gauge},
+		DomainStorageBudgetUtilizationGauge:  {metricName: "domain_storage_budget_utilization", metricType: Gauge},
+		StorageMeteringFailures:              {metricName: "storage_metering_failures", metricType: Counter},
+		StorageMeteringLatency:               {metricName: "storage_metering_latency", metricType: Timer},
 	},
 	History: {
 		TaskRequests:             {metricName: "task_requests", metricType: Counter},
@@ -2562,7 +2695,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		StaleMutableStateCounter:                            {metricName: "stale_mutable_state", metricType: Counter},
 		DataInconsistentCounter:                             {metricName: "data_inconsistent", metricType: Counter},
 		TimerResurrectionCounter:                            {metricName: "timer_resurrection", metricType: Counter},
+		UserTimerFireSkew:                                   {metricName: "user_timer_fire_skew", metricType: Timer},
 		ActivityResurrectionCounter:                         {metricName: "activity_resurrection", metricType: Counter},
+		DuplicateActivityCompletionCounter:                  {metricName: "duplicate_activity_completion", metricType: Counter},
+		DecisionPanicCounter:                                {metricName: "decision_panic", metricType: Counter},
+		WorkflowQuarantinedCounter:                          {metricName: "workflow_quarantined", metricType: Counter},
+		QuarantinedDecisionCounter:                          {metricName: "quarantined_decision", metricType: Counter},
 		AutoResetPointsLimitExceededCounter:                 {metricName: "auto_reset_points_exceed_limit", metricType: Counter},
 		AutoResetPointCorruptionCounter:                     {metricName: "auto_reset_point_corruption", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:                     {metricName: "concurrency_update_failure", metricType: Counter},
@@ -2596,6 +2734,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardInfoTimerFailoverInProgressTimer:               {metricName: "shardinfo_timer_failover_in_progress", metricType: Timer},
 		ShardInfoTransferFailoverLatencyTimer:               {metricName: "shardinfo_transfer_failover_latency", metricType: Timer},
 		ShardInfoTimerFailoverLatencyTimer:                  {metricName: "shardinfo_timer_failover_latency", metricType: Timer},
+		ShardInfoUpdateCoalescedCounter:                     {metricName: "shardinfo_update_coalesced", metricType: Counter},
 		SyncShardFromRemoteCounter:                          {metricName: "syncshard_remote_count", metricType: Counter},
 		SyncShardFromRemoteFailure:                          {metricName: "syncshard_remote_failed", metricType: Counter},
 		MembershipChangedCounter:                            {metricName: "membership_changed_count", metricType: Counter},
@@ -2623,7 +2762,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheLatency:                                        {metricName: "cache_latency", metricType: Timer},
 		CacheMissCounter:                                    {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                            {metricName: "acquire_lock_failed", metricType: Counter},
+		ReadSnapshotHitCounter:                              {metricName: "read_snapshot_hit", metricType: Counter},
 		WorkflowContextCleared:                              {metricName: "workflow_context_cleared", metricType: Counter},
+		HistoryCacheSizeBytes:                               {metricName: "history_cache_size_bytes", metricType: Gauge},
+		HistoryCacheDomainEntries:                           {metricName: "history_cache_domain_entries", metricType: Gauge},
+		HistoryCacheDomainSizeBytes:                         {metricName: "history_cache_domain_size_bytes", metricType: Gauge},
+		LockWaitLatency:                                     {metricName: "lock_wait_latency", metricType: Timer},
 		MutableStateSize:                                    {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                   {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                                    {metricName: "activity_info_size", metricType: Timer},
@@ -2694,6 +2838,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTaskQueryLatency:                            {metricName: "decision_task_query_latency", metricType: Timer},
 		ConsistentQueryTimeoutCount:                         {metricName: "consistent_query_timeout", metricType: Counter},
 		QueryBeforeFirstDecisionCount:                       {metricName: "query_before_first_decision", metricType: Counter},
+		QueryResultCacheHitCount:                            {metricName: "query_result_cache_hit", metricType: Counter},
 		QueryBufferExceededCount:                            {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                      {metricName: "query_registry_invalid_state", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:               {metricName: "worker_not_supports_consistent_query", metricType: Counter},
@@ -2703,6 +2848,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTaskLatency:                              {metricName: "replication_task_latency", metricType: Timer},
 		MutableStateChecksumMismatch:                        {metricName: "mutable_state_checksum_mismatch", metricType: Counter},
 		MutableStateChecksumInvalidated:                     {metricName: "mutable_state_checksum_invalidated", metricType: Counter},
+		MutableStateInvariantViolation:                      {metricName: "mutable_state_invariant_violation", metricType: Counter},
 		FailoverMarkerCount:                                 {metricName: "failover_marker_count", metricType: Counter},
 		FailoverMarkerReplicationLatency:                    {metricName: "failover_marker_replication_latency", metricType: Timer},
 		FailoverMarkerInsertFailure:                         {metricName: "failover_marker_insert_failures", metricType: Counter},
@@ -2710,6 +2856,26 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		FailoverMarkerUpdateShardFailure:                    {metricName: "failover_marker_update_shard_failures", metricType: Counter},
 		FailoverMarkerCallbackCount:                         {metricName: "failover_marker_callback_count", metricType: Counter},
 		HistoryFailoverCallbackCount:                        {metricName: "failover_callback_handler_count", metricType: Counter},
+		DomainFailoverFirstStartLatency:                     {metricName: "domain_failover_first_start_latency", metricType: Timer},
+		ActivityHeartbeatCoalescedCounter:                   {metricName: "activity_heartbeat_coalesced", metricType: Counter},
+		WebhookDeliverySuccess:                              {metricName: "webhook_delivery_success", metricType: Counter},
+		WebhookDeliveryFailures:                             {metricName: "webhook_delivery_failures", metricType: Counter},
+		WebhookDeliveryDropped:                              {metricName: "webhook_delivery_dropped", metricType: Counter},
+		WebhookDeliveryLatency:                              {metricName: "webhook_delivery_latency", metricType: Timer},
+		CloudEventsEmitted:                                  {metricName: "cloudevents_emitted", metricType: Counter},
+		CloudEventsEmitFailures:                             {metricName: "cloudevents_emit_failures", metricType: Counter},
+		CloudEventsEmitDropped:                              {metricName: "cloudevents_emit_dropped", metricType: Counter},
+		HistoryExportBlobsUploaded:                          {metricName: "history_export_blobs_uploaded", metricType: Counter},
+		HistoryExportFailures:                               {metricName: "history_export_failures", metricType: Counter},
+		HistoryExportDropped:                                {metricName: "history_export_dropped", metricType: Counter},
+		ReplicationDomainPendingTasks:                       {metricName: "replication_domain_pending_tasks", metricType: Gauge},
+		ReplicationDomainTaskIDLag:                          {metricName: "replication_domain_task_id_lag", metricType: Gauge},
+		ReplicationDomainLag:                                {metricName: "replication_domain_lag", metricType: Timer},
+		ReplicationLagSLOViolations:                         {metricName: "replication_lag_slo_violations", metricType: Counter},
+		ReplicationLagTrackerFailures:                       {metricName: "replication_lag_tracker_failures", metricType: Counter},
+		ShardCacheWarmUpExecutions:                          {metricName: "shard_cache_warm_up_executions", metricType: Counter},
+		ShardCacheWarmUpFailures:                            {metricName: "shard_cache_warm_up_failures", metricType: Counter},
+		ShardCacheWarmUpLatency:                             {metricName: "shard_cache_warm_up_latency", metricType: Timer},
 		TransferTasksCount:                                  {metricName: "transfer_tasks_count", metricType: Timer},
 		TimerTasksCount:                                     {metricName: "timer_tasks_count", metricType: Timer},
 		CrossClusterTasksCount:                              {metricName: "cross_cluster_tasks_count", metricType: Timer},
@@ -2746,6 +2912,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskListManagersGauge:                    {metricName: "tasklist_managers", metricType: Gauge},
 		TaskLagPerTaskListGauge:                  {metricName: "task_lag_per_tl", metricType: Gauge},
 		TaskBacklogPerTaskListGauge:              {metricName: "task_backlog_per_tl", metricType: Gauge},
+		BacklogAlertPublishedPerTaskListCounter:  {metricName: "backlog_alert_published_per_tl", metricType: Counter},
+		BacklogAlertFailedPerTaskListCounter:     {metricName: "backlog_alert_failed_per_tl", metricType: Counter},
+		ShortenedLongPollPerTaskListCounter:      {metricName: "long_poll_shortened_per_tl", metricType: Counter},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -2756,6 +2925,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ESProcessorRequests:                           {metricName: "es_processor_requests"},
 		ESProcessorRetries:                            {metricName: "es_processor_retries"},
 		ESProcessorFailures:                           {metricName: "es_processor_errors"},
+		ESProcessorNackedMessages:                     {metricName: "es_processor_nacked_messages", metricType: Counter},
 		ESProcessorCorruptedData:                      {metricName: "es_processor_corrupted_data"},
 		ESProcessorProcessMsgLatency:                  {metricName: "es_processor_process_msg_latency", metricType: Timer},
 		IndexProcessorCorruptedData:                   {metricName: "index_processor_corrupted_data"},
@@ -2827,6 +2997,18 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WatchDogNumDeletedCorruptWorkflows:            {metricName: "watchdog_num_deleted_corrupt_workflows", metricType: Counter},
 		WatchDogNumFailedToDeleteCorruptWorkflows:     {metricName: "watchdog_num_failed_to_delete_corrupt_workflows", metricType: Counter},
 		WatchDogNumCorruptWorkflowProcessed:           {metricName: "watchdog_num_corrupt_workflows_processed", metricType: Counter},
+		DynamicConfigDriftCount:                       {metricName: "dynamic_config_drift_count", metricType: Gauge},
+		DynamicConfigDriftDetectionFailures:           {metricName: "dynamic_config_drift_detection_failures", metricType: Counter},
+		SignalGatewaySignalsDelivered:                 {metricName: "signal_gateway_signals_delivered", metricType: Counter},
+		SignalGatewayCorruptedMessages:                {metricName: "signal_gateway_corrupted_messages", metricType: Counter},
+		SignalGatewayMessagesSentToDLQ:                {metricName: "signal_gateway_messages_sent_to_dlq", metricType: Counter},
+		SignalGatewayDeliveryLatency:                  {metricName: "signal_gateway_delivery_latency", metricType: Timer},
+		BlobReencoderDomainsReencoded:                 {metricName: "blob_reencoder_domains_reencoded", metricType: Counter},
+		BlobReencoderFailures:                         {metricName: "blob_reencoder_failures", metricType: Counter},
+		StuckWorkflowsGauge:                           {metricName: "stuck_workflows", metricType: Gauge},
+		StuckWorkflowAlerts:                           {metricName: "stuck_workflow_alerts", metricType: Counter},
+		StuckWorkflowDetectionFailures:                {metricName: "stuck_workflow_detection_failures", metricType: Counter},
+		StuckWorkflowDetectionLatency:                 {metricName: "stuck_workflow_detection_latency", metricType: Timer},
 	},
 }
 
@@ -2889,6 +3071,11 @@ var PersistenceLatencyBuckets = tally.DurationBuckets([]time.Duration{
 	60 * time.Second,
 })
 
+// PersistenceTaskBatchSizeBuckets contains value buckets for measuring the number of tasks written in one transaction
+var PersistenceTaskBatchSizeBuckets = tally.ValueBuckets([]float64{
+	1, 2, 3, 4, 5, 10, 20, 50, 100, 200, 500, 1000,
+})
+
 // ErrorClass is an enum to help with classifying SLA vs. non-SLA errors (SLA = "service level agreement")
 type ErrorClass uint8
 
@@ -2910,3 +3097,11 @@ func (mn MetricName) Empty() bool {
 func (mn MetricName) String() string {
 	return string(mn)
 }
+
+// GetScopeOperation returns the operation tag of the given scope, it is empty if the scope is not defined
+func GetScopeOperation(serviceIdx ServiceIdx, scopeIdx int) string {
+	if def, ok := ScopeDefs[Common][scopeIdx]; ok {
+		return def.operation
+	}
+	return ScopeDefs[serviceIdx][scopeIdx].operation
+}
@@ -48,6 +48,7 @@ const (
 	transport              = "transport"
 	caller                 = "caller"
 	signalName             = "signalName"
+	lockPriority           = "lock_priority"
 
 	allValue     = "all"
 	unknownValue = "_unknown_"
@@ -180,3 +181,8 @@ func SignalNameTag(value string) Tag {
 func SignalNameAllTag() Tag {
 	return metricWithUnknown(signalName, allValue)
 }
+
+// LockPriorityTag returns a new workflow lock priority tag
+func LockPriorityTag(value int) Tag {
+	return simpleMetric{key: lockPriority, value: strconv.Itoa(value)}
+}
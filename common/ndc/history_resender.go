@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/reconciliation/entity"
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/rpc"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -259,7 +260,7 @@ func (n *HistoryResenderImpl) getHistory(
 	pageSize int32,
 ) (*types.GetWorkflowExecutionRawHistoryV2Response, error) {
 
-	ctx, cancel := context.WithTimeout(ctx, resendContextTimeout)
+	ctx, cancel := context.WithTimeout(rpc.ContextWithPriorityClass(ctx, rpc.PriorityClassReplication), resendContextTimeout)
 	defer cancel()
 	response, err := n.adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &types.GetWorkflowExecutionRawHistoryV2Request{
 		Domain: domainName,
@@ -241,6 +241,7 @@ func newDNSSRVProvider(
 func (provider *dnsSRVProvider) Hosts() ([]string, error) {
 	var results []string
 	resolvedHosts := map[string][]string{}
+	seen := map[string]struct{}{}
 
 	for _, host := range provider.UnresolvedHosts {
 		hostParts := strings.Split(host, ".")
@@ -273,7 +274,15 @@ func (provider *dnsSRVProvider) Hosts() ([]string, error) {
 			resolved = targets
 		}
 
-		results = append(results, resolved...)
+		// SRV records of different services may point at the same targets,
+		// so only keep the first occurrence of each host:port.
+		for _, hostport := range resolved {
+			if _, ok := seen[hostport]; ok {
+				continue
+			}
+			seen[hostport] = struct{}{}
+			results = append(results, hostport)
+		}
 	}
 
 	if len(results) == 0 {
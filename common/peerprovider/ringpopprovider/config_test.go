@@ -263,6 +263,28 @@ func (s *RingpopSuite) TestDNSSRVMode() {
 	s.NotNil(err, "error should be returned when no hosts")
 }
 
+func (s *RingpopSuite) TestDNSSRVMode_DuplicateTargets() {
+	provider := newDNSSRVProvider(
+		[]string{"service-a.example.net", "service-b.example.net"},
+		&mockResolver{
+			SRV: map[string][]net.SRV{
+				"service-a": []net.SRV{{Target: "az1.addr.example.net", Port: 7755}},
+				"service-b": []net.SRV{{Target: "az1.addr.example.net", Port: 7755}, {Target: "az2.addr.example.net", Port: 7755}},
+			},
+			Hosts: map[string][]string{
+				"az1.addr.example.net": []string{"10.0.0.1"},
+				"az2.addr.example.net": []string{"10.0.0.2"},
+			},
+			suite: s,
+		},
+		loggerimpl.NewNopLogger(),
+	)
+
+	hostports, err := provider.Hosts()
+	s.Nil(err)
+	s.ElementsMatch([]string{"10.0.0.1:7755", "10.0.0.2:7755"}, hostports, "duplicate targets should be removed")
+}
+
 func (s *RingpopSuite) TestInvalidConfig() {
 	var cfg Config
 	s.NotNil(cfg.validate())
@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -100,6 +101,9 @@ type (
 		datastores    map[storeType]Datastore
 		clusterName   string
 		dc            *p.DynamicConfiguration
+		// hedgedReadBudget is shared by all the hedged clients so that
+		// the hedged read budget applies to the host rather than to each shard
+		hedgedReadBudget quotas.Limiter
 	}
 
 	storeType int
@@ -149,6 +153,9 @@ func NewFactory(
 		clusterName:   clusterName,
 		dc:            dc,
 	}
+	if factory.isHedgedReadConfigured() {
+		factory.hedgedReadBudget = p.NewHedgedReadBudget(dc.HedgedReadMaxRPS)
+	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
 	return factory
@@ -208,6 +215,9 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if f.hedgedReadBudget != nil {
+		result = p.NewHistoryPersistenceHedgedClient(result, f.dc.HedgedReadDelay, f.hedgedReadBudget, f.metricsClient)
+	}
 	if f.metricsClient != nil {
 		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
@@ -250,12 +260,21 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if f.hedgedReadBudget != nil {
+		result = p.NewWorkflowExecutionPersistenceHedgedClient(result, f.dc.HedgedReadDelay, f.hedgedReadBudget, f.metricsClient)
+	}
 	if f.metricsClient != nil {
 		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger, f.config)
 	}
 	return result, nil
 }
 
+// isHedgedReadConfigured checks whether hedged read dynamic config is available, tools may create
+// the factory without it
+func (f *factoryImpl) isHedgedReadConfigured() bool {
+	return f.metricsClient != nil && f.dc != nil && f.dc.HedgedReadDelay != nil && f.dc.HedgedReadMaxRPS != nil
+}
+
 // NewVisibilityManager returns a new visibility manager
 func (f *factoryImpl) NewVisibilityManager(
 	params *Params,
@@ -429,7 +448,7 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 			*defaultCfg.SQL,
 			clusterName,
 			f.logger,
-			getSQLParser(f.logger, common.EncodingType(defaultCfg.SQL.EncodingType), decodingTypes...),
+			getSQLParser(f.logger, f.dc, common.EncodingType(defaultCfg.SQL.EncodingType), decodingTypes...),
 			f.dc)
 	default:
 		f.logger.Fatal("invalid config: one of nosql or sql params must be specified for defaultDataStore")
@@ -464,7 +483,7 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 			*visibilityCfg.SQL,
 			clusterName,
 			f.logger,
-			getSQLParser(f.logger, common.EncodingType(visibilityCfg.SQL.EncodingType), decodingTypes...),
+			getSQLParser(f.logger, f.dc, common.EncodingType(visibilityCfg.SQL.EncodingType), decodingTypes...),
 			f.dc)
 	default:
 		f.logger.Fatal("invalid config: one of nosql or sql params must be specified for visibilityStore")
@@ -473,8 +492,17 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
-func getSQLParser(logger log.Logger, encodingType common.EncodingType, decodingTypes ...common.EncodingType) serialization.Parser {
-	parser, err := serialization.NewParser(encodingType, decodingTypes...)
+func getSQLParser(
+	logger log.Logger,
+	dc *p.DynamicConfiguration,
+	encodingType common.EncodingType,
+	decodingTypes ...common.EncodingType,
+) serialization.Parser {
+	var blobEncodings dynamicconfig.MapPropertyFn
+	if dc != nil {
+		blobEncodings = dc.SQLBlobEncodings
+	}
+	parser, err := serialization.NewMigratingParser(encodingType, blobEncodings, decodingTypes...)
 	if err != nil {
 		logger.Fatal("failed to construct sql parser", tag.Error(err))
 	}
@@ -28,6 +28,9 @@ type (
 	// DynamicConfiguration represents dynamic configuration for persistence layer
 	DynamicConfiguration struct {
 		EnableSQLAsyncTransaction dynamicconfig.BoolPropertyFn
+		SQLBlobEncodings          dynamicconfig.MapPropertyFn
+		HedgedReadDelay           dynamicconfig.DurationPropertyFn
+		HedgedReadMaxRPS          dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -35,5 +38,8 @@ type (
 func NewDynamicConfiguration(dc *dynamicconfig.Collection) *DynamicConfiguration {
 	return &DynamicConfiguration{
 		EnableSQLAsyncTransaction: dc.GetBoolProperty(dynamicconfig.EnableSQLAsyncTransaction),
+		SQLBlobEncodings:          dc.GetMapProperty(dynamicconfig.SQLBlobEncodings),
+		HedgedReadDelay:           dc.GetDurationProperty(dynamicconfig.PersistenceHedgedReadDelay),
+		HedgedReadMaxRPS:          dc.GetIntProperty(dynamicconfig.PersistenceHedgedReadMaxRPS),
 	}
 }
@@ -923,6 +923,7 @@ type (
 	// ListConcreteExecutionsEntity is a single entity in ListConcreteExecutionsResponse
 	ListConcreteExecutionsEntity struct {
 		ExecutionInfo    *WorkflowExecutionInfo
+		ExecutionStats   *ExecutionStats
 		VersionHistories *VersionHistories
 	}
 
@@ -794,7 +794,7 @@ func (m *executionManagerImpl) ListConcreteExecutions(
 		PageToken:  response.NextPageToken,
 	}
 	for i, e := range response.Executions {
-		info, _, err := m.DeserializeExecutionInfo(e.ExecutionInfo)
+		info, stats, err := m.DeserializeExecutionInfo(e.ExecutionInfo)
 		if err != nil {
 			return nil, err
 		}
@@ -804,6 +804,7 @@ func (m *executionManagerImpl) ListConcreteExecutions(
 		}
 		newResponse.Executions[i] = &ListConcreteExecutionsEntity{
 			ExecutionInfo:    info,
+			ExecutionStats:   stats,
 			VersionHistories: vh,
 		}
 	}
@@ -461,7 +461,8 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 	lastFirstEventID := common.EmptyEventID
 
 	for _, batch := range dataBlobs {
-		events, err := m.historySerializer.DeserializeBatchEvents(batch)
+		// only event headers are needed to validate the batch, so payloads of stale batches are never decoded
+		events, err := m.historySerializer.DeserializeBatchEventsLazy(batch)
 		if err != nil {
 			return nil, nil, nil, 0, 0, err
 		}
@@ -512,12 +513,17 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 			}
 		}
 
+		decodedEvents, err := DecodeLazyHistoryEvents(events)
+		if err != nil {
+			return nil, nil, nil, 0, 0, err
+		}
+
 		token.LastEventVersion = firstEvent.Version
 		token.LastEventID = lastEvent.ID
 		if byBatch {
-			historyEventBatches = append(historyEventBatches, &types.History{Events: events})
+			historyEventBatches = append(historyEventBatches, &types.History{Events: decodedEvents})
 		} else {
-			historyEvents = append(historyEvents, events...)
+			historyEvents = append(historyEvents, decodedEvents...)
 		}
 		lastFirstEventID = firstEvent.ID
 	}
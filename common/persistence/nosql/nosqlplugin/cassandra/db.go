@@ -21,6 +21,8 @@
 package cassandra
 
 import (
+	"encoding/binary"
+
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -68,3 +70,11 @@ func (db *cdb) IsTimeoutError(err error) bool {
 func (db *cdb) IsThrottlingError(err error) bool {
 	return db.client.IsThrottlingError(err)
 }
+
+// shardRoutingKey returns the routing key of the partition of a shard in the executions table,
+// so that token aware routing works without looking up the partition key of the statement
+func shardRoutingKey(shardID int) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, uint32(shardID))
+	return key
+}
@@ -22,6 +22,7 @@ package gocql
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gocql/gocql"
 )
@@ -45,6 +46,32 @@ const (
 	LocalSerial
 )
 
+// ParseConsistency parses a consistency level name, e.g. LOCAL_QUORUM
+func ParseConsistency(name string) (Consistency, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "ANY":
+		return Any, nil
+	case "ONE":
+		return One, nil
+	case "TWO":
+		return Two, nil
+	case "THREE":
+		return Three, nil
+	case "QUORUM":
+		return Quorum, nil
+	case "ALL":
+		return All, nil
+	case "LOCAL_QUORUM":
+		return LocalQuorum, nil
+	case "EACH_QUORUM":
+		return EachQuorum, nil
+	case "LOCAL_ONE":
+		return LocalOne, nil
+	default:
+		return 0, fmt.Errorf("unknown consistency level: %v", name)
+	}
+}
+
 func mustConvertConsistency(c Consistency) gocql.Consistency {
 	switch c {
 	case Any:
@@ -65,6 +65,7 @@ type (
 		WithContext(context.Context) Query
 		WithTimestamp(int64) Query
 		Consistency(Consistency) Query
+		RoutingKey([]byte) Query
 		Bind(...interface{}) Query
 	}
 
@@ -114,5 +115,18 @@ type (
 		SerialConsistency     SerialConsistency
 		Timeout               time.Duration
 		ConnectTimeout        time.Duration
+		// DisableTokenAwareRouting routes queries round robin instead of to a replica of the queried partition
+		DisableTokenAwareRouting bool
+		// SpeculativeExecution is the speculative execution policy of reads, no speculative execution if nil
+		SpeculativeExecution *SpeculativeExecution
+		// ConsistencyOverrides is the consistency level of the queries against a table, keyed by table name
+		ConsistencyOverrides map[string]Consistency
+	}
+
+	// SpeculativeExecution is the config for sending a read to another host
+	// when the previous attempts did not complete within Delay
+	SpeculativeExecution struct {
+		MaxAttempts int
+		Delay       time.Duration
 	}
 )
@@ -351,6 +351,20 @@ func (mr *MockQueryMockRecorder) PageState(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageState", reflect.TypeOf((*MockQuery)(nil).PageState), arg0)
 }
 
+// RoutingKey mocks base method.
+func (m *MockQuery) RoutingKey(arg0 []byte) Query {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoutingKey", arg0)
+	ret0, _ := ret[0].(Query)
+	return ret0
+}
+
+// RoutingKey indicates an expected call of RoutingKey.
+func (mr *MockQueryMockRecorder) RoutingKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoutingKey", reflect.TypeOf((*MockQuery)(nil).RoutingKey), arg0)
+}
+
 // Scan mocks base method.
 func (m *MockQuery) Scan(arg0 ...interface{}) error {
 	m.ctrl.T.Helper()
@@ -103,6 +103,11 @@ func (q *query) Consistency(c Consistency) Query {
 	return q
 }
 
+func (q *query) RoutingKey(key []byte) Query {
+	q.Query.RoutingKey(key)
+	return q
+}
+
 func (q *query) WithTimestamp(timestamp int64) Query {
 	q.Query.WithTimestamp(timestamp)
 	return q
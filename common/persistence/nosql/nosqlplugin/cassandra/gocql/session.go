@@ -22,6 +22,7 @@
 package gocql
 
 import (
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -108,6 +109,18 @@ func (s *session) Query(
 	if q == nil {
 		return nil
 	}
+	if len(s.config.ConsistencyOverrides) > 0 {
+		if c, ok := s.config.ConsistencyOverrides[tableName(stmt)]; ok {
+			q.Consistency(mustConvertConsistency(c))
+		}
+	}
+	if s.config.SpeculativeExecution != nil && isSelectStatement(stmt) {
+		// speculative execution only applies to idempotent queries
+		q.Idempotent(true).SetSpeculativeExecutionPolicy(&gocql.SimpleSpeculativeExecution{
+			NumAttempts:  s.config.SpeculativeExecution.MaxAttempts,
+			TimeoutDelay: s.config.SpeculativeExecution.Delay,
+		})
+	}
 	return newQuery(s, q)
 }
 
@@ -153,3 +166,22 @@ func (s *session) handleError(err error) error {
 	}
 	return err
 }
+
+func isSelectStatement(stmt string) bool {
+	stmt = strings.TrimSpace(stmt)
+	return len(stmt) >= len("SELECT") && strings.EqualFold(stmt[:len("SELECT")], "SELECT")
+}
+
+// tableName returns the name of the table a SELECT, INSERT, UPDATE or DELETE statement is against
+func tableName(stmt string) string {
+	fields := strings.Fields(stmt)
+	for i := 0; i < len(fields)-1; i++ {
+		switch {
+		case strings.EqualFold(fields[i], "FROM"), strings.EqualFold(fields[i], "INTO"):
+			return fields[i+1]
+		case i == 0 && strings.EqualFold(fields[i], "UPDATE"):
+			return fields[i+1]
+		}
+	}
+	return ""
+}
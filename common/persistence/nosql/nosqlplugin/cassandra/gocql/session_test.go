@@ -0,0 +1,57 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gocql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableName(t *testing.T) {
+	assert.Equal(t, "executions", tableName(`SELECT execution FROM executions WHERE shard_id = ?`))
+	assert.Equal(t, "history_node", tableName(`INSERT INTO history_node (tree_id, branch_id) VALUES (?, ?)`))
+	assert.Equal(t, "executions", tableName(`UPDATE executions
+		SET range_id = ?
+		WHERE shard_id = ?`))
+	assert.Equal(t, "tasks", tableName(`DELETE FROM tasks WHERE domain_id = ?`))
+	assert.Equal(t, "", tableName(`TRUNCATE`))
+}
+
+func TestIsSelectStatement(t *testing.T) {
+	assert.True(t, isSelectStatement(`SELECT execution FROM executions`))
+	assert.True(t, isSelectStatement(`
+		select execution from executions`))
+	assert.False(t, isSelectStatement(`UPDATE executions SET range_id = ?`))
+	assert.False(t, isSelectStatement(`SEL`))
+}
+
+func TestParseConsistency(t *testing.T) {
+	c, err := ParseConsistency("local_one")
+	assert.NoError(t, err)
+	assert.Equal(t, LocalOne, c)
+	c, err = ParseConsistency("LOCAL_QUORUM")
+	assert.NoError(t, err)
+	assert.Equal(t, LocalQuorum, c)
+	_, err = ParseConsistency("LOCAL_SERIAL")
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/uber/cadence/common/config"
@@ -65,7 +66,11 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger) (nosqlplugi
 }
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*cdb, error) {
-	session, err := gocql.GetRegisteredClient().CreateSession(toGoCqlConfig(cfg))
+	clusterConfig, err := toGoCqlConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	session, err := gocql.GetRegisteredClient().CreateSession(clusterConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +78,7 @@ func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*cdb, error)
 	return db, nil
 }
 
-func toGoCqlConfig(cfg *config.NoSQL) gocql.ClusterConfig {
+func toGoCqlConfig(cfg *config.NoSQL) (gocql.ClusterConfig, error) {
 	if cfg.Port == 0 {
 		cfg.Port = environment.GetCassandraPort()
 	}
@@ -83,6 +88,24 @@ func toGoCqlConfig(cfg *config.NoSQL) gocql.ClusterConfig {
 	if cfg.ProtoVersion == 0 {
 		cfg.ProtoVersion = environment.GetCassandraProtoVersion()
 	}
+	var consistencyOverrides map[string]gocql.Consistency
+	if len(cfg.ConsistencyOverrides) > 0 {
+		consistencyOverrides = make(map[string]gocql.Consistency, len(cfg.ConsistencyOverrides))
+		for table, name := range cfg.ConsistencyOverrides {
+			consistency, err := gocql.ParseConsistency(name)
+			if err != nil {
+				return gocql.ClusterConfig{}, fmt.Errorf("invalid consistency override for table %v: %v", table, err)
+			}
+			consistencyOverrides[table] = consistency
+		}
+	}
+	var speculativeExecution *gocql.SpeculativeExecution
+	if cfg.SpeculativeExecution != nil && cfg.SpeculativeExecution.MaxAttempts > 0 {
+		speculativeExecution = &gocql.SpeculativeExecution{
+			MaxAttempts: cfg.SpeculativeExecution.MaxAttempts,
+			Delay:       cfg.SpeculativeExecution.Delay,
+		}
+	}
 	return gocql.ClusterConfig{
 		Hosts:                 cfg.Hosts,
 		Port:                  cfg.Port,
@@ -99,5 +122,9 @@ func toGoCqlConfig(cfg *config.NoSQL) gocql.ClusterConfig {
 		SerialConsistency:     gocql.LocalSerial,
 		Timeout:               defaultSessionTimeout,
 		ConnectTimeout:        defaultConnectTimeout,
-	}
+
+		DisableTokenAwareRouting: cfg.DisableTokenAwareRouting,
+		SpeculativeExecution:     speculativeExecution,
+		ConsistencyOverrides:     consistencyOverrides,
+	}, nil
 }
@@ -131,7 +131,7 @@ func (db *cdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error
 		markerData,
 		markerEncoding,
 		row.RangeID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(row.ShardID)).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -168,7 +168,7 @@ func (db *cdb) SelectShard(ctx context.Context, shardID int, currentClusterName
 		rowTypeShardRunID,
 		defaultVisibilityTimestamp,
 		rowTypeShardTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -291,7 +291,7 @@ func (db *cdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, pr
 		defaultVisibilityTimestamp,
 		rowTypeShardTaskID,
 		previousRangeID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -346,7 +346,7 @@ func (db *cdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previ
 		defaultVisibilityTimestamp,
 		rowTypeShardTaskID,
 		previousRangeID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(row.ShardID)).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -99,7 +99,7 @@ func (db *cdb) SelectCurrentWorkflow(
 		permanentRunID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -213,7 +213,7 @@ func (db *cdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainI
 		runID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -297,7 +297,7 @@ func (db *cdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
 		currentRunIDCondition,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -311,7 +311,7 @@ func (db *cdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainI
 		runID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -321,7 +321,7 @@ func (db *cdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageT
 		templateListCurrentExecutionsQuery,
 		shardID,
 		rowTypeExecution,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -357,7 +357,7 @@ func (db *cdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pag
 		templateListWorkflowExecutionQuery,
 		shardID,
 		rowTypeExecution,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -397,7 +397,7 @@ func (db *cdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domai
 		runID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -421,7 +421,7 @@ func (db *cdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pa
 		defaultVisibilityTimestamp,
 		exclusiveMinTaskID,
 		inclusiveMaxTaskID,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -454,7 +454,7 @@ func (db *cdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64
 		rowTypeTransferRunID,
 		defaultVisibilityTimestamp,
 		taskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -469,7 +469,7 @@ func (db *cdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclus
 		defaultVisibilityTimestamp,
 		exclusiveBeginTaskID,
 		inclusiveEndTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -486,7 +486,7 @@ func (db *cdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardI
 		rowTypeTimerRunID,
 		minTimestamp,
 		maxTimestamp,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -520,7 +520,7 @@ func (db *cdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, v
 		rowTypeTimerRunID,
 		ts,
 		taskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -536,7 +536,7 @@ func (db *cdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusive
 		rowTypeTimerRunID,
 		start,
 		end,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -552,7 +552,7 @@ func (db *cdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID,
 		defaultVisibilityTimestamp,
 		exclusiveMinTaskID,
 		inclusiveMaxTaskID,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 	return populateGetReplicationTasks(query)
 }
 
@@ -565,7 +565,7 @@ func (db *cdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID in
 		rowTypeReplicationRunID,
 		defaultVisibilityTimestamp,
 		taskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -579,7 +579,7 @@ func (db *cdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inc
 		rowTypeReplicationRunID,
 		defaultVisibilityTimestamp,
 		inclusiveEndTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -595,7 +595,7 @@ func (db *cdb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID
 		defaultVisibilityTimestamp,
 		exclusiveMinTaskID,
 		inclusiveMaxTaskID,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -630,7 +630,7 @@ func (db *cdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCl
 		rowTypeCrossClusterRunID,
 		defaultVisibilityTimestamp,
 		taskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -645,7 +645,7 @@ func (db *cdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, ta
 		defaultVisibilityTimestamp,
 		exclusiveBeginTaskID,
 		inclusiveEndTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -674,7 +674,7 @@ func (db *cdb) InsertReplicationDLQTask(ctx context.Context, shardID int, source
 		defaultVisibilityTimestamp,
 		defaultVisibilityTimestamp,
 		task.TaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -690,7 +690,7 @@ func (db *cdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shard
 		defaultVisibilityTimestamp,
 		exclusiveMinTaskID,
 		inclusiveMaxTaskID,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
 
 	return populateGetReplicationTasks(query)
 }
@@ -703,7 +703,7 @@ func (db *cdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int,
 		rowTypeDLQDomainID,
 		sourceCluster,
 		rowTypeDLQRunID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -723,7 +723,7 @@ func (db *cdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, source
 		rowTypeDLQRunID,
 		defaultVisibilityTimestamp,
 		taskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -738,7 +738,7 @@ func (db *cdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int,
 		defaultVisibilityTimestamp,
 		exclusiveBeginTaskID,
 		inclusiveEndTaskID,
-	).WithContext(ctx)
+	).RoutingKey(shardRoutingKey(shardID)).WithContext(ctx)
 
 	return query.Exec()
 }
@@ -0,0 +1,58 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.ConfigStoreCRUD = (*memdb)(nil)
+
+// InsertConfig inserts a new version of the config
+// Must return ConditionFailure error if the version exists already
+func (db *memdb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	configKey := key(row.RowType, row.Version)
+	if _, ok := s.configs[configKey]; ok {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	s.configs[configKey] = deepCopy(row)
+	return nil
+}
+
+// SelectLatestConfig returns the config of the highest version
+func (db *memdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	keys := s.configs.keys(prefix(rowType))
+	if len(keys) == 0 {
+		return nil, errNotFound
+	}
+	return deepCopy(s.configs[keys[len(keys)-1]]).(*persistence.InternalConfigStoreEntry), nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var (
+	errNotFound = errors.New("row not found")
+)
+
+// memdb represents a logical connection to an in-memory store
+type memdb struct {
+	store  *store
+	cfg    *config.NoSQL
+	logger log.Logger
+}
+
+var _ nosqlplugin.DB = (*memdb)(nil)
+
+func (db *memdb) Close() {}
+
+func (db *memdb) PluginName() string {
+	return PluginName
+}
+
+func (db *memdb) IsNotFoundError(err error) bool {
+	return err == errNotFound
+}
+
+func (db *memdb) IsTimeoutError(err error) bool {
+	return err == context.DeadlineExceeded
+}
+
+func (db *memdb) IsThrottlingError(err error) bool {
+	return false
+}
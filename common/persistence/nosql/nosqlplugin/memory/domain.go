@@ -0,0 +1,157 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+var _ nosqlplugin.DomainCRUD = (*memdb)(nil)
+
+// Insert a new record to domain
+// return types.DomainAlreadyExistsError error if failed or already exists
+// Must return ConditionFailure error if other condition doesn't match
+func (db *memdb) InsertDomain(ctx context.Context, row *nosqlplugin.DomainRow) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.domains[key(row.Info.Name)]; ok {
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	}
+	if s.findDomainByID(row.Info.ID) != nil {
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	}
+
+	inserted := deepCopy(row).(*nosqlplugin.DomainRow)
+	inserted.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	inserted.PreviousFailoverVersion = common.InitialPreviousFailoverVersion
+	inserted.NotificationVersion = s.domainNotificationVersion
+	s.domains[key(row.Info.Name)] = inserted
+	s.domainNotificationVersion++
+	return nil
+}
+
+// Update domain data
+// Must return ConditionFailure error if update condition doesn't match
+func (db *memdb) UpdateDomain(ctx context.Context, row *nosqlplugin.DomainRow) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if row.NotificationVersion != s.domainNotificationVersion {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	updated := deepCopy(row).(*nosqlplugin.DomainRow)
+	// is_global_domain is immutable once the domain is registered
+	if existing, ok := s.domains[key(row.Info.Name)]; ok {
+		updated.IsGlobalDomain = existing.(*nosqlplugin.DomainRow).IsGlobalDomain
+	}
+	s.domains[key(row.Info.Name)] = updated
+	s.domainNotificationVersion++
+	return nil
+}
+
+// Get one domain data, either by domainID or domainName
+func (db *memdb) SelectDomain(ctx context.Context, domainID *string, domainName *string) (*nosqlplugin.DomainRow, error) {
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	var row *nosqlplugin.DomainRow
+	if domainID != nil {
+		row = s.findDomainByID(*domainID)
+	} else if found, ok := s.domains[key(*domainName)]; ok {
+		row = found.(*nosqlplugin.DomainRow)
+	}
+	if row == nil {
+		return nil, errNotFound
+	}
+	return deepCopy(row).(*nosqlplugin.DomainRow), nil
+}
+
+// Get all domain data
+func (db *memdb) SelectAllDomains(ctx context.Context, pageSize int, pageToken []byte) ([]*nosqlplugin.DomainRow, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.domains.selectPage("", pageSize, pageToken, all)
+	domains := make([]*nosqlplugin.DomainRow, 0, len(rows))
+	for _, row := range rows {
+		domains = append(domains, row.(*nosqlplugin.DomainRow))
+	}
+	return domains, nextPageToken, nil
+}
+
+// Delete a domain, either by domainID or domainName
+func (db *memdb) DeleteDomain(ctx context.Context, domainID *string, domainName *string) error {
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if domainName == nil {
+		row := s.findDomainByID(*domainID)
+		if row == nil {
+			return nil
+		}
+		domainName = common.StringPtr(row.Info.Name)
+	}
+	delete(s.domains, key(*domainName))
+	return nil
+}
+
+// SelectDomainMetadata returns the notification version of domains
+func (db *memdb) SelectDomainMetadata(ctx context.Context) (int64, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	return s.domainNotificationVersion, nil
+}
+
+// findDomainByID returns the domain with the ID, or nil. The store lock must be held.
+func (s *store) findDomainByID(domainID string) *nosqlplugin.DomainRow {
+	for _, row := range s.domains {
+		if domain := row.(*nosqlplugin.DomainRow); domain.Info.ID == domainID {
+			return domain
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.HistoryEventsCRUD = (*memdb)(nil)
+
+// InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
+func (db *memdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if treeRow != nil {
+		s.historyTrees[key(treeRow.TreeID, treeRow.BranchID)] = deepCopy(treeRow)
+	}
+	if nodeRow != nil {
+		s.historyNodes[historyNodeKey(nodeRow)] = deepCopy(nodeRow)
+	}
+	return nil
+}
+
+// SelectFromHistoryNode read nodes based on a filter
+func (db *memdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.historyNodes.selectPage(prefix(filter.TreeID, filter.BranchID), filter.PageSize, filter.NextPageToken, func(row interface{}) bool {
+		nodeID := row.(*nosqlplugin.HistoryNodeRow).NodeID
+		return nodeID >= filter.MinNodeID && nodeID < filter.MaxNodeID
+	})
+	nodes := make([]*nosqlplugin.HistoryNodeRow, 0, len(rows))
+	for _, row := range rows {
+		nodes = append(nodes, row.(*nosqlplugin.HistoryNodeRow))
+	}
+	return nodes, nextPageToken, nil
+}
+
+// DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
+func (db *memdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if treeFilter.BranchID != nil {
+		delete(s.historyTrees, key(treeFilter.TreeID, *treeFilter.BranchID))
+	}
+	for _, nodeFilter := range nodeFilters {
+		s.historyNodes.deleteIf(prefix(nodeFilter.TreeID, nodeFilter.BranchID), func(row interface{}) bool {
+			return row.(*nosqlplugin.HistoryNodeRow).NodeID >= nodeFilter.MinNodeID
+		})
+	}
+	return nil
+}
+
+// SelectAllHistoryTrees will return all tree branches with pagination
+func (db *memdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.historyTrees.selectPage("", pageSize, nextPageToken, all)
+	trees := make([]*nosqlplugin.HistoryTreeRow, 0, len(rows))
+	for _, row := range rows {
+		trees = append(trees, row.(*nosqlplugin.HistoryTreeRow))
+	}
+	return trees, nextPageToken, nil
+}
+
+// SelectFromHistoryTree read branch records for a tree
+func (db *memdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, _ := s.historyTrees.selectPage(prefix(filter.TreeID), 0, nil, all)
+	trees := make([]*nosqlplugin.HistoryTreeRow, 0, len(rows))
+	for _, row := range rows {
+		tree := row.(*nosqlplugin.HistoryTreeRow)
+		ancestors := tree.Ancestors
+		if len(ancestors) > 0 {
+			// sort ancestors based on EndNodeID so that we can set BeginNodeID
+			sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+			ancestors[0].BeginNodeID = int64(1)
+			for i := 1; i < len(ancestors); i++ {
+				ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+			}
+		}
+		trees = append(trees, tree)
+	}
+	return trees, nil
+}
+
+// historyNodeKey orders the nodes of a branch by nodeID ASC, txnID DESC
+func historyNodeKey(row *nosqlplugin.HistoryNodeRow) string {
+	var txnID int64
+	if row.TxnID != nil {
+		txnID = *row.TxnID
+	}
+	return key(row.TreeID, row.BranchID, row.NodeID, ^txnID)
+}
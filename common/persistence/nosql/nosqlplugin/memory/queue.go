@@ -0,0 +1,188 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.MessageQueueCRUD = (*memdb)(nil)
+
+// Insert message into queue, return error if failed or already exists
+// Must return conditionFailed error if row already exists
+func (db *memdb) InsertIntoQueue(ctx context.Context, row *nosqlplugin.QueueMessageRow) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	messageKey := key(row.QueueType, row.ID)
+	if _, ok := s.queueMessages[messageKey]; ok {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	s.queueMessages[messageKey] = deepCopy(row)
+	return nil
+}
+
+// Get the ID of last message inserted into the queue
+func (db *memdb) SelectLastEnqueuedMessageID(ctx context.Context, queueType persistence.QueueType) (int64, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	keys := s.queueMessages.keys(prefix(queueType))
+	if len(keys) == 0 {
+		return 0, errNotFound
+	}
+	return s.queueMessages[keys[len(keys)-1]].(*nosqlplugin.QueueMessageRow).ID, nil
+}
+
+// Read queue messages starting from the exclusiveBeginMessageID
+func (db *memdb) SelectMessagesFrom(ctx context.Context, queueType persistence.QueueType, exclusiveBeginMessageID int64, maxRows int) ([]*nosqlplugin.QueueMessageRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, _ := s.queueMessages.selectPage(prefix(queueType), maxRows, nil, func(row interface{}) bool {
+		return row.(*nosqlplugin.QueueMessageRow).ID > exclusiveBeginMessageID
+	})
+	messages := make([]*nosqlplugin.QueueMessageRow, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, row.(*nosqlplugin.QueueMessageRow))
+	}
+	return messages, nil
+}
+
+// Read queue message starting from an ID with pagination
+func (db *memdb) SelectMessagesBetween(ctx context.Context, request nosqlplugin.SelectMessagesBetweenRequest) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.queueMessages.selectPage(prefix(request.QueueType), request.PageSize, request.NextPageToken, func(row interface{}) bool {
+		id := row.(*nosqlplugin.QueueMessageRow).ID
+		return id > request.ExclusiveBeginMessageID && id <= request.InclusiveEndMessageID
+	})
+	response := &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          make([]nosqlplugin.QueueMessageRow, 0, len(rows)),
+		NextPageToken: nextPageToken,
+	}
+	for _, row := range rows {
+		response.Rows = append(response.Rows, *row.(*nosqlplugin.QueueMessageRow))
+	}
+	return response, nil
+}
+
+// Delete all messages before exclusiveBeginMessageID
+func (db *memdb) DeleteMessagesBefore(ctx context.Context, queueType persistence.QueueType, exclusiveBeginMessageID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.queueMessages.deleteIf(prefix(queueType), func(row interface{}) bool {
+		return row.(*nosqlplugin.QueueMessageRow).ID < exclusiveBeginMessageID
+	})
+	return nil
+}
+
+// Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
+func (db *memdb) DeleteMessagesInRange(ctx context.Context, queueType persistence.QueueType, exclusiveBeginMessageID int64, inclusiveEndMessageID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.queueMessages.deleteIf(prefix(queueType), func(row interface{}) bool {
+		id := row.(*nosqlplugin.QueueMessageRow).ID
+		return id > exclusiveBeginMessageID && id <= inclusiveEndMessageID
+	})
+	return nil
+}
+
+// Delete one message
+func (db *memdb) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.queueMessages, key(queueType, messageID))
+	return nil
+}
+
+// Insert an empty metadata row, when it doesn't exist
+func (db *memdb) InsertQueueMetadata(ctx context.Context, queueType persistence.QueueType, version int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	// it's ok if the metadata row exists already
+	if _, ok := s.queueMetadata[key(queueType)]; !ok {
+		s.queueMetadata[key(queueType)] = &nosqlplugin.QueueMetadataRow{
+			QueueType:        queueType,
+			ClusterAckLevels: make(map[string]int64),
+			Version:          version,
+		}
+	}
+	return nil
+}
+
+// **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
+// then the current version will increase by one when updating the metadata row
+// it should return ConditionFailure if the condition is not met
+func (db *memdb) UpdateQueueMetadataCas(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	previous, ok := s.queueMetadata[key(row.QueueType)]
+	if !ok || previous.(*nosqlplugin.QueueMetadataRow).Version != row.Version-1 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	s.queueMetadata[key(row.QueueType)] = deepCopy(&row)
+	return nil
+}
+
+// Read a QueueMetadata
+func (db *memdb) SelectQueueMetadata(ctx context.Context, queueType persistence.QueueType) (*nosqlplugin.QueueMetadataRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, ok := s.queueMetadata[key(queueType)]
+	if !ok {
+		return nil, errNotFound
+	}
+	metadata := deepCopy(row).(*nosqlplugin.QueueMetadataRow)
+	if metadata.ClusterAckLevels == nil {
+		metadata.ClusterAckLevels = make(map[string]int64)
+	}
+	return metadata, nil
+}
+
+// GetQueueSize returns the number of messages in the queue
+func (db *memdb) GetQueueSize(ctx context.Context, queueType persistence.QueueType) (int64, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	return int64(len(s.queueMessages.keys(prefix(queueType)))), nil
+}
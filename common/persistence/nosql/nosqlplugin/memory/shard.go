@@ -0,0 +1,125 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.ShardCRUD = (*memdb)(nil)
+
+// InsertShard creates a new shard, return error is there is any.
+// Return ShardOperationConditionFailure if the condition doesn't meet
+func (db *memdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if previous, ok := s.shards[key(row.ShardID)]; ok {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: previous.(*nosqlplugin.ShardRow).RangeID,
+		}
+	}
+	s.shards[key(row.ShardID)] = deepCopy(row)
+	return nil
+}
+
+// SelectShard gets a shard
+func (db *memdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, ok := s.shards[key(shardID)]
+	if !ok {
+		return 0, nil, errNotFound
+	}
+	info := deepCopy(row).(*nosqlplugin.ShardRow)
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return info.RangeID, info, nil
+}
+
+// UpdateRangeID updates the rangeID
+// Return ShardOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, err := s.assertShardRangeID(shardID, previousRangeID)
+	if err != nil {
+		return err
+	}
+	row.RangeID = rangeID
+	return nil
+}
+
+// UpdateShard updates a shard
+// Return ShardOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if _, err := s.assertShardRangeID(row.ShardID, previousRangeID); err != nil {
+		return err
+	}
+	s.shards[key(row.ShardID)] = deepCopy(row)
+	return nil
+}
+
+// assertShardRangeID returns the shard row if its rangeID is the expected one,
+// or a ShardOperationConditionFailure otherwise. The store lock must be held.
+func (s *store) assertShardRangeID(shardID int, rangeID int64) (*nosqlplugin.ShardRow, error) {
+	row, ok := s.shards[key(shardID)]
+	if !ok {
+		return nil, &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: -1,
+			Details: fmt.Sprintf("shard %v not found", shardID),
+		}
+	}
+	shard := row.(*nosqlplugin.ShardRow)
+	if shard.RangeID != rangeID {
+		return nil, &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: shard.RangeID,
+		}
+	}
+	return shard, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// keySeparator separates the columns of a composite key. It sorts before
+	// any printable character so that composite keys sort column by column.
+	keySeparator = "\x00"
+)
+
+var (
+	storesLock sync.Mutex
+	stores     = make(map[string]*store)
+)
+
+type (
+	// store holds all the tables of a keyspace. A single lock serializes all
+	// the operations, which gives every batch write the atomicity and
+	// isolation that the conditional writes of real databases provide.
+	store struct {
+		sync.Mutex
+
+		shards              table // shardID -> *nosqlplugin.ShardRow
+		currentWorkflows    table // shardID, domainID, workflowID -> *nosqlplugin.CurrentWorkflowRow
+		workflowExecutions  table // shardID, domainID, workflowID, runID -> *nosqlplugin.WorkflowExecution
+		transferTasks       table // shardID, taskID -> *nosqlplugin.TransferTask
+		crossClusterTasks   table // shardID, targetCluster, taskID -> *nosqlplugin.CrossClusterTask
+		replicationTasks    table // shardID, taskID -> *nosqlplugin.ReplicationTask
+		timerTasks          table // shardID, visibilityTimestamp, taskID -> *nosqlplugin.TimerTask
+		replicationDLQTasks table // shardID, sourceCluster, taskID -> *nosqlplugin.ReplicationTask
+		taskLists           table // domainID, taskListName, taskListType -> *nosqlplugin.TaskListRow
+		tasks               table // domainID, taskListName, taskListType, taskID -> *nosqlplugin.TaskRow
+		domains             table // domainName -> *nosqlplugin.DomainRow
+		queueMessages       table // queueType, messageID -> *nosqlplugin.QueueMessageRow
+		queueMetadata       table // queueType -> *nosqlplugin.QueueMetadataRow
+		historyTrees        table // treeID, branchID -> *nosqlplugin.HistoryTreeRow
+		historyNodes        table // treeID, branchID, nodeID, txnID DESC -> *nosqlplugin.HistoryNodeRow
+		openVisibility      table // domainID, runID -> *nosqlplugin.VisibilityRow
+		closedVisibility    table // domainID, runID -> *nosqlplugin.VisibilityRow
+		configs             table // rowType, version -> *persistence.InternalConfigStoreEntry
+
+		domainNotificationVersion int64
+	}
+
+	// table maps the encoded primary key of a row to the row
+	table map[string]interface{}
+)
+
+// getStore returns the store of the keyspace, creating it if necessary
+func getStore(keyspace string) *store {
+	storesLock.Lock()
+	defer storesLock.Unlock()
+
+	s, ok := stores[keyspace]
+	if !ok {
+		s = &store{}
+		s.reset()
+		stores[keyspace] = s
+	}
+	return s
+}
+
+// reset drops all the data of the store
+func (s *store) reset() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shards = make(table)
+	s.currentWorkflows = make(table)
+	s.workflowExecutions = make(table)
+	s.transferTasks = make(table)
+	s.crossClusterTasks = make(table)
+	s.replicationTasks = make(table)
+	s.timerTasks = make(table)
+	s.replicationDLQTasks = make(table)
+	s.taskLists = make(table)
+	s.tasks = make(table)
+	s.domains = make(table)
+	s.queueMessages = make(table)
+	s.queueMetadata = make(table)
+	s.historyTrees = make(table)
+	s.historyNodes = make(table)
+	s.openVisibility = make(table)
+	s.closedVisibility = make(table)
+	s.configs = make(table)
+	s.domainNotificationVersion = 0
+}
+
+// keys returns the sorted keys of the rows whose key starts with prefix
+func (t table) keys(prefix string) []string {
+	var keys []string
+	for k := range t {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// deleteIf deletes the rows whose key starts with prefix and that match the predicate
+func (t table) deleteIf(prefix string, predicate func(row interface{}) bool) int {
+	deleted := 0
+	for k, row := range t {
+		if strings.HasPrefix(k, prefix) && predicate(row) {
+			delete(t, k)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// selectPage returns a page of the rows whose key starts with prefix and that match the predicate,
+// in the order of their keys, and the token of the next page
+func (t table) selectPage(prefix string, pageSize int, pageToken []byte, predicate func(row interface{}) bool) ([]interface{}, []byte) {
+	var keys []string
+	for _, k := range t.keys(prefix) {
+		if predicate(t[k]) {
+			keys = append(keys, k)
+		}
+	}
+	keys, nextPageToken := page(keys, pageSize, pageToken)
+	rows := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, deepCopy(t[k]))
+	}
+	return rows, nextPageToken
+}
+
+// page returns the keys following the one encoded in pageToken, at most pageSize of them,
+// and the token of the next page. The token is nil when there is no more keys.
+// Keys are used as tokens so that deleting rows between two pages doesn't skip any row.
+func page(keys []string, pageSize int, pageToken []byte) ([]string, []byte) {
+	start := 0
+	if len(pageToken) > 0 {
+		last := string(pageToken)
+		start = sort.Search(len(keys), func(i int) bool { return keys[i] > last })
+	}
+	end := len(keys)
+	var nextPageToken []byte
+	if pageSize > 0 && start+pageSize < end {
+		end = start + pageSize
+		nextPageToken = []byte(keys[end-1])
+	}
+	return keys[start:end], nextPageToken
+}
+
+// key encodes the columns into a string key whose lexical order follows the
+// order of the columns
+func key(columns ...interface{}) string {
+	encoded := make([]string, 0, len(columns))
+	for _, column := range columns {
+		switch c := column.(type) {
+		case string:
+			encoded = append(encoded, c)
+		case int:
+			encoded = append(encoded, int64Key(int64(c)))
+		case int64:
+			encoded = append(encoded, int64Key(c))
+		case persistence.QueueType:
+			encoded = append(encoded, int64Key(int64(c)))
+		case time.Time:
+			encoded = append(encoded, int64Key(c.UnixNano()))
+		default:
+			panic(fmt.Sprintf("unsupported key column type %T", column))
+		}
+	}
+	return strings.Join(encoded, keySeparator)
+}
+
+// prefix encodes the leading columns of a key, to be used with table.keys
+func prefix(columns ...interface{}) string {
+	return key(columns...) + keySeparator
+}
+
+// int64Key encodes a signed integer into a fixed width string that sorts in numeric order
+func int64Key(v int64) string {
+	return fmt.Sprintf("%016x", uint64(v)^(1<<63))
+}
+
+// deepCopy returns a copy of v that doesn't share any pointer, map or slice with it,
+// so that callers can't mutate the rows stored in memory, nor see mutations made by others.
+func deepCopy(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return copyValue(reflect.ValueOf(v)).Interface()
+}
+
+var dataBlobType = reflect.TypeOf(&persistence.DataBlob{})
+
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		// blobs without data are read back as nil, the same as from a blob column of the other plugins
+		if v.Type() == dataBlobType && len(v.Elem().FieldByName("Data").Bytes()) == 0 {
+			return reflect.Zero(v.Type())
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(copyValue(v.Elem()))
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(k, copyValue(v.MapIndex(k)))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		// unexported fields (e.g. the ones of time.Time) are copied by value
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
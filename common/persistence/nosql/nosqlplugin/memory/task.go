@@ -0,0 +1,219 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
+)
+
+var _ nosqlplugin.TaskCRUD = (*memdb)(nil)
+
+// SelectTaskList returns a single tasklist row.
+// Return IsNotFoundError if the row doesn't exist
+func (db *memdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, ok := s.taskLists[taskListKey(filter)]
+	if !ok {
+		return nil, errNotFound
+	}
+	return deepCopy(row).(*nosqlplugin.TaskListRow), nil
+}
+
+// InsertTaskList insert a single tasklist row
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+	if previous, ok := s.taskLists[taskListKey(filter)]; ok {
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: previous.(*nosqlplugin.TaskListRow).RangeID,
+		}
+	}
+	inserted := deepCopy(row).(*nosqlplugin.TaskListRow)
+	inserted.RangeID = initialRangeID
+	inserted.AckLevel = 0
+	s.taskLists[taskListKey(filter)] = inserted
+	return nil
+}
+
+// UpdateTaskList updates a single tasklist row
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateTaskList(ctx context.Context, row *nosqlplugin.TaskListRow, previousRangeID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+	if err := s.assertTaskListRangeID(filter, previousRangeID); err != nil {
+		return err
+	}
+	s.taskLists[taskListKey(filter)] = deepCopy(row)
+	return nil
+}
+
+// UpdateTaskListWithTTL updates a single tasklist row. TTL is not supported, so this is the same as UpdateTaskList.
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) UpdateTaskListWithTTL(ctx context.Context, ttlSeconds int64, row *nosqlplugin.TaskListRow, previousRangeID int64) error {
+	return db.UpdateTaskList(ctx, row, previousRangeID)
+}
+
+// ListTaskList returns all tasklists.
+func (db *memdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.taskLists.selectPage("", pageSize, nextPageToken, all)
+	result := &nosqlplugin.ListTaskListResult{
+		TaskLists:     make([]*nosqlplugin.TaskListRow, 0, len(rows)),
+		NextPageToken: nextPageToken,
+	}
+	for _, row := range rows {
+		result.TaskLists = append(result.TaskLists, row.(*nosqlplugin.TaskListRow))
+	}
+	return result, nil
+}
+
+// DeleteTaskList deletes a single tasklist row
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.assertTaskListRangeID(filter, previousRangeID); err != nil {
+		return err
+	}
+	delete(s.taskLists, taskListKey(filter))
+	return nil
+}
+
+// InsertTasks inserts a batch of tasks
+// Return TaskOperationConditionFailure if the condition doesn't meet
+func (db *memdb) InsertTasks(
+	ctx context.Context,
+	tasksToInsert []*nosqlplugin.TaskRowForInsert,
+	tasklistCondition *nosqlplugin.TaskListRow,
+) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     tasklistCondition.DomainID,
+		TaskListName: tasklistCondition.TaskListName,
+		TaskListType: tasklistCondition.TaskListType,
+	}
+	if err := s.assertTaskListRangeID(filter, tasklistCondition.RangeID); err != nil {
+		return err
+	}
+	for _, task := range tasksToInsert {
+		row := deepCopy(&task.TaskRow).(*nosqlplugin.TaskRow)
+		row.DomainID = filter.DomainID
+		row.TaskListName = filter.TaskListName
+		row.TaskListType = filter.TaskListType
+		s.tasks[key(filter.DomainID, filter.TaskListName, filter.TaskListType, task.TaskID)] = row
+	}
+	taskList := deepCopy(tasklistCondition).(*nosqlplugin.TaskListRow)
+	taskList.LastUpdatedTime = time.Now()
+	s.taskLists[taskListKey(filter)] = taskList
+	return nil
+}
+
+// SelectTasks return tasks that associated to a tasklist
+func (db *memdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, _ := s.tasks.selectPage(prefix(taskListKey(&filter.TaskListFilter)), filter.BatchSize, nil, func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.TaskRow).TaskID
+		return taskID > filter.MinTaskID && taskID <= filter.MaxTaskID
+	})
+	tasks := make([]*nosqlplugin.TaskRow, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, row.(*nosqlplugin.TaskRow))
+	}
+	return tasks, nil
+}
+
+// RangeDeleteTasks deletes a range of tasks, BatchSize is ignored
+func (db *memdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (int, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	deleted := 0
+	for _, k := range s.tasks.keys(prefix(taskListKey(&filter.TaskListFilter))) {
+		if filter.BatchSize > 0 && deleted >= filter.BatchSize {
+			break
+		}
+		taskID := s.tasks[k].(*nosqlplugin.TaskRow).TaskID
+		if taskID > filter.MinTaskID && taskID <= filter.MaxTaskID {
+			delete(s.tasks, k)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// assertTaskListRangeID checks the rangeID of a tasklist. The store lock must be held.
+func (s *store) assertTaskListRangeID(filter *nosqlplugin.TaskListFilter, rangeID int64) error {
+	row, ok := s.taskLists[taskListKey(filter)]
+	if !ok {
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: -1,
+			Details: fmt.Sprintf("tasklist %v of type %v not found", filter.TaskListName, filter.TaskListType),
+		}
+	}
+	if previous := row.(*nosqlplugin.TaskListRow).RangeID; previous != rangeID {
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: previous,
+		}
+	}
+	return nil
+}
+
+func taskListKey(filter *nosqlplugin.TaskListFilter) string {
+	return key(filter.DomainID, filter.TaskListName, filter.TaskListType)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/memory"
+	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
+)
+
+func TestMemoryHistoryPersistence(t *testing.T) {
+	s := new(memoryHistoryPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryMatchingPersistence(t *testing.T) {
+	// GetOrphanTasks is not implemented by NoSQL persistence
+	os.Setenv("SKIP_GET_ORPHAN_TASKS", "true")
+	defer os.Unsetenv("SKIP_GET_ORPHAN_TASKS")
+
+	s := new(persistencetests.MatchingPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryDomainPersistence(t *testing.T) {
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryShardPersistence(t *testing.T) {
+	s := new(persistencetests.ShardPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryVisibilityPersistence(t *testing.T) {
+	s := new(persistencetests.DBVisibilityPersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryExecutionManager(t *testing.T) {
+	s := new(persistencetests.ExecutionManagerSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryExecutionManagerWithEventsV2(t *testing.T) {
+	s := new(persistencetests.ExecutionManagerSuiteForEventsV2)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryQueuePersistence(t *testing.T) {
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMemoryConfigStorePersistence(t *testing.T) {
+	s := new(persistencetests.ConfigStorePersistenceSuite)
+	s.TestBase = NewTestBaseWithMemory()
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func NewTestBaseWithMemory() persistencetests.TestBase {
+	return persistencetests.NewTestBaseWithNoSQL(&persistencetests.TestBaseOptions{
+		DBPluginName: memory.PluginName,
+	})
+}
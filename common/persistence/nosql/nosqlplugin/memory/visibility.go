@@ -0,0 +1,144 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.VisibilityCRUD = (*memdb)(nil)
+
+// InsertVisibility inserts a record of an open workflow. TTL is not supported.
+func (db *memdb) InsertVisibility(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForInsert) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	inserted := deepCopy(&row.VisibilityRow).(*nosqlplugin.VisibilityRow)
+	inserted.DomainID = row.DomainID
+	s.openVisibility[key(row.DomainID, row.RunID)] = inserted
+	return nil
+}
+
+// UpdateVisibility closes the record of a workflow. TTL is not supported.
+func (db *memdb) UpdateVisibility(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForUpdate) error {
+	if row.UpdateCloseToOpen {
+		// TODO implement it when where is a need
+		panic("not supported operation")
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	updated := deepCopy(&row.VisibilityRow).(*nosqlplugin.VisibilityRow)
+	updated.DomainID = row.DomainID
+	delete(s.openVisibility, key(row.DomainID, row.RunID))
+	s.closedVisibility[key(row.DomainID, row.RunID)] = updated
+	return nil
+}
+
+// SelectVisibility lists the records matching the filter, sorted by start or close time, latest first
+func (db *memdb) SelectVisibility(ctx context.Context, filter *nosqlplugin.VisibilityFilter) (*nosqlplugin.SelectVisibilityResponse, error) {
+	var open bool
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		open = true
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		open = false
+	default:
+		panic("filter type is not supported")
+	}
+	sortByCloseTime := !open && filter.SortType == nosqlplugin.SortByClosedTime
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	visibility := s.closedVisibility
+	if open {
+		visibility = s.openVisibility
+	}
+	request := filter.ListRequest
+	sorted := make(table)
+	for _, k := range visibility.keys(prefix(request.DomainUUID)) {
+		row := visibility[k].(*nosqlplugin.VisibilityRow)
+		switch filter.FilterType {
+		case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+			if row.TypeName != filter.WorkflowType {
+				continue
+			}
+		case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+			if row.WorkflowID != filter.WorkflowID {
+				continue
+			}
+		case nosqlplugin.ClosedByClosedStatus:
+			if row.Status == nil || int32(*row.Status) != filter.CloseStatus {
+				continue
+			}
+		}
+		sortTime := row.StartTime
+		if sortByCloseTime {
+			sortTime = row.CloseTime
+		}
+		if sortTime.Before(request.EarliestTime) || sortTime.After(request.LatestTime) {
+			continue
+		}
+		// latest first
+		sorted[key(^sortTime.UnixNano(), row.RunID)] = row
+	}
+
+	rows, nextPageToken := sorted.selectPage("", request.PageSize, request.NextPageToken, all)
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(rows)),
+		NextPageToken: nextPageToken,
+	}
+	for _, row := range rows {
+		response.Executions = append(response.Executions, row.(*nosqlplugin.VisibilityRow))
+	}
+	return response, nil
+}
+
+// DeleteVisibility deletes the record of a workflow, open or closed
+func (db *memdb) DeleteVisibility(ctx context.Context, domainID, workflowID, runID string) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.openVisibility, key(domainID, runID))
+	delete(s.closedVisibility, key(domainID, runID))
+	return nil
+}
+
+// SelectOneClosedWorkflow returns the record of a closed workflow, or nil if it's not found
+func (db *memdb) SelectOneClosedWorkflow(ctx context.Context, domainID, workflowID, runID string) (*nosqlplugin.VisibilityRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, ok := s.closedVisibility[key(domainID, runID)]
+	if !ok || row.(*nosqlplugin.VisibilityRow).WorkflowID != workflowID {
+		return nil, nil
+	}
+	return deepCopy(row).(*nosqlplugin.VisibilityRow), nil
+}
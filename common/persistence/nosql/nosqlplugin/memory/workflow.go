@@ -0,0 +1,445 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+var _ nosqlplugin.WorkflowCRUD = (*memdb)(nil)
+
+func (db *memdb) InsertWorkflowExecutionWithTasks(
+	ctx context.Context,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("InsertWorkflowExecutionWithTasks only supports WorkflowExecutionMapsWriteModeCreate")
+	}
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("InsertWorkflowExecutionWithTasks doesn't support EventBufferWriteMode")
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	// all the conditions are checked before writing anything, so that the batch is all or nothing
+	if err := s.assertWorkflowShardRangeID(shardCondition); err != nil {
+		return err
+	}
+	if err := s.assertCurrentWorkflowCondition(shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	executionKey := key(shardID, domainID, workflowID, execution.RunID)
+	if previous, ok := s.workflowExecutions[executionKey]; ok {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			workflowID, execution.RunID, shardCondition.RangeID)
+		row := previous.(*workflowExecutionRow)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  row.Execution.ExecutionInfo.CreateRequestID,
+				RunID:            row.Execution.ExecutionInfo.RunID,
+				State:            row.Execution.ExecutionInfo.State,
+				CloseStatus:      row.Execution.ExecutionInfo.CloseStatus,
+				LastWriteVersion: row.LastWriteVersion,
+			},
+		}
+	}
+
+	s.writeCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest)
+	s.workflowExecutions[executionKey] = newWorkflowExecution(execution)
+	s.writeTasks(shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	return nil
+}
+
+func (db *memdb) UpdateWorkflowExecutionWithTasks(
+	ctx context.Context,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	mutatedExecution *nosqlplugin.WorkflowExecutionRequest,
+	insertedExecution *nosqlplugin.WorkflowExecutionRequest,
+	resetExecution *nosqlplugin.WorkflowExecutionRequest,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	// all the conditions are checked before writing anything, so that the batch is all or nothing
+	if err := s.assertWorkflowShardRangeID(shardCondition); err != nil {
+		return err
+	}
+	if err := s.assertCurrentWorkflowCondition(shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	for _, execution := range []*nosqlplugin.WorkflowExecutionRequest{mutatedExecution, resetExecution} {
+		if execution == nil {
+			continue
+		}
+		if err := s.assertNextEventIDCondition(shardID, execution); err != nil {
+			return err
+		}
+	}
+
+	s.writeCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest)
+	if mutatedExecution != nil {
+		executionKey := key(shardID, domainID, workflowID, mutatedExecution.RunID)
+		updateWorkflowExecution(s.workflowExecutions[executionKey].(*workflowExecutionRow), mutatedExecution)
+	}
+	if insertedExecution != nil {
+		executionKey := key(shardID, domainID, workflowID, insertedExecution.RunID)
+		s.workflowExecutions[executionKey] = newWorkflowExecution(insertedExecution)
+	}
+	if resetExecution != nil {
+		executionKey := key(shardID, domainID, workflowID, resetExecution.RunID)
+		s.workflowExecutions[executionKey] = newWorkflowExecution(resetExecution)
+	}
+	s.writeTasks(shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	return nil
+}
+
+func (db *memdb) SelectCurrentWorkflow(
+	ctx context.Context,
+	shardID int, domainID, workflowID string,
+) (*nosqlplugin.CurrentWorkflowRow, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, ok := s.currentWorkflows[key(shardID, domainID, workflowID)]
+	if !ok {
+		return nil, errNotFound
+	}
+	return deepCopy(row).(*nosqlplugin.CurrentWorkflowRow), nil
+}
+
+func (db *memdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	row, ok := s.workflowExecutions[key(shardID, domainID, workflowID, runID)]
+	if !ok {
+		return nil, errNotFound
+	}
+	return deepCopy(row.(*workflowExecutionRow).Execution).(*nosqlplugin.WorkflowExecution), nil
+}
+
+func (db *memdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	currentKey := key(shardID, domainID, workflowID)
+	if row, ok := s.currentWorkflows[currentKey]; ok && row.(*nosqlplugin.CurrentWorkflowRow).RunID == currentRunIDCondition {
+		delete(s.currentWorkflows, currentKey)
+	}
+	return nil
+}
+
+func (db *memdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.workflowExecutions, key(shardID, domainID, workflowID, runID))
+	return nil
+}
+
+func (db *memdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*p.CurrentWorkflowExecution, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.currentWorkflows.selectPage(prefix(shardID), pageSize, pageToken, all)
+	executions := make([]*p.CurrentWorkflowExecution, 0, len(rows))
+	for _, row := range rows {
+		current := row.(*nosqlplugin.CurrentWorkflowRow)
+		executions = append(executions, &p.CurrentWorkflowExecution{
+			DomainID:     current.DomainID,
+			WorkflowID:   current.WorkflowID,
+			RunID:        current.RunID,
+			State:        current.State,
+			CurrentRunID: current.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
+}
+
+func (db *memdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*p.InternalListConcreteExecutionsEntity, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.workflowExecutions.selectPage(prefix(shardID), pageSize, pageToken, all)
+	executions := make([]*p.InternalListConcreteExecutionsEntity, 0, len(rows))
+	for _, row := range rows {
+		execution := row.(*workflowExecutionRow).Execution
+		executions = append(executions, &p.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    execution.ExecutionInfo,
+			VersionHistories: execution.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
+}
+
+func (db *memdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.workflowExecutions[key(shardID, domainID, workflowID, runID)]
+	return ok, nil
+}
+
+func (db *memdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.transferTasks.selectPage(prefix(shardID), pageSize, pageToken, func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.TransferTask).TaskID
+		return taskID > exclusiveMinTaskID && taskID <= inclusiveMaxTaskID
+	})
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(rows))
+	for _, row := range rows {
+		task := row.(*nosqlplugin.TransferTask)
+		readTransferTask(task)
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.transferTasks, key(shardID, taskID))
+	return nil
+}
+
+func (db *memdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.transferTasks.deleteIf(prefix(shardID), func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.TransferTask).TaskID
+		return taskID > exclusiveBeginTaskID && taskID <= inclusiveEndTaskID
+	})
+	return nil
+}
+
+func (db *memdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.timerTasks.selectPage(prefix(shardID), pageSize, pageToken, func(row interface{}) bool {
+		return inTimeRange(row.(*nosqlplugin.TimerTask).VisibilityTimestamp, inclusiveMinTime, exclusiveMaxTime)
+	})
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, row.(*nosqlplugin.TimerTask))
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.timerTasks, key(shardID, visibilityTimestamp, taskID))
+	return nil
+}
+
+func (db *memdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.timerTasks.deleteIf(prefix(shardID), func(row interface{}) bool {
+		return inTimeRange(row.(*nosqlplugin.TimerTask).VisibilityTimestamp, inclusiveMinTime, exclusiveMaxTime)
+	})
+	return nil
+}
+
+func (db *memdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	return selectReplicationTasks(s.replicationTasks, prefix(shardID), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+}
+
+func (db *memdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.replicationTasks, key(shardID, taskID))
+	return nil
+}
+
+func (db *memdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.replicationTasks.deleteIf(prefix(shardID), func(row interface{}) bool {
+		return row.(*nosqlplugin.ReplicationTask).TaskID <= inclusiveEndTaskID
+	})
+	return nil
+}
+
+func (db *memdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	if _, err := s.assertShardRangeID(shardCondition.ShardID, shardCondition.RangeID); err != nil {
+		return err
+	}
+	s.writeTasks(shardCondition.ShardID, nil, nil, tasks, nil)
+	return nil
+}
+
+func (db *memdb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	rows, nextPageToken := s.crossClusterTasks.selectPage(prefix(shardID, targetCluster), pageSize, pageToken, func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.CrossClusterTask).TaskID
+		return taskID > exclusiveMinTaskID && taskID <= inclusiveMaxTaskID
+	})
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(rows))
+	for _, row := range rows {
+		task := row.(*nosqlplugin.CrossClusterTask)
+		readTransferTask(&task.TransferTask)
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *memdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.crossClusterTasks, key(shardID, targetCluster, taskID))
+	return nil
+}
+
+func (db *memdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.crossClusterTasks.deleteIf(prefix(shardID, targetCluster), func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.CrossClusterTask).TaskID
+		return taskID > exclusiveBeginTaskID && taskID <= inclusiveEndTaskID
+	})
+	return nil
+}
+
+func (db *memdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.replicationDLQTasks[key(shardID, sourceCluster, task.TaskID)] = deepCopy(&task)
+	return nil
+}
+
+func (db *memdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	return selectReplicationTasks(s.replicationDLQTasks, prefix(shardID, sourceCluster), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+}
+
+func (db *memdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	return int64(len(s.replicationDLQTasks.keys(prefix(shardID, sourceCluster)))), nil
+}
+
+func (db *memdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.replicationDLQTasks, key(shardID, sourceCluster, taskID))
+	return nil
+}
+
+func (db *memdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	s := db.store
+	s.Lock()
+	defer s.Unlock()
+
+	s.replicationDLQTasks.deleteIf(prefix(shardID, sourceCluster), func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.ReplicationTask).TaskID
+		return taskID > exclusiveBeginTaskID && taskID <= inclusiveEndTaskID
+	})
+	return nil
+}
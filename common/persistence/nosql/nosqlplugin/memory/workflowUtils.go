@@ -0,0 +1,294 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	// emptyDomainID and emptyRunID are the dummy parent IDs written by the execution store
+	emptyDomainID = "10000000-0000-f000-f000-000000000000"
+	emptyRunID    = "30000000-0000-f000-f000-000000000000"
+)
+
+// workflowExecutionRow is a row of the workflow execution table
+type workflowExecutionRow struct {
+	Execution        *nosqlplugin.WorkflowExecution
+	LastWriteVersion int64
+}
+
+// all is a predicate matching all the rows
+func all(row interface{}) bool {
+	return true
+}
+
+func inTimeRange(t, inclusiveMinTime, exclusiveMaxTime time.Time) bool {
+	return !t.Before(inclusiveMinTime) && t.Before(exclusiveMaxTime)
+}
+
+// assertWorkflowShardRangeID checks the shard condition of a workflow write. The store lock must be held.
+func (s *store) assertWorkflowShardRangeID(shardCondition *nosqlplugin.ShardCondition) error {
+	row, ok := s.shards[key(shardCondition.ShardID)]
+	if !ok {
+		msg := fmt.Sprintf("Failed to operate on workflow execution. ShardID: %v not found", shardCondition.ShardID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	if rangeID := row.(*nosqlplugin.ShardRow).RangeID; rangeID != shardCondition.RangeID {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+		}
+	}
+	return nil
+}
+
+// assertCurrentWorkflowCondition checks the condition of a current workflow write. The store lock must be held.
+func (s *store) assertCurrentWorkflowCondition(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	previous, exists := s.currentWorkflows[key(shardID, domainID, workflowID)]
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		if !exists {
+			return nil
+		}
+		current := previous.(*nosqlplugin.CurrentWorkflowRow)
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v",
+			workflowID, current.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  current.CreateRequestID,
+				RunID:            current.RunID,
+				State:            current.State,
+				CloseStatus:      current.CloseStatus,
+				LastWriteVersion: current.LastWriteVersion,
+			},
+		}
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		condition := request.Condition
+		if condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		if !exists {
+			msg := fmt.Sprintf("Current workflow condition failed. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: <not found>",
+				workflowID, condition.GetCurrentRunID())
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+		current := previous.(*nosqlplugin.CurrentWorkflowRow)
+		if current.RunID != condition.GetCurrentRunID() ||
+			(condition.LastWriteVersion != nil && condition.State != nil &&
+				(current.LastWriteVersion != *condition.LastWriteVersion || current.State != *condition.State)) {
+			msg := fmt.Sprintf("Current workflow condition failed. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+				workflowID, condition.GetCurrentRunID(), current.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+}
+
+// assertNextEventIDCondition checks the condition of a workflow execution update. The store lock must be held.
+func (s *store) assertNextEventIDCondition(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	previous, ok := s.workflowExecutions[key(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)]
+	if !ok {
+		msg := fmt.Sprintf("Failed to update mutable state. WorkflowId: %v, RunId: %v not found",
+			execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	actualNextEventID := previous.(*workflowExecutionRow).Execution.ExecutionInfo.NextEventID
+	if execution.PreviousNextEventIDCondition == nil || actualNextEventID != *execution.PreviousNextEventIDCondition {
+		msg := fmt.Sprintf("Failed to update mutable state. Request Condition: %v, Actual Value: %v",
+			execution.PreviousNextEventIDCondition, actualNextEventID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	return nil
+}
+
+// writeCurrentWorkflow applies a current workflow write whose condition has been checked. The store lock must be held.
+func (s *store) writeCurrentWorkflow(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) {
+	if request.WriteMode == nosqlplugin.CurrentWorkflowWriteModeNoop {
+		return
+	}
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	s.currentWorkflows[key(shardID, domainID, workflowID)] = &row
+}
+
+// writeTasks inserts the background tasks of a shard. The store lock must be held.
+func (s *store) writeTasks(
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) {
+	for _, task := range transferTasks {
+		s.transferTasks[key(shardID, task.TaskID)] = deepCopy(task)
+	}
+	for _, task := range crossClusterTasks {
+		s.crossClusterTasks[key(shardID, task.TargetCluster, task.TaskID)] = deepCopy(task)
+	}
+	for _, task := range replicationTasks {
+		s.replicationTasks[key(shardID, task.TaskID)] = deepCopy(task)
+	}
+	for _, task := range timerTasks {
+		s.timerTasks[key(shardID, task.VisibilityTimestamp, task.TaskID)] = deepCopy(task)
+	}
+}
+
+// readTransferTask maps the dummy target run ID back to an empty one, as the other plugins do
+func readTransferTask(task *nosqlplugin.TransferTask) {
+	if task.TargetRunID == p.TransferTaskTransferTargetRunID {
+		task.TargetRunID = ""
+	}
+}
+
+func selectReplicationTasks(
+	t table,
+	prefix string,
+	pageSize int,
+	pageToken []byte,
+	exclusiveMinTaskID int64,
+	inclusiveMaxTaskID int64,
+) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	rows, nextPageToken := t.selectPage(prefix, pageSize, pageToken, func(row interface{}) bool {
+		taskID := row.(*nosqlplugin.ReplicationTask).TaskID
+		return taskID > exclusiveMinTaskID && taskID <= inclusiveMaxTaskID
+	})
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, row.(*nosqlplugin.ReplicationTask))
+	}
+	return tasks, nextPageToken, nil
+}
+
+// newWorkflowExecution creates the row of a workflow execution, overriding all the maps and the event buffer
+func newWorkflowExecution(request *nosqlplugin.WorkflowExecutionRequest) *workflowExecutionRow {
+	row := &workflowExecutionRow{
+		Execution: &nosqlplugin.WorkflowExecution{
+			ActivityInfos:       make(map[int64]*p.InternalActivityInfo),
+			TimerInfos:          make(map[string]*p.TimerInfo),
+			ChildExecutionInfos: make(map[int64]*p.InternalChildExecutionInfo),
+			RequestCancelInfos:  make(map[int64]*p.RequestCancelInfo),
+			SignalInfos:         make(map[int64]*p.SignalInfo),
+			SignalRequestedIDs:  make(map[string]struct{}),
+			BufferedEvents:      []*p.DataBlob{},
+		},
+	}
+	updateWorkflowExecution(row, request)
+	return row
+}
+
+// updateWorkflowExecution applies the changes of a workflow execution request on an existing row
+func updateWorkflowExecution(row *workflowExecutionRow, request *nosqlplugin.WorkflowExecutionRequest) {
+	request = deepCopy(request).(*nosqlplugin.WorkflowExecutionRequest)
+	execution := row.Execution
+
+	execution.ExecutionInfo = &request.InternalWorkflowExecutionInfo
+	// the dummy parent IDs of a workflow without parent are read back as empty, as the other plugins do
+	if execution.ExecutionInfo.ParentDomainID == emptyDomainID {
+		execution.ExecutionInfo.ParentDomainID = ""
+	}
+	if execution.ExecutionInfo.ParentRunID == emptyRunID {
+		execution.ExecutionInfo.ParentRunID = ""
+	}
+	execution.VersionHistories = request.VersionHistories
+	if request.Checksums != nil {
+		execution.Checksum = *request.Checksums
+	}
+	row.LastWriteVersion = request.LastWriteVersion
+
+	for k, v := range request.ActivityInfos {
+		execution.ActivityInfos[k] = v
+	}
+	for k, v := range request.TimerInfos {
+		execution.TimerInfos[k] = v
+	}
+	for k, v := range request.ChildWorkflowInfos {
+		execution.ChildExecutionInfos[k] = v
+	}
+	for k, v := range request.RequestCancelInfos {
+		execution.RequestCancelInfos[k] = v
+	}
+	for k, v := range request.SignalInfos {
+		execution.SignalInfos[k] = v
+	}
+	for _, k := range request.SignalRequestedIDs {
+		execution.SignalRequestedIDs[k] = struct{}{}
+	}
+
+	if request.MapsWriteMode == nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		for _, k := range request.ActivityInfoKeysToDelete {
+			delete(execution.ActivityInfos, k)
+		}
+		for _, k := range request.TimerInfoKeysToDelete {
+			delete(execution.TimerInfos, k)
+		}
+		for _, k := range request.ChildWorkflowInfoKeysToDelete {
+			delete(execution.ChildExecutionInfos, k)
+		}
+		for _, k := range request.RequestCancelInfoKeysToDelete {
+			delete(execution.RequestCancelInfos, k)
+		}
+		for _, k := range request.SignalInfoKeysToDelete {
+			delete(execution.SignalInfos, k)
+		}
+		for _, k := range request.SignalRequestedIDsKeysToDelete {
+			delete(execution.SignalRequestedIDs, k)
+		}
+	}
+
+	switch request.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeAppend:
+		execution.BufferedEvents = append(execution.BufferedEvents, request.NewBufferedEventBatch)
+	case nosqlplugin.EventBufferWriteModeClear:
+		execution.BufferedEvents = []*p.DataBlob{}
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/memory"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/mongodb"
 	"github.com/uber/cadence/common/types"
 )
@@ -40,6 +41,7 @@ import (
 var supportedPlugins = map[string]bool{
 	cassandra.PluginName: true,
 	mongodb.PluginName:   true,
+	memory.PluginName:    true,
 }
 
 // Currently you cannot clear or remove any entries in cluster_config table
@@ -108,8 +108,8 @@ func (s *MatchingPersistenceSuite) TestCreateTask() {
 		s.Equal(workflowExecution.RunID, resp.Tasks[0].RunID)
 		s.Equal(sid, resp.Tasks[0].ScheduleID)
 		s.True(resp.Tasks[0].CreatedTime.UnixNano() > 0)
-		if s.TaskMgr.GetName() != "cassandra" {
-			// cassandra uses TTL and expiry isn't stored as part of task state
+		if !s.isNoSQLTaskStore() {
+			// NoSQL stores use TTL and expiry isn't stored as part of task state
 			s.True(time.Now().Before(resp.Tasks[0].Expiry))
 			s.True(resp.Tasks[0].Expiry.Before(time.Now().Add((defaultScheduleToStartTimeout + 1) * time.Second)))
 		}
@@ -419,10 +419,20 @@ func (s *MatchingPersistenceSuite) deleteAllTaskList() {
 	}
 }
 
+// isNoSQLTaskStore returns true if the task store is backed by a NoSQL plugin,
+// which supports neither ListTaskList nor storing the task expiry
+func (s *MatchingPersistenceSuite) isNoSQLTaskStore() bool {
+	switch s.TaskMgr.GetName() {
+	case "cassandra", "memory":
+		return true
+	}
+	return false
+}
+
 // TestListWithOneTaskList test
 func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
-	if s.TaskMgr.GetName() == "cassandra" {
-		// ListTaskList API is currently not supported in cassandra
+	if s.isNoSQLTaskStore() {
+		// ListTaskList API is currently not supported in NoSQL stores
 		return
 	}
 	s.deleteAllTaskList()
@@ -485,8 +495,8 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
 
 // TestListWithMultipleTaskList test
 func (s *MatchingPersistenceSuite) TestListWithMultipleTaskList() {
-	if s.TaskMgr.GetName() == "cassandra" {
-		// ListTaskList API is currently not supported in cassandra"
+	if s.isNoSQLTaskStore() {
+		// ListTaskList API is currently not supported in NoSQL stores
 		return
 	}
 	s.deleteAllTaskList()
@@ -0,0 +1,136 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// workflowExecutionFencingAuditPersistenceClient cross-checks the rangeID of conditional
+	// writes against the shard owner's view of the rangeID and reports fencing violations
+	workflowExecutionFencingAuditPersistenceClient struct {
+		ExecutionManager
+		enabled      dynamicconfig.BoolPropertyFn
+		rangeIDFn    func() int64
+		metricClient metrics.Client
+		logger       log.Logger
+	}
+)
+
+var _ ExecutionManager = (*workflowExecutionFencingAuditPersistenceClient)(nil)
+
+// NewWorkflowExecutionPersistenceFencingAuditClient creates a client which reports conditional
+// writes whose rangeID diverges from rangeIDFn, or which are rejected because the shard is owned
+// by another host, as fencing violations
+func NewWorkflowExecutionPersistenceFencingAuditClient(
+	persistence ExecutionManager,
+	enabled dynamicconfig.BoolPropertyFn,
+	rangeIDFn func() int64,
+	metricClient metrics.Client,
+	logger log.Logger,
+) ExecutionManager {
+	return &workflowExecutionFencingAuditPersistenceClient{
+		ExecutionManager: persistence,
+		enabled:          enabled,
+		rangeIDFn:        rangeIDFn,
+		metricClient:     metricClient,
+		logger:           logger,
+	}
+}
+
+func (p *workflowExecutionFencingAuditPersistenceClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (*CreateWorkflowExecutionResponse, error) {
+	resp, err := p.ExecutionManager.CreateWorkflowExecution(ctx, request)
+	p.auditRangeID(metrics.PersistenceCreateWorkflowExecutionScope, request.RangeID, request.NewWorkflowSnapshot.ExecutionInfo, err)
+	return resp, err
+}
+
+func (p *workflowExecutionFencingAuditPersistenceClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+	resp, err := p.ExecutionManager.UpdateWorkflowExecution(ctx, request)
+	p.auditRangeID(metrics.PersistenceUpdateWorkflowExecutionScope, request.RangeID, request.UpdateWorkflowMutation.ExecutionInfo, err)
+	return resp, err
+}
+
+func (p *workflowExecutionFencingAuditPersistenceClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (*ConflictResolveWorkflowExecutionResponse, error) {
+	resp, err := p.ExecutionManager.ConflictResolveWorkflowExecution(ctx, request)
+	p.auditRangeID(metrics.PersistenceConflictResolveWorkflowExecutionScope, request.RangeID, request.ResetWorkflowSnapshot.ExecutionInfo, err)
+	return resp, err
+}
+
+func (p *workflowExecutionFencingAuditPersistenceClient) CreateFailoverMarkerTasks(
+	ctx context.Context,
+	request *CreateFailoverMarkersRequest,
+) error {
+	err := p.ExecutionManager.CreateFailoverMarkerTasks(ctx, request)
+	p.auditRangeID(metrics.PersistenceCreateFailoverMarkerTasksScope, request.RangeID, nil, err)
+	return err
+}
+
+// auditRangeID is called after a conditional write. A violation is reported if the request rangeID is not
+// the shard owner's current rangeID, or if the write was rejected because the rangeID in persistence has moved on.
+func (p *workflowExecutionFencingAuditPersistenceClient) auditRangeID(
+	scope int,
+	requestRangeID int64,
+	executionInfo *WorkflowExecutionInfo,
+	err error,
+) {
+	if !p.enabled() {
+		return
+	}
+
+	shardRangeID := p.rangeIDFn()
+	_, ownershipLost := err.(*ShardOwnershipLostError)
+	if requestRangeID == shardRangeID && !ownershipLost {
+		return
+	}
+
+	p.metricClient.IncCounter(scope, metrics.PersistenceFencingViolationCounter)
+	tags := []tag.Tag{
+		tag.ShardRangeID(shardRangeID),
+		tag.RequestShardRangeID(requestRangeID),
+	}
+	if executionInfo != nil {
+		tags = append(tags,
+			tag.WorkflowDomainID(executionInfo.DomainID),
+			tag.WorkflowID(executionInfo.WorkflowID),
+			tag.WorkflowRunID(executionInfo.RunID),
+		)
+	}
+	if ownershipLost {
+		p.logger.Error("Fencing violation: conditional write rejected, shard is owned by another host.", append(tags, tag.Error(err))...)
+		return
+	}
+	p.logger.Error("Fencing violation: conditional write rangeID diverges from the shard owner's rangeID.", tags...)
+}
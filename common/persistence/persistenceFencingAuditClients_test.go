@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestFencingAudit_ReportsViolations(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scope := tally.NewTestScope("test", nil)
+	mockManager := NewMockExecutionManager(controller)
+	rangeID := int64(10)
+	client := NewWorkflowExecutionPersistenceFencingAuditClient(
+		mockManager,
+		dynamicconfig.GetBoolPropertyFn(true),
+		func() int64 { return rangeID },
+		metrics.NewClient(scope, metrics.History),
+		loggerimpl.NewNopLogger(),
+	)
+	violations := func() int64 {
+		var total int64
+		for _, counter := range scope.Snapshot().Counters() {
+			if counter.Name() == "test.persistence_fencing_violation" {
+				total += counter.Value()
+			}
+		}
+		return total
+	}
+
+	request := &UpdateWorkflowExecutionRequest{
+		RangeID:                10,
+		UpdateWorkflowMutation: WorkflowMutation{ExecutionInfo: &WorkflowExecutionInfo{}},
+	}
+	mockManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(&UpdateWorkflowExecutionResponse{}, nil).Times(1)
+	_, err := client.UpdateWorkflowExecution(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), violations())
+
+	// the write is rejected because another host has taken over the shard
+	mockManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(nil, &ShardOwnershipLostError{}).Times(1)
+	_, err = client.UpdateWorkflowExecution(context.Background(), request)
+	assert.IsType(t, &ShardOwnershipLostError{}, err)
+	assert.Equal(t, int64(1), violations())
+
+	// the request rangeID is stale compared to the shard owner's view
+	rangeID = 11
+	mockManager.EXPECT().CreateFailoverMarkerTasks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	err = client.CreateFailoverMarkerTasks(context.Background(), &CreateFailoverMarkersRequest{RangeID: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), violations())
+}
+
+func TestFencingAudit_Disabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scope := tally.NewTestScope("test", nil)
+	mockManager := NewMockExecutionManager(controller)
+	client := NewWorkflowExecutionPersistenceFencingAuditClient(
+		mockManager,
+		dynamicconfig.GetBoolPropertyFn(false),
+		func() int64 { return 11 },
+		metrics.NewClient(scope, metrics.History),
+		loggerimpl.NewNopLogger(),
+	)
+
+	mockManager.EXPECT().CreateFailoverMarkerTasks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	err := client.CreateFailoverMarkerTasks(context.Background(), &CreateFailoverMarkersRequest{RangeID: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, scope.Snapshot().Counters())
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type (
+	// hedgedReader sends a second attempt of an idempotent read if the first one has not
+	// returned within the hedge delay, the number of second attempts is bounded by a rate limiter
+	hedgedReader struct {
+		delay        dynamicconfig.DurationPropertyFn
+		budget       quotas.Limiter
+		metricClient metrics.Client
+	}
+
+	hedgedResult struct {
+		resp   interface{}
+		err    error
+		hedged bool
+	}
+
+	workflowExecutionHedgedPersistenceClient struct {
+		ExecutionManager
+		reader *hedgedReader
+	}
+
+	historyHedgedPersistenceClient struct {
+		HistoryManager
+		reader *hedgedReader
+	}
+)
+
+var _ ExecutionManager = (*workflowExecutionHedgedPersistenceClient)(nil)
+var _ HistoryManager = (*historyHedgedPersistenceClient)(nil)
+
+// NewWorkflowExecutionPersistenceHedgedClient creates a client which hedges GetWorkflowExecution,
+// the budget limits the hedged attempts and is meant to be shared by all the clients of a host
+func NewWorkflowExecutionPersistenceHedgedClient(
+	persistence ExecutionManager,
+	delay dynamicconfig.DurationPropertyFn,
+	budget quotas.Limiter,
+	metricClient metrics.Client,
+) ExecutionManager {
+	return &workflowExecutionHedgedPersistenceClient{
+		ExecutionManager: persistence,
+		reader:           newHedgedReader(delay, budget, metricClient),
+	}
+}
+
+// NewHistoryPersistenceHedgedClient creates a client which hedges ReadHistoryBranch,
+// the budget limits the hedged attempts and is meant to be shared by all the clients of a host
+func NewHistoryPersistenceHedgedClient(
+	persistence HistoryManager,
+	delay dynamicconfig.DurationPropertyFn,
+	budget quotas.Limiter,
+	metricClient metrics.Client,
+) HistoryManager {
+	return &historyHedgedPersistenceClient{
+		HistoryManager: persistence,
+		reader:         newHedgedReader(delay, budget, metricClient),
+	}
+}
+
+// NewHedgedReadBudget creates the rate limiter bounding the hedged read attempts of a host
+func NewHedgedReadBudget(maxRPS dynamicconfig.IntPropertyFn) quotas.Limiter {
+	return quotas.NewDynamicRateLimiter(func() float64 {
+		return float64(maxRPS())
+	})
+}
+
+func newHedgedReader(
+	delay dynamicconfig.DurationPropertyFn,
+	budget quotas.Limiter,
+	metricClient metrics.Client,
+) *hedgedReader {
+	return &hedgedReader{
+		delay:        delay,
+		budget:       budget,
+		metricClient: metricClient,
+	}
+}
+
+func (p *workflowExecutionHedgedPersistenceClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetWorkflowExecutionResponse, error) {
+	resp, err := p.reader.read(ctx, metrics.PersistenceGetWorkflowExecutionScope, func(ctx context.Context) (interface{}, error) {
+		return p.ExecutionManager.GetWorkflowExecution(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*GetWorkflowExecutionResponse), nil
+}
+
+func (p *historyHedgedPersistenceClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchResponse, error) {
+	resp, err := p.reader.read(ctx, metrics.PersistenceReadHistoryBranchScope, func(ctx context.Context) (interface{}, error) {
+		return p.HistoryManager.ReadHistoryBranch(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ReadHistoryBranchResponse), nil
+}
+
+// read returns the first successful response among the started attempts,
+// or the last error once all started attempts have failed
+func (r *hedgedReader) read(
+	ctx context.Context,
+	scope int,
+	op func(context.Context) (interface{}, error),
+) (interface{}, error) {
+	delay := r.delay()
+	if delay <= 0 {
+		return op(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgedResult, 2)
+	run := func(hedged bool) {
+		resp, err := op(ctx)
+		results <- hedgedResult{resp: resp, err: err, hedged: hedged}
+	}
+	go run(false)
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedgeC := timer.C
+
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			if r.budget.Allow() {
+				r.metricClient.IncCounter(scope, metrics.PersistenceHedgedRequestsCounter)
+				go run(true)
+				pending++
+			}
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				if result.hedged {
+					r.metricClient.IncCounter(scope, metrics.PersistenceHedgedRequestWinsCounter)
+				}
+				return result.resp, nil
+			}
+			if pending == 0 {
+				return nil, result.err
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestHedgedClient_SlowReadIsHedged(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scope := tally.NewTestScope("test", nil)
+	mockManager := NewMockHistoryManager(controller)
+	client := NewHistoryPersistenceHedgedClient(
+		mockManager,
+		dynamicconfig.GetDurationPropertyFn(10*time.Millisecond),
+		NewHedgedReadBudget(dynamicconfig.GetIntPropertyFn(10)),
+		metrics.NewClient(scope, metrics.History),
+	)
+	counter := func(name string) int64 {
+		var total int64
+		for _, counter := range scope.Snapshot().Counters() {
+			if counter.Name() == name {
+				total += counter.Value()
+			}
+		}
+		return total
+	}
+
+	request := &ReadHistoryBranchRequest{BranchToken: []byte("token")}
+	response := &ReadHistoryBranchResponse{NextPageToken: []byte("next")}
+	gomock.InOrder(
+		// the first attempt is stuck until the hedged attempt returns
+		mockManager.EXPECT().ReadHistoryBranch(gomock.Any(), request).DoAndReturn(
+			func(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).Times(1),
+		mockManager.EXPECT().ReadHistoryBranch(gomock.Any(), request).Return(response, nil).Times(1),
+	)
+	resp, err := client.ReadHistoryBranch(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, response, resp)
+	assert.Equal(t, int64(1), counter("test.persistence_hedged_requests"))
+	assert.Equal(t, int64(1), counter("test.persistence_hedged_request_wins"))
+
+	// errors returned before the hedge delay are not hedged
+	mockManager.EXPECT().ReadHistoryBranch(gomock.Any(), request).Return(nil, errors.New("some error")).Times(1)
+	_, err = client.ReadHistoryBranch(context.Background(), request)
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), counter("test.persistence_hedged_requests"))
+}
+
+func TestHedgedClient_Disabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockManager := NewMockExecutionManager(controller)
+	client := NewWorkflowExecutionPersistenceHedgedClient(
+		mockManager,
+		dynamicconfig.GetDurationPropertyFn(0),
+		NewHedgedReadBudget(dynamicconfig.GetIntPropertyFn(10)),
+		metrics.NewClient(tally.NoopScope, metrics.History),
+	)
+
+	request := &GetWorkflowExecutionRequest{DomainID: "some random domain ID"}
+	response := &GetWorkflowExecutionResponse{}
+	mockManager.EXPECT().GetWorkflowExecution(gomock.Any(), request).DoAndReturn(
+		func(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+			time.Sleep(20 * time.Millisecond)
+			return response, nil
+		}).Times(1)
+	resp, err := client.GetWorkflowExecution(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, response, resp)
+}
+
+func TestHedgedClient_BudgetIsShared(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(scope, metrics.History)
+	budget := NewHedgedReadBudget(dynamicconfig.GetIntPropertyFn(1))
+	mockManager1 := NewMockExecutionManager(controller)
+	client1 := NewWorkflowExecutionPersistenceHedgedClient(
+		mockManager1,
+		dynamicconfig.GetDurationPropertyFn(10*time.Millisecond),
+		budget,
+		metricsClient,
+	)
+	mockManager2 := NewMockExecutionManager(controller)
+	client2 := NewWorkflowExecutionPersistenceHedgedClient(
+		mockManager2,
+		dynamicconfig.GetDurationPropertyFn(10*time.Millisecond),
+		budget,
+		metricsClient,
+	)
+
+	request := &GetWorkflowExecutionRequest{DomainID: "some random domain ID"}
+	response := &GetWorkflowExecutionResponse{}
+	slowRead := func(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+			return response, nil
+		}
+	}
+
+	// the first client uses up the budget
+	mockManager1.EXPECT().GetWorkflowExecution(gomock.Any(), request).DoAndReturn(slowRead).Times(2)
+	_, err := client1.GetWorkflowExecution(context.Background(), request)
+	assert.NoError(t, err)
+
+	// so the second one is not allowed to hedge
+	mockManager2.EXPECT().GetWorkflowExecution(gomock.Any(), request).DoAndReturn(slowRead).Times(1)
+	_, err = client2.GetWorkflowExecution(context.Background(), request)
+	assert.NoError(t, err)
+
+	var hedged int64
+	for _, counter := range scope.Snapshot().Counters() {
+		if counter.Name() == "test.persistence_hedged_requests" {
+			hedged += counter.Value()
+		}
+	}
+	assert.Equal(t, int64(1), hedged)
+}
@@ -333,6 +333,10 @@ func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(
 		resp, err = p.persistence.CreateWorkflowExecution(ctx, request)
 		return err
 	}
+	p.emitTaskBatchSize(
+		metrics.PersistenceCreateWorkflowExecutionScope,
+		snapshotTaskCount(&request.NewWorkflowSnapshot),
+	)
 	err := p.call(metrics.PersistenceCreateWorkflowExecutionScope, op)
 	if err != nil {
 		return nil, err
@@ -367,6 +371,10 @@ func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(
 		resp, err = p.persistence.UpdateWorkflowExecution(ctx, request)
 		return err
 	}
+	p.emitTaskBatchSize(
+		metrics.PersistenceUpdateWorkflowExecutionScope,
+		mutationTaskCount(&request.UpdateWorkflowMutation)+snapshotTaskCount(request.NewWorkflowSnapshot),
+	)
 	err := p.call(metrics.PersistenceUpdateWorkflowExecutionScope, op)
 	if err != nil {
 		return nil, err
@@ -384,6 +392,12 @@ func (p *workflowExecutionPersistenceClient) ConflictResolveWorkflowExecution(
 		resp, err = p.persistence.ConflictResolveWorkflowExecution(ctx, request)
 		return err
 	}
+	p.emitTaskBatchSize(
+		metrics.PersistenceConflictResolveWorkflowExecutionScope,
+		snapshotTaskCount(&request.ResetWorkflowSnapshot)+
+			snapshotTaskCount(request.NewWorkflowSnapshot)+
+			mutationTaskCount(request.CurrentWorkflowMutation),
+	)
 	err := p.call(metrics.PersistenceConflictResolveWorkflowExecutionScope, op)
 	if err != nil {
 		return nil, err
@@ -704,9 +718,31 @@ func (p *workflowExecutionPersistenceClient) CreateFailoverMarkerTasks(
 	op := func() error {
 		return p.persistence.CreateFailoverMarkerTasks(ctx, request)
 	}
+	p.emitTaskBatchSize(metrics.PersistenceCreateFailoverMarkerTasksScope, len(request.Markers))
 	return p.call(metrics.PersistenceCreateFailoverMarkerTasksScope, op)
 }
 
+func (p *workflowExecutionPersistenceClient) emitTaskBatchSize(scope int, numTasks int) {
+	if numTasks == 0 {
+		return
+	}
+	p.metricClient.Scope(scope).RecordHistogramValue(metrics.PersistenceTaskBatchSize, float64(numTasks))
+}
+
+func mutationTaskCount(mutation *WorkflowMutation) int {
+	if mutation == nil {
+		return 0
+	}
+	return len(mutation.TransferTasks) + len(mutation.CrossClusterTasks) + len(mutation.ReplicationTasks) + len(mutation.TimerTasks)
+}
+
+func snapshotTaskCount(snapshot *WorkflowSnapshot) int {
+	if snapshot == nil {
+		return 0
+	}
+	return len(snapshot.TransferTasks) + len(snapshot.CrossClusterTasks) + len(snapshot.ReplicationTasks) + len(snapshot.TimerTasks)
+}
+
 func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(
 	ctx context.Context,
 	request *GetTimerIndexTasksRequest,
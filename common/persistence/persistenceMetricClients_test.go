@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestUpdateWorkflowExecution_EmitsTaskBatchSize(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scope := tally.NewTestScope("test", nil)
+	mockManager := NewMockExecutionManager(controller)
+	mockManager.EXPECT().GetShardID().Return(1).AnyTimes()
+	client := NewWorkflowExecutionPersistenceMetricsClient(
+		mockManager,
+		metrics.NewClient(scope, metrics.History),
+		loggerimpl.NewNopLogger(),
+		&config.Persistence{},
+	)
+
+	request := &UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: WorkflowMutation{
+			TransferTasks: []Task{&ActivityTask{}, &ActivityTask{}},
+			TimerTasks:    []Task{&ActivityTimeoutTask{}},
+		},
+		NewWorkflowSnapshot: &WorkflowSnapshot{
+			TransferTasks: []Task{&DecisionTask{}},
+		},
+	}
+	mockManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(&UpdateWorkflowExecutionResponse{}, nil).Times(1)
+
+	_, err := client.UpdateWorkflowExecution(context.Background(), request)
+	assert.NoError(t, err)
+
+	var batchSizes map[float64]int64
+	for _, histogram := range scope.Snapshot().Histograms() {
+		if histogram.Name() == "test.persistence_task_batch_size" {
+			batchSizes = histogram.Values()
+		}
+	}
+	assert.Equal(t, int64(1), batchSizes[4])
+}
@@ -26,6 +26,7 @@ import (
 	"fmt"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -33,9 +34,37 @@ type (
 	parser struct {
 		encoder  encoder
 		decoders map[common.EncodingType]decoder
+
+		// encoders and blobEncodings are only set by NewMigratingParser
+		encoders      map[common.EncodingType]encoder
+		blobEncodings dynamicconfig.MapPropertyFn
 	}
 )
 
+// blob type names, used as keys of the blob encodings map
+const (
+	blobTypeShardInfo             = "ShardInfo"
+	blobTypeDomainInfo            = "DomainInfo"
+	blobTypeHistoryTreeInfo       = "HistoryTreeInfo"
+	blobTypeWorkflowExecutionInfo = "WorkflowExecutionInfo"
+	blobTypeActivityInfo          = "ActivityInfo"
+	blobTypeChildExecutionInfo    = "ChildExecutionInfo"
+	blobTypeSignalInfo            = "SignalInfo"
+	blobTypeRequestCancelInfo     = "RequestCancelInfo"
+	blobTypeTimerInfo             = "TimerInfo"
+	blobTypeTaskInfo              = "TaskInfo"
+	blobTypeTaskListInfo          = "TaskListInfo"
+	blobTypeTransferTaskInfo      = "TransferTaskInfo"
+	blobTypeCrossClusterTaskInfo  = "CrossClusterTaskInfo"
+	blobTypeTimerTaskInfo         = "TimerTaskInfo"
+	blobTypeReplicationTaskInfo   = "ReplicationTaskInfo"
+)
+
+var migratableEncodingTypes = []common.EncodingType{
+	common.EncodingTypeThriftRW,
+	common.EncodingTypeProto,
+}
+
 // NewParser constructs a new parser using encoder as specified by encodingType and using decoders specified by decodingTypes
 func NewParser(encodingType common.EncodingType, decodingTypes ...common.EncodingType) (Parser, error) {
 	encoder, err := getEncoder(encodingType)
@@ -56,168 +85,215 @@ func NewParser(encodingType common.EncodingType, decodingTypes ...common.Encodin
 	}, nil
 }
 
+// NewMigratingParser constructs a parser which writes each blob type with the encoding configured for it by blobEncodings,
+// falling back to encodingType for blob types which are not configured. Blobs of every migratable encoding can be read
+// in addition to decodingTypes, so the write encoding of a blob type can be switched at runtime in either direction.
+func NewMigratingParser(
+	encodingType common.EncodingType,
+	blobEncodings dynamicconfig.MapPropertyFn,
+	decodingTypes ...common.EncodingType,
+) (Parser, error) {
+	p, err := NewParser(encodingType, decodingTypes...)
+	if err != nil {
+		return nil, err
+	}
+	result := p.(*parser)
+	result.blobEncodings = blobEncodings
+	result.encoders = make(map[common.EncodingType]encoder)
+	for _, et := range migratableEncodingTypes {
+		encoder, err := getEncoder(et)
+		if err != nil {
+			return nil, err
+		}
+		result.encoders[et] = encoder
+		if _, ok := result.decoders[et]; !ok {
+			decoder, err := getDecoder(et)
+			if err != nil {
+				return nil, err
+			}
+			result.decoders[et] = decoder
+		}
+	}
+	return result, nil
+}
+
 func (p *parser) ShardInfoToBlob(info *ShardInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.shardInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeShardInfo)
+	data, err := encoder.shardInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) DomainInfoToBlob(info *DomainInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.domainInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeDomainInfo)
+	data, err := encoder.domainInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) HistoryTreeInfoToBlob(info *HistoryTreeInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.historyTreeInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeHistoryTreeInfo)
+	data, err := encoder.historyTreeInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) WorkflowExecutionInfoToBlob(info *WorkflowExecutionInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.workflowExecutionInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeWorkflowExecutionInfo)
+	data, err := encoder.workflowExecutionInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) ActivityInfoToBlob(info *ActivityInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.activityInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeActivityInfo)
+	data, err := encoder.activityInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) ChildExecutionInfoToBlob(info *ChildExecutionInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.childExecutionInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeChildExecutionInfo)
+	data, err := encoder.childExecutionInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) SignalInfoToBlob(info *SignalInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.signalInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeSignalInfo)
+	data, err := encoder.signalInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) RequestCancelInfoToBlob(info *RequestCancelInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.requestCancelInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeRequestCancelInfo)
+	data, err := encoder.requestCancelInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) TimerInfoToBlob(info *TimerInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.timerInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeTimerInfo)
+	data, err := encoder.timerInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) TaskInfoToBlob(info *TaskInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.taskInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeTaskInfo)
+	data, err := encoder.taskInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) TaskListInfoToBlob(info *TaskListInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.taskListInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeTaskListInfo)
+	data, err := encoder.taskListInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) TransferTaskInfoToBlob(info *TransferTaskInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.transferTaskInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeTransferTaskInfo)
+	data, err := encoder.transferTaskInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) CrossClusterTaskInfoToBlob(info *CrossClusterTaskInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.crossClusterTaskInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeCrossClusterTaskInfo)
+	data, err := encoder.crossClusterTaskInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) TimerTaskInfoToBlob(info *TimerTaskInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.timerTaskInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeTimerTaskInfo)
+	data, err := encoder.timerTaskInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
 func (p *parser) ReplicationTaskInfoToBlob(info *ReplicationTaskInfo) (persistence.DataBlob, error) {
 	db := persistence.DataBlob{}
-	data, err := p.encoder.replicationTaskInfoToBlob(info)
+	encoder := p.getEncoder(blobTypeReplicationTaskInfo)
+	data, err := encoder.replicationTaskInfoToBlob(info)
 	if err != nil {
 		return db, err
 	}
 	db.Data = data
-	db.Encoding = p.encoder.encodingType()
+	db.Encoding = encoder.encodingType()
 	return db, nil
 }
 
@@ -349,6 +425,20 @@ func (p *parser) getCachedDecoder(encoding common.EncodingType) (decoder, error)
 	return decoder, nil
 }
 
+func (p *parser) getEncoder(blobType string) encoder {
+	if p.blobEncodings == nil {
+		return p.encoder
+	}
+	encodingType, ok := p.blobEncodings()[blobType].(string)
+	if !ok {
+		return p.encoder
+	}
+	if encoder, ok := p.encoders[common.EncodingType(encodingType)]; ok {
+		return encoder
+	}
+	return p.encoder
+}
+
 func getDecoder(encoding common.EncodingType) (decoder, error) {
 	switch encoding {
 	case common.EncodingTypeThriftRW:
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 )
 
 func TestParse(t *testing.T) {
@@ -44,3 +45,63 @@ func TestParse(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, domainInfo, decodedDomainInfo)
 }
+
+func TestMigratingParser(t *testing.T) {
+	blobEncodings := map[string]interface{}{
+		"DomainInfo": string(common.EncodingTypeProto),
+	}
+	migratingParser, err := NewMigratingParser(
+		common.EncodingTypeThriftRW,
+		func(...dynamicconfig.FilterOption) map[string]interface{} { return blobEncodings },
+		common.EncodingTypeThriftRW,
+	)
+	assert.NoError(t, err)
+	domainInfo := &DomainInfo{
+		Name: "test_name",
+		Data: map[string]string{"test_key": "test_value"},
+	}
+	db, err := migratingParser.DomainInfoToBlob(domainInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeProto, db.Encoding)
+	decodedDomainInfo, err := migratingParser.DomainInfoFromBlob(db.Data, string(db.Encoding))
+	assert.NoError(t, err)
+	assert.Equal(t, domainInfo, decodedDomainInfo)
+
+	// blob types which are not configured keep the default encoding
+	taskInfo := &TaskInfo{WorkflowID: "test_workflow_id"}
+	taskDB, err := migratingParser.TaskInfoToBlob(taskInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeThriftRW, taskDB.Encoding)
+
+	// blobs written before the encoding was switched back are still readable
+	delete(blobEncodings, "DomainInfo")
+	thriftDB, err := migratingParser.DomainInfoToBlob(domainInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeThriftRW, thriftDB.Encoding)
+	decodedDomainInfo, err = migratingParser.DomainInfoFromBlob(db.Data, string(db.Encoding))
+	assert.NoError(t, err)
+	assert.Equal(t, domainInfo, decodedDomainInfo)
+}
+
+func BenchmarkThriftParser_DomainInfo(b *testing.B) {
+	thriftParser, err := NewParser(common.EncodingTypeThriftRW, common.EncodingTypeThriftRW)
+	if err != nil {
+		b.Fatal(err)
+	}
+	domainInfo := &DomainInfo{
+		Name:        "test_name",
+		Description: "test_description",
+		Owner:       "test_owner",
+		Data:        map[string]string{"test_key": "test_value"},
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		db, err := thriftParser.DomainInfoToBlob(domainInfo)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := thriftParser.DomainInfoFromBlob(db.Data, string(db.Encoding)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -22,8 +22,16 @@
 
 package serialization
 
+import (
+	sqlblobsv1 "github.com/uber/cadence/.gen/proto/sqlblobs/v1"
+)
+
 type (
 	protoDecoder struct{}
+
+	protoUnmarshaler interface {
+		Unmarshal([]byte) error
+	}
 )
 
 func newProtoDecoder() decoder {
@@ -31,61 +39,125 @@ func newProtoDecoder() decoder {
 }
 
 func (d *protoDecoder) shardInfoFromBlob(data []byte) (*ShardInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.ShardInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return shardInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) domainInfoFromBlob(data []byte) (*DomainInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.DomainInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return domainInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) historyTreeInfoFromBlob(data []byte) (*HistoryTreeInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.HistoryTreeInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return historyTreeInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) workflowExecutionInfoFromBlob(data []byte) (*WorkflowExecutionInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.WorkflowExecutionInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return workflowExecutionInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) activityInfoFromBlob(data []byte) (*ActivityInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.ActivityInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return activityInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) childExecutionInfoFromBlob(data []byte) (*ChildExecutionInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.ChildExecutionInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return childExecutionInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) signalInfoFromBlob(data []byte) (*SignalInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.SignalInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return signalInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) requestCancelInfoFromBlob(data []byte) (*RequestCancelInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.RequestCancelInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return requestCancelInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) timerInfoFromBlob(data []byte) (*TimerInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.TimerInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return timerInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) taskInfoFromBlob(data []byte) (*TaskInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.TaskInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return taskInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) taskListInfoFromBlob(data []byte) (*TaskListInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.TaskListInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return taskListInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) transferTaskInfoFromBlob(data []byte) (*TransferTaskInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.TransferTaskInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return transferTaskInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) crossClusterTaskInfoFromBlob(data []byte) (*CrossClusterTaskInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.TransferTaskInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return transferTaskInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) timerTaskInfoFromBlob(data []byte) (*TimerTaskInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.TimerTaskInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return timerTaskInfoFromProto(result), nil
 }
 
 func (d *protoDecoder) replicationTaskInfoFromBlob(data []byte) (*ReplicationTaskInfo, error) {
-	panic("not implemented")
+	result := &sqlblobsv1.ReplicationTaskInfo{}
+	if err := protoDecode(data, result); err != nil {
+		return nil, err
+	}
+	return replicationTaskInfoFromProto(result), nil
+}
+
+func protoDecode(data []byte, result protoUnmarshaler) error {
+	return result.Unmarshal(data)
 }
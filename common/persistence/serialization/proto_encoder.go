@@ -26,72 +26,82 @@ import (
 	"github.com/uber/cadence/common"
 )
 
-type protoEncoder struct{}
+type (
+	protoEncoder struct{}
+
+	protoMarshaler interface {
+		Marshal() ([]byte, error)
+	}
+)
 
 func newProtoEncoder() encoder {
 	return &protoEncoder{}
 }
 
-func (e *protoEncoder) shardInfoToBlob(*ShardInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) shardInfoToBlob(info *ShardInfo) ([]byte, error) {
+	return protoEncode(shardInfoToProto(info))
 }
 
-func (e *protoEncoder) domainInfoToBlob(*DomainInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) domainInfoToBlob(info *DomainInfo) ([]byte, error) {
+	return protoEncode(domainInfoToProto(info))
 }
 
-func (e *protoEncoder) historyTreeInfoToBlob(*HistoryTreeInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) historyTreeInfoToBlob(info *HistoryTreeInfo) ([]byte, error) {
+	return protoEncode(historyTreeInfoToProto(info))
 }
 
-func (e *protoEncoder) workflowExecutionInfoToBlob(*WorkflowExecutionInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) workflowExecutionInfoToBlob(info *WorkflowExecutionInfo) ([]byte, error) {
+	return protoEncode(workflowExecutionInfoToProto(info))
 }
 
-func (e *protoEncoder) activityInfoToBlob(*ActivityInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) activityInfoToBlob(info *ActivityInfo) ([]byte, error) {
+	return protoEncode(activityInfoToProto(info))
 }
 
-func (e *protoEncoder) childExecutionInfoToBlob(*ChildExecutionInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) childExecutionInfoToBlob(info *ChildExecutionInfo) ([]byte, error) {
+	return protoEncode(childExecutionInfoToProto(info))
 }
 
-func (e *protoEncoder) signalInfoToBlob(*SignalInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) signalInfoToBlob(info *SignalInfo) ([]byte, error) {
+	return protoEncode(signalInfoToProto(info))
 }
 
-func (e *protoEncoder) requestCancelInfoToBlob(*RequestCancelInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) requestCancelInfoToBlob(info *RequestCancelInfo) ([]byte, error) {
+	return protoEncode(requestCancelInfoToProto(info))
 }
 
-func (e *protoEncoder) timerInfoToBlob(*TimerInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) timerInfoToBlob(info *TimerInfo) ([]byte, error) {
+	return protoEncode(timerInfoToProto(info))
 }
 
-func (e *protoEncoder) taskInfoToBlob(*TaskInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) taskInfoToBlob(info *TaskInfo) ([]byte, error) {
+	return protoEncode(taskInfoToProto(info))
 }
 
-func (e *protoEncoder) taskListInfoToBlob(*TaskListInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) taskListInfoToBlob(info *TaskListInfo) ([]byte, error) {
+	return protoEncode(taskListInfoToProto(info))
 }
 
-func (e *protoEncoder) transferTaskInfoToBlob(*TransferTaskInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) transferTaskInfoToBlob(info *TransferTaskInfo) ([]byte, error) {
+	return protoEncode(transferTaskInfoToProto(info))
 }
 
-func (e *protoEncoder) crossClusterTaskInfoToBlob(*CrossClusterTaskInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) crossClusterTaskInfoToBlob(info *CrossClusterTaskInfo) ([]byte, error) {
+	return protoEncode(transferTaskInfoToProto(info))
 }
 
-func (e *protoEncoder) timerTaskInfoToBlob(*TimerTaskInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) timerTaskInfoToBlob(info *TimerTaskInfo) ([]byte, error) {
+	return protoEncode(timerTaskInfoToProto(info))
 }
 
-func (e *protoEncoder) replicationTaskInfoToBlob(*ReplicationTaskInfo) ([]byte, error) {
-	panic("not implemented")
+func (e *protoEncoder) replicationTaskInfoToBlob(info *ReplicationTaskInfo) ([]byte, error) {
+	return protoEncode(replicationTaskInfoToProto(info))
 }
 
 func (e *protoEncoder) encodingType() common.EncodingType {
 	return common.EncodingTypeProto
 }
+
+func protoEncode(m protoMarshaler) ([]byte, error) {
+	return m.Marshal()
+}
@@ -0,0 +1,183 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serialization
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestShardInfoProto(t *testing.T) {
+	expected := &ShardInfo{
+		StolenSinceRenew:           int32(rand.Intn(1000)),
+		UpdatedAt:                  time.Now(),
+		ReplicationAckLevel:        int64(rand.Intn(1000)),
+		TransferAckLevel:           int64(rand.Intn(1000)),
+		ClusterTransferAckLevel:    map[string]int64{"key_1": int64(rand.Intn(1000))},
+		ClusterTimerAckLevel:       map[string]time.Time{"key_1": time.Now()},
+		Owner:                      "test_owner",
+		TimerProcessingQueueStates: []byte("TimerProcessingQueueStates"),
+		CrossClusterProcessingQueueStatesEncoding: "CrossClusterProcessingQueueStatesEncoding",
+	}
+	actual := shardInfoFromProto(shardInfoToProto(expected))
+	assert.Equal(t, expected.StolenSinceRenew, actual.StolenSinceRenew)
+	assert.Equal(t, expected.UpdatedAt.Sub(actual.UpdatedAt), time.Duration(0))
+	assert.Equal(t, expected.ReplicationAckLevel, actual.ReplicationAckLevel)
+	assert.Equal(t, expected.TransferAckLevel, actual.TransferAckLevel)
+	assert.True(t, actual.TimerAckLevel.IsZero())
+	assert.Equal(t, expected.ClusterTransferAckLevel, actual.ClusterTransferAckLevel)
+	assert.Equal(t, expected.ClusterTimerAckLevel["key_1"].Sub(actual.ClusterTimerAckLevel["key_1"]), time.Duration(0))
+	assert.Equal(t, expected.Owner, actual.Owner)
+	assert.Equal(t, expected.TimerProcessingQueueStates, actual.TimerProcessingQueueStates)
+	assert.Equal(t, expected.CrossClusterProcessingQueueStatesEncoding, actual.CrossClusterProcessingQueueStatesEncoding)
+}
+
+func TestDomainInfoProto(t *testing.T) {
+	expected := &DomainInfo{
+		Name:                     "domain_name",
+		Retention:                time.Hour * 24 * 7,
+		EmitMetric:               true,
+		ArchivalStatus:           int16(rand.Intn(1000)),
+		Clusters:                 []string{"cluster_a", "cluster_b"},
+		Data:                     map[string]string{"key_1": "value_1"},
+		HistoryArchivalURI:       "HistoryArchivalURI",
+		VisibilityArchivalStatus: int16(rand.Intn(1000)),
+		FailoverEndTimestamp:     common.TimePtr(time.Now()),
+		LastUpdatedTimestamp:     time.Now(),
+	}
+	actual := domainInfoFromProto(domainInfoToProto(expected))
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.Retention, actual.Retention)
+	assert.Equal(t, expected.EmitMetric, actual.EmitMetric)
+	assert.Equal(t, expected.ArchivalStatus, actual.ArchivalStatus)
+	assert.Equal(t, expected.Clusters, actual.Clusters)
+	assert.Equal(t, expected.Data, actual.Data)
+	assert.Equal(t, expected.HistoryArchivalURI, actual.HistoryArchivalURI)
+	assert.Equal(t, expected.VisibilityArchivalStatus, actual.VisibilityArchivalStatus)
+	assert.Equal(t, expected.FailoverEndTimestamp.Sub(*actual.FailoverEndTimestamp), time.Duration(0))
+	assert.Equal(t, expected.LastUpdatedTimestamp.Sub(actual.LastUpdatedTimestamp), time.Duration(0))
+
+	actual = domainInfoFromProto(domainInfoToProto(&DomainInfo{Name: "domain_name"}))
+	assert.Nil(t, actual.FailoverEndTimestamp)
+}
+
+func TestHistoryTreeInfoProto(t *testing.T) {
+	expected := &HistoryTreeInfo{
+		CreatedTimestamp: time.Now(),
+		Ancestors: []*types.HistoryBranchRange{
+			{
+				BranchID:    "branch_id",
+				BeginNodeID: int64(rand.Intn(1000)),
+				EndNodeID:   int64(rand.Intn(1000)),
+			},
+		},
+		Info: "info",
+	}
+	actual := historyTreeInfoFromProto(historyTreeInfoToProto(expected))
+	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
+	assert.Equal(t, expected.Ancestors, actual.Ancestors)
+	assert.Equal(t, expected.Info, actual.Info)
+}
+
+func TestWorkflowExecutionInfoProto(t *testing.T) {
+	expected := &WorkflowExecutionInfo{
+		ParentDomainID:          UUID(uuid.New()),
+		InitiatedID:             int64(rand.Intn(1000)),
+		CompletionEventBatchID:  common.Int64Ptr(0),
+		WorkflowTimeout:         time.Minute,
+		LastWriteEventID:        common.Int64Ptr(int64(rand.Intn(1000))),
+		StartTimestamp:          time.Now(),
+		RetryBackoffCoefficient: 2.0,
+		RetryNonRetryableErrors: []string{"error_a"},
+		Memo:                    map[string][]byte{"key_1": []byte("value_1")},
+	}
+	actual := workflowExecutionInfoFromProto(workflowExecutionInfoToProto(expected))
+	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
+	assert.Equal(t, expected.InitiatedID, actual.InitiatedID)
+	assert.Equal(t, expected.CompletionEventBatchID, actual.CompletionEventBatchID)
+	assert.Equal(t, expected.WorkflowTimeout, actual.WorkflowTimeout)
+	assert.Equal(t, expected.LastWriteEventID, actual.LastWriteEventID)
+	assert.Equal(t, expected.StartTimestamp.Sub(actual.StartTimestamp), time.Duration(0))
+	assert.Equal(t, expected.RetryBackoffCoefficient, actual.RetryBackoffCoefficient)
+	assert.Equal(t, expected.RetryNonRetryableErrors, actual.RetryNonRetryableErrors)
+	assert.Equal(t, expected.Memo, actual.Memo)
+	assert.Zero(t, actual.DecisionTimeout)
+}
+
+func TestTransferTaskInfoProto(t *testing.T) {
+	expected := &TransferTaskInfo{
+		DomainID:                UUID(uuid.New()),
+		WorkflowID:              "WorkflowID",
+		RunID:                   UUID(uuid.New()),
+		TaskType:                int16(rand.Intn(1000)),
+		TargetDomainID:          UUID(uuid.New()),
+		TargetDomainIDs:         []UUID{UUID(uuid.New()), UUID(uuid.New())},
+		TargetWorkflowID:        "TargetWorkflowID",
+		TargetRunID:             UUID(uuid.New()),
+		TaskList:                "TaskList",
+		TargetChildWorkflowOnly: true,
+		ScheduleID:              int64(rand.Intn(1000)),
+		Version:                 int64(rand.Intn(1000)),
+	}
+	actual := transferTaskInfoFromProto(transferTaskInfoToProto(expected))
+	assert.Equal(t, expected, actual)
+}
+
+func TestTimerTaskInfoProto(t *testing.T) {
+	expected := &TimerTaskInfo{
+		DomainID:        UUID(uuid.New()),
+		WorkflowID:      "WorkflowID",
+		RunID:           UUID(uuid.New()),
+		TaskType:        int16(rand.Intn(1000)),
+		TimeoutType:     common.Int16Ptr(0),
+		Version:         int64(rand.Intn(1000)),
+		ScheduleAttempt: int64(rand.Intn(1000)),
+		EventID:         int64(rand.Intn(1000)),
+	}
+	actual := timerTaskInfoFromProto(timerTaskInfoToProto(expected))
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtoBlobRoundTrip(t *testing.T) {
+	expected := &ActivityInfo{
+		Version:             int64(rand.Intn(1000)),
+		ScheduledEvent:      []byte("ScheduledEvent"),
+		ActivityID:          "ActivityID",
+		RequestID:           "RequestID",
+		StartToCloseTimeout: time.Second * 30,
+		TaskList:            "TaskList",
+	}
+	data, err := newProtoEncoder().activityInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().activityInfoFromBlob(data)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
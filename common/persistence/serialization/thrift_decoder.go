@@ -23,8 +23,6 @@
 package serialization
 
 import (
-	"bytes"
-
 	"go.uber.org/thriftrw/protocol/binary"
 
 	"github.com/uber/cadence/.gen/go/sqlblobs"
@@ -159,7 +157,9 @@ func (d *thriftDecoder) replicationTaskInfoFromBlob(data []byte) (*ReplicationTa
 }
 
 func thriftRWDecode(b []byte, result thriftRWType) error {
-	buf := bytes.NewReader(b)
+	buf := getReader(b)
+	defer putReader(buf)
+
 	sr := binary.Default.Reader(buf)
 	return result.Decode(sr)
 }
@@ -23,8 +23,6 @@
 package serialization
 
 import (
-	"bytes"
-
 	"go.uber.org/thriftrw/protocol/binary"
 
 	"github.com/uber/cadence/common"
@@ -101,11 +99,16 @@ func (e *thriftEncoder) encodingType() common.EncodingType {
 }
 
 func thriftRWEncode(t thriftRWType) ([]byte, error) {
-	var b bytes.Buffer
-	sw := binary.Default.Writer(&b)
+	b := getBuffer()
+	defer putBuffer(b)
+
+	sw := binary.Default.Writer(b)
 	defer sw.Close()
 	if err := t.Encode(sw); err != nil {
 		return nil, err
 	}
-	return b.Bytes(), nil
+	// the pooled buffer is reused, so the blob has to be copied out
+	blob := make([]byte, b.Len())
+	copy(blob, b.Bytes())
+	return blob, nil
 }
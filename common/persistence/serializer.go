@@ -21,9 +21,14 @@
 package persistence
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
+	"go.uber.org/thriftrw/protocol/binary"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+
 	"github.com/uber/cadence/.gen/go/config"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/replicator"
@@ -41,6 +46,9 @@ type (
 		// serialize/deserialize history events
 		SerializeBatchEvents(batch []*types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBatchEvents(data *DataBlob) ([]*types.HistoryEvent, error)
+		// DeserializeBatchEventsLazy only decodes the event headers (ID, version, etc.),
+		// the rest of each event is decoded when it is accessed
+		DeserializeBatchEventsLazy(data *DataBlob) ([]*LazyHistoryEvent, error)
 
 		// serialize/deserialize a single history event
 		SerializeEvent(event *types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error)
@@ -75,6 +83,20 @@ type (
 		DeserializeDynamicConfigBlob(data *DataBlob) (*types.DynamicConfigBlob, error)
 	}
 
+	// LazyHistoryEvent is a history event whose ID, timestamp, type, version and task ID are decoded upfront,
+	// while the rest of the event is only decoded on the first call to Event.
+	// It references the data blob it is decoded from and is not safe for concurrent use.
+	LazyHistoryEvent struct {
+		ID        int64
+		Timestamp *int64
+		EventType *types.EventType
+		Version   int64
+		TaskID    int64
+
+		payload []byte
+		event   *types.HistoryEvent
+	}
+
 	// CadenceSerializationError is an error type for cadence serialization
 	CadenceSerializationError struct {
 		msg string
@@ -91,10 +113,20 @@ type (
 	}
 
 	serializerImpl struct {
-		thriftrwEncoder codec.BinaryEncoder
+		thriftrwEncoder *codec.ThriftRWEncoder
 	}
 )
 
+// thrift field IDs of the history event headers decoded by DeserializeBatchEventsLazy
+const (
+	historyEventsFieldID         int16 = 10
+	historyEventIDFieldID        int16 = 10
+	historyEventTimestampFieldID int16 = 20
+	historyEventTypeFieldID      int16 = 30
+	historyEventVersionFieldID   int16 = 35
+	historyEventTaskIDFieldID    int16 = 36
+)
+
 // NewPayloadSerializer returns a PayloadSerializer
 func NewPayloadSerializer() PayloadSerializer {
 	return &serializerImpl{
@@ -118,6 +150,35 @@ func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*types.Histor
 	return events, err
 }
 
+func (t *serializerImpl) DeserializeBatchEventsLazy(data *DataBlob) ([]*LazyHistoryEvent, error) {
+	if data == nil || len(data.Data) == 0 {
+		return nil, nil
+	}
+	if data.GetEncoding() != common.EncodingTypeThriftRW {
+		// partial decoding is only supported by thriftrw, other encodings are decoded upfront
+		events, err := t.DeserializeBatchEvents(data)
+		if err != nil {
+			return nil, err
+		}
+		lazyEvents := make([]*LazyHistoryEvent, 0, len(events))
+		for _, event := range events {
+			lazyEvents = append(lazyEvents, newDecodedLazyHistoryEvent(event))
+		}
+		return lazyEvents, nil
+	}
+
+	var events []*LazyHistoryEvent
+	err := t.thriftrwEncoder.DecodeStream(data.Data, func(sr stream.Reader, offsetFn func() int) error {
+		var err error
+		events, err = decodeLazyHistory(sr, offsetFn, data.Data)
+		return err
+	})
+	if err != nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("DeserializeBatchEventsLazy encoding: \"%v\", error: %v", data.Encoding, err.Error()))
+	}
+	return events, nil
+}
+
 func (t *serializerImpl) SerializeEvent(event *types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
 	if event == nil {
 		return nil, nil
@@ -403,6 +464,161 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 	}
 }
 
+// Event returns the fully decoded history event
+func (e *LazyHistoryEvent) Event() (*types.HistoryEvent, error) {
+	if e.event != nil {
+		return e.event, nil
+	}
+
+	thriftEvent := workflow.HistoryEvent{}
+	sr := binary.Default.Reader(bytes.NewReader(e.payload))
+	defer sr.Close()
+	if err := thriftEvent.Decode(sr); err != nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("DeserializeEvent error: %v", err.Error()))
+	}
+	e.event = thrift.ToHistoryEvent(&thriftEvent)
+	e.payload = nil
+	return e.event, nil
+}
+
+// DecodeLazyHistoryEvents fully decodes the lazy history events
+func DecodeLazyHistoryEvents(lazyEvents []*LazyHistoryEvent) ([]*types.HistoryEvent, error) {
+	events := make([]*types.HistoryEvent, 0, len(lazyEvents))
+	for _, lazyEvent := range lazyEvents {
+		event, err := lazyEvent.Event()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func newDecodedLazyHistoryEvent(event *types.HistoryEvent) *LazyHistoryEvent {
+	return &LazyHistoryEvent{
+		ID:        event.ID,
+		Timestamp: event.Timestamp,
+		EventType: event.EventType,
+		Version:   event.Version,
+		TaskID:    event.TaskID,
+		event:     event,
+	}
+}
+
+// decodeLazyHistory walks a thrift encoded History struct, only decoding the headers of its events
+// and keeping the encoded bytes of each event so that it can be decoded later
+func decodeLazyHistory(
+	sr stream.Reader,
+	offsetFn func() int,
+	data []byte,
+) ([]*LazyHistoryEvent, error) {
+
+	var events []*LazyHistoryEvent
+	if err := sr.ReadStructBegin(); err != nil {
+		return nil, err
+	}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return nil, err
+	}
+	for ok {
+		if fh.ID == historyEventsFieldID && fh.Type == wire.TList {
+			if events, err = decodeLazyHistoryEventList(sr, offsetFn, data); err != nil {
+				return nil, err
+			}
+		} else if err := sr.Skip(fh.Type); err != nil {
+			return nil, err
+		}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return nil, err
+		}
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return nil, err
+		}
+	}
+	if err := sr.ReadStructEnd(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func decodeLazyHistoryEventList(
+	sr stream.Reader,
+	offsetFn func() int,
+	data []byte,
+) ([]*LazyHistoryEvent, error) {
+
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+	if lh.Type != wire.TStruct {
+		return nil, fmt.Errorf("unexpected history event list element type: %v", lh.Type)
+	}
+
+	events := make([]*LazyHistoryEvent, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		start := offsetFn()
+		event, err := decodeLazyHistoryEvent(sr)
+		if err != nil {
+			return nil, err
+		}
+		event.payload = data[start:offsetFn()]
+		events = append(events, event)
+	}
+	if err := sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func decodeLazyHistoryEvent(
+	sr stream.Reader,
+) (*LazyHistoryEvent, error) {
+
+	event := &LazyHistoryEvent{}
+	if err := sr.ReadStructBegin(); err != nil {
+		return nil, err
+	}
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return nil, err
+	}
+	for ok {
+		switch {
+		case fh.ID == historyEventIDFieldID && fh.Type == wire.TI64:
+			event.ID, err = sr.ReadInt64()
+		case fh.ID == historyEventTimestampFieldID && fh.Type == wire.TI64:
+			var timestamp int64
+			timestamp, err = sr.ReadInt64()
+			event.Timestamp = &timestamp
+		case fh.ID == historyEventTypeFieldID && fh.Type == wire.TI32:
+			var eventType int32
+			eventType, err = sr.ReadInt32()
+			event.EventType = thrift.ToEventType(workflow.EventType(eventType).Ptr())
+		case fh.ID == historyEventVersionFieldID && fh.Type == wire.TI64:
+			event.Version, err = sr.ReadInt64()
+		case fh.ID == historyEventTaskIDFieldID && fh.Type == wire.TI64:
+			event.TaskID, err = sr.ReadInt64()
+		default:
+			err = sr.Skip(fh.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return nil, err
+		}
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return nil, err
+		}
+	}
+	if err := sr.ReadStructEnd(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType common.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
@@ -528,3 +528,62 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestDeserializeBatchEventsLazy() {
+	serializer := NewPayloadSerializer()
+
+	events := []*types.HistoryEvent{
+		{
+			ID:        3,
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: types.EventTypeDecisionTaskStarted.Ptr(),
+			Version:   12,
+			TaskID:    1001,
+			DecisionTaskStartedEventAttributes: &types.DecisionTaskStartedEventAttributes{
+				ScheduledEventID: 2,
+				Identity:         "worker",
+				RequestID:        "request-id",
+			},
+		},
+		{
+			ID:        4,
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+			Version:   12,
+			TaskID:    1002,
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result:           []byte("result"),
+				ScheduledEventID: 5,
+				StartedEventID:   6,
+				Identity:         "worker",
+			},
+		},
+	}
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+		blob, err := serializer.SerializeBatchEvents(events, encodingType)
+		s.Nil(err)
+
+		lazyEvents, err := serializer.DeserializeBatchEventsLazy(blob)
+		s.Nil(err)
+		s.Len(lazyEvents, len(events))
+		for i, lazyEvent := range lazyEvents {
+			s.Equal(events[i].ID, lazyEvent.ID)
+			s.Equal(events[i].Timestamp, lazyEvent.Timestamp)
+			s.Equal(events[i].EventType, lazyEvent.EventType)
+			s.Equal(events[i].Version, lazyEvent.Version)
+			s.Equal(events[i].TaskID, lazyEvent.TaskID)
+		}
+
+		decodedEvents, err := DecodeLazyHistoryEvents(lazyEvents)
+		s.Nil(err)
+		s.Equal(events, decodedEvents)
+	}
+
+	lazyEvents, err := serializer.DeserializeBatchEventsLazy(nil)
+	s.Nil(err)
+	s.Nil(lazyEvents)
+
+	_, err = serializer.DeserializeBatchEventsLazy(NewDataBlob([]byte{1, 2, 3}, common.EncodingTypeThriftRW))
+	s.IsType(&CadenceDeserializationError{}, err)
+}
@@ -35,7 +35,6 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/log"
-	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/serialization"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
@@ -1234,26 +1233,26 @@ func (m *sqlExecutionStore) CreateFailoverMarkerTasks(
 ) error {
 	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(m.shardID, m.db.GetTotalNumDBShards())
 	return m.txExecuteShardLocked(dbShardID, ctx, "CreateFailoverMarkerTasks", request.RangeID, func(tx sqlplugin.Tx) error {
+		// markers belong to different domains, so rows are built per marker and inserted in one statement
+		var rows []sqlplugin.ReplicationTasksRow
 		for _, task := range request.Markers {
-			t := []p.Task{task}
-			if err := createReplicationTasks(
-				ctx,
-				tx,
-				t,
+			markerRows, err := replicationTasksToRows(
+				[]p.Task{task},
 				m.shardID,
 				serialization.MustParseUUID(task.DomainID),
 				emptyWorkflowID,
 				serialization.MustParseUUID(emptyReplicationRunID),
 				m.parser,
-			); err != nil {
-				rollBackErr := tx.Rollback()
-				if rollBackErr != nil {
-					m.logger.Error("transaction rollback error", tag.Error(rollBackErr))
-				}
+			)
+			if err != nil {
 				return err
 			}
+			rows = append(rows, markerRows...)
 		}
-		return nil
+		if len(rows) == 0 {
+			return nil
+		}
+		return insertReplicationTasks(ctx, tx, rows)
 	})
 }
 
@@ -1407,6 +1407,29 @@ func createReplicationTasks(
 	if len(replicationTasks) == 0 {
 		return nil
 	}
+	replicationTasksRows, err := replicationTasksToRows(
+		replicationTasks,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+		parser,
+	)
+	if err != nil {
+		return err
+	}
+	return insertReplicationTasks(ctx, tx, replicationTasksRows)
+}
+
+func replicationTasksToRows(
+	replicationTasks []p.Task,
+	shardID int,
+	domainID serialization.UUID,
+	workflowID string,
+	runID serialization.UUID,
+	parser serialization.Parser,
+) ([]sqlplugin.ReplicationTasksRow, error) {
+
 	replicationTasksRows := make([]sqlplugin.ReplicationTasksRow, len(replicationTasks))
 
 	for i, task := range replicationTasks {
@@ -1421,7 +1444,7 @@ func createReplicationTasks(
 		case p.ReplicationTaskTypeHistory:
 			historyReplicationTask, ok := task.(*p.HistoryReplicationTask)
 			if !ok {
-				return &types.InternalServiceError{
+				return nil, &types.InternalServiceError{
 					Message: fmt.Sprintf("createReplicationTasks failed. Failed to cast %v to HistoryReplicationTask", task),
 				}
 			}
@@ -1439,7 +1462,7 @@ func createReplicationTasks(
 			version = task.GetVersion()
 
 		default:
-			return &types.InternalServiceError{
+			return nil, &types.InternalServiceError{
 				Message: fmt.Sprintf("Unknown replication task: %v", task.GetType()),
 			}
 		}
@@ -1460,7 +1483,7 @@ func createReplicationTasks(
 			CreationTimestamp:       task.GetVisibilityTimestamp(),
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		replicationTasksRows[i].ShardID = shardID
 		replicationTasksRows[i].TaskID = task.GetTaskID()
@@ -1468,6 +1491,15 @@ func createReplicationTasks(
 		replicationTasksRows[i].DataEncoding = string(blob.Encoding)
 	}
 
+	return replicationTasksRows, nil
+}
+
+func insertReplicationTasks(
+	ctx context.Context,
+	tx sqlplugin.Tx,
+	replicationTasksRows []sqlplugin.ReplicationTasksRow,
+) error {
+
 	result, err := tx.InsertIntoReplicationTasks(ctx, replicationTasksRows)
 	if err != nil {
 		return convertCommonErrors(tx, "createReplicationTasks", "", err)
@@ -1480,9 +1512,9 @@ func createReplicationTasks(
 		}
 	}
 
-	if int(rowsAffected) != len(replicationTasks) {
+	if int(rowsAffected) != len(replicationTasksRows) {
 		return &types.InternalServiceError{
-			Message: fmt.Sprintf("createReplicationTasks failed. Inserted %v instead of %v rows into transfer_tasks. Error: %v", rowsAffected, len(replicationTasks), err),
+			Message: fmt.Sprintf("createReplicationTasks failed. Inserted %v instead of %v rows into transfer_tasks. Error: %v", rowsAffected, len(replicationTasksRows), err),
 		}
 	}
 
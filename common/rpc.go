@@ -47,6 +47,9 @@ const (
 	ClientImplHeaderName = "cadence-client-name"
 	// AuthorizationTokenHeaderName refers to the jwt token in the request
 	AuthorizationTokenHeaderName = "cadence-authorization"
+	// PriorityClassHeaderName refers to the priority class of an internal request,
+	// used to shed lower priority work first under overload
+	PriorityClassHeaderName = "cadence-priority-class"
 )
 
 type (
@@ -125,8 +125,8 @@ func (d *Factory) GetMaxMessageSize() int {
 	return d.maxMessageSize
 }
 
-func createDialer(transport *grpc.Transport, tlsConfig *tls.Config) *grpc.Dialer {
-	var dialOptions []grpc.DialOption
+func createDialer(transport *grpc.Transport, tlsConfig *tls.Config, options ...grpc.DialOption) *grpc.Dialer {
+	dialOptions := options
 	if tlsConfig != nil {
 		dialOptions = append(dialOptions, grpc.DialerCredentials(credentials.NewTLS(tlsConfig)))
 	}
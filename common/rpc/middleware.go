@@ -23,13 +23,25 @@ package rpc
 import (
 	"context"
 	"io"
+	"sync/atomic"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 
 	"go.uber.org/cadence/worker"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+const (
+	// PriorityClassUser is the priority class of user facing requests, it is assumed when no class is set
+	PriorityClassUser = "user"
+	// PriorityClassReplication is the priority class of cross cluster replication requests
+	PriorityClassReplication = "replication"
+	// PriorityClassBackground is the priority class of background work, like scanners and archival
+	PriorityClassBackground = "background"
 )
 
 type authOutboundMiddleware struct {
@@ -53,7 +65,10 @@ func (m *authOutboundMiddleware) Call(ctx context.Context, request *transport.Re
 
 type contextKey string
 
-const _responseInfoContextKey = contextKey("response-info")
+const (
+	_responseInfoContextKey  = contextKey("response-info")
+	_priorityClassContextKey = contextKey("priority-class")
+)
 
 // ContextWithResponseInfo will create a child context that has ResponseInfo set as value.
 // This value will get filled after the call is made and can be used later to retrieve some info of interest.
@@ -139,3 +154,61 @@ func (m *HeaderForwardingMiddleware) Call(ctx context.Context, request *transpor
 
 	return out.Call(ctx, request)
 }
+
+// ContextWithPriorityClass will create a child context whose outbound calls are tagged with the given priority class.
+func ContextWithPriorityClass(parent context.Context, priorityClass string) context.Context {
+	return context.WithValue(parent, _priorityClassContextKey, priorityClass)
+}
+
+// PriorityClassMiddleware sets the priority class header of outbound calls, from the context if present,
+// otherwise to the default class unless the header is already set (e.g. forwarded from the inbound call).
+type PriorityClassMiddleware struct {
+	DefaultClass string
+}
+
+func (m *PriorityClassMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	if priorityClass, ok := ctx.Value(_priorityClassContextKey).(string); ok {
+		request.Headers = request.Headers.With(common.PriorityClassHeaderName, priorityClass)
+	} else if _, exists := request.Headers.Get(common.PriorityClassHeaderName); !exists && m.DefaultClass != "" {
+		request.Headers = request.Headers.With(common.PriorityClassHeaderName, m.DefaultClass)
+	}
+	return out.Call(ctx, request)
+}
+
+// AdmissionControlMiddleware rejects inbound requests of replication and background priority classes
+// once the number of in-flight requests on the host reaches the threshold of their class.
+// User facing requests are never rejected.
+type AdmissionControlMiddleware struct {
+	backgroundThreshold  dynamicconfig.IntPropertyFn
+	replicationThreshold dynamicconfig.IntPropertyFn
+	inflight             int64
+}
+
+// NewAdmissionControlMiddleware creates an inbound middleware shedding lower priority requests first
+func NewAdmissionControlMiddleware(
+	backgroundThreshold dynamicconfig.IntPropertyFn,
+	replicationThreshold dynamicconfig.IntPropertyFn,
+) *AdmissionControlMiddleware {
+	return &AdmissionControlMiddleware{
+		backgroundThreshold:  backgroundThreshold,
+		replicationThreshold: replicationThreshold,
+	}
+}
+
+func (m *AdmissionControlMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
+	inflight := atomic.AddInt64(&m.inflight, 1)
+	defer atomic.AddInt64(&m.inflight, -1)
+
+	priorityClass, _ := req.Headers.Get(common.PriorityClassHeaderName)
+	var threshold int
+	switch priorityClass {
+	case PriorityClassBackground:
+		threshold = m.backgroundThreshold()
+	case PriorityClassReplication:
+		threshold = m.replicationThreshold()
+	}
+	if threshold > 0 && inflight > int64(threshold) {
+		return yarpcerrors.ResourceExhaustedErrorf("%s request rejected, %d requests in flight", priorityClass, inflight-1)
+	}
+	return h.Handle(ctx, req, resw)
+}
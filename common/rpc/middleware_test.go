@@ -29,9 +29,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/yarpcerrors"
 	"go.uber.org/yarpc/yarpctest"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 )
 
@@ -123,6 +125,56 @@ func TestHeaderForwardingMiddleware(t *testing.T) {
 
 }
 
+func TestPriorityClassMiddleware(t *testing.T) {
+	m := PriorityClassMiddleware{}
+	_, err := m.Call(context.Background(), &transport.Request{}, &fakeOutbound{verify: func(r *transport.Request) {
+		assert.Empty(t, r.Headers.Items())
+	}})
+	assert.NoError(t, err)
+
+	// default class does not override a forwarded header
+	m = PriorityClassMiddleware{DefaultClass: PriorityClassBackground}
+	request := &transport.Request{Headers: transport.NewHeaders().With(common.PriorityClassHeaderName, PriorityClassReplication)}
+	_, err = m.Call(context.Background(), request, &fakeOutbound{verify: func(r *transport.Request) {
+		assert.Equal(t, PriorityClassReplication, r.Headers.Items()[common.PriorityClassHeaderName])
+	}})
+	assert.NoError(t, err)
+	_, err = m.Call(context.Background(), &transport.Request{}, &fakeOutbound{verify: func(r *transport.Request) {
+		assert.Equal(t, PriorityClassBackground, r.Headers.Items()[common.PriorityClassHeaderName])
+	}})
+	assert.NoError(t, err)
+
+	// class from context takes precedence
+	ctx := ContextWithPriorityClass(context.Background(), PriorityClassUser)
+	_, err = m.Call(ctx, request, &fakeOutbound{verify: func(r *transport.Request) {
+		assert.Equal(t, PriorityClassUser, r.Headers.Items()[common.PriorityClassHeaderName])
+	}})
+	assert.NoError(t, err)
+}
+
+func TestAdmissionControlMiddleware(t *testing.T) {
+	m := NewAdmissionControlMiddleware(dynamicconfig.GetIntPropertyFn(1), dynamicconfig.GetIntPropertyFn(2))
+	requestOf := func(priorityClass string) *transport.Request {
+		return &transport.Request{Headers: transport.NewHeaders().With(common.PriorityClassHeaderName, priorityClass)}
+	}
+
+	assert.NoError(t, m.Handle(context.Background(), requestOf(PriorityClassBackground), nil, &fakeHandler{}))
+
+	// one request is in flight
+	m.inflight = 1
+	err := m.Handle(context.Background(), requestOf(PriorityClassBackground), nil, &fakeHandler{})
+	assert.True(t, yarpcerrors.IsResourceExhausted(err))
+	assert.NoError(t, m.Handle(context.Background(), requestOf(PriorityClassReplication), nil, &fakeHandler{}))
+	assert.NoError(t, m.Handle(context.Background(), &transport.Request{}, nil, &fakeHandler{}))
+
+	// two requests are in flight
+	m.inflight = 2
+	err = m.Handle(context.Background(), requestOf(PriorityClassReplication), nil, &fakeHandler{})
+	assert.True(t, yarpcerrors.IsResourceExhausted(err))
+	assert.NoError(t, m.Handle(context.Background(), requestOf(PriorityClassUser), nil, &fakeHandler{}))
+	assert.Equal(t, int64(2), m.inflight)
+}
+
 type fakeHandler struct {
 	ctx context.Context
 }
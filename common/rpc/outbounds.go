@@ -32,9 +32,13 @@ import (
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/transport"
+	yarpcgrpccompressor "go.uber.org/yarpc/compressor/grpc"
+	yarpcgzip "go.uber.org/yarpc/compressor/gzip"
 	"go.uber.org/yarpc/peer/direct"
 	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/keepalive"
 )
 
 const (
@@ -44,6 +48,14 @@ const (
 	crossDCCaller = "cadence-xdc-client"
 )
 
+// gzipCompressor is used by cross DC outbounds configured with gzip rpcCompression.
+// It is registered with gRPC so that inbounds are able to decompress such requests.
+var gzipCompressor = yarpcgzip.New()
+
+func init() {
+	encoding.RegisterCompressor(yarpcgrpccompressor.New(gzipCompressor))
+}
+
 // OutboundsBuilder allows defining outbounds for the dispatcher
 type OutboundsBuilder interface {
 	Build(*grpc.Transport, *tchannel.Transport) (yarpc.Outbounds, error)
@@ -150,7 +162,11 @@ func (b crossDCOutbounds) Build(grpcTransport *grpc.Transport, tchannelTransport
 			if err != nil {
 				return nil, err
 			}
-			peerChooser, err := b.pcf.CreatePeerChooser(createDialer(grpcTransport, tlsConfig), clusterInfo.RPCAddress)
+			var dialOptions []grpc.DialOption
+			if clusterInfo.RPCCompression == config.GzipCompression {
+				dialOptions = append(dialOptions, grpc.Compressor(gzipCompressor))
+			}
+			peerChooser, err := b.pcf.CreatePeerChooser(createDialer(grpcTransport, tlsConfig, dialOptions...), clusterInfo.RPCAddress)
 			if err != nil {
 				return nil, err
 			}
@@ -183,16 +199,17 @@ type directOutbound struct {
 	serviceName string
 	grpcEnabled bool
 	tlsConfig   *tls.Config
+	dialOptions []grpc.DialOption
 }
 
-func NewDirectOutbound(serviceName string, grpcEnabled bool, tlsConfig *tls.Config) OutboundsBuilder {
-	return directOutbound{serviceName, grpcEnabled, tlsConfig}
+func NewDirectOutbound(serviceName string, grpcEnabled bool, tlsConfig *tls.Config, dialOptions ...grpc.DialOption) OutboundsBuilder {
+	return directOutbound{serviceName, grpcEnabled, tlsConfig, dialOptions}
 }
 
 func (o directOutbound) Build(grpc *grpc.Transport, tchannel *tchannel.Transport) (yarpc.Outbounds, error) {
 	var outbound transport.UnaryOutbound
 	if o.grpcEnabled {
-		directChooser, err := direct.New(direct.Configuration{}, createDialer(grpc, o.tlsConfig))
+		directChooser, err := direct.New(direct.Configuration{}, createDialer(grpc, o.tlsConfig, o.dialOptions...))
 		if err != nil {
 			return nil, err
 		}
@@ -213,6 +230,17 @@ func (o directOutbound) Build(grpc *grpc.Transport, tchannel *tchannel.Transport
 	}, nil
 }
 
+func keepaliveDialOptions(cfg config.GRPCKeepalive) []grpc.DialOption {
+	if cfg.Time <= 0 {
+		return nil
+	}
+	return []grpc.DialOption{grpc.KeepaliveParams(keepalive.ClientParameters{
+		Time:                cfg.Time,
+		Timeout:             cfg.Timeout,
+		PermitWithoutStream: cfg.PermitWithoutStream,
+	})}
+}
+
 func IsGRPCOutbound(config transport.ClientConfig) bool {
 	namer, ok := config.GetUnaryOutbound().(transport.Namer)
 	if !ok {
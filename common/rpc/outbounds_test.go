@@ -24,6 +24,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/service"
@@ -145,14 +146,17 @@ func TestCrossDCOutbounds(t *testing.T) {
 		"cluster-A": {Enabled: true, RPCName: "cadence-frontend", RPCAddress: "address-A", RPCTransport: "grpc", AuthorizationProvider: config.AuthorizationProvider{Enable: true, PrivateKey: tempFile(t, "key")}},
 		"cluster-B": {Enabled: true, RPCName: "cadence-frontend", RPCAddress: "address-B", RPCTransport: "tchannel"},
 		"cluster-C": {Enabled: false},
+		"cluster-D": {Enabled: true, RPCName: "cadence-frontend", RPCAddress: "address-D", RPCTransport: "grpc", RPCCompression: "gzip"},
 	}
 	outbounds, err := NewCrossDCOutbounds(clusterGroup, &fakePeerChooserFactory{}).Build(grpc, tchannel)
 	assert.NoError(t, err)
-	assert.Equal(t, 2, len(outbounds))
+	assert.Equal(t, 3, len(outbounds))
 	assert.Equal(t, "cadence-frontend", outbounds["cluster-A"].ServiceName)
 	assert.Equal(t, "cadence-frontend", outbounds["cluster-B"].ServiceName)
+	assert.Equal(t, "cadence-frontend", outbounds["cluster-D"].ServiceName)
 	assert.NotNil(t, outbounds["cluster-A"].Unary)
 	assert.NotNil(t, outbounds["cluster-B"].Unary)
+	assert.NotNil(t, outbounds["cluster-D"].Unary)
 }
 
 func TestDirectOutbound(t *testing.T) {
@@ -170,6 +174,15 @@ func TestDirectOutbound(t *testing.T) {
 	assert.NotNil(t, outbounds["cadence-history"].Unary)
 }
 
+func TestKeepaliveDialOptions(t *testing.T) {
+	assert.Empty(t, keepaliveDialOptions(config.GRPCKeepalive{}))
+	assert.Len(t, keepaliveDialOptions(config.GRPCKeepalive{Time: time.Minute, Timeout: 20 * time.Second, PermitWithoutStream: true}), 1)
+
+	outbounds, err := NewDirectOutbound("cadence-history", true, nil, keepaliveDialOptions(config.GRPCKeepalive{Time: time.Minute})...).Build(&grpc.Transport{}, &tchannel.Transport{})
+	assert.NoError(t, err)
+	assert.NotNil(t, outbounds["cadence-history"].Unary)
+}
+
 func TestIsGRPCOutboud(t *testing.T) {
 	assert.True(t, IsGRPCOutbound(&transport.OutboundConfig{Outbounds: transport.Outbounds{Unary: (&grpc.Transport{}).NewSingleOutbound("localhost:1234")}}))
 	assert.False(t, IsGRPCOutbound(&transport.OutboundConfig{Outbounds: transport.Outbounds{Unary: (&tchannel.Transport{}).NewSingleOutbound("localhost:1234")}}))
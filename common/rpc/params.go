@@ -78,6 +78,7 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 	}
 
 	enableGRPCOutbound := dc.GetBoolProperty(dynamicconfig.EnableGRPCOutbound)()
+	keepaliveOptions := keepaliveDialOptions(serviceConfig.RPC.Keepalive)
 
 	publicClientOutbound, err := newPublicClientOutbound(config)
 	if err != nil {
@@ -90,21 +91,39 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 		GRPCAddress:     net.JoinHostPort(listenIP.String(), strconv.Itoa(int(serviceConfig.RPC.GRPCPort))),
 		GRPCMaxMsgSize:  serviceConfig.RPC.GRPCMaxMsgSize,
 		OutboundsBuilder: CombineOutbounds(
-			NewDirectOutbound(service.History, enableGRPCOutbound, outboundTLS[service.History]),
-			NewDirectOutbound(service.Matching, enableGRPCOutbound, outboundTLS[service.Matching]),
+			NewDirectOutbound(service.History, enableGRPCOutbound, outboundTLS[service.History], keepaliveOptions...),
+			NewDirectOutbound(service.Matching, enableGRPCOutbound, outboundTLS[service.Matching], keepaliveOptions...),
 			publicClientOutbound,
 		),
 		InboundTLS:  inboundTLS,
 		OutboundTLS: outboundTLS,
 		InboundMiddleware: yarpc.InboundMiddleware{
-			Unary: &InboundMetricsMiddleware{},
+			Unary: yarpc.UnaryInboundMiddleware(
+				&InboundMetricsMiddleware{},
+				NewAdmissionControlMiddleware(
+					dc.GetIntProperty(dynamicconfig.RPCShedBackgroundInflightThreshold),
+					dc.GetIntProperty(dynamicconfig.RPCShedReplicationInflightThreshold),
+				),
+			),
 		},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
-			Unary: &HeaderForwardingMiddleware{},
+			Unary: yarpc.UnaryOutboundMiddleware(
+				&HeaderForwardingMiddleware{},
+				&PriorityClassMiddleware{DefaultClass: defaultPriorityClass(serviceName)},
+			),
 		},
 	}, nil
 }
 
+// defaultPriorityClass returns the priority class of calls made by a service which are not
+// on behalf of an inbound request, all calls from the worker service are background work
+func defaultPriorityClass(serviceName string) string {
+	if serviceName == service.Worker {
+		return PriorityClassBackground
+	}
+	return ""
+}
+
 func getListenIP(config config.RPC) (net.IP, error) {
 	if config.BindOnLocalHost && len(config.BindOnIP) > 0 {
 		return nil, fmt.Errorf("bindOnLocalHost and bindOnIP are mutually exclusive")
@@ -301,3 +301,11 @@ type ListDynamicConfigRequest struct {
 type ListDynamicConfigResponse struct {
 	Entries []*DynamicConfigEntry `json:"entries,omitempty"`
 }
+
+// GetEntries is an internal getter (TBD...)
+func (v *ListDynamicConfigResponse) GetEntries() (o []*DynamicConfigEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+	return
+}
@@ -21,9 +21,17 @@
 package types
 
 import (
+	"strings"
+	"time"
+
 	"go.uber.org/zap/zapcore"
 )
 
+const (
+	serviceBusyRetryAfterPrefix = " (retry after "
+	serviceBusyRetryAfterSuffix = ")"
+)
+
 func (err AccessDeniedError) Error() string {
 	return err.Message
 }
@@ -135,6 +143,33 @@ func (err ServiceBusyError) Error() string {
 	return err.Message
 }
 
+// EncodeServiceBusyMessage returns the wire message for a ServiceBusyError.
+// The IDL has no field for the retry hint, so a non-zero RetryAfter is carried
+// as a suffix on the message and recovered by DecodeServiceBusyMessage.
+func EncodeServiceBusyMessage(err *ServiceBusyError) string {
+	if err.GetRetryAfter() <= 0 {
+		return err.Message
+	}
+	return err.Message + serviceBusyRetryAfterPrefix + err.RetryAfter.String() + serviceBusyRetryAfterSuffix
+}
+
+// DecodeServiceBusyMessage rebuilds a ServiceBusyError from a wire message,
+// stripping the retry hint added by EncodeServiceBusyMessage if present.
+func DecodeServiceBusyMessage(message string) *ServiceBusyError {
+	if strings.HasSuffix(message, serviceBusyRetryAfterSuffix) {
+		if idx := strings.LastIndex(message, serviceBusyRetryAfterPrefix); idx >= 0 {
+			hint := message[idx+len(serviceBusyRetryAfterPrefix) : len(message)-len(serviceBusyRetryAfterSuffix)]
+			if retryAfter, err := time.ParseDuration(hint); err == nil && retryAfter > 0 {
+				return &ServiceBusyError{
+					Message:    message[:idx],
+					RetryAfter: retryAfter,
+				}
+			}
+		}
+	}
+	return &ServiceBusyError{Message: message}
+}
+
 func (err WorkflowExecutionAlreadyStartedError) Error() string {
 	return err.Message
 }
@@ -99,7 +99,7 @@ func FromError(err error) error {
 	case *types.LimitExceededError:
 		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.LimitExceededError{}))
 	case *types.ServiceBusyError:
-		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.ServiceBusyError{}))
+		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, types.EncodeServiceBusyMessage(e), protobuf.WithErrorDetails(&apiv1.ServiceBusyError{}))
 	case *types.RemoteSyncMatchedError:
 		return protobuf.NewError(yarpcerrors.CodeUnavailable, e.Message, protobuf.WithErrorDetails(&sharedv1.RemoteSyncMatchedError{}))
 	case *types.StickyWorkerUnavailableError:
@@ -224,9 +224,7 @@ func ToError(err error) error {
 				Message: status.Message(),
 			}
 		case *apiv1.ServiceBusyError:
-			return &types.ServiceBusyError{
-				Message: status.Message(),
-			}
+			return types.DecodeServiceBusyMessage(status.Message())
 		}
 	case yarpcerrors.CodeUnavailable:
 		switch getErrorDetails(err).(type) {
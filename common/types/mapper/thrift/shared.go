@@ -4824,7 +4824,7 @@ func FromServiceBusyError(t *types.ServiceBusyError) *shared.ServiceBusyError {
 		return nil
 	}
 	return &shared.ServiceBusyError{
-		Message: t.Message,
+		Message: types.EncodeServiceBusyMessage(t),
 	}
 }
 
@@ -4833,9 +4833,7 @@ func ToServiceBusyError(t *shared.ServiceBusyError) *types.ServiceBusyError {
 	if t == nil {
 		return nil
 	}
-	return &types.ServiceBusyError{
-		Message: t.Message,
-	}
+	return types.DecodeServiceBusyMessage(t.Message)
 }
 
 // FromSignalExternalWorkflowExecutionDecisionAttributes converts internal SignalExternalWorkflowExecutionDecisionAttributes type to thrift
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // AccessDeniedError is an internal type (TBD...)
@@ -5386,7 +5387,16 @@ func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
 
 // ServiceBusyError is an internal type (TBD...)
 type ServiceBusyError struct {
-	Message string `json:"message,required"`
+	Message    string        `json:"message,required"`
+	RetryAfter time.Duration `json:"retryAfter,omitempty"`
+}
+
+// GetRetryAfter is an internal getter (TBD...)
+func (v *ServiceBusyError) GetRetryAfter() (o time.Duration) {
+	if v != nil {
+		return v.RetryAfter
+	}
+	return
 }
 
 // SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
@@ -265,11 +265,12 @@ func CheckDecisionResultLimit(
 
 // IsServiceTransientError checks if the error is a transient error.
 func IsServiceTransientError(err error) bool {
-	switch err.(type) {
+	switch err := err.(type) {
 	case *types.InternalServiceError:
 		return true
 	case *types.ServiceBusyError:
-		return true
+		// a retry-after hint asks the caller to back off instead of retrying right away
+		return err.RetryAfter == 0
 	case *types.ShardOwnershipLostError:
 		return true
 	case *yarpcerrors.Status:
@@ -57,6 +57,11 @@ func TestIsServiceTransientError_ContextCancel(t *testing.T) {
 	require.False(t, IsServiceTransientError(ctx.Err()))
 }
 
+func TestIsServiceTransientError_ServiceBusyRetryAfter(t *testing.T) {
+	require.True(t, IsServiceTransientError(&types.ServiceBusyError{Message: "busy"}))
+	require.False(t, IsServiceTransientError(&types.ServiceBusyError{Message: "busy", RetryAfter: time.Second}))
+}
+
 func TestIsContextTimeoutError(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
@@ -50,6 +50,7 @@ import (
 
 	// the import is a test dependency
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/memory"
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
@@ -200,6 +201,10 @@ func NewPersistenceTestCluster(clusterConfig *TestClusterConfig) testcluster.Per
 		ops := clusterConfig.Persistence
 		ops.DBPluginName = "cassandra"
 		testCluster = nosql.NewTestCluster(ops.DBPluginName, ops.DBName, ops.DBUsername, ops.DBPassword, ops.DBHost, ops.DBPort, ops.ProtoVersion, "")
+	} else if TestFlags.PersistenceType == memory.PluginName {
+		ops := clusterConfig.Persistence
+		ops.DBPluginName = memory.PluginName
+		testCluster = nosql.NewTestCluster(ops.DBPluginName, ops.DBName, ops.DBUsername, ops.DBPassword, ops.DBHost, ops.DBPort, ops.ProtoVersion, "")
 	} else if TestFlags.PersistenceType == config.StoreTypeSQL {
 		var ops *persistencetests.TestBaseOptions
 		if TestFlags.SQLPluginName == mysql.PluginName {
@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
@@ -107,6 +108,7 @@ type (
 		config                *Config
 		domainDLQHandler      domain.DLQMessageHandler
 		domainFailoverWatcher domain.FailoverWatcher
+		storageMeter          metering.StorageMeter
 		eventSerializer       persistence.PayloadSerializer
 		esClient              elasticsearch.GenericClient
 		throttleRetry         *backoff.ThrottleRetry
@@ -172,6 +174,22 @@ func NewAdminHandler(
 			resource.GetMetricsClient(),
 			resource.GetLogger(),
 		),
+		storageMeter: metering.NewStorageMeter(
+			&metering.Config{
+				NumHistoryShards:  config.NumHistoryShards,
+				MeteringInterval:  config.StorageMeteringInterval,
+				MeteringRPS:       config.StorageMeteringRPS,
+				DomainBudgetBytes: config.DomainStorageBudgetBytes,
+			},
+			resource.GetExecutionManager,
+			resource.GetVisibilityManager(),
+			resource.GetDomainCache(),
+			resource.GetMembershipResolver(),
+			resource.GetHostInfo(),
+			resource.GetTimeSource(),
+			resource.GetMetricsClient(),
+			resource.GetLogger(),
+		),
 		eventSerializer: persistence.NewPayloadSerializer(),
 		esClient:        params.ESClient,
 		throttleRetry: backoff.NewThrottleRetry(
@@ -188,12 +206,17 @@ func (adh *adminHandlerImpl) Start() {
 	if adh.config.EnableGracefulFailover() {
 		adh.domainFailoverWatcher.Start()
 	}
+
+	if adh.config.EnableStorageMetering() {
+		adh.storageMeter.Start()
+	}
 }
 
 // Stop stops the handler
 func (adh *adminHandlerImpl) Stop() {
 	adh.domainDLQHandler.Stop()
 	adh.domainFailoverWatcher.Stop()
+	adh.storageMeter.Stop()
 }
 
 // AddSearchAttribute add search attribute to whitelist
@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/yarpc/yarpcerrors"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+)
+
+const (
+	// weight of the latest forwarded call in the moving average of the latency
+	remoteClusterLatencyWeight = 0.2
+	// consecutive forwarding failures after which a remote cluster is considered unhealthy
+	remoteClusterMaxConsecutiveFailures = 5
+	// time a remote cluster is not forwarded to after being considered unhealthy or slow
+	remoteClusterUnhealthyBackoff = 10 * time.Second
+)
+
+// latencyUntrackedAPIs are the APIs whose latency depends on the workers rather than on the remote cluster:
+// long polls wait for a task to be available and queries wait for a worker to answer
+var latencyUntrackedAPIs = map[string]struct{}{
+	"PollForActivityTask":            {},
+	"PollForDecisionTask":            {},
+	"QueryWorkflow":                  {},
+	"QueryWorkflowStrongConsistency": {},
+}
+
+type (
+	// remoteClusterHealth tracks latency and failures of calls forwarded to remote clusters,
+	// so that calls are rejected locally instead of forwarded to a cluster which is slow or unreachable
+	remoteClusterHealth struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		clusters   map[string]*remoteClusterStats
+	}
+
+	remoteClusterStats struct {
+		consecutiveFailures int
+		unhealthyUntil      time.Time
+		// latency is tracked per API, as APIs served by the same cluster have very different latencies
+		apis map[string]*remoteAPIStats
+	}
+
+	remoteAPIStats struct {
+		latency      time.Duration
+		lastCallTime time.Time
+	}
+)
+
+func newRemoteClusterHealth(
+	timeSource clock.TimeSource,
+) *remoteClusterHealth {
+	return &remoteClusterHealth{
+		timeSource: timeSource,
+		clusters:   make(map[string]*remoteClusterStats),
+	}
+}
+
+// record records the outcome of a call forwarded to the remote cluster,
+// calls which failed because the caller context is done say nothing about the remote cluster and are ignored
+func (h *remoteClusterHealth) record(
+	ctx context.Context,
+	clusterName string,
+	apiName string,
+	latency time.Duration,
+	err error,
+) {
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	stats, ok := h.clusters[clusterName]
+	if !ok {
+		stats = &remoteClusterStats{apis: make(map[string]*remoteAPIStats)}
+		h.clusters[clusterName] = stats
+	}
+	now := h.timeSource.Now()
+
+	if isForwardingFailure(err) {
+		stats.consecutiveFailures++
+		if stats.consecutiveFailures >= remoteClusterMaxConsecutiveFailures {
+			stats.unhealthyUntil = now.Add(remoteClusterUnhealthyBackoff)
+		}
+		return
+	}
+	stats.consecutiveFailures = 0
+
+	if _, ok := latencyUntrackedAPIs[apiName]; ok {
+		return
+	}
+	apiStats, ok := stats.apis[apiName]
+	if !ok {
+		apiStats = &remoteAPIStats{latency: latency}
+		stats.apis[apiName] = apiStats
+	}
+	apiStats.lastCallTime = now
+	apiStats.latency = time.Duration(remoteClusterLatencyWeight*float64(latency) + (1-remoteClusterLatencyWeight)*float64(apiStats.latency))
+}
+
+// shouldForward returns false if calls to the remote cluster keep failing or if the API is slower than maxLatency
+// on the remote cluster, a zero maxLatency disables the latency check
+func (h *remoteClusterHealth) shouldForward(
+	clusterName string,
+	apiName string,
+	maxLatency time.Duration,
+) bool {
+	h.Lock()
+	defer h.Unlock()
+
+	stats, ok := h.clusters[clusterName]
+	if !ok {
+		return true
+	}
+	now := h.timeSource.Now()
+	if now.Before(stats.unhealthyUntil) {
+		return false
+	}
+	apiStats, ok := stats.apis[apiName]
+	if ok && maxLatency > 0 && apiStats.latency > maxLatency {
+		// still forward once per backoff, so the latency of a recovered cluster is noticed
+		return now.Sub(apiStats.lastCallTime) >= remoteClusterUnhealthyBackoff
+	}
+	return true
+}
+
+func isForwardingFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	return common.IsContextTimeoutError(err) || yarpcerrors.IsUnavailable(err) || yarpcerrors.IsDeadlineExceeded(err)
+}
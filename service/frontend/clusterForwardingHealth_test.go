@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc/yarpcerrors"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+func TestRemoteClusterHealth_Latency(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	health := newRemoteClusterHealth(timeSource)
+	maxLatency := 100 * time.Millisecond
+	ctx := context.Background()
+
+	require.True(t, health.shouldForward("cluster", "SignalWorkflowExecution", maxLatency))
+	health.record(ctx, "cluster", "SignalWorkflowExecution", 50*time.Millisecond, nil)
+	require.True(t, health.shouldForward("cluster", "SignalWorkflowExecution", maxLatency))
+
+	health.record(ctx, "cluster", "SignalWorkflowExecution", time.Second, nil)
+	require.False(t, health.shouldForward("cluster", "SignalWorkflowExecution", maxLatency))
+	require.True(t, health.shouldForward("cluster", "SignalWorkflowExecution", 0))
+	require.True(t, health.shouldForward("other-cluster", "SignalWorkflowExecution", maxLatency))
+	// the latency of an API does not affect the other APIs
+	require.True(t, health.shouldForward("cluster", "StartWorkflowExecution", maxLatency))
+
+	// a slow cluster is probed again after the backoff
+	timeSource.Update(timeSource.Now().Add(remoteClusterUnhealthyBackoff))
+	require.True(t, health.shouldForward("cluster", "SignalWorkflowExecution", maxLatency))
+}
+
+func TestRemoteClusterHealth_LatencyUntrackedAPIs(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	health := newRemoteClusterHealth(timeSource)
+	maxLatency := 100 * time.Millisecond
+
+	for apiName := range latencyUntrackedAPIs {
+		health.record(context.Background(), "cluster", apiName, time.Minute, nil)
+		require.True(t, health.shouldForward("cluster", apiName, maxLatency))
+	}
+}
+
+func TestRemoteClusterHealth_Failures(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	health := newRemoteClusterHealth(timeSource)
+	ctx := context.Background()
+
+	for i := 0; i != remoteClusterMaxConsecutiveFailures-1; i++ {
+		health.record(ctx, "cluster", "SignalWorkflowExecution", time.Millisecond, yarpcerrors.UnavailableErrorf("unavailable"))
+	}
+	// errors returned by the remote cluster are not forwarding failures
+	health.record(ctx, "cluster", "SignalWorkflowExecution", time.Millisecond, errors.New("some error"))
+	require.True(t, health.shouldForward("cluster", "SignalWorkflowExecution", 0))
+
+	for i := 0; i != remoteClusterMaxConsecutiveFailures; i++ {
+		health.record(ctx, "cluster", "SignalWorkflowExecution", time.Millisecond, yarpcerrors.DeadlineExceededErrorf("timeout"))
+	}
+	require.False(t, health.shouldForward("cluster", "StartWorkflowExecution", 0))
+
+	timeSource.Update(timeSource.Now().Add(remoteClusterUnhealthyBackoff))
+	require.True(t, health.shouldForward("cluster", "StartWorkflowExecution", 0))
+}
+
+func TestRemoteClusterHealth_CallerContextDone(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	health := newRemoteClusterHealth(timeSource)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the caller giving up says nothing about the remote cluster
+	for i := 0; i != remoteClusterMaxConsecutiveFailures; i++ {
+		health.record(ctx, "cluster", "SignalWorkflowExecution", time.Millisecond, context.Canceled)
+		health.record(ctx, "cluster", "SignalWorkflowExecution", time.Millisecond, yarpcerrors.DeadlineExceededErrorf("timeout"))
+	}
+	require.True(t, health.shouldForward("cluster", "SignalWorkflowExecution", 0))
+}
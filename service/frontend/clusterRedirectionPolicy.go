@@ -23,8 +23,10 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
@@ -94,6 +96,8 @@ type (
 		allDomainAPIs      bool
 		selectedAPIs       map[string]struct{}
 		targetCluster      string
+		timeSource         clock.TimeSource
+		health             *remoteClusterHealth
 	}
 )
 
@@ -177,6 +181,7 @@ func (policy *noopRedirectionPolicy) WithDomainNameRedirect(ctx context.Context,
 
 // newSelectedOrAllAPIsForwardingPolicy creates a forwarding policy for selected APIs based on domain
 func newSelectedOrAllAPIsForwardingPolicy(currentClusterName string, config *Config, domainCache cache.DomainCache, allDoaminAPIs bool, selectedAPIs map[string]struct{}, targetCluster string) *selectedOrAllAPIsForwardingRedirectionPolicy {
+	timeSource := clock.NewRealTimeSource()
 	return &selectedOrAllAPIsForwardingRedirectionPolicy{
 		currentClusterName: currentClusterName,
 		config:             config,
@@ -184,6 +189,8 @@ func newSelectedOrAllAPIsForwardingPolicy(currentClusterName string, config *Con
 		allDomainAPIs:      allDoaminAPIs,
 		selectedAPIs:       selectedAPIs,
 		targetCluster:      targetCluster,
+		timeSource:         timeSource,
+		health:             newRemoteClusterHealth(timeSource),
 	}
 }
 
@@ -207,14 +214,37 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainNameRedire
 
 func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) withRedirect(ctx context.Context, domainEntry *cache.DomainCacheEntry, apiName string, call func(string) error) error {
 	targetDC, enableDomainNotActiveForwarding := policy.getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx, domainEntry, apiName)
+	if !policy.shouldForward(domainEntry, targetDC, apiName) {
+		// reject locally, the caller gets the domain not active error and can call the active cluster directly
+		targetDC, enableDomainNotActiveForwarding = policy.currentClusterName, false
+	}
 
-	err := call(targetDC)
+	err := policy.callCluster(ctx, targetDC, apiName, call)
 
 	targetDC, ok := policy.isDomainNotActiveError(err)
-	if !ok || !enableDomainNotActiveForwarding {
+	if !ok || !enableDomainNotActiveForwarding || !policy.shouldForward(domainEntry, targetDC, apiName) {
 		return err
 	}
-	return call(targetDC)
+	return policy.callCluster(ctx, targetDC, apiName, call)
+}
+
+// callCluster calls the target cluster and measures the call if it is forwarded to a remote cluster
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) callCluster(ctx context.Context, targetDC string, apiName string, call func(string) error) error {
+	if targetDC == policy.currentClusterName {
+		return call(targetDC)
+	}
+
+	startTime := policy.timeSource.Now()
+	err := call(targetDC)
+	policy.health.record(ctx, targetDC, apiName, policy.timeSource.Now().Sub(startTime), err)
+	return err
+}
+
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) shouldForward(domainEntry *cache.DomainCacheEntry, targetDC string, apiName string) bool {
+	if targetDC == policy.currentClusterName {
+		return true
+	}
+	return policy.health.shouldForward(targetDC, apiName, policy.config.DomainNotActiveForwardingMaxLatency(domainEntry.GetInfo().Name))
 }
 
 func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) isDomainNotActiveError(err error) (string, bool) {
@@ -238,6 +268,11 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) getTargetClusterAndI
 		return policy.currentClusterName, false
 	}
 
+	if policy.isForwardingDisabled(domainEntry.GetInfo().Name, apiName) {
+		// do not do dc redirection if the API is excluded for the domain, e.g. long polls
+		return policy.currentClusterName, false
+	}
+
 	currentActiveCluster := domainEntry.GetReplicationConfig().ActiveClusterName
 	if policy.allDomainAPIs {
 		if policy.targetCluster == "" {
@@ -258,3 +293,12 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) getTargetClusterAndI
 
 	return currentActiveCluster, true
 }
+
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) isForwardingDisabled(domainName string, apiName string) bool {
+	for _, disabledAPI := range strings.Split(policy.config.DomainNotActiveForwardingDisabledAPIs(domainName), ",") {
+		if strings.TrimSpace(disabledAPI) == apiName {
+			return true
+		}
+	}
+	return false
+}
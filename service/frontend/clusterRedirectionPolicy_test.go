@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
@@ -353,6 +354,61 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) setupLocalDomain() {
 	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).AnyTimes()
 }
 
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_APIDisabled() {
+	s.setupGlobalDomainWithTwoReplicationCluster(true, false)
+	s.mockConfig.DomainNotActiveForwardingDisabledAPIs = func(domain string) string {
+		return "PollForDecisionTask, SignalWorkflowExecution"
+	}
+
+	callCount := 0
+	callFn := func(targetCluster string) error {
+		callCount++
+		s.Equal(s.currentClusterName, targetCluster)
+		return nil
+	}
+
+	err := s.policy.WithDomainIDRedirect(context.Background(), s.domainID, "SignalWorkflowExecution", callFn)
+	s.Nil(err)
+	err = s.policy.WithDomainNameRedirect(context.Background(), s.domainName, "SignalWorkflowExecution", callFn)
+	s.Nil(err)
+	s.Equal(2, callCount)
+}
+
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_UnhealthyCluster() {
+	s.setupGlobalDomainWithTwoReplicationCluster(true, false)
+	apiName := "SignalWorkflowExecution"
+
+	alternativeClusterCallCount := 0
+	currentClusterCallCount := 0
+	callFn := func(targetCluster string) error {
+		switch targetCluster {
+		case s.currentClusterName:
+			currentClusterCallCount++
+			return &types.DomainNotActiveError{
+				CurrentCluster: s.currentClusterName,
+				ActiveCluster:  s.alternativeClusterName,
+			}
+		case s.alternativeClusterName:
+			alternativeClusterCallCount++
+			return yarpcerrors.UnavailableErrorf("cluster unreachable")
+		default:
+			panic(fmt.Sprintf("unknown cluster name %v", targetCluster))
+		}
+	}
+
+	for i := 0; i != remoteClusterMaxConsecutiveFailures; i++ {
+		err := s.policy.WithDomainIDRedirect(context.Background(), s.domainID, apiName, callFn)
+		s.True(yarpcerrors.IsUnavailable(err))
+	}
+	s.Equal(remoteClusterMaxConsecutiveFailures, alternativeClusterCallCount)
+
+	// the alternative cluster is unhealthy, so the call is rejected locally
+	err := s.policy.WithDomainIDRedirect(context.Background(), s.domainID, apiName, callFn)
+	s.IsType(&types.DomainNotActiveError{}, err)
+	s.Equal(remoteClusterMaxConsecutiveFailures, alternativeClusterCallCount)
+	s.Equal(1, currentClusterCallCount)
+}
+
 func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithTwoReplicationCluster(forwardingEnabled bool, isRecordActive bool) {
 	activeCluster := s.alternativeClusterName
 	if isRecordActive {
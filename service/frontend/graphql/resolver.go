@@ -0,0 +1,351 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultPageSize = 100
+)
+
+type (
+	// Handler is the subset of the frontend handler used to resolve queries
+	Handler interface {
+		ListWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest) (*types.ListWorkflowExecutionsResponse, error)
+		DescribeWorkflowExecution(context.Context, *types.DescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
+	}
+
+	queryResolver struct {
+		handler Handler
+	}
+
+	workflowConnectionResolver struct {
+		workflows     []*workflowResolver
+		nextPageToken *string
+	}
+
+	// workflowResolver resolves fields from the visibility record when available
+	// and only describes the workflow when a field requires it
+	workflowResolver struct {
+		handler    Handler
+		domain     string
+		workflowID string
+		runID      string
+		info       *types.WorkflowExecutionInfo
+
+		describeOnce     sync.Once
+		describeResponse *types.DescribeWorkflowExecutionResponse
+		describeErr      error
+	}
+
+	pendingActivityResolver struct {
+		info *types.PendingActivityInfo
+	}
+)
+
+func (r *queryResolver) Workflows(ctx context.Context, args struct {
+	Domain        string
+	Query         *string
+	PageSize      *int32
+	NextPageToken *string
+}) (*workflowConnectionResolver, error) {
+	request := &types.ListWorkflowExecutionsRequest{
+		Domain:   args.Domain,
+		PageSize: defaultPageSize,
+	}
+	if args.Query != nil {
+		request.Query = *args.Query
+	}
+	if args.PageSize != nil {
+		request.PageSize = *args.PageSize
+	}
+	if args.NextPageToken != nil {
+		request.NextPageToken = []byte(*args.NextPageToken)
+	}
+	response, err := r.handler.ListWorkflowExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := &workflowConnectionResolver{}
+	for _, info := range response.Executions {
+		connection.workflows = append(connection.workflows, &workflowResolver{
+			handler:    r.handler,
+			domain:     args.Domain,
+			workflowID: info.GetExecution().GetWorkflowID(),
+			runID:      info.GetExecution().GetRunID(),
+			info:       info,
+		})
+	}
+	if len(response.NextPageToken) > 0 {
+		connection.nextPageToken = common.StringPtr(string(response.NextPageToken))
+	}
+	return connection, nil
+}
+
+func (r *queryResolver) Workflow(ctx context.Context, args struct {
+	Domain     string
+	WorkflowID string
+	RunID      *string
+}) (*workflowResolver, error) {
+	workflow := &workflowResolver{
+		handler:    r.handler,
+		domain:     args.Domain,
+		workflowID: args.WorkflowID,
+	}
+	if args.RunID != nil {
+		workflow.runID = *args.RunID
+	}
+	response, err := workflow.describe(ctx)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	workflow.info = response.WorkflowExecutionInfo
+	if runID := workflow.info.GetExecution().GetRunID(); runID != "" {
+		workflow.runID = runID
+	}
+	return workflow, nil
+}
+
+func (r *workflowConnectionResolver) Workflows() []*workflowResolver {
+	return r.workflows
+}
+
+func (r *workflowConnectionResolver) NextPageToken() *string {
+	return r.nextPageToken
+}
+
+func (r *workflowResolver) Domain() string {
+	return r.domain
+}
+
+func (r *workflowResolver) WorkflowID() string {
+	return r.workflowID
+}
+
+func (r *workflowResolver) RunID() string {
+	return r.runID
+}
+
+func (r *workflowResolver) Type(ctx context.Context) (string, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return info.GetType().GetName(), nil
+}
+
+func (r *workflowResolver) TaskList(ctx context.Context) (string, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return info.TaskList, nil
+}
+
+func (r *workflowResolver) StartTime(ctx context.Context) (*graphql.Time, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTime(info.StartTime), nil
+}
+
+func (r *workflowResolver) CloseTime(ctx context.Context) (*graphql.Time, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTime(info.CloseTime), nil
+}
+
+func (r *workflowResolver) CloseStatus(ctx context.Context) (*string, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil || info.CloseStatus == nil {
+		return nil, err
+	}
+	return common.StringPtr(info.CloseStatus.String()), nil
+}
+
+func (r *workflowResolver) HistoryLength(ctx context.Context) (int32, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(info.HistoryLength), nil
+}
+
+func (r *workflowResolver) IsCron(ctx context.Context) (bool, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+	return info.IsCron, nil
+}
+
+func (r *workflowResolver) PendingActivities(ctx context.Context) ([]*pendingActivityResolver, error) {
+	response, err := r.describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activities := make([]*pendingActivityResolver, 0, len(response.PendingActivities))
+	for _, activity := range response.PendingActivities {
+		activities = append(activities, &pendingActivityResolver{info: activity})
+	}
+	return activities, nil
+}
+
+func (r *workflowResolver) PendingChildren(ctx context.Context) ([]*workflowResolver, error) {
+	response, err := r.describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]*workflowResolver, 0, len(response.PendingChildren))
+	for _, child := range response.PendingChildren {
+		domain := child.Domain
+		if domain == "" {
+			domain = r.domain
+		}
+		children = append(children, &workflowResolver{
+			handler:    r.handler,
+			domain:     domain,
+			workflowID: child.WorkflowID,
+			runID:      child.RunID,
+		})
+	}
+	return children, nil
+}
+
+func (r *workflowResolver) Parent(ctx context.Context) (*workflowResolver, error) {
+	info, err := r.getInfo(ctx)
+	if err != nil || info.ParentExecution == nil {
+		return nil, err
+	}
+	domain := r.domain
+	if info.ParentDomain != nil && *info.ParentDomain != "" {
+		domain = *info.ParentDomain
+	}
+	return &workflowResolver{
+		handler:    r.handler,
+		domain:     domain,
+		workflowID: info.ParentExecution.GetWorkflowID(),
+		runID:      info.ParentExecution.GetRunID(),
+	}, nil
+}
+
+// getInfo returns the visibility record of the workflow if it was listed, otherwise it describes the workflow
+func (r *workflowResolver) getInfo(ctx context.Context) (*types.WorkflowExecutionInfo, error) {
+	if r.info != nil {
+		return r.info, nil
+	}
+	response, err := r.describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if response.WorkflowExecutionInfo == nil {
+		return &types.WorkflowExecutionInfo{}, nil
+	}
+	return response.WorkflowExecutionInfo, nil
+}
+
+func (r *workflowResolver) describe(ctx context.Context) (*types.DescribeWorkflowExecutionResponse, error) {
+	r.describeOnce.Do(func() {
+		r.describeResponse, r.describeErr = r.handler.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain: r.domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: r.workflowID,
+				RunID:      r.runID,
+			},
+		})
+	})
+	return r.describeResponse, r.describeErr
+}
+
+func (r *pendingActivityResolver) ActivityID() string {
+	return r.info.GetActivityID()
+}
+
+func (r *pendingActivityResolver) ActivityType() string {
+	if r.info.ActivityType == nil {
+		return ""
+	}
+	return r.info.ActivityType.Name
+}
+
+func (r *pendingActivityResolver) State() string {
+	if r.info.State == nil {
+		return ""
+	}
+	return r.info.State.String()
+}
+
+func (r *pendingActivityResolver) Attempt() int32 {
+	return r.info.GetAttempt()
+}
+
+func (r *pendingActivityResolver) MaximumAttempts() int32 {
+	return r.info.GetMaximumAttempts()
+}
+
+func (r *pendingActivityResolver) ScheduledTime() *graphql.Time {
+	return toTime(r.info.ScheduledTimestamp)
+}
+
+func (r *pendingActivityResolver) LastStartedTime() *graphql.Time {
+	return toTime(r.info.LastStartedTimestamp)
+}
+
+func (r *pendingActivityResolver) LastHeartbeatTime() *graphql.Time {
+	return toTime(r.info.LastHeartbeatTimestamp)
+}
+
+func (r *pendingActivityResolver) ExpirationTime() *graphql.Time {
+	return toTime(r.info.ExpirationTimestamp)
+}
+
+func (r *pendingActivityResolver) LastFailureReason() *string {
+	return r.info.LastFailureReason
+}
+
+func (r *pendingActivityResolver) LastWorkerIdentity() string {
+	return r.info.GetLastWorkerIdentity()
+}
+
+func toTime(unixNano *int64) *graphql.Time {
+	if unixNano == nil || *unixNano == 0 {
+		return nil
+	}
+	return &graphql.Time{Time: time.Unix(0, *unixNano)}
+}
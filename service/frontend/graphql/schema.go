@@ -0,0 +1,75 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package graphql
+
+// schema is the read-only GraphQL schema served by the frontend, it only exposes queries
+// backed by the visibility store and the DescribeWorkflowExecution API
+const schema = `
+schema {
+	query: Query
+}
+
+scalar Time
+
+type Query {
+	# workflows lists workflows matching the visibility query, the same syntax as ListWorkflowExecutions
+	workflows(domain: String!, query: String, pageSize: Int, nextPageToken: String): WorkflowConnection!
+	# workflow describes a single workflow, the current run is used when runID is not set
+	workflow(domain: String!, workflowID: String!, runID: String): Workflow
+}
+
+type WorkflowConnection {
+	workflows: [Workflow!]!
+	nextPageToken: String
+}
+
+type Workflow {
+	domain: String!
+	workflowID: String!
+	runID: String!
+	type: String!
+	taskList: String!
+	startTime: Time
+	closeTime: Time
+	closeStatus: String
+	historyLength: Int!
+	isCron: Boolean!
+	pendingActivities: [PendingActivity!]!
+	pendingChildren: [Workflow!]!
+	parent: Workflow
+}
+
+type PendingActivity {
+	activityID: String!
+	activityType: String!
+	state: String!
+	attempt: Int!
+	maximumAttempts: Int!
+	scheduledTime: Time
+	lastStartedTime: Time
+	lastHeartbeatTime: Time
+	expirationTime: Time
+	lastFailureReason: String
+	lastWorkerIdentity: String!
+}
+`
@@ -0,0 +1,136 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	// Path is the http path the GraphQL endpoint is served on
+	Path = "/graphql"
+
+	maxQueryDepth      = 10
+	maxParallelism     = 10
+	serverReadTimeout  = 10 * time.Second
+	serverWriteTimeout = time.Minute
+	shutdownTimeout    = 5 * time.Second
+)
+
+type (
+	// Server serves the read-only GraphQL endpoint over http
+	Server struct {
+		status   int32
+		config   *config.GraphQL
+		server   *http.Server
+		listener net.Listener
+		logger   log.Logger
+	}
+)
+
+// NewServer creates a new GraphQL server resolving queries with the handler
+func NewServer(
+	config *config.GraphQL,
+	handler Handler,
+	logger log.Logger,
+) (*Server, error) {
+	schema, err := graphql.ParseSchema(
+		schema,
+		&queryResolver{handler: handler},
+		graphql.MaxDepth(maxQueryDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, &relay.Handler{Schema: schema})
+	return &Server{
+		status: common.DaemonStatusInitialized,
+		config: config,
+		server: &http.Server{
+			Handler:      mux,
+			ReadTimeout:  serverReadTimeout,
+			WriteTimeout: serverWriteTimeout,
+		},
+		logger: logger.WithTags(tag.ComponentGraphQL),
+	}, nil
+}
+
+// Start starts listening on the configured port
+func (s *Server) Start() error {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	host := ""
+	if s.config.BindOnLocalHost {
+		host = "127.0.0.1"
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%d", host, s.config.Port))
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("GraphQL server failed.", tag.Error(err))
+		}
+	}()
+	s.logger.Info("GraphQL server started.", tag.Address(listener.Addr().String()))
+	return nil
+}
+
+// Stop gracefully shuts down the server
+func (s *Server) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.logger.Warn("Failed to gracefully shutdown GraphQL server.", tag.Error(err))
+	}
+	s.logger.Info("GraphQL server stopped.")
+}
+
+// Addr returns the address the server is listening on, it is only available after Start
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
@@ -0,0 +1,177 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	testHandler struct {
+		listRequests     []*types.ListWorkflowExecutionsRequest
+		describeRequests []*types.DescribeWorkflowExecutionRequest
+		executions       map[string]*types.DescribeWorkflowExecutionResponse
+	}
+
+	graphQLResponse struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+)
+
+func (h *testHandler) ListWorkflowExecutions(
+	_ context.Context,
+	request *types.ListWorkflowExecutionsRequest,
+) (*types.ListWorkflowExecutionsResponse, error) {
+	h.listRequests = append(h.listRequests, request)
+	return &types.ListWorkflowExecutionsResponse{
+		Executions:    []*types.WorkflowExecutionInfo{h.executions["parent"].WorkflowExecutionInfo},
+		NextPageToken: []byte("next"),
+	}, nil
+}
+
+func (h *testHandler) DescribeWorkflowExecution(
+	_ context.Context,
+	request *types.DescribeWorkflowExecutionRequest,
+) (*types.DescribeWorkflowExecutionResponse, error) {
+	h.describeRequests = append(h.describeRequests, request)
+	response, ok := h.executions[request.Execution.WorkflowID]
+	if !ok {
+		return nil, &types.EntityNotExistsError{}
+	}
+	return response, nil
+}
+
+func newTestHandler() *testHandler {
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+	activityState := types.PendingActivityStateStarted
+	return &testHandler{
+		executions: map[string]*types.DescribeWorkflowExecutionResponse{
+			"parent": {
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+					Execution:     &types.WorkflowExecution{WorkflowID: "parent", RunID: "parent-run"},
+					Type:          &types.WorkflowType{Name: "parent-type"},
+					StartTime:     common.Int64Ptr(startTime),
+					HistoryLength: 10,
+					TaskList:      "test-tl",
+				},
+				PendingActivities: []*types.PendingActivityInfo{
+					{
+						ActivityID:   "1",
+						ActivityType: &types.ActivityType{Name: "activity-type"},
+						State:        &activityState,
+						Attempt:      2,
+					},
+				},
+				PendingChildren: []*types.PendingChildExecutionInfo{
+					{WorkflowID: "child", RunID: "child-run"},
+				},
+			},
+			"child": {
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+					Execution:       &types.WorkflowExecution{WorkflowID: "child", RunID: "child-run"},
+					Type:            &types.WorkflowType{Name: "child-type"},
+					ParentExecution: &types.WorkflowExecution{WorkflowID: "parent", RunID: "parent-run"},
+				},
+			},
+		},
+	}
+}
+
+func TestServer(t *testing.T) {
+	handler := newTestHandler()
+	server, err := NewServer(&config.GraphQL{BindOnLocalHost: true}, handler, log.NewNoop())
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	query := func(query string) graphQLResponse {
+		body, err := json.Marshal(map[string]string{"query": query})
+		require.NoError(t, err)
+		response, err := http.Post(fmt.Sprintf("http://%v%v", server.Addr(), Path), "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer response.Body.Close()
+		var result graphQLResponse
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+		return result
+	}
+
+	result := query(`{
+		workflows(domain: "test-domain", query: "WorkflowType = 'parent-type'", pageSize: 10) {
+			nextPageToken
+			workflows {
+				workflowID
+				type
+				startTime
+				historyLength
+				pendingActivities { activityID activityType state attempt }
+				pendingChildren { workflowID type parent { workflowID } }
+			}
+		}
+	}`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{
+		"workflows": {
+			"nextPageToken": "next",
+			"workflows": [{
+				"workflowID": "parent",
+				"type": "parent-type",
+				"startTime": "2021-01-01T00:00:00Z",
+				"historyLength": 10,
+				"pendingActivities": [{"activityID": "1", "activityType": "activity-type", "state": "STARTED", "attempt": 2}],
+				"pendingChildren": [{"workflowID": "child", "type": "child-type", "parent": {"workflowID": "parent"}}]
+			}]
+		}
+	}`, string(result.Data))
+	require.Len(t, handler.listRequests, 1)
+	assert.Equal(t, &types.ListWorkflowExecutionsRequest{
+		Domain:   "test-domain",
+		PageSize: 10,
+		Query:    "WorkflowType = 'parent-type'",
+	}, handler.listRequests[0])
+	// the parent is described once for both pending activities and children, the child once for its type
+	assert.Len(t, handler.describeRequests, 2)
+
+	result = query(`{ workflow(domain: "test-domain", workflowID: "missing") { runID } }`)
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"workflow": null}`, string(result.Data))
+
+	result = query(`mutation { workflow(domain: "test-domain", workflowID: "parent") { runID } }`)
+	assert.NotEmpty(t, result.Errors)
+}
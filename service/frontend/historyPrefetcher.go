@@ -0,0 +1,150 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	historyPrefetchTTL     = time.Minute
+	historyPrefetchTimeout = 10 * time.Second
+)
+
+type (
+	// historyPrefetcher reads ahead the next page of a paginated history read, so that
+	// a client paging sequentially through a large history finds the page already fetched
+	historyPrefetcher struct {
+		historyManager persistence.HistoryManager
+		pages          cache.Cache
+		maxInFlight    int32
+		inFlight       int32
+	}
+
+	prefetchedHistoryPage struct {
+		done          chan struct{}
+		events        []*types.HistoryEvent
+		size          int
+		nextPageToken []byte
+		err           error
+	}
+)
+
+// newHistoryPrefetcher creates a history prefetcher, a non positive cacheSize disables read ahead
+func newHistoryPrefetcher(
+	historyManager persistence.HistoryManager,
+	cacheSize int,
+) *historyPrefetcher {
+	if cacheSize <= 0 {
+		return &historyPrefetcher{historyManager: historyManager}
+	}
+	return &historyPrefetcher{
+		historyManager: historyManager,
+		pages: cache.New(&cache.Options{
+			TTL:      historyPrefetchTTL,
+			MaxCount: cacheSize,
+		}),
+		maxInFlight: int32(cacheSize),
+	}
+}
+
+// readPage reads a full page of history events. The page is served from an earlier
+// prefetch when there is one, and if prefetch is true the following page is read ahead.
+func (p *historyPrefetcher) readPage(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+	prefetch bool,
+) (events []*types.HistoryEvent, size int, nextPageToken []byte, prefetchHit bool, err error) {
+
+	key := historyPrefetchKey(request)
+	if page := p.take(key); page != nil {
+		select {
+		case <-page.done:
+		case <-ctx.Done():
+			return nil, 0, nil, false, ctx.Err()
+		}
+		// a failed prefetch is not returned, the page is read again below
+		if page.err == nil {
+			events, size, nextPageToken, prefetchHit = page.events, page.size, page.nextPageToken, true
+		}
+	}
+
+	if !prefetchHit {
+		events, size, nextPageToken, err = persistenceutils.ReadFullPageV2Events(ctx, p.historyManager, request)
+		if err != nil {
+			return nil, 0, nil, false, err
+		}
+	}
+
+	if prefetch && len(nextPageToken) != 0 {
+		nextRequest := *request
+		nextRequest.NextPageToken = nextPageToken
+		p.prefetch(&nextRequest)
+	}
+	return events, size, nextPageToken, prefetchHit, nil
+}
+
+func (p *historyPrefetcher) take(key string) *prefetchedHistoryPage {
+	if p.pages == nil {
+		return nil
+	}
+	page, ok := p.pages.Get(key).(*prefetchedHistoryPage)
+	if !ok {
+		return nil
+	}
+	p.pages.Delete(key)
+	return page
+}
+
+func (p *historyPrefetcher) prefetch(request *persistence.ReadHistoryBranchRequest) {
+	if atomic.AddInt32(&p.inFlight, 1) > p.maxInFlight {
+		atomic.AddInt32(&p.inFlight, -1)
+		return
+	}
+
+	page := &prefetchedHistoryPage{done: make(chan struct{})}
+	if existing, err := p.pages.PutIfNotExist(historyPrefetchKey(request), page); err != nil || existing != page {
+		// the page is already being prefetched
+		atomic.AddInt32(&p.inFlight, -1)
+		return
+	}
+
+	go func() {
+		defer atomic.AddInt32(&p.inFlight, -1)
+		defer close(page.done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), historyPrefetchTimeout)
+		defer cancel()
+		page.events, page.size, page.nextPageToken, page.err = persistenceutils.ReadFullPageV2Events(ctx, p.historyManager, request)
+	}()
+}
+
+func historyPrefetchKey(request *persistence.ReadHistoryBranchRequest) string {
+	return fmt.Sprintf("%x/%v/%v/%v/%x", request.BranchToken, request.MinEventID, request.MaxEventID, request.PageSize, request.NextPageToken)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func historyPageRequest(nextPageToken []byte) *persistence.ReadHistoryBranchRequest {
+	return &persistence.ReadHistoryBranchRequest{
+		BranchToken:   []byte("branch"),
+		MinEventID:    1,
+		MaxEventID:    100,
+		PageSize:      1,
+		NextPageToken: nextPageToken,
+	}
+}
+
+func withNextPageToken(token []byte) interface{} {
+	return mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return bytes.Equal(request.NextPageToken, token)
+	})
+}
+
+func waitForPrefetch(t *testing.T, prefetcher *historyPrefetcher, request *persistence.ReadHistoryBranchRequest) {
+	page, ok := prefetcher.pages.Get(historyPrefetchKey(request)).(*prefetchedHistoryPage)
+	require.True(t, ok)
+	select {
+	case <-page.done:
+	case <-time.After(time.Second):
+		t.Fatal("prefetch did not complete")
+	}
+}
+
+func TestHistoryPrefetcher_ServesPrefetchedPage(t *testing.T) {
+	historyManager := &mocks.HistoryV2Manager{}
+	defer historyManager.AssertExpectations(t)
+	historyManager.On("ReadHistoryBranch", mock.Anything, withNextPageToken(nil)).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 1}},
+		NextPageToken: []byte("page-2"),
+	}, nil).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, withNextPageToken([]byte("page-2"))).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 2}},
+	}, nil).Once()
+
+	prefetcher := newHistoryPrefetcher(historyManager, 10)
+	events, _, token, hit, err := prefetcher.readPage(context.Background(), historyPageRequest(nil), true)
+	require.NoError(t, err)
+	assert.False(t, hit)
+	assert.Equal(t, []byte("page-2"), token)
+	assert.Equal(t, int64(1), events[0].ID)
+
+	waitForPrefetch(t, prefetcher, historyPageRequest(token))
+	events, _, token, hit, err = prefetcher.readPage(context.Background(), historyPageRequest(token), true)
+	require.NoError(t, err)
+	assert.True(t, hit)
+	assert.Empty(t, token)
+	assert.Equal(t, int64(2), events[0].ID)
+}
+
+func TestHistoryPrefetcher_PrefetchDisabled(t *testing.T) {
+	historyManager := &mocks.HistoryV2Manager{}
+	defer historyManager.AssertExpectations(t)
+	historyManager.On("ReadHistoryBranch", mock.Anything, withNextPageToken(nil)).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 1}},
+		NextPageToken: []byte("page-2"),
+	}, nil).Once()
+
+	prefetcher := newHistoryPrefetcher(historyManager, 10)
+	_, _, token, hit, err := prefetcher.readPage(context.Background(), historyPageRequest(nil), false)
+	require.NoError(t, err)
+	assert.False(t, hit)
+	assert.Nil(t, prefetcher.pages.Get(historyPrefetchKey(historyPageRequest(token))))
+}
+
+func TestHistoryPrefetcher_FailedPrefetchIsReadAgain(t *testing.T) {
+	historyManager := &mocks.HistoryV2Manager{}
+	defer historyManager.AssertExpectations(t)
+	historyManager.On("ReadHistoryBranch", mock.Anything, withNextPageToken(nil)).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 1}},
+		NextPageToken: []byte("page-2"),
+	}, nil).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, withNextPageToken([]byte("page-2"))).Return(nil, errors.New("some random error")).Once()
+	historyManager.On("ReadHistoryBranch", mock.Anything, withNextPageToken([]byte("page-2"))).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 2}},
+	}, nil).Once()
+
+	prefetcher := newHistoryPrefetcher(historyManager, 10)
+	_, _, token, _, err := prefetcher.readPage(context.Background(), historyPageRequest(nil), true)
+	require.NoError(t, err)
+
+	waitForPrefetch(t, prefetcher, historyPageRequest(token))
+	events, _, _, hit, err := prefetcher.readPage(context.Background(), historyPageRequest(token), true)
+	require.NoError(t, err)
+	assert.False(t, hit)
+	assert.Equal(t, int64(2), events[0].ID)
+}
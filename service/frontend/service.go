@@ -30,8 +30,10 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/frontend/graphql"
 )
 
 // Config represents configuration for cadence-frontend service
@@ -59,6 +61,7 @@ type Config struct {
 	DisallowQuery                   dynamicconfig.BoolPropertyFnWithDomainFilter
 	ShutdownDrainDuration           dynamicconfig.DurationPropertyFn
 	Lockdown                        dynamicconfig.BoolPropertyFnWithDomainFilter
+	ClusterReadOnly                 dynamicconfig.BoolPropertyFn
 
 	// id length limits
 	MaxIDLengthWarnLimit  dynamicconfig.IntPropertyFn
@@ -89,6 +92,14 @@ type Config struct {
 	EnableGracefulFailover                      dynamicconfig.BoolPropertyFn
 	DomainFailoverRefreshInterval               dynamicconfig.DurationPropertyFn
 	DomainFailoverRefreshTimerJitterCoefficient dynamicconfig.FloatPropertyFn
+	DomainNotActiveForwardingDisabledAPIs       dynamicconfig.StringPropertyFnWithDomainFilter
+	DomainNotActiveForwardingMaxLatency         dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// per-domain storage metering
+	EnableStorageMetering    dynamicconfig.BoolPropertyFn
+	StorageMeteringInterval  dynamicconfig.DurationPropertyFn
+	StorageMeteringRPS       dynamicconfig.IntPropertyFn
+	DomainStorageBudgetBytes dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
@@ -101,9 +112,17 @@ type Config struct {
 
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithDomainFilter
 
+	// history pagination read ahead
+	EnableHistoryPrefetch    dynamicconfig.BoolPropertyFnWithDomainFilter
+	HistoryPrefetchCacheSize dynamicconfig.IntPropertyFn
+
 	// max number of decisions per RespondDecisionTaskCompleted request (unlimited by default)
 	DecisionResultCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// read-only traffic shadowing
+	ShadowTrafficRate    dynamicconfig.FloatPropertyFn
+	ShadowTrafficCluster dynamicconfig.StringPropertyFn
+
 	// Debugging
 
 	// Emit signal related metrics with signal name tag. Be aware of cardinality.
@@ -151,6 +170,12 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		EnableGracefulFailover:                      dc.GetBoolProperty(dynamicconfig.EnableGracefulFailover),
 		DomainFailoverRefreshInterval:               dc.GetDurationProperty(dynamicconfig.DomainFailoverRefreshInterval),
 		DomainFailoverRefreshTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.DomainFailoverRefreshTimerJitterCoefficient),
+		DomainNotActiveForwardingDisabledAPIs:       dc.GetStringPropertyFilteredByDomain(dynamicconfig.DomainNotActiveForwardingDisabledAPIs),
+		DomainNotActiveForwardingMaxLatency:         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DomainNotActiveForwardingMaxLatency),
+		EnableStorageMetering:                       dc.GetBoolProperty(dynamicconfig.FrontendEnableStorageMetering),
+		StorageMeteringInterval:                     dc.GetDurationProperty(dynamicconfig.FrontendStorageMeteringInterval),
+		StorageMeteringRPS:                          dc.GetIntProperty(dynamicconfig.FrontendStorageMeteringRPS),
+		DomainStorageBudgetBytes:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainStorageBudgetBytes),
 		EnableClientVersionCheck:                    dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck),
 		ValidSearchAttributes:                       dc.GetMapProperty(dynamicconfig.ValidSearchAttributes),
 		SearchAttributesNumberOfKeysLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit),
@@ -158,8 +183,13 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		SearchAttributesTotalSizeLimit:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit),
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisallowQuery),
+		ClusterReadOnly:                             dc.GetBoolProperty(dynamicconfig.FrontendClusterReadOnly),
 		SendRawWorkflowHistory:                      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.SendRawWorkflowHistory),
+		EnableHistoryPrefetch:                       dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEnableHistoryPrefetch),
+		HistoryPrefetchCacheSize:                    dc.GetIntProperty(dynamicconfig.FrontendHistoryPrefetchCacheSize),
 		DecisionResultCountLimit:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDecisionResultCountLimit),
+		ShadowTrafficRate:                           dc.GetFloat64Property(dynamicconfig.FrontendShadowTrafficRate),
+		ShadowTrafficCluster:                        dc.GetStringProperty(dynamicconfig.FrontendShadowTrafficCluster),
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.Lockdown),
 		domainConfig: domain.Config{
@@ -188,8 +218,10 @@ type Service struct {
 	resource.Resource
 
 	status       int32
+	drainStart   int64 // unix nanos when draining started, accessed atomically
 	handler      *WorkflowHandler
 	adminHandler AdminHandler
+	graphQL      *graphql.Server
 	stopC        chan struct{}
 	config       *Config
 	params       *resource.Params
@@ -260,7 +292,7 @@ func (s *Service) Start() {
 	s.handler = NewWorkflowHandler(s, s.config, s.GetDomainReplicationQueue(), client.NewVersionChecker())
 
 	// Additional decorations
-	var handler Handler = s.handler
+	var handler Handler = NewShadowHandler(s.handler, s, s.config)
 	if s.params.ClusterRedirectionPolicy != nil {
 		handler = NewClusterRedirectionHandler(handler, s, s.config, *s.params.ClusterRedirectionPolicy)
 	}
@@ -274,6 +306,14 @@ func (s *Service) Start() {
 	grpcHandler := newGrpcHandler(handler)
 	grpcHandler.register(s.GetDispatcher())
 
+	if s.params.GraphQLConfig.Port != 0 {
+		graphQLServer, err := graphql.NewServer(&s.params.GraphQLConfig, handler, logger)
+		if err != nil {
+			logger.Fatal("fail to create graphql server", tag.Error(err))
+		}
+		s.graphQL = graphQLServer
+	}
+
 	s.adminHandler = NewAdminHandler(s, s.params, s.config)
 	s.adminHandler = NewAccessControlledAdminHandlerImpl(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
 
@@ -287,6 +327,11 @@ func (s *Service) Start() {
 	s.Resource.Start()
 	s.handler.Start()
 	s.adminHandler.Start()
+	if s.graphQL != nil {
+		if err := s.graphQL.Start(); err != nil {
+			logger.Fatal("fail to start graphql server", tag.Error(err))
+		}
+	}
 
 	// base (service is not started in frontend or admin handler) in case of race condition in yarpc registration function
 
@@ -308,15 +353,16 @@ func (s *Service) Stop() {
 	// 4. Wait for a second
 	// 5. Stop everything forcefully and return
 
-	requestDrainTime := common.MinDuration(time.Second, s.config.ShutdownDrainDuration())
-	failureDetectionTime := common.MaxDuration(0, s.config.ShutdownDrainDuration()-requestDrainTime)
+	requestDrainTime, failureDetectionTime := s.shutdownDrainTimes()
 
-	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to ShuttingDown")
-	s.handler.UpdateHealthStatus(HealthStatusShuttingDown)
+	s.Drain()
 
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
-	time.Sleep(failureDetectionTime)
+	time.Sleep(failureDetectionTime - s.drainElapsed())
 
+	if s.graphQL != nil {
+		s.graphQL.Stop()
+	}
 	s.handler.Stop()
 	s.adminHandler.Stop()
 
@@ -327,3 +373,38 @@ func (s *Service) Stop() {
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
 }
+
+// Drain fails the rpc health check, so that client side load balancers stop forwarding requests to this host
+func (s *Service) Drain() {
+	if !atomic.CompareAndSwapInt64(&s.drainStart, 0, time.Now().UnixNano()) {
+		return
+	}
+
+	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to ShuttingDown")
+	s.handler.UpdateHealthStatus(HealthStatusShuttingDown)
+}
+
+// DrainStatus reports draining as done once others had time to discover this host is unhealthy
+func (s *Service) DrainStatus() common.DrainStatus {
+	if atomic.LoadInt64(&s.drainStart) == 0 {
+		return common.DrainStatus{}
+	}
+
+	_, failureDetectionTime := s.shutdownDrainTimes()
+	return common.DrainStatus{
+		Draining: true,
+		Done:     s.drainElapsed() >= failureDetectionTime,
+	}
+}
+
+func (s *Service) drainElapsed() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&s.drainStart)))
+}
+
+// shutdownDrainTimes splits the shutdown drain duration into the time for others to
+// discover this host is unhealthy, and the time for inflight requests to drain afterwards
+func (s *Service) shutdownDrainTimes() (requestDrainTime time.Duration, failureDetectionTime time.Duration) {
+	requestDrainTime = common.MinDuration(time.Second, s.config.ShutdownDrainDuration())
+	failureDetectionTime = common.MaxDuration(0, s.config.ShutdownDrainDuration()-requestDrainTime)
+	return requestDrainTime, failureDetectionTime
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+const shadowRequestTimeout = 10 * time.Second
+
+var _ Handler = (*ShadowHandlerImpl)(nil)
+
+type (
+	// ShadowHandlerImpl is simple wrapper over frontend service, mirroring a sample of read-only requests
+	// to the shadow traffic cluster and comparing the outcome, callers always get the local response
+	ShadowHandlerImpl struct {
+		Handler
+
+		resource resource.Resource
+		config   *Config
+	}
+)
+
+// NewShadowHandler creates a frontend handler mirroring read-only traffic to the shadow traffic cluster
+func NewShadowHandler(
+	wfHandler Handler,
+	resource resource.Resource,
+	config *Config,
+) *ShadowHandlerImpl {
+	return &ShadowHandlerImpl{
+		Handler:  wfHandler,
+		resource: resource,
+		config:   config,
+	}
+}
+
+// DescribeWorkflowExecution API call
+func (handler *ShadowHandlerImpl) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *types.DescribeWorkflowExecutionRequest,
+) (*types.DescribeWorkflowExecutionResponse, error) {
+	resp, err := handler.Handler.DescribeWorkflowExecution(ctx, request)
+	handler.shadow(metrics.FrontendDescribeWorkflowExecutionScope, err, func(ctx context.Context, client frontend.Client) error {
+		_, err := client.DescribeWorkflowExecution(ctx, request)
+		return err
+	})
+	return resp, err
+}
+
+// GetWorkflowExecutionHistory API call
+func (handler *ShadowHandlerImpl) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *types.GetWorkflowExecutionHistoryRequest,
+) (*types.GetWorkflowExecutionHistoryResponse, error) {
+	resp, err := handler.Handler.GetWorkflowExecutionHistory(ctx, request)
+	if request.GetWaitForNewEvent() {
+		// long polls are not shadowed, their outcome depends on timing
+		return resp, err
+	}
+	handler.shadow(metrics.FrontendGetWorkflowExecutionHistoryScope, err, func(ctx context.Context, client frontend.Client) error {
+		_, err := client.GetWorkflowExecutionHistory(ctx, request)
+		return err
+	})
+	return resp, err
+}
+
+// ListOpenWorkflowExecutions API call
+func (handler *ShadowHandlerImpl) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *types.ListOpenWorkflowExecutionsRequest,
+) (*types.ListOpenWorkflowExecutionsResponse, error) {
+	resp, err := handler.Handler.ListOpenWorkflowExecutions(ctx, request)
+	handler.shadow(metrics.FrontendListOpenWorkflowExecutionsScope, err, func(ctx context.Context, client frontend.Client) error {
+		_, err := client.ListOpenWorkflowExecutions(ctx, request)
+		return err
+	})
+	return resp, err
+}
+
+// ListClosedWorkflowExecutions API call
+func (handler *ShadowHandlerImpl) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *types.ListClosedWorkflowExecutionsRequest,
+) (*types.ListClosedWorkflowExecutionsResponse, error) {
+	resp, err := handler.Handler.ListClosedWorkflowExecutions(ctx, request)
+	handler.shadow(metrics.FrontendListClosedWorkflowExecutionsScope, err, func(ctx context.Context, client frontend.Client) error {
+		_, err := client.ListClosedWorkflowExecutions(ctx, request)
+		return err
+	})
+	return resp, err
+}
+
+// ListWorkflowExecutions API call
+func (handler *ShadowHandlerImpl) ListWorkflowExecutions(
+	ctx context.Context,
+	request *types.ListWorkflowExecutionsRequest,
+) (*types.ListWorkflowExecutionsResponse, error) {
+	resp, err := handler.Handler.ListWorkflowExecutions(ctx, request)
+	handler.shadow(metrics.FrontendListWorkflowExecutionsScope, err, func(ctx context.Context, client frontend.Client) error {
+		_, err := client.ListWorkflowExecutions(ctx, request)
+		return err
+	})
+	return resp, err
+}
+
+// shadow asynchronously replays a request against the shadow traffic cluster, if the request is sampled,
+// and records whether the shadow outcome matches the local one
+func (handler *ShadowHandlerImpl) shadow(
+	scopeIdx int,
+	localErr error,
+	call func(context.Context, frontend.Client) error,
+) {
+	clusterName, ok := handler.shadowCluster()
+	if !ok {
+		return
+	}
+
+	client := handler.resource.GetRemoteFrontendClient(clusterName)
+	go func() {
+		var err error
+		defer log.CapturePanic(handler.resource.GetLogger(), &err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), shadowRequestTimeout)
+		defer cancel()
+
+		scope := handler.resource.GetMetricsClient().Scope(scopeIdx)
+		scope.IncCounter(metrics.ShadowRequestsCounter)
+		sw := scope.StartTimer(metrics.ShadowRequestLatency)
+		err = call(ctx, client)
+		sw.Stop()
+
+		if !isSameShadowOutcome(localErr, err) {
+			scope.IncCounter(metrics.ShadowRequestMismatchCounter)
+			handler.resource.GetLogger().Debug("Shadow request outcome mismatch",
+				tag.ClusterName(clusterName),
+				tag.Error(err),
+			)
+		}
+	}()
+}
+
+// shadowCluster returns the cluster to mirror the current request to, if it is sampled for shadowing
+func (handler *ShadowHandlerImpl) shadowCluster() (string, bool) {
+	rate := handler.config.ShadowTrafficRate()
+	if rate <= 0 || rand.Float64() >= rate {
+		return "", false
+	}
+
+	clusterName := handler.config.ShadowTrafficCluster()
+	clusterMetadata := handler.resource.GetClusterMetadata()
+	if clusterName == "" || clusterName == clusterMetadata.GetCurrentClusterName() {
+		return "", false
+	}
+	info, ok := clusterMetadata.GetAllClusterInfo()[clusterName]
+	if !ok || !info.Enabled {
+		return "", false
+	}
+	return clusterName, true
+}
+
+// isSameShadowOutcome checks whether two calls either both succeeded or failed with the same error type
+func isSameShadowOutcome(localErr error, shadowErr error) bool {
+	if localErr == nil || shadowErr == nil {
+		return localErr == nil && shadowErr == nil
+	}
+	return reflect.TypeOf(localErr) == reflect.TypeOf(shadowErr)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestShadowHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockResource := resource.NewTest(controller, metrics.Frontend)
+	mockHandler := NewMockHandler(controller)
+	config := NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), mockResource.GetLogger()), 0, false)
+	handler := NewShadowHandler(mockHandler, mockResource, config)
+
+	request := &types.DescribeWorkflowExecutionRequest{Domain: "some random domain name"}
+	response := &types.DescribeWorkflowExecutionResponse{}
+
+	// shadowing is disabled by default
+	mockHandler.EXPECT().DescribeWorkflowExecution(gomock.Any(), request).Return(response, nil).Times(1)
+	resp, err := handler.DescribeWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, resp)
+
+	config.ShadowTrafficRate = dynamicconfig.GetFloatPropertyFn(1)
+	config.ShadowTrafficCluster = dynamicconfig.GetStringPropertyFn(cluster.TestAlternativeClusterName)
+
+	shadowed := make(chan struct{})
+	mockHandler.EXPECT().DescribeWorkflowExecution(gomock.Any(), request).Return(response, nil).Times(1)
+	mockResource.RemoteFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), request).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...interface{}) (*types.DescribeWorkflowExecutionResponse, error) {
+			close(shadowed)
+			return nil, &types.EntityNotExistsError{}
+		}).Times(1)
+	resp, err = handler.DescribeWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, resp, "caller should only see the local response")
+
+	select {
+	case <-shadowed:
+	case <-time.After(time.Second):
+		t.Fatal("request was not shadowed")
+	}
+
+	// the current cluster is never used as shadow traffic cluster
+	config.ShadowTrafficCluster = dynamicconfig.GetStringPropertyFn(cluster.TestCurrentClusterName)
+	mockHandler.EXPECT().DescribeWorkflowExecution(gomock.Any(), request).Return(response, nil).Times(1)
+	_, err = handler.DescribeWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+}
+
+func TestIsSameShadowOutcome(t *testing.T) {
+	assert.True(t, isSameShadowOutcome(nil, nil))
+	assert.True(t, isSameShadowOutcome(&types.EntityNotExistsError{}, &types.EntityNotExistsError{Message: "not found"}))
+	assert.False(t, isSameShadowOutcome(nil, &types.EntityNotExistsError{}))
+	assert.False(t, isSameShadowOutcome(errors.New("some error"), nil))
+	assert.False(t, isSameShadowOutcome(&types.EntityNotExistsError{}, &types.BadRequestError{}))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// shardBackoff remembers history shards that rejected work with a retry-after hint,
+	// so that requests for those shards are rejected at the frontend until the hint expires
+	// instead of piling up on the overloaded shard
+	shardBackoff struct {
+		numShards  int
+		timeSource clock.TimeSource
+
+		sync.RWMutex
+		busyUntil map[int]time.Time
+	}
+)
+
+func newShardBackoff(
+	numShards int,
+	timeSource clock.TimeSource,
+) *shardBackoff {
+	return &shardBackoff{
+		numShards:  numShards,
+		timeSource: timeSource,
+		busyUntil:  make(map[int]time.Time),
+	}
+}
+
+// allow returns a ServiceBusyError carrying the remaining backoff if the shard
+// owning the workflow is still backing off
+func (b *shardBackoff) allow(workflowID string) error {
+	shardID := common.WorkflowIDToHistoryShard(workflowID, b.numShards)
+
+	b.RLock()
+	busyUntil, ok := b.busyUntil[shardID]
+	b.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	remaining := busyUntil.Sub(b.timeSource.Now())
+	if remaining <= 0 {
+		b.Lock()
+		if b.busyUntil[shardID] == busyUntil {
+			delete(b.busyUntil, shardID)
+		}
+		b.Unlock()
+		return nil
+	}
+	return &types.ServiceBusyError{
+		Message:    fmt.Sprintf("History shard %v is overloaded, retry later.", shardID),
+		RetryAfter: remaining,
+	}
+}
+
+// update starts a backoff for the shard owning the workflow if err is
+// a ServiceBusyError returned by history with a retry-after hint
+func (b *shardBackoff) update(workflowID string, err error) {
+	busyErr, ok := err.(*types.ServiceBusyError)
+	if !ok || busyErr.RetryAfter <= 0 {
+		return
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(workflowID, b.numShards)
+	busyUntil := b.timeSource.Now().Add(busyErr.RetryAfter)
+
+	b.Lock()
+	defer b.Unlock()
+	if busyUntil.After(b.busyUntil[shardID]) {
+		b.busyUntil[shardID] = busyUntil
+	}
+}
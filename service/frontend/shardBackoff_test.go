@@ -0,0 +1,62 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestShardBackoff(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Unix(0, 0))
+	b := newShardBackoff(numHistoryShards, timeSource)
+
+	assert.NoError(t, b.allow(testWorkflowID))
+
+	// errors without a retry-after hint do not start a backoff
+	b.update(testWorkflowID, errors.New("some random error"))
+	b.update(testWorkflowID, &types.ServiceBusyError{Message: "busy"})
+	assert.NoError(t, b.allow(testWorkflowID))
+
+	b.update(testWorkflowID, &types.ServiceBusyError{Message: "busy", RetryAfter: 10 * time.Second})
+	err := b.allow(testWorkflowID)
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	assert.Equal(t, 10*time.Second, err.(*types.ServiceBusyError).RetryAfter)
+
+	// a shorter hint does not cut an ongoing backoff short
+	b.update(testWorkflowID, &types.ServiceBusyError{Message: "busy", RetryAfter: time.Second})
+	timeSource.Update(time.Unix(4, 0))
+	err = b.allow(testWorkflowID)
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	assert.Equal(t, 6*time.Second, err.(*types.ServiceBusyError).RetryAfter)
+
+	timeSource.Update(time.Unix(10, 0))
+	assert.NoError(t, b.allow(testWorkflowID))
+	assert.Empty(t, b.busyUntil)
+}
@@ -45,7 +45,6 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
-	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
@@ -85,6 +84,8 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		throttleRetry             *backoff.ThrottleRetry
+		historyPrefetcher         *historyPrefetcher
+		shardBackoff              *shardBackoff
 	}
 
 	getHistoryContinuationToken struct {
@@ -134,6 +135,7 @@ var (
 	errEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
 	errDomainInLockdown                           = &types.BadRequestError{Message: "Domain is not accepting fail overs at this time due to lockdown."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
+	errClusterReadOnly                            = &types.BadRequestError{Message: "Cluster is in read-only mode, writes are not accepted."}
 
 	// err for archival
 	errHistoryNotFound = &types.BadRequestError{Message: "Requested workflow history not found, may have passed retention period."}
@@ -207,6 +209,8 @@ func NewWorkflowHandler(
 			backoff.WithRetryPolicy(frontendServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		historyPrefetcher: newHistoryPrefetcher(resource.GetHistoryManager(), config.HistoryPrefetchCacheSize()),
+		shardBackoff:      newShardBackoff(config.NumHistoryShards, resource.GetTimeSource()),
 	}
 }
 
@@ -1689,6 +1693,10 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return nil, errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return nil, wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1989,6 +1997,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return nil, wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2007,6 +2019,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
+	if err := wh.shardBackoff.allow(startRequest.GetWorkflowID()); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	if ok := wh.allow(true, startRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
@@ -2136,6 +2152,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 
 	resp, err = wh.GetHistoryClient().StartWorkflowExecution(ctx, historyRequest)
 	if err != nil {
+		wh.shardBackoff.update(startRequest.GetWorkflowID(), err)
 		return nil, wh.error(err, scope, tags...)
 	}
 	return resp, nil
@@ -2334,6 +2351,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				ctx,
 				scope,
 				domainID,
+				domainName,
 				*execution,
 				firstEventID,
 				nextEventID,
@@ -2428,6 +2446,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return wh.error(err, scope)
 	}
@@ -2444,6 +2466,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(errDomainNotSet, scope, tags...)
 	}
 
+	if err := wh.shardBackoff.allow(wfExecution.GetWorkflowID()); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
 	if ok := wh.allow(true, signalRequest); !ok {
 		return wh.error(createServiceBusyError(), scope, tags...)
 	}
@@ -2519,6 +2545,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		SignalRequest: signalRequest,
 	})
 	if err != nil {
+		wh.shardBackoff.update(wfExecution.GetWorkflowID(), err)
 		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 	}
 
@@ -2543,6 +2570,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return nil, wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2561,6 +2592,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope, tags...)
 	}
 
+	if err := wh.shardBackoff.allow(signalWithStartRequest.GetWorkflowID()); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	if ok := wh.allow(true, signalWithStartRequest); !ok {
 		return nil, wh.error(createServiceBusyError(), scope, tags...)
 	}
@@ -2702,6 +2737,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		SignalWithStartRequest: signalWithStartRequest,
 	})
 	if err != nil {
+		wh.shardBackoff.update(signalWithStartRequest.GetWorkflowID(), err)
 		return nil, wh.error(err, scope, tags...)
 	}
 
@@ -2723,6 +2759,10 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 		return errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return wh.error(err, scope)
 	}
@@ -2778,6 +2818,10 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 		return nil, errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return nil, wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2832,6 +2876,10 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return errShuttingDown
 	}
 
+	if wh.config.ClusterReadOnly() {
+		return wh.error(errClusterReadOnly, scope)
+	}
+
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
 		return wh.error(err, scope)
 	}
@@ -3851,6 +3899,7 @@ func (wh *WorkflowHandler) getHistory(
 	ctx context.Context,
 	scope metrics.Scope,
 	domainID string,
+	domainName string,
 	execution types.WorkflowExecution,
 	firstEventID, nextEventID int64,
 	pageSize int32,
@@ -3863,19 +3912,24 @@ func (wh *WorkflowHandler) getHistory(
 
 	isFirstPage := len(nextPageToken) == 0
 	shardID := common.WorkflowIDToHistoryShard(execution.WorkflowID, wh.config.NumHistoryShards)
-	var err error
-	historyEvents, size, nextPageToken, err := persistenceutils.ReadFullPageV2Events(ctx, wh.GetHistoryManager(), &persistence.ReadHistoryBranchRequest{
+	enablePrefetch := wh.config.EnableHistoryPrefetch(domainName)
+	historyEvents, size, nextPageToken, prefetchHit, err := wh.historyPrefetcher.readPage(ctx, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
 		MinEventID:    firstEventID,
 		MaxEventID:    nextEventID,
 		PageSize:      int(pageSize),
 		NextPageToken: nextPageToken,
 		ShardID:       common.IntPtr(shardID),
-	})
+	}, enablePrefetch)
 
 	if err != nil {
 		return nil, nil, err
 	}
+	if prefetchHit {
+		scope.IncCounter(metrics.HistoryPagePrefetchHitCounter)
+	} else if enablePrefetch && !isFirstPage {
+		scope.IncCounter(metrics.HistoryPagePrefetchMissCounter)
+	}
 
 	scope.RecordTimer(metrics.HistorySize, time.Duration(size))
 
@@ -4096,6 +4150,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 			ctx,
 			scope,
 			domainID,
+			domainName,
 			*matchingResp.WorkflowExecution,
 			firstEventID,
 			nextEventID,
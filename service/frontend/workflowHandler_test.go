@@ -305,6 +305,32 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNot
 	s.Equal(errRequestNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_ClusterReadOnly() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.ClusterReadOnly = dc.GetBoolPropertyFn(true)
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.StartWorkflowExecution(context.Background(), &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+	})
+	s.Error(err)
+	s.Equal(errClusterReadOnly, err)
+}
+
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_Failed_ClusterReadOnly() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.ClusterReadOnly = dc.GetBoolPropertyFn(true)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.SignalWorkflowExecution(context.Background(), &types.SignalWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		SignalName: "signal",
+	})
+	s.Error(err)
+	s.Equal(errClusterReadOnly, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dc.GetIntPropertyFn(10)
@@ -1084,7 +1110,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
 	scope := metrics.NoopScope(metrics.Frontend)
-	history, token, err := wh.getHistory(context.Background(), scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	history, token, err := wh.getHistory(context.Background(), scope, domainID, s.testDomain, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
 	s.NoError(err)
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
@@ -1487,6 +1513,31 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_ShardBackoff() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	signalRequest := &types.SignalWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		SignalName: "test_signal",
+	}
+	busyErr := &types.ServiceBusyError{Message: "shard overloaded", RetryAfter: time.Minute}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(busyErr).Times(1)
+
+	err := wh.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(busyErr, err)
+
+	// the shard is backing off, the request is rejected without reaching history
+	err = wh.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.IsType(&types.ServiceBusyError{}, err)
+	s.True(err.(*types.ServiceBusyError).RetryAfter > 0)
+	s.True(err.(*types.ServiceBusyError).RetryAfter <= time.Minute)
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	config := NewConfig(
 		dc.NewCollection(
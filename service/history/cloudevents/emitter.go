@@ -0,0 +1,185 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/messaging"
+)
+
+const (
+	// KafkaSink publishes events to the kafka topic of the cloudevents application
+	KafkaSink = "kafka"
+)
+
+var (
+	errKafkaNotConfigured = errors.New("kafka is not configured")
+)
+
+type (
+	// Emitter emits workflow lifecycle events to the configured sink
+	Emitter interface {
+		Emit(ctx context.Context, event *Event) error
+	}
+
+	// Sink delivers encoded events
+	Sink interface {
+		Send(ctx context.Context, event *Event, payload []byte) error
+	}
+
+	// Config is the config for the emitter
+	Config struct {
+		// Sink is either KafkaSink or an http(s) url events are posted to, empty disables emission
+		Sink dynamicconfig.StringPropertyFn
+	}
+
+	emitterImpl struct {
+		sync.Mutex
+		config             *Config
+		getMessagingClient func() messaging.Client
+		httpClient         *http.Client
+		sinks              map[string]Sink
+	}
+
+	httpSink struct {
+		client *http.Client
+		url    string
+	}
+
+	kafkaSink struct {
+		producer messaging.Producer
+	}
+)
+
+var _ Emitter = (*emitterImpl)(nil)
+
+// NewEmitter creates a new emitter, the messaging client is only resolved when the kafka sink is used
+func NewEmitter(
+	config *Config,
+	getMessagingClient func() messaging.Client,
+) Emitter {
+	return &emitterImpl{
+		config:             config,
+		getMessagingClient: getMessagingClient,
+		httpClient:         &http.Client{},
+		sinks:              make(map[string]Sink),
+	}
+}
+
+// Emit encodes the event in the structured content mode and sends it to the configured sink
+func (e *emitterImpl) Emit(
+	ctx context.Context,
+	event *Event,
+) error {
+	sink, err := e.getSink(e.config.Sink())
+	if err != nil || sink == nil {
+		return err
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return sink.Send(ctx, event, payload)
+}
+
+func (e *emitterImpl) getSink(
+	name string,
+) (Sink, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	if sink, ok := e.sinks[name]; ok {
+		return sink, nil
+	}
+	var sink Sink
+	switch {
+	case name == KafkaSink:
+		messagingClient := e.getMessagingClient()
+		if messagingClient == nil {
+			return nil, errKafkaNotConfigured
+		}
+		producer, err := messagingClient.NewProducer(common.CloudEventsAppName)
+		if err != nil {
+			return nil, err
+		}
+		sink = &kafkaSink{producer: producer}
+	case strings.HasPrefix(name, "http://"), strings.HasPrefix(name, "https://"):
+		sink = &httpSink{client: e.httpClient, url: name}
+	default:
+		return nil, fmt.Errorf("unsupported cloudevents sink %q", name)
+	}
+	e.sinks[name] = sink
+	return sink, nil
+}
+
+func (s *httpSink) Send(
+	ctx context.Context,
+	_ *Event,
+	payload []byte,
+) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", ContentType)
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("cloudevents sink responded with status code %v", response.StatusCode)
+	}
+	return nil
+}
+
+func (s *kafkaSink) Send(
+	ctx context.Context,
+	event *Event,
+	payload []byte,
+) error {
+	return s.producer.Publish(ctx, &sarama.ProducerMessage{
+		// keyed by workflow so that events of a workflow are kept in order
+		Key:   sarama.StringEncoder(event.Data.Domain + "/" + event.Data.WorkflowID),
+		Value: sarama.ByteEncoder(payload),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("content-type"), Value: []byte(ContentType)},
+		},
+	})
+}
@@ -0,0 +1,172 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	testMessagingClient struct {
+		apps     []string
+		producer *testProducer
+	}
+
+	testProducer struct {
+		messages []interface{}
+	}
+)
+
+func (c *testMessagingClient) NewConsumer(appName, consumerName string) (messaging.Consumer, error) {
+	return nil, nil
+}
+
+func (c *testMessagingClient) NewProducer(appName string) (messaging.Producer, error) {
+	c.apps = append(c.apps, appName)
+	return c.producer, nil
+}
+
+func (p *testProducer) Publish(_ context.Context, message interface{}) error {
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func TestNewWorkflowEvents(t *testing.T) {
+	startTime := time.Unix(1600000000, 0)
+	closeTime := startTime.Add(time.Minute)
+	data := &WorkflowData{
+		Domain:       "test-domain",
+		WorkflowID:   "wid",
+		RunID:        "rid",
+		WorkflowType: "test-workflow",
+		TaskList:     "test-tl",
+		StartTime:    startTime,
+	}
+
+	started := NewWorkflowStartedEvent("cluster0", data)
+	assert.Equal(t, &Event{
+		SpecVersion:     "1.0",
+		ID:              "rid/started",
+		Source:          "/cadence/cluster0/domains/test-domain",
+		Type:            "com.uber.cadence.workflow.started",
+		Subject:         "wid/rid",
+		Time:            startTime,
+		DataContentType: "application/json",
+		Data:            data,
+	}, started)
+
+	data.CloseTime = &closeTime
+	data.CloseStatus = "CONTINUED_AS_NEW"
+	closed := NewWorkflowClosedEvent("cluster0", data)
+	assert.Equal(t, "rid/continued_as_new", closed.ID)
+	assert.Equal(t, "com.uber.cadence.workflow.continued_as_new", closed.Type)
+	assert.Equal(t, closeTime, closed.Time)
+}
+
+func TestEmitter_HTTPSink(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		if r.URL.Path == "/unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	sink := server.URL + "/events"
+	emitter := NewEmitter(&Config{Sink: func(...dynamicconfig.FilterOption) string { return sink }}, noMessagingClient)
+	event := newTestEvent()
+	require.NoError(t, emitter.Emit(context.Background(), event))
+
+	request := <-requests
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, ContentType, request.Header.Get("Content-Type"))
+	var received Event
+	require.NoError(t, json.Unmarshal(<-bodies, &received))
+	assert.Equal(t, event.ID, received.ID)
+	assert.Equal(t, event.Data.WorkflowID, received.Data.WorkflowID)
+
+	sink = server.URL + "/unavailable"
+	assert.Error(t, emitter.Emit(context.Background(), event))
+}
+
+func TestEmitter_KafkaSink(t *testing.T) {
+	client := &testMessagingClient{producer: &testProducer{}}
+	emitter := NewEmitter(&Config{Sink: dynamicconfig.GetStringPropertyFn(KafkaSink)}, func() messaging.Client { return client })
+	event := newTestEvent()
+	require.NoError(t, emitter.Emit(context.Background(), event))
+	require.NoError(t, emitter.Emit(context.Background(), event))
+
+	assert.Equal(t, []string{common.CloudEventsAppName}, client.apps)
+	require.Len(t, client.producer.messages, 2)
+	message, ok := client.producer.messages[0].(*sarama.ProducerMessage)
+	require.True(t, ok)
+	assert.Equal(t, sarama.StringEncoder("test-domain/wid"), message.Key)
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("content-type"), Value: []byte(ContentType)}}, message.Headers)
+	var received Event
+	require.NoError(t, json.Unmarshal(message.Value.(sarama.ByteEncoder), &received))
+	assert.Equal(t, event.Type, received.Type)
+}
+
+func TestEmitter_InvalidSink(t *testing.T) {
+	emitter := NewEmitter(&Config{Sink: dynamicconfig.GetStringPropertyFn("")}, noMessagingClient)
+	assert.NoError(t, emitter.Emit(context.Background(), newTestEvent()))
+
+	emitter = NewEmitter(&Config{Sink: dynamicconfig.GetStringPropertyFn(KafkaSink)}, noMessagingClient)
+	assert.Equal(t, errKafkaNotConfigured, emitter.Emit(context.Background(), newTestEvent()))
+
+	emitter = NewEmitter(&Config{Sink: dynamicconfig.GetStringPropertyFn("sqs")}, noMessagingClient)
+	assert.Error(t, emitter.Emit(context.Background(), newTestEvent()))
+}
+
+func noMessagingClient() messaging.Client {
+	return nil
+}
+
+func newTestEvent() *Event {
+	return NewWorkflowStartedEvent("cluster0", &WorkflowData{
+		Domain:       "test-domain",
+		WorkflowID:   "wid",
+		RunID:        "rid",
+		WorkflowType: "test-workflow",
+		TaskList:     "test-tl",
+		StartTime:    time.Unix(1600000000, 0),
+	})
+}
@@ -0,0 +1,102 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cloudevents
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// SpecVersion is the CloudEvents spec version of the emitted events
+	SpecVersion = "1.0"
+	// ContentType is the content type of events encoded in the structured content mode
+	ContentType = "application/cloudevents+json"
+
+	eventTypePrefix = "com.uber.cadence.workflow."
+	dataContentType = "application/json"
+)
+
+type (
+	// Event is a CloudEvents 1.0 event in its structured JSON representation
+	Event struct {
+		SpecVersion     string        `json:"specversion"`
+		ID              string        `json:"id"`
+		Source          string        `json:"source"`
+		Type            string        `json:"type"`
+		Subject         string        `json:"subject"`
+		Time            time.Time     `json:"time"`
+		DataContentType string        `json:"datacontenttype"`
+		Data            *WorkflowData `json:"data"`
+	}
+
+	// WorkflowData is the data of a workflow lifecycle event
+	WorkflowData struct {
+		Domain        string     `json:"domain"`
+		WorkflowID    string     `json:"workflowID"`
+		RunID         string     `json:"runID"`
+		WorkflowType  string     `json:"workflowType"`
+		TaskList      string     `json:"taskList"`
+		StartTime     time.Time  `json:"startTime"`
+		CloseTime     *time.Time `json:"closeTime,omitempty"`
+		CloseStatus   string     `json:"closeStatus,omitempty"`
+		HistoryLength int64      `json:"historyLength,omitempty"`
+	}
+)
+
+// NewWorkflowStartedEvent creates the event emitted when a workflow starts
+func NewWorkflowStartedEvent(
+	clusterName string,
+	data *WorkflowData,
+) *Event {
+	return newEvent(clusterName, "started", data.StartTime, data)
+}
+
+// NewWorkflowClosedEvent creates the event emitted when a workflow closes,
+// its type is derived from the close status, e.g. com.uber.cadence.workflow.completed
+func NewWorkflowClosedEvent(
+	clusterName string,
+	data *WorkflowData,
+) *Event {
+	return newEvent(clusterName, strings.ToLower(data.CloseStatus), *data.CloseTime, data)
+}
+
+func newEvent(
+	clusterName string,
+	transition string,
+	eventTime time.Time,
+	data *WorkflowData,
+) *Event {
+	return &Event{
+		SpecVersion: SpecVersion,
+		// the same transition of a run always has the same id so that consumers can dedup redeliveries
+		ID:              fmt.Sprintf("%v/%v", data.RunID, transition),
+		Source:          fmt.Sprintf("/cadence/%v/domains/%v", clusterName, data.Domain),
+		Type:            eventTypePrefix + transition,
+		Subject:         fmt.Sprintf("%v/%v", data.WorkflowID, data.RunID),
+		Time:            eventTime,
+		DataContentType: dataContentType,
+		Data:            data,
+	}
+}
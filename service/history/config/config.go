@@ -61,10 +61,25 @@ type Config struct {
 	WorkflowDeletionJitterRange     dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// HistoryCache settings
-	// Change of these configs require shard restart
-	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
-	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
-	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	// Change of these configs require shard restart, except for the domain quota, metrics, lock release delay and lock priorities
+	HistoryCacheInitialSize            dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSize                dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSizeBytes           dynamicconfig.IntPropertyFn
+	HistoryCacheTTL                    dynamicconfig.DurationPropertyFn
+	HistoryCacheEvictionPolicy         dynamicconfig.StringPropertyFn
+	HistoryCacheDomainQuotaPercent     dynamicconfig.IntPropertyFnWithDomainIDFilter
+	HistoryCacheDomainMetrics          dynamicconfig.BoolPropertyFn
+	HistoryCacheLockReleaseDelay       dynamicconfig.DurationPropertyFnWithDomainFilter
+	HistoryCacheLockReleaseDelayScopes dynamicconfig.MapPropertyFn
+	WorkflowLockPriorities             dynamicconfig.MapPropertyFn
+
+	// StickyDecisionPinning settings
+	// Keeps the workflow context of a sticky decision in the history cache until the decision is completed
+	EnableStickyDecisionPinning dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// ShardCacheWarmUp settings
+	// Loads the recently updated executions of a shard into the execution cache when the shard is acquired
+	ShardCacheWarmUpMaxExecutions dynamicconfig.IntPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
@@ -76,6 +91,17 @@ type Config struct {
 	EventsCacheGlobalInitialCount dynamicconfig.IntPropertyFn
 	EventsCacheGlobalMaxCount     dynamicconfig.IntPropertyFn
 
+	// CacheMemoryBudget settings
+	// Shrinks history and events caches when heap exceeds the budget
+	CacheMemoryBudgetFraction      dynamicconfig.FloatPropertyFn
+	CacheMemoryBudgetCheckInterval dynamicconfig.DurationPropertyFn
+
+	// Backpressure settings
+	// Rejects new starts and signals on an overloaded shard with a retry-after hint
+	BusyPendingTaskThreshold dynamicconfig.IntPropertyFn
+	BusyLockWaitThreshold    dynamicconfig.DurationPropertyFn
+	BusyRetryAfter           dynamicconfig.DurationPropertyFn
+
 	// ShardController settings
 	RangeSizeBits           uint
 	AcquireShardInterval    dynamicconfig.DurationPropertyFn
@@ -105,6 +131,7 @@ type Config struct {
 
 	// QueueProcessor settings
 	QueueProcessorEnableSplit                          dynamicconfig.BoolPropertyFn
+	QueueProcessorSplitRollout                         dynamicconfig.BoolPropertyFnWithShardIDFilter
 	QueueProcessorSplitMaxLevel                        dynamicconfig.IntPropertyFn
 	QueueProcessorEnableRandomSplitByDomainID          dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	QueueProcessorRandomSplitProbability               dynamicconfig.FloatPropertyFn
@@ -117,6 +144,7 @@ type Config struct {
 	QueueProcessorPollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	QueueProcessorEnablePersistQueueStates             dynamicconfig.BoolPropertyFn
 	QueueProcessorEnableLoadQueueStates                dynamicconfig.BoolPropertyFn
+	QueueProcessorEnableCachePrefetch                  dynamicconfig.BoolPropertyFn
 
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                                dynamicconfig.IntPropertyFn
@@ -137,6 +165,7 @@ type Config struct {
 	TimerProcessorMaxTimeShift                        dynamicconfig.DurationPropertyFn
 	TimerProcessorHistoryArchivalSizeLimit            dynamicconfig.IntPropertyFn
 	TimerProcessorArchivalTimeLimit                   dynamicconfig.DurationPropertyFn
+	TimerProcessorOverdueTaskThreshold                dynamicconfig.DurationPropertyFn
 
 	// TransferQueueProcessor settings
 	TransferTaskBatchSize                                dynamicconfig.IntPropertyFn
@@ -189,6 +218,15 @@ type Config struct {
 	ReplicatorProcessorFetchTasksBatchSize dynamicconfig.IntPropertyFnWithShardIDFilter
 	ReplicatorUpperLatency                 dynamicconfig.DurationPropertyFn
 
+	// ReplicationLagTracker settings
+	EnableReplicationLagTracker   dynamicconfig.BoolPropertyFn
+	ReplicationLagTrackerInterval dynamicconfig.DurationPropertyFn
+	ReplicationLagSLO             dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// NDC conflict resolution settings
+	NDCConflictResolutionPolicy           dynamicconfig.StringPropertyFnWithDomainFilter
+	NDCConflictResolutionPreferredCluster dynamicconfig.StringPropertyFnWithDomainFilter
+
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
 	HistoryMgrNumConns   dynamicconfig.IntPropertyFn
@@ -196,9 +234,13 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	// MaxSignalRequestIDsCarriedOver bounds the signal requestIDs kept for deduplication across continue-as-new
+	MaxSignalRequestIDsCarriedOver dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
+	// ShardUpdateMaxStaleness the max time a coalesced shard info update can stay unpersisted
+	ShardUpdateMaxStaleness dynamicconfig.DurationPropertyFn
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval            dynamicconfig.DurationPropertyFn
 	ShardSyncTimerJitterCoefficient dynamicconfig.FloatPropertyFn
@@ -221,6 +263,23 @@ type Config struct {
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
 
+	// Workflow close webhook settings
+	EnableWorkflowCloseWebhook                  dynamicconfig.BoolPropertyFnWithDomainFilter
+	WorkflowCloseWebhookMaxConcurrentDeliveries dynamicconfig.IntPropertyFn
+	WorkflowCloseWebhookRequestTimeout          dynamicconfig.DurationPropertyFn
+	WorkflowCloseWebhookMaxRetryDuration        dynamicconfig.DurationPropertyFn
+
+	// CloudEvents settings
+	EnableCloudEvents             dynamicconfig.BoolPropertyFnWithDomainFilter
+	CloudEventsSink               dynamicconfig.StringPropertyFn
+	CloudEventsMaxConcurrentEmits dynamicconfig.IntPropertyFn
+
+	// HistoryExport settings
+	// Streams workflow histories to the blobstore as they are written
+	HistoryExportMode        dynamicconfig.StringPropertyFnWithDomainFilter
+	HistoryExportQueueSize   dynamicconfig.IntPropertyFn
+	HistoryExportConcurrency dynamicconfig.IntPropertyFn
+
 	// Archival settings
 	NumArchiveSystemWorkflows        dynamicconfig.IntPropertyFn
 	ArchiveRequestRPS                dynamicconfig.IntPropertyFn
@@ -257,6 +316,12 @@ type Config struct {
 	DecisionRetryMaxAttempts                 dynamicconfig.IntPropertyFnWithDomainFilter
 	NormalDecisionScheduleToStartMaxAttempts dynamicconfig.IntPropertyFnWithDomainFilter
 	NormalDecisionScheduleToStartTimeout     dynamicconfig.DurationPropertyFnWithDomainFilter
+	// DecisionQuarantineThreshold is the number of server side failures of the same decision after which
+	// the workflow is quarantined, so the decision is no longer dispatched until DecisionQuarantineTTL passes
+	DecisionQuarantineThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+	DecisionQuarantineTTL       dynamicconfig.DurationPropertyFn
+	// ActivityHeartbeatCoalescingInterval is the minimal interval between two persisted heartbeats of an activity
+	ActivityHeartbeatCoalescingInterval dynamicconfig.DurationPropertyFnWithDomainFilter
 
 	// The following is used by the new RPC replication stack
 	ReplicationTaskFetcherParallelism                  dynamicconfig.IntPropertyFn
@@ -284,13 +349,22 @@ type Config struct {
 	EnableConsistentQuery         dynamicconfig.BoolPropertyFn
 	EnableConsistentQueryByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	MaxBufferedQueryCount         dynamicconfig.IntPropertyFn
+	// EnableQueryResultCache caches eventually consistent query results until the next decision completes
+	EnableQueryResultCache dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// EnableWorkflowReadSnapshot serves describe and query from a read only mutable state snapshot
+	EnableWorkflowReadSnapshot dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	EnableCrossClusterOperations dynamicconfig.BoolPropertyFnWithDomainFilter
 
+	// EnableShardFencingAudit reports conditional writes whose rangeID diverges from the shard owner's
+	EnableShardFencingAudit dynamicconfig.BoolPropertyFn
+
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithDomainFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithDomainFilter
 	MutableStateChecksumInvalidateBefore  dynamicconfig.FloatPropertyFn
+	MutableStateInvariantCheckProbability dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// History check for corruptions
 	EnableHistoryCorruptionCheck dynamicconfig.BoolPropertyFnWithDomainFilter
@@ -304,9 +378,17 @@ type Config struct {
 	EnableActivityLocalDispatchByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	// Max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.
 	MaxActivityCountDispatchByDomain dynamicconfig.IntPropertyFnWithDomainFilter
+	// Cluster wide rate of activity tasks dispatched to matching per domain, 0 means no limit
+	ActivityDispatchRPSByDomain dynamicconfig.IntPropertyFnWithDomainFilter
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicconfig.DurationPropertyFnWithDomainFilter
 
+	// Max # of pending activities and child workflows per workflow execution, 0 means no limit
+	PendingActivitiesCountLimit     dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingChildWorkflowsCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
+	// Rate of child workflow starts per parent workflow execution, 0 means no limit
+	ChildWorkflowStartRPSPerParent dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// Debugging configurations
 	EnableDebugMode             bool // note that this value is initialized once on service start
 	EnableTaskInfoLogByDomainID dynamicconfig.BoolPropertyFnWithDomainIDFilter
@@ -343,7 +425,16 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize),
+		HistoryCacheMaxSizeBytes:             dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSizeBytes),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL),
+		HistoryCacheEvictionPolicy:           dc.GetStringProperty(dynamicconfig.HistoryCacheEvictionPolicy),
+		HistoryCacheDomainQuotaPercent:       dc.GetIntPropertyFilteredByDomainID(dynamicconfig.HistoryCacheDomainQuotaPercent),
+		HistoryCacheDomainMetrics:            dc.GetBoolProperty(dynamicconfig.HistoryCacheEnableDomainMetrics),
+		HistoryCacheLockReleaseDelay:         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryCacheLockReleaseDelay),
+		HistoryCacheLockReleaseDelayScopes:   dc.GetMapProperty(dynamicconfig.HistoryCacheLockReleaseDelayScopes),
+		WorkflowLockPriorities:               dc.GetMapProperty(dynamicconfig.WorkflowLockPriorities),
+		EnableStickyDecisionPinning:          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableStickyDecisionPinning),
+		ShardCacheWarmUpMaxExecutions:        dc.GetIntProperty(dynamicconfig.HistoryShardCacheWarmUpMaxExecutions),
 		EventsCacheInitialCount:              dc.GetIntProperty(dynamicconfig.EventsCacheInitialCount),
 		EventsCacheMaxCount:                  dc.GetIntProperty(dynamicconfig.EventsCacheMaxCount),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize),
@@ -351,6 +442,11 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EventsCacheGlobalEnable:              dc.GetBoolProperty(dynamicconfig.EventsCacheGlobalEnable),
 		EventsCacheGlobalInitialCount:        dc.GetIntProperty(dynamicconfig.EventsCacheGlobalInitialCount),
 		EventsCacheGlobalMaxCount:            dc.GetIntProperty(dynamicconfig.EventsCacheGlobalMaxCount),
+		CacheMemoryBudgetFraction:            dc.GetFloat64Property(dynamicconfig.HistoryCacheMemoryBudgetFraction),
+		CacheMemoryBudgetCheckInterval:       dc.GetDurationProperty(dynamicconfig.HistoryCacheMemoryBudgetCheckInterval),
+		BusyPendingTaskThreshold:             dc.GetIntProperty(dynamicconfig.HistoryBusyPendingTaskThreshold),
+		BusyLockWaitThreshold:                dc.GetDurationProperty(dynamicconfig.HistoryBusyLockWaitThreshold),
+		BusyRetryAfter:                       dc.GetDurationProperty(dynamicconfig.HistoryBusyRetryAfter),
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency),
@@ -376,6 +472,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ResurrectionCheckMinDelay:               dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ResurrectionCheckMinDelay),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableSplit),
+		QueueProcessorSplitRollout:                         dc.GetRolloutPropertyFilteredByShardID(dynamicconfig.QueueProcessorSplitRolloutPercentage),
 		QueueProcessorSplitMaxLevel:                        dc.GetIntProperty(dynamicconfig.QueueProcessorSplitMaxLevel),
 		QueueProcessorEnableRandomSplitByDomainID:          dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.QueueProcessorEnableRandomSplitByDomainID),
 		QueueProcessorRandomSplitProbability:               dc.GetFloat64Property(dynamicconfig.QueueProcessorRandomSplitProbability),
@@ -388,6 +485,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		QueueProcessorPollBackoffIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.QueueProcessorPollBackoffIntervalJitterCoefficient),
 		QueueProcessorEnablePersistQueueStates:             dc.GetBoolProperty(dynamicconfig.QueueProcessorEnablePersistQueueStates),
 		QueueProcessorEnableLoadQueueStates:                dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableLoadQueueStates),
+		QueueProcessorEnableCachePrefetch:                  dc.GetBoolProperty(dynamicconfig.QueueProcessorEnableCachePrefetch),
 
 		TimerTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize),
 		TimerTaskDeleteBatchSize:                          dc.GetIntProperty(dynamicconfig.TimerTaskDeleteBatchSize),
@@ -407,6 +505,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		TimerProcessorMaxTimeShift:                        dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxTimeShift),
 		TimerProcessorHistoryArchivalSizeLimit:            dc.GetIntProperty(dynamicconfig.TimerProcessorHistoryArchivalSizeLimit),
 		TimerProcessorArchivalTimeLimit:                   dc.GetDurationProperty(dynamicconfig.TimerProcessorArchivalTimeLimit),
+		TimerProcessorOverdueTaskThreshold:                dc.GetDurationProperty(dynamicconfig.TimerProcessorOverdueTaskThreshold),
 
 		TransferTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TransferTaskBatchSize),
 		TransferTaskDeleteBatchSize:                          dc.GetIntProperty(dynamicconfig.TransferTaskDeleteBatchSize),
@@ -454,11 +553,20 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicatorProcessorFetchTasksBatchSize: dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicatorTaskBatchSize),
 		ReplicatorUpperLatency:                 dc.GetDurationProperty(dynamicconfig.ReplicatorUpperLatency),
 
+		EnableReplicationLagTracker:   dc.GetBoolProperty(dynamicconfig.EnableReplicationLagTracker),
+		ReplicationLagTrackerInterval: dc.GetDurationProperty(dynamicconfig.ReplicationLagTrackerInterval),
+		ReplicationLagSLO:             dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ReplicationLagSLO),
+
+		NDCConflictResolutionPolicy:           dc.GetStringPropertyFilteredByDomain(dynamicconfig.NDCConflictResolutionPolicy),
+		NDCConflictResolutionPreferredCluster: dc.GetStringPropertyFilteredByDomain(dynamicconfig.NDCConflictResolutionPreferredCluster),
+
 		ExecutionMgrNumConns:            dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns),
 		HistoryMgrNumConns:              dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns),
 		MaximumBufferedEventsBatch:      dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch),
 		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution),
+		MaxSignalRequestIDsCarriedOver:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxSignalRequestIDsCarriedOver),
 		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval),
+		ShardUpdateMaxStaleness:         dc.GetDurationProperty(dynamicconfig.ShardUpdateMaxStaleness),
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval),
 		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient),
 
@@ -470,6 +578,19 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker),
 		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.ParentClosePolicyThreshold),
 
+		EnableWorkflowCloseWebhook:                  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowCloseWebhook),
+		WorkflowCloseWebhookMaxConcurrentDeliveries: dc.GetIntProperty(dynamicconfig.WorkflowCloseWebhookMaxConcurrentDeliveries),
+		WorkflowCloseWebhookRequestTimeout:          dc.GetDurationProperty(dynamicconfig.WorkflowCloseWebhookRequestTimeout),
+		WorkflowCloseWebhookMaxRetryDuration:        dc.GetDurationProperty(dynamicconfig.WorkflowCloseWebhookMaxRetryDuration),
+
+		EnableCloudEvents:             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableCloudEvents),
+		CloudEventsSink:               dc.GetStringProperty(dynamicconfig.CloudEventsSink),
+		CloudEventsMaxConcurrentEmits: dc.GetIntProperty(dynamicconfig.CloudEventsMaxConcurrentEmits),
+
+		HistoryExportMode:        dc.GetStringPropertyFilteredByDomain(dynamicconfig.HistoryExportMode),
+		HistoryExportQueueSize:   dc.GetIntProperty(dynamicconfig.HistoryExportQueueSize),
+		HistoryExportConcurrency: dc.GetIntProperty(dynamicconfig.HistoryExportConcurrency),
+
 		NumArchiveSystemWorkflows:        dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows),
 		ArchiveRequestRPS:                dc.GetIntProperty(dynamicconfig.ArchiveRequestRPS),
 		ArchiveInlineHistoryRPS:          dc.GetIntProperty(dynamicconfig.ArchiveInlineHistoryRPS),
@@ -498,6 +619,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		DecisionRetryMaxAttempts:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.DecisionRetryMaxAttempts),
 		NormalDecisionScheduleToStartMaxAttempts: dc.GetIntPropertyFilteredByDomain(dynamicconfig.NormalDecisionScheduleToStartMaxAttempts),
 		NormalDecisionScheduleToStartTimeout:     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.NormalDecisionScheduleToStartTimeout),
+		DecisionQuarantineThreshold:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.DecisionQuarantineThreshold),
+		DecisionQuarantineTTL:                    dc.GetDurationProperty(dynamicconfig.DecisionQuarantineTTL),
+		ActivityHeartbeatCoalescingInterval:      dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityHeartbeatCoalescingInterval),
 
 		ReplicationTaskFetcherParallelism:                  dc.GetIntProperty(dynamicconfig.ReplicationTaskFetcherParallelism),
 		ReplicationTaskFetcherAggregationInterval:          dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherAggregationInterval),
@@ -524,9 +648,13 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain),
 		EnableCrossClusterOperations:          dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableCrossClusterOperations),
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount),
+		EnableQueryResultCache:                dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableQueryResultCache),
+		EnableWorkflowReadSnapshot:            dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowReadSnapshot),
+		EnableShardFencingAudit:               dc.GetBoolProperty(dynamicconfig.EnableShardFencingAudit),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore),
+		MutableStateInvariantCheckProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateInvariantCheckProbability),
 
 		EnableHistoryCorruptionCheck: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableHistoryCorruptionCheck),
 
@@ -536,9 +664,14 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableActivityLocalDispatchByDomain),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxActivityCountDispatchByDomain),
+		ActivityDispatchRPSByDomain:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.ActivityDispatchRPSByDomain),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry),
 
+		PendingActivitiesCountLimit:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingActivitiesCountLimit),
+		PendingChildWorkflowsCountLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingChildWorkflowsCountLimit),
+		ChildWorkflowStartRPSPerParent:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.ChildWorkflowStartRPSPerParent),
+
 		EnableDebugMode:             dc.GetBoolProperty(dynamicconfig.EnableDebugMode)(),
 		EnableTaskInfoLogByDomainID: dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.HistoryEnableTaskInfoLogByDomainID),
 	}
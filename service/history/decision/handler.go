@@ -23,6 +23,7 @@ package decision
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 	"time"
 
 	"go.uber.org/yarpc"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -68,6 +70,7 @@ type (
 		throttledLogger log.Logger
 		attrValidator   *attrValidator
 		versionChecker  client.VersionChecker
+		quarantine      *quarantine
 	}
 )
 
@@ -96,6 +99,7 @@ func NewHandler(
 			logger,
 		),
 		versionChecker: client.NewVersionChecker(),
+		quarantine:     newQuarantine(config),
 	}
 }
 
@@ -166,6 +170,21 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 	scheduleID := req.GetScheduleID()
 	requestID := req.GetRequestID()
 
+	workflowIdentifier := definition.NewWorkflowIdentifier(domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	if quarantined := handler.quarantine.getQuarantined(workflowIdentifier); quarantined != nil {
+		// dropping the task in matching stops dispatching the failing decision,
+		// it is dispatched again by a timer once the quarantine ends
+		handler.metricsClient.IncCounter(metrics.HistoryRecordDecisionTaskStartedScope, metrics.QuarantinedDecisionCounter)
+		if quarantined.claimRedispatch() {
+			if err := handler.redispatchAfterQuarantine(ctx, domainEntry, workflowExecution, scheduleID, quarantined.until); err != nil {
+				// let matching retry the task, so the redispatch is scheduled by a later attempt
+				quarantined.unclaimRedispatch()
+				return nil, err
+			}
+		}
+		return nil, &types.EntityNotExistsError{Message: "Decision task is quarantined."}
+	}
+
 	var resp *types.RecordDecisionTaskStartedResponse
 	err = workflow.UpdateWithActionFunc(
 		ctx,
@@ -173,7 +192,15 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 		domainID,
 		workflowExecution,
 		handler.timeSource.Now(),
-		func(context execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+		func(context execution.Context, mutableState execution.MutableState) (_ *workflow.UpdateAction, retError error) {
+			defer handler.recoverDecisionPanic(
+				metrics.HistoryRecordDecisionTaskStartedScope,
+				domainEntry.GetInfo().Name,
+				workflowIdentifier,
+				scheduleID,
+				&retError,
+			)
+
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrNotExists
 			}
@@ -304,6 +331,12 @@ func (handler *handlerImpl) HandleDecisionTaskCompleted(
 		RunID:      token.RunID,
 	}
 
+	workflowIdentifier := definition.NewWorkflowIdentifier(domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	if handler.quarantine.isQuarantined(workflowIdentifier) {
+		handler.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.QuarantinedDecisionCounter)
+		return nil, &types.EntityNotExistsError{Message: "Decision task is quarantined."}
+	}
+
 	call := yarpc.CallFromContext(ctx)
 	clientLibVersion := call.Header(common.LibraryVersionHeaderName)
 	clientFeatureVersion := call.Header(common.FeatureVersionHeaderName)
@@ -314,6 +347,16 @@ func (handler *handlerImpl) HandleDecisionTaskCompleted(
 		return nil, err
 	}
 	defer func() { release(retError) }()
+	// runs before release, so the pin of a sticky decision scheduled by this completion is not removed
+	defer handler.executionCache.UnpinSticky(workflowIdentifier)
+	// runs before release, so the cached workflow context is cleared after a panic
+	defer handler.recoverDecisionPanic(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		domainEntry.GetInfo().Name,
+		workflowIdentifier,
+		token.ScheduleID,
+		&retError,
+	)
 
 Update_History_Loop:
 	for attempt := 0; attempt < workflow.ConditionalRetryCount; attempt++ {
@@ -885,6 +928,95 @@ func (handler *handlerImpl) failDecisionHelper(
 	return mutableState, nil
 }
 
+// recoverDecisionPanic converts a panic during decision processing into an error,
+// and quarantines the workflow if processing of the same decision keeps panicking
+func (handler *handlerImpl) recoverDecisionPanic(
+	scope int,
+	domainName string,
+	workflowIdentifier definition.WorkflowIdentifier,
+	scheduleID int64,
+	retError *error,
+) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	handler.metricsClient.IncCounter(scope, metrics.DecisionPanicCounter)
+	handler.logger.Error("Panic during decision processing",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(workflowIdentifier.WorkflowID),
+		tag.WorkflowRunID(workflowIdentifier.RunID),
+		tag.WorkflowScheduleID(scheduleID),
+		tag.Value(r),
+		tag.DetailInfo(string(debug.Stack())),
+	)
+	*retError = &types.InternalServiceError{Message: fmt.Sprintf("Decision processing failed: %v", r)}
+
+	if handler.quarantine.recordFailure(domainName, workflowIdentifier, scheduleID, handler.timeSource.Now()) {
+		handler.metricsClient.IncCounter(scope, metrics.WorkflowQuarantinedCounter)
+		handler.logger.Error("Workflow quarantined after repeated decision processing failures",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(workflowIdentifier.WorkflowID),
+			tag.WorkflowRunID(workflowIdentifier.RunID),
+			tag.WorkflowScheduleID(scheduleID),
+			tag.Timestamp(handler.quarantine.getQuarantined(workflowIdentifier).until),
+		)
+	}
+}
+
+// redispatchAfterQuarantine creates a schedule to start timeout timer for the decision rejected because
+// of the quarantine, which fires when the quarantine ends and schedules a new attempt of the decision
+func (handler *handlerImpl) redispatchAfterQuarantine(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+	scheduleID int64,
+	quarantineEnd time.Time,
+) error {
+
+	err := workflow.UpdateWithActionFunc(
+		ctx,
+		handler.executionCache,
+		domainEntry.GetInfo().ID,
+		workflowExecution,
+		handler.timeSource.Now(),
+		func(context execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrNotExists
+			}
+			decision, ok := mutableState.GetDecisionInfo(scheduleID)
+			if !ok || decision.StartedID != common.EmptyEventID {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			mutableState.AddTimerTasks(&persistence.DecisionTimeoutTask{
+				// TaskID is set by shard
+				VisibilityTimestamp: quarantineEnd.Add(quarantineRedispatchDelay),
+				TimeoutType:         int(execution.TimerTypeScheduleToStart),
+				EventID:             decision.ScheduleID,
+				ScheduleAttempt:     decision.Attempt,
+				Version:             decision.Version,
+			})
+			return &workflow.UpdateAction{}, nil
+		},
+	)
+	if err == workflow.ErrNotExists {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	handler.logger.Info("Quarantined decision is dispatched again once the quarantine ends",
+		tag.WorkflowDomainName(domainEntry.GetInfo().Name),
+		tag.WorkflowID(workflowExecution.GetWorkflowID()),
+		tag.WorkflowRunID(workflowExecution.GetRunID()),
+		tag.WorkflowScheduleID(scheduleID),
+		tag.Timestamp(quarantineEnd),
+	)
+	return nil
+}
+
 func (handler *handlerImpl) getActiveDomainByID(id string) (*cache.DomainCacheEntry, error) {
 	return cache.GetActiveDomainByID(handler.shard.GetDomainCache(), handler.shard.GetClusterMetadata().GetCurrentClusterName(), id)
 }
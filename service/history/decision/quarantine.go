@@ -0,0 +1,151 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package decision
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	quarantineMaxCount = 1000
+	// quarantineRedispatchDelay makes sure the decision is dispatched again after the quarantine has expired
+	quarantineRedispatchDelay = time.Second
+)
+
+type (
+	// quarantine tracks decisions whose processing fails server side, e.g. because of a corrupted history,
+	// and quarantines their workflows after repeated failures, so the decision is no longer dispatched
+	// and retried forever. Quarantine is kept in memory by the shard owner and expires after a TTL,
+	// the first decision rejected during a quarantine is dispatched again by a timer when it ends.
+	quarantine struct {
+		threshold   dynamicconfig.IntPropertyFnWithDomainFilter
+		ttl         time.Duration
+		failures    cache.Cache // definition.WorkflowIdentifier -> *decisionFailures
+		quarantined cache.Cache // definition.WorkflowIdentifier -> *quarantinedWorkflow
+	}
+
+	quarantinedWorkflow struct {
+		scheduleID int64
+		until      time.Time
+		// set once the decision is scheduled to be dispatched again when the quarantine ends
+		redispatchScheduled int32
+	}
+
+	decisionFailures struct {
+		sync.Mutex
+		scheduleID int64
+		count      int
+	}
+)
+
+func newQuarantine(
+	config *config.Config,
+) *quarantine {
+	ttl := config.DecisionQuarantineTTL()
+	return &quarantine{
+		threshold: config.DecisionQuarantineThreshold,
+		ttl:       ttl,
+		failures: cache.New(&cache.Options{
+			TTL:      ttl,
+			MaxCount: quarantineMaxCount,
+		}),
+		quarantined: cache.New(&cache.Options{
+			TTL:      ttl,
+			MaxCount: quarantineMaxCount,
+		}),
+	}
+}
+
+// recordFailure records a server side failure of the decision with the given scheduleID,
+// at the given time and returns true if the workflow is quarantined because of it
+func (q *quarantine) recordFailure(
+	domainName string,
+	workflow definition.WorkflowIdentifier,
+	scheduleID int64,
+	now time.Time,
+) bool {
+	threshold := q.threshold(domainName)
+	if threshold <= 0 {
+		return false
+	}
+
+	value, err := q.failures.PutIfNotExist(workflow, &decisionFailures{scheduleID: scheduleID})
+	if err != nil {
+		return false
+	}
+	failures := value.(*decisionFailures)
+	failures.Lock()
+	defer failures.Unlock()
+
+	// only failures of the same decision are counted, a new decision may succeed
+	if failures.scheduleID != scheduleID {
+		failures.scheduleID = scheduleID
+		failures.count = 0
+	}
+	failures.count++
+	if failures.count < threshold {
+		return false
+	}
+
+	q.failures.Delete(workflow)
+	q.quarantined.Put(workflow, &quarantinedWorkflow{
+		scheduleID: scheduleID,
+		until:      now.Add(q.ttl),
+	})
+	return true
+}
+
+// getQuarantined returns the quarantine of the workflow, or nil if its decisions can be processed
+func (q *quarantine) getQuarantined(
+	workflow definition.WorkflowIdentifier,
+) *quarantinedWorkflow {
+	value := q.quarantined.Get(workflow)
+	if value == nil {
+		return nil
+	}
+	return value.(*quarantinedWorkflow)
+}
+
+// isQuarantined returns true if decisions of the workflow must not be processed
+func (q *quarantine) isQuarantined(
+	workflow definition.WorkflowIdentifier,
+) bool {
+	return q.getQuarantined(workflow) != nil
+}
+
+// claimRedispatch returns true for the first caller only, which then schedules
+// the rejected decision to be dispatched again when the quarantine ends
+func (w *quarantinedWorkflow) claimRedispatch() bool {
+	return atomic.CompareAndSwapInt32(&w.redispatchScheduled, 0, 1)
+}
+
+// unclaimRedispatch allows the next rejected decision to schedule the redispatch,
+// after scheduling it failed
+func (w *quarantinedWorkflow) unclaimRedispatch() {
+	atomic.StoreInt32(&w.redispatchScheduled, 0)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package decision
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+	test "github.com/uber/cadence/service/history/testing"
+)
+
+func newTestQuarantine(threshold int) *quarantine {
+	config := config.NewForTest()
+	config.DecisionQuarantineThreshold = dynamicconfig.GetIntPropertyFilteredByDomain(threshold)
+	config.DecisionQuarantineTTL = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	return newQuarantine(config)
+}
+
+func TestQuarantine_Disabled(t *testing.T) {
+	q := newTestQuarantine(0)
+	workflow := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID)
+
+	for i := 0; i < 10; i++ {
+		require.False(t, q.recordFailure(constants.TestDomainName, workflow, 5, time.Now()))
+	}
+	require.False(t, q.isQuarantined(workflow))
+}
+
+func TestQuarantine_SameDecision(t *testing.T) {
+	q := newTestQuarantine(3)
+	workflow := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID)
+	other := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, "other-run")
+
+	require.False(t, q.recordFailure(constants.TestDomainName, workflow, 5, time.Now()))
+	require.False(t, q.recordFailure(constants.TestDomainName, workflow, 5, time.Now()))
+	require.False(t, q.isQuarantined(workflow))
+	require.True(t, q.recordFailure(constants.TestDomainName, workflow, 5, time.Now()))
+	require.True(t, q.isQuarantined(workflow))
+	require.False(t, q.isQuarantined(other))
+}
+
+func TestQuarantine_Redispatch(t *testing.T) {
+	q := newTestQuarantine(1)
+	workflow := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID)
+	now := time.Now()
+
+	require.True(t, q.recordFailure(constants.TestDomainName, workflow, 5, now))
+	quarantined := q.getQuarantined(workflow)
+	require.NotNil(t, quarantined)
+	require.Equal(t, now.Add(time.Hour), quarantined.until)
+
+	require.True(t, quarantined.claimRedispatch())
+	require.False(t, quarantined.claimRedispatch())
+	quarantined.unclaimRedispatch()
+	require.True(t, quarantined.claimRedispatch())
+}
+
+func TestQuarantine_NewDecisionResetsCount(t *testing.T) {
+	q := newTestQuarantine(2)
+	workflow := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID)
+
+	require.False(t, q.recordFailure(constants.TestDomainName, workflow, 5, time.Now()))
+	require.False(t, q.recordFailure(constants.TestDomainName, workflow, 8, time.Now()))
+	require.False(t, q.isQuarantined(workflow))
+	require.True(t, q.recordFailure(constants.TestDomainName, workflow, 8, time.Now()))
+	require.True(t, q.isQuarantined(workflow))
+}
+
+func TestRecoverDecisionPanic(t *testing.T) {
+	handler := &handlerImpl{
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.History),
+		logger:        loggerimpl.NewNopLogger(),
+		timeSource:    clock.NewRealTimeSource(),
+		quarantine:    newTestQuarantine(2),
+	}
+	workflow := definition.NewWorkflowIdentifier(constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID)
+	process := func() (retError error) {
+		defer handler.recoverDecisionPanic(metrics.HistoryRespondDecisionTaskCompletedScope, constants.TestDomainName, workflow, 5, &retError)
+		panic("corrupted history")
+	}
+
+	err := process()
+	require.IsType(t, &types.InternalServiceError{}, err)
+	require.Contains(t, err.Error(), "corrupted history")
+	require.False(t, handler.quarantine.isQuarantined(workflow))
+
+	require.Error(t, process())
+	require.True(t, handler.quarantine.isQuarantined(workflow))
+}
+
+func TestHandleDecisionTaskStarted_Quarantined(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistence.ShardInfo{RangeID: 1},
+		config.NewForTest(),
+	)
+	defer mockShard.Finish(t)
+	mockShard.SetEventsCache(events.NewCache(
+		mockShard.GetShardID(),
+		mockShard.GetHistoryManager(),
+		mockShard.GetConfig(),
+		mockShard.GetLogger(),
+		mockShard.GetMetricsClient(),
+	))
+	mockEngine := engine.NewMockEngine(controller)
+	mockEngine.EXPECT().NotifyNewHistoryEvent(gomock.Any()).AnyTimes()
+	mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any(), gomock.Any()).AnyTimes()
+	mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any(), gomock.Any()).AnyTimes()
+	mockEngine.EXPECT().NotifyNewCrossClusterTasks(gomock.Any(), gomock.Any()).AnyTimes()
+	mockShard.SetEngine(mockEngine)
+	mockShard.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, nil).AnyTimes()
+	mockShard.Resource.DomainCache.EXPECT().GetDomainName(constants.TestDomainID).Return(constants.TestDomainName, nil).AnyTimes()
+
+	handler := NewHandler(mockShard, execution.NewCache(mockShard), common.NewJSONTaskTokenSerializer()).(*handlerImpl)
+	handler.quarantine = newTestQuarantine(1)
+
+	workflowExecution, mutableState, err := test.StartWorkflow(mockShard, constants.TestDomainID)
+	require.NoError(t, err)
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	require.NoError(t, err)
+
+	workflow := definition.NewWorkflowIdentifier(constants.TestDomainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	require.True(t, handler.quarantine.recordFailure(constants.TestDomainName, workflow, di.ScheduleID, time.Now()))
+	quarantineEnd := handler.quarantine.getQuarantined(workflow).until
+
+	// the first rejected decision creates a timer which dispatches the decision again once the quarantine ends
+	mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+	mockShard.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+		timerTasks := req.UpdateWorkflowMutation.TimerTasks
+		if len(timerTasks) != 1 {
+			return false
+		}
+		timerTask, ok := timerTasks[0].(*persistence.DecisionTimeoutTask)
+		return ok &&
+			timerTask.EventID == di.ScheduleID &&
+			timerTask.TimeoutType == int(execution.TimerTypeScheduleToStart) &&
+			timerTask.VisibilityTimestamp.Equal(quarantineEnd.Add(quarantineRedispatchDelay))
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	request := &types.RecordDecisionTaskStartedRequest{
+		DomainUUID:        constants.TestDomainID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        di.ScheduleID,
+		RequestID:         "request-id",
+		PollRequest: &types.PollForDecisionTaskRequest{
+			TaskList: &types.TaskList{Name: di.TaskList},
+		},
+	}
+	_, err = handler.HandleDecisionTaskStarted(context.Background(), request)
+	require.IsType(t, &types.EntityNotExistsError{}, err)
+
+	// later rejections of the same quarantine do not create another timer
+	_, err = handler.HandleDecisionTaskStarted(context.Background(), request)
+	require.IsType(t, &types.EntityNotExistsError{}, err)
+}
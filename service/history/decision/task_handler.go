@@ -248,6 +248,19 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return validatePendingCountLimit(
+				"activities",
+				len(handler.mutableState.GetPendingActivityInfos()),
+				handler.config.PendingActivitiesCountLimit(handler.domainEntry.GetInfo().Name),
+			)
+		},
+		types.DecisionTaskFailedCauseBadScheduleActivityAttributes,
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeScheduleActivityTask.String()),
 		attr.Input,
@@ -852,6 +865,19 @@ func (handler *taskHandlerImpl) handleDecisionStartChildWorkflow(
 		return err
 	}
 
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return validatePendingCountLimit(
+				"child workflows",
+				len(handler.mutableState.GetPendingChildExecutionInfos()),
+				handler.config.PendingChildWorkflowsCountLimit(handler.domainEntry.GetInfo().Name),
+			)
+		},
+		types.DecisionTaskFailedCauseBadStartChildExecutionAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeStartChildWorkflowExecution.String()),
 		attr.Input,
@@ -1055,6 +1081,20 @@ func (handler *taskHandlerImpl) validateDecisionAttr(
 	return nil
 }
 
+func validatePendingCountLimit(
+	name string,
+	pendingCount int,
+	limit int,
+) error {
+
+	if limit > 0 && pendingCount >= limit {
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("Workflow already has %v pending %v, limit is %v.", pendingCount, name, limit),
+		}
+	}
+	return nil
+}
+
 func (handler *taskHandlerImpl) handlerFailDecision(
 	failedCause types.DecisionTaskFailedCause,
 	failMessage string,
@@ -0,0 +1,54 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package decision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidatePendingCountLimit(t *testing.T) {
+	tests := []struct {
+		msg          string
+		pendingCount int
+		limit        int
+		expectErr    bool
+	}{
+		{msg: "no limit", pendingCount: 50000, limit: 0},
+		{msg: "below limit", pendingCount: 9, limit: 10},
+		{msg: "limit reached", pendingCount: 10, limit: 10, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			err := validatePendingCountLimit("activities", tt.pendingCount, tt.limit)
+			if !tt.expectErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, &types.BadRequestError{}, err)
+			assert.Equal(t, "Workflow already has 10 pending activities, limit is 10.", err.Error())
+		})
+	}
+}
@@ -90,6 +90,7 @@ func NewGlobalCache(
 	logger log.Logger,
 	metricsClient metrics.Client,
 	maxSize uint64,
+	capacityScale func() float64,
 ) Cache {
 	return newCacheWithOption(
 		nil,
@@ -101,6 +102,7 @@ func NewGlobalCache(
 		logger,
 		metricsClient,
 		maxSize,
+		capacityScale,
 	)
 }
 
@@ -122,6 +124,7 @@ func NewCache(
 		logger,
 		metricsClient,
 		0,
+		nil,
 	)
 }
 
@@ -135,17 +138,21 @@ func newCacheWithOption(
 	logger log.Logger,
 	metrics metrics.Client,
 	maxSize uint64,
+	capacityScale func() float64,
 ) *cacheImpl {
 	opts := &cache.Options{}
 	opts.InitialCapacity = initialCount
 	opts.TTL = ttl
-	opts.MaxCount = maxCount
+	opts.CapacityScale = capacityScale
 
+	// the events cache is bounded either by count or by size
 	if maxSize > 0 {
 		opts.MaxSize = maxSize
 		opts.GetCacheItemSizeFunc = func(event interface{}) uint64 {
 			return common.GetSizeOfHistoryEvent(event.(*types.HistoryEvent))
 		}
+	} else {
+		opts.MaxCount = maxCount
 	}
 
 	return &cacheImpl{
@@ -81,7 +81,7 @@ func (s *eventsCacheSuite) TearDownTest() {
 
 func (s *eventsCacheSuite) newTestEventsCache() *cacheImpl {
 	return newCacheWithOption(common.IntPtr(10), 16, 32, time.Minute, s.mockHistoryManager, false, s.logger,
-		metrics.NewClient(tally.NoopScope, metrics.History), 0)
+		metrics.NewClient(tally.NoopScope, metrics.History), 0, nil)
 }
 
 func (s *eventsCacheSuite) TestEventsCacheHitSuccess() {
@@ -22,6 +22,8 @@ package execution
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -52,7 +54,13 @@ type (
 		disabled         bool
 		logger           log.Logger
 		metricsClient    metrics.Client
+		metricsScope     metrics.Scope
 		config           *config.Config
+
+		// stickyPins holds the release timers of the workflow contexts pinned for sticky decisions,
+		// each pinned context holds a single reference in the cache until its timer fires or it is unpinned
+		stickyPinsLock sync.Mutex
+		stickyPins     map[definition.WorkflowIdentifier]*time.Timer
 	}
 )
 
@@ -74,15 +82,47 @@ func NewCache(shard shard.Context) *Cache {
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
 	opts.MaxCount = config.HistoryCacheMaxSize()
+	opts.CapacityScale = shard.GetService().GetCacheMemoryBudget().CapacityScale
+	if maxSizeBytes := config.HistoryCacheMaxSizeBytes(); maxSizeBytes > 0 {
+		opts.MaxSize = uint64(maxSizeBytes)
+		opts.GetCacheItemSizeFunc = getContextSize
+	}
+	opts.GetPartitionFunc = getContextDomainID
+	opts.PartitionQuota = func(domainID string) float64 {
+		return float64(config.HistoryCacheDomainQuotaPercent(domainID)) / 100
+	}
+
+	logger := shard.GetLogger().WithTags(tag.ComponentHistoryCache)
+	policy, err := cache.NewEvictionPolicy(config.HistoryCacheEvictionPolicy(), opts.MaxCount, getContextSize)
+	if err != nil {
+		logger.Warn("Invalid history cache eviction policy, falling back to LRU.", tag.Error(err))
+	}
+	opts.EvictionPolicy = policy
 
 	return &Cache{
 		Cache:            cache.New(opts),
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
-		logger:           shard.GetLogger().WithTags(tag.ComponentHistoryCache),
+		logger:           logger,
 		metricsClient:    shard.GetMetricsClient(),
-		config:           config,
+		metricsScope: shard.GetMetricsClient().Scope(
+			metrics.HistoryCacheScope,
+			metrics.InstanceTag(strconv.Itoa(shard.GetShardID())),
+		),
+		config:     config,
+		stickyPins: make(map[definition.WorkflowIdentifier]*time.Timer),
+	}
+}
+
+func getContextDomainID(key interface{}) string {
+	return key.(definition.WorkflowIdentifier).DomainID
+}
+
+func getContextSize(value interface{}) uint64 {
+	if workflowContext, ok := value.(*contextImpl); ok {
+		return uint64(atomic.LoadInt64(&workflowContext.estimatedSize))
 	}
+	return 0
 }
 
 // GetOrCreateCurrentWorkflowExecution gets or creates workflow execution context for the current run
@@ -138,14 +178,14 @@ func (c *Cache) GetAndCreateWorkflowExecution(
 	releaseFunc := NoopReleaseFn
 	// If cache hit, we need to lock the cache to prevent race condition
 	if cacheHit {
-		if err := contextFromCache.Lock(ctx); err != nil {
+		if err := contextFromCache.Lock(ctx, c.getLockPriority(ctx)); err != nil {
 			// ctx is done before lock can be acquired
 			c.Release(key)
 			c.metricsClient.IncCounter(metrics.HistoryCacheGetAndCreateScope, metrics.CacheFailures)
 			c.metricsClient.IncCounter(metrics.HistoryCacheGetAndCreateScope, metrics.AcquireLockFailedCounter)
 			return nil, nil, nil, false, err
 		}
-		releaseFunc = c.makeReleaseFunc(key, contextFromCache, false)
+		releaseFunc = c.makeReleaseFunc(key, contextFromCache, metrics.HistoryCacheGetAndCreateScope, false)
 	} else {
 		c.metricsClient.IncCounter(metrics.HistoryCacheGetAndCreateScope, metrics.CacheMissCounter)
 	}
@@ -204,6 +244,172 @@ func (c *Cache) GetOrCreateWorkflowExecution(
 	)
 }
 
+// GetWorkflowExecutionForRead gets the mutable state of a workflow execution for read only access.
+// If read snapshots are enabled and the cached context has one, the snapshot is returned without
+// acquiring the workflow lock, otherwise the context is locked and the mutable state is loaded.
+// With read snapshots enabled the loaded mutable state is copied into a new snapshot, which is
+// returned after the lock is released and reused by later reads until the next update.
+// The returned mutable state must not be modified.
+func (c *Cache) GetWorkflowExecutionForRead(
+	ctx context.Context,
+	domainID string,
+	execution types.WorkflowExecution,
+) (MutableState, ReleaseFunc, error) {
+
+	scope := metrics.HistoryCacheGetForReadScope
+	c.metricsClient.IncCounter(scope, metrics.CacheRequests)
+	sw := c.metricsClient.StartTimer(scope, metrics.CacheLatency)
+	defer sw.Stop()
+
+	if err := c.validateWorkflowExecutionInfo(ctx, domainID, &execution); err != nil {
+		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
+		return nil, nil, err
+	}
+
+	domainName, err := c.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
+		return nil, nil, err
+	}
+	if !c.disabled && c.config.EnableWorkflowReadSnapshot(domainName) {
+		key := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowID(), execution.GetRunID())
+		if workflowCtx, cacheHit := c.Get(key).(Context); cacheHit {
+			snapshot := workflowCtx.GetReadSnapshot()
+			c.Release(key)
+			if snapshot != nil {
+				c.metricsClient.IncCounter(scope, metrics.ReadSnapshotHitCounter)
+				return snapshot, NoopReleaseFn, nil
+			}
+		}
+	}
+
+	workflowCtx, release, err := c.getOrCreateWorkflowExecutionInternal(
+		ctx,
+		domainID,
+		execution,
+		scope,
+		false,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	mutableState, err := workflowCtx.LoadWorkflowExecution(ctx)
+	if err != nil {
+		release(err)
+		return nil, nil, err
+	}
+	if !c.disabled && c.config.EnableWorkflowReadSnapshot(domainName) {
+		if snapshot := workflowCtx.UpdateReadSnapshot(); snapshot != nil {
+			release(nil)
+			return snapshot, NoopReleaseFn, nil
+		}
+	}
+	return mutableState, release, nil
+}
+
+// PrefetchWorkflowExecution loads the mutable state of a workflow execution into the cache without
+// acquiring the workflow lock, so a later GetOrCreateWorkflowExecution does not have to read it from
+// the DB while holding the lock. It is a no-op if the mutable state is already loaded.
+func (c *Cache) PrefetchWorkflowExecution(
+	ctx context.Context,
+	domainID string,
+	execution types.WorkflowExecution,
+) error {
+
+	if c.disabled {
+		return nil
+	}
+
+	scope := metrics.HistoryCachePrefetchScope
+	c.metricsClient.IncCounter(scope, metrics.CacheRequests)
+	sw := c.metricsClient.StartTimer(scope, metrics.CacheLatency)
+	defer sw.Stop()
+
+	if err := c.validateWorkflowExecutionInfo(ctx, domainID, &execution); err != nil {
+		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
+		return err
+	}
+
+	key := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowID(), execution.GetRunID())
+	workflowCtx, cacheHit := c.Get(key).(Context)
+	if !cacheHit {
+		c.metricsClient.IncCounter(scope, metrics.CacheMissCounter)
+		elem, err := c.PutIfNotExist(key, NewContext(domainID, execution, c.shard, c.executionManager, c.logger))
+		if err != nil {
+			c.metricsClient.IncCounter(scope, metrics.CacheFailures)
+			return err
+		}
+		workflowCtx = elem.(Context)
+	}
+	defer c.Release(key)
+
+	impl, ok := workflowCtx.(*contextImpl)
+	if !ok {
+		return nil
+	}
+	if err := impl.prefetch(ctx); err != nil {
+		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
+		return err
+	}
+	return nil
+}
+
+// PinForSticky pins the cached workflow context of the given key for at most ttl, so it is not evicted between a
+// sticky decision task being scheduled and completed and the next decision does not have to reload the mutable state.
+// Pinning an already pinned context extends its pin, the pin is removed early by UnpinSticky. It returns false
+// if the workflow context is not in the cache, as there is nothing to keep loaded for the decision.
+func (c *Cache) PinForSticky(
+	key definition.WorkflowIdentifier,
+	ttl time.Duration,
+) bool {
+
+	if c.disabled || ttl <= 0 {
+		return false
+	}
+
+	c.stickyPinsLock.Lock()
+	defer c.stickyPinsLock.Unlock()
+
+	if timer, ok := c.stickyPins[key]; ok {
+		// the pinned context already holds a reference, only its release is postponed
+		timer.Stop()
+	} else if c.Get(key) == nil {
+		return false
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(ttl, func() {
+		c.stickyPinsLock.Lock()
+		defer c.stickyPinsLock.Unlock()
+
+		// the pin may have been removed or extended since the timer was set
+		if c.stickyPins[key] != timer {
+			return
+		}
+		delete(c.stickyPins, key)
+		c.Release(key)
+	})
+	c.stickyPins[key] = timer
+	return true
+}
+
+// UnpinSticky removes the pin of a workflow context set by PinForSticky, it is a no-op if the context is not pinned
+func (c *Cache) UnpinSticky(
+	key definition.WorkflowIdentifier,
+) {
+
+	c.stickyPinsLock.Lock()
+	defer c.stickyPinsLock.Unlock()
+
+	timer, ok := c.stickyPins[key]
+	if !ok {
+		return
+	}
+	timer.Stop()
+	delete(c.stickyPins, key)
+	c.Release(key)
+}
+
 func (c *Cache) getOrCreateWorkflowExecutionInternal(
 	ctx context.Context,
 	domainID string,
@@ -233,9 +439,16 @@ func (c *Cache) getOrCreateWorkflowExecutionInternal(
 
 	// TODO This will create a closure on every request.
 	//  Consider revisiting this if it causes too much GC activity
-	releaseFunc := c.makeReleaseFunc(key, workflowCtx, forceClearContext)
-
-	if err := workflowCtx.Lock(ctx); err != nil {
+	releaseFunc := c.makeReleaseFunc(key, workflowCtx, scope, forceClearContext)
+
+	// time spent waiting for the lock, including waits cut short by ctx, feeds shard backpressure
+	priority := c.getLockPriority(ctx)
+	lockStartTime := time.Now()
+	err := workflowCtx.Lock(ctx, priority)
+	lockWait := time.Since(lockStartTime)
+	c.shard.GetLoadMonitor().RecordLockWait(lockWait)
+	c.metricsClient.Scope(scope, metrics.LockPriorityTag(priority)).RecordTimer(metrics.LockWaitLatency, lockWait)
+	if err != nil {
 		// ctx is done before lock can be acquired
 		c.Release(key)
 		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
@@ -276,6 +489,7 @@ func (c *Cache) validateWorkflowExecutionInfo(
 func (c *Cache) makeReleaseFunc(
 	key definition.WorkflowIdentifier,
 	context Context,
+	scope int,
 	forceClearContext bool,
 ) func(error) {
 
@@ -293,14 +507,54 @@ func (c *Cache) makeReleaseFunc(
 						// TODO see issue #668, there are certain type or errors which can bypass the clear
 						context.Clear()
 					}
+					c.injectLockReleaseDelay(key.DomainID, scope)
 					context.Unlock()
 					c.Release(key)
 				}
+				c.emitCacheUsage(key.DomainID)
 			}
 		}()
 	}
 }
 
+// injectLockReleaseDelay holds the workflow lock for the configured delay before it is released,
+// so lock contention can be simulated for a domain in latency and chaos testing
+func (c *Cache) injectLockReleaseDelay(
+	domainID string,
+	scope int,
+) {
+	if scopes := c.config.HistoryCacheLockReleaseDelayScopes(); len(scopes) > 0 {
+		if enabled, ok := scopes[metrics.GetScopeOperation(metrics.History, scope)].(bool); !ok || !enabled {
+			return
+		}
+	}
+	domainName, err := c.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return
+	}
+	if delay := c.config.HistoryCacheLockReleaseDelay(domainName); delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+func (c *Cache) emitCacheUsage(
+	domainID string,
+) {
+	c.metricsScope.UpdateGauge(metrics.HistoryCacheSizeBytes, float64(c.SizeBytes()))
+	if !c.config.HistoryCacheDomainMetrics() {
+		return
+	}
+
+	domainName, err := c.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return
+	}
+	entries, sizeBytes := c.PartitionUsage(domainID)
+	domainScope := c.metricsScope.Tagged(metrics.DomainTag(domainName))
+	domainScope.UpdateGauge(metrics.HistoryCacheDomainEntries, float64(entries))
+	domainScope.UpdateGauge(metrics.HistoryCacheDomainSizeBytes, float64(sizeBytes))
+}
+
 func (c *Cache) getCurrentExecutionWithRetry(
 	ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest,
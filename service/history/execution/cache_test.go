@@ -21,19 +21,25 @@
 package execution
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/shard"
 )
 
@@ -74,6 +80,8 @@ func (s *historyCacheSuite) SetupTest() {
 		},
 		config.NewForTest(),
 	)
+	// the domain name is resolved when the workflow lock is released
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName("test_domain_id").Return("test_domain", nil).AnyTimes()
 }
 
 func (s *historyCacheSuite) TearDownTest() {
@@ -144,6 +152,235 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	release(err4)
 }
 
+func (s *historyCacheSuite) TestPinForSticky() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(2)
+	domainID := "test_domain_id"
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-sticky-pinning",
+		RunID:      uuid.New(),
+	}
+	we2 := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-sticky-pinning",
+		RunID:      uuid.New(),
+	}
+	key := definition.NewWorkflowIdentifier(domainID, we.GetWorkflowID(), we.GetRunID())
+
+	// a context which is not cached is not pinned
+	s.False(s.cache.PinForSticky(key, time.Minute))
+
+	_, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	release(nil)
+
+	// pinning twice extends the pin, a single unpin removes it
+	s.True(s.cache.PinForSticky(key, time.Minute))
+	s.True(s.cache.PinForSticky(key, time.Minute))
+
+	// Cache is full because context is pinned, should get an error now
+	_, _, err = s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we2)
+	s.NotNil(err)
+
+	s.cache.UnpinSticky(key)
+	s.cache.UnpinSticky(key)
+	_, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we2)
+	s.Nil(err)
+	release(nil)
+}
+
+func (s *historyCacheSuite) TestPinForSticky_Expired() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(2)
+	domainID := "test_domain_id"
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-sticky-pinning-expired",
+		RunID:      uuid.New(),
+	}
+	we2 := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-sticky-pinning-expired",
+		RunID:      uuid.New(),
+	}
+	key := definition.NewWorkflowIdentifier(domainID, we.GetWorkflowID(), we.GetRunID())
+
+	_, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	release(nil)
+	s.True(s.cache.PinForSticky(key, 10*time.Millisecond))
+
+	// the pin is removed once its ttl elapses
+	s.Eventually(func() bool {
+		_, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we2)
+		if err != nil {
+			return false
+		}
+		release(nil)
+		return true
+	}, time.Second, 10*time.Millisecond)
+	s.cache.stickyPinsLock.Lock()
+	defer s.cache.stickyPinsLock.Unlock()
+	s.Empty(s.cache.stickyPins)
+}
+
+func (s *historyCacheSuite) TestHistoryCacheSizeBytes() {
+	s.mockShard.GetConfig().HistoryCacheMaxSizeBytes = dynamicconfig.GetIntPropertyFn(2048)
+	domainID := "test_domain_id"
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-size-bytes",
+		RunID:      uuid.New(),
+	}
+
+	context, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	context.(*contextImpl).mutableState = &mutableStateBuilder{
+		executionInfo: &persistence.WorkflowExecutionInfo{WorkflowID: we.WorkflowID},
+	}
+	context.(*contextImpl).updateEstimatedSize()
+	release(nil)
+	size := estimateMutableStateSize(context.(*contextImpl).mutableState)
+	s.Equal(uint64(executionInfoSizeEstimate+len(we.WorkflowID)), size)
+	s.Equal(size, s.cache.SizeBytes())
+
+	we2 := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-size-bytes",
+		RunID:      uuid.New(),
+	}
+	context2, release2, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we2)
+	s.Nil(err)
+	context2.(*contextImpl).mutableState = &mutableStateBuilder{
+		executionInfo: &persistence.WorkflowExecutionInfo{WorkflowID: we2.WorkflowID},
+	}
+	context2.(*contextImpl).updateEstimatedSize()
+	release2(nil)
+
+	// the loaded mutable state of the second context exceeds the size limit, the first context is evicted
+	s.Equal(size, s.cache.SizeBytes())
+	newContext, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	s.False(context == newContext)
+	release(nil)
+}
+
+func (s *historyCacheSuite) TestHistoryCacheDomainQuota() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(10)
+	s.mockShard.GetConfig().HistoryCacheDomainQuotaPercent = func(domainID string) int {
+		if domainID == "noisy_domain_id" {
+			return 20
+		}
+		return 100
+	}
+	s.mockShard.GetConfig().HistoryCacheDomainMetrics = dynamicconfig.GetBoolPropertyFn(true)
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain", nil).AnyTimes()
+	s.cache = NewCache(s.mockShard)
+
+	quiet := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-domain-quota",
+		RunID:      uuid.New(),
+	}
+	quietContext, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("quiet_domain_id", quiet)
+	s.Nil(err)
+	release(nil)
+
+	var noisyContexts []Context
+	for i := 0; i < 3; i++ {
+		execution := types.WorkflowExecution{
+			WorkflowID: "wf-cache-test-domain-quota",
+			RunID:      uuid.New(),
+		}
+		noisyContext, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("noisy_domain_id", execution)
+		s.Nil(err)
+		release(nil)
+		noisyContexts = append(noisyContexts, noisyContext)
+	}
+
+	// the noisy domain only evicts its own contexts
+	count, _ := s.cache.PartitionUsage("noisy_domain_id")
+	s.Equal(2, count)
+	s.Equal(3, s.cache.Size())
+	newContext, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("quiet_domain_id", quiet)
+	s.Nil(err)
+	s.True(quietContext == newContext)
+	release(nil)
+	newContext, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground("noisy_domain_id", *noisyContexts[0].GetExecution())
+	s.Nil(err)
+	s.False(noisyContexts[0] == newContext)
+	release(nil)
+}
+
+func (s *historyCacheSuite) TestHistoryCacheLockReleaseDelay() {
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName("delayed_domain_id").Return("delayed_domain", nil).AnyTimes()
+	s.mockShard.GetConfig().HistoryCacheLockReleaseDelay = func(domainName string) time.Duration {
+		if domainName == "delayed_domain" {
+			return 50 * time.Millisecond
+		}
+		return 0
+	}
+	s.mockShard.GetConfig().HistoryCacheLockReleaseDelayScopes = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"HistoryCacheGetOrCreate": true,
+	})
+	s.cache = NewCache(s.mockShard)
+	execution := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-lock-release-delay",
+		RunID:      uuid.New(),
+	}
+
+	_, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("delayed_domain_id", execution)
+	s.Nil(err)
+	start := time.Now()
+	release(nil)
+	s.True(time.Since(start) >= 50*time.Millisecond)
+
+	// the delay is only injected for the configured domains and operations
+	_, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground("test_domain_id", execution)
+	s.Nil(err)
+	start = time.Now()
+	release(nil)
+	s.True(time.Since(start) < 50*time.Millisecond)
+
+	_, release, err = s.cache.GetOrCreateCurrentWorkflowExecution(context.Background(), "delayed_domain_id", execution.WorkflowID)
+	s.Nil(err)
+	start = time.Now()
+	release(nil)
+	s.True(time.Since(start) < 50*time.Millisecond)
+}
+
+func (s *historyCacheSuite) TestHistoryCacheLockPriority() {
+	s.mockShard.GetConfig().WorkflowLockPriorities = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"RespondDecisionTaskCompleted": 0,
+		"RecordActivityTaskStarted":    float64(2),
+	})
+	s.cache = NewCache(s.mockShard)
+
+	s.Equal(LockPriorityDefault, s.cache.getLockPriority(context.Background()))
+	s.Equal(LockPriorityHigh, s.cache.getLockPriority(ContextWithCallerScope(context.Background(), metrics.HistoryRespondDecisionTaskCompletedScope)))
+	s.Equal(2, s.cache.getLockPriority(ContextWithCallerScope(context.Background(), metrics.HistoryRecordActivityTaskStartedScope)))
+	s.Equal(LockPriorityDefault, s.cache.getLockPriority(ContextWithCallerScope(context.Background(), metrics.HistorySignalWorkflowExecutionScope)))
+
+	domainID := "test_domain_id"
+	execution := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-lock-priority",
+		RunID:      uuid.New(),
+	}
+	_, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, execution)
+	s.Nil(err)
+
+	// the high priority caller acquires the lock before the earlier default priority caller
+	acquired := make(chan int, 2)
+	for _, scope := range []int{metrics.HistorySignalWorkflowExecutionScope, metrics.HistoryRespondDecisionTaskCompletedScope} {
+		ctx := ContextWithCallerScope(context.Background(), scope)
+		go func(scope int) {
+			_, release, err := s.cache.GetOrCreateWorkflowExecution(ctx, domainID, execution)
+			s.Nil(err)
+			acquired <- scope
+			release(nil)
+		}(scope)
+		time.Sleep(50 * time.Millisecond)
+	}
+	release(nil)
+	s.Equal(metrics.HistoryRespondDecisionTaskCompletedScope, <-acquired)
+	s.Equal(metrics.HistorySignalWorkflowExecutionScope, <-acquired)
+}
+
 func (s *historyCacheSuite) TestHistoryCacheClear() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
 	domainID := "test_domain_id"
@@ -214,3 +451,148 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 	s.Nil(context.(*contextImpl).mutableState)
 	release(nil)
 }
+
+func (s *historyCacheSuite) TestGetWorkflowExecutionForRead_ReadSnapshot() {
+	s.mockShard.GetConfig().EnableWorkflowReadSnapshot = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	domainID := "test_domain_id"
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(domainID).Return("test_domain", nil).AnyTimes()
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-read-snapshot",
+		RunID:      uuid.New(),
+	}
+
+	// hold the workflow lock for the whole test, reads must not wait for it
+	workflowCtx, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	defer release(nil)
+
+	snapshot := NewMockMutableState(s.controller)
+	workflowCtx.(*contextImpl).setReadSnapshot(snapshot)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	mutableState, readRelease, err := s.cache.GetWorkflowExecutionForRead(ctx, domainID, we)
+	s.Nil(err)
+	s.Equal(snapshot, mutableState)
+	readRelease(nil)
+}
+
+func (s *historyCacheSuite) TestGetWorkflowExecutionForRead_ReadSnapshotDisabled() {
+	s.mockShard.GetConfig().EnableWorkflowReadSnapshot = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	domainID := "test_domain_id"
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(domainID).Return("test_domain", nil).AnyTimes()
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-read-snapshot-disabled",
+		RunID:      uuid.New(),
+	}
+
+	workflowCtx, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	defer release(nil)
+
+	workflowCtx.(*contextImpl).setReadSnapshot(NewMockMutableState(s.controller))
+
+	// with snapshots disabled the read falls back to the workflow lock which is still held
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = s.cache.GetWorkflowExecutionForRead(ctx, domainID, we)
+	s.Equal(ctx.Err(), err)
+}
+
+func (s *historyCacheSuite) TestGetWorkflowExecutionForRead_CreateReadSnapshot() {
+	s.mockShard.GetConfig().EnableWorkflowReadSnapshot = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	domainID := constants.TestDomainID
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(domainID).Return(constants.TestDomainName, nil).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(domainID).Return(constants.TestLocalDomainEntry, nil).AnyTimes()
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-create-read-snapshot",
+		RunID:      uuid.New(),
+	}
+
+	workflowCtx, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	mockMutableState := NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().StartTransaction(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mockMutableState.EXPECT().GetDomainEntry().Return(constants.TestLocalDomainEntry).AnyTimes()
+	// the mutable state is only copied by the first read
+	mockMutableState.EXPECT().CopyToPersistence().Return(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   domainID,
+			WorkflowID: we.GetWorkflowID(),
+			RunID:      we.GetRunID(),
+		},
+		ExecutionStats: &persistence.ExecutionStats{},
+	}).Times(1)
+	workflowCtx.(*contextImpl).mutableState = mockMutableState
+	release(nil)
+
+	snapshot, readRelease, err := s.cache.GetWorkflowExecutionForRead(context.Background(), domainID, we)
+	s.Nil(err)
+	readRelease(nil)
+	s.NotEqual(mockMutableState, snapshot)
+	s.Equal(we.GetRunID(), snapshot.GetExecutionInfo().RunID)
+
+	// the workflow lock is released before the snapshot is returned and later reads reuse the snapshot
+	_, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	defer release(nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	mutableState, readRelease, err := s.cache.GetWorkflowExecutionForRead(ctx, domainID, we)
+	s.Nil(err)
+	readRelease(nil)
+	s.Equal(snapshot, mutableState)
+}
+
+func (s *historyCacheSuite) TestPrefetchWorkflowExecution() {
+	domainID := "test_domain_id"
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-prefetch",
+		RunID:      uuid.New(),
+	}
+	response := &persistence.GetWorkflowExecutionResponse{}
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(response, nil).Once()
+
+	// hold the workflow lock for the whole test, prefetch must not wait for it
+	workflowCtx, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	defer release(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.Nil(s.cache.PrefetchWorkflowExecution(ctx, domainID, we))
+	s.Equal(response, workflowCtx.(*contextImpl).prefetched)
+
+	// a pending prefetch is not read again
+	s.Nil(s.cache.PrefetchWorkflowExecution(ctx, domainID, we))
+
+	workflowCtx.Clear()
+	s.Nil(workflowCtx.(*contextImpl).takePrefetched())
+}
+
+func (s *historyCacheSuite) TestPrefetchWorkflowExecution_ClearedDuringRead() {
+	domainID := "test_domain_id"
+	s.cache = NewCache(s.mockShard)
+	we := types.WorkflowExecution{
+		WorkflowID: "wf-cache-test-prefetch-cleared",
+		RunID:      uuid.New(),
+	}
+
+	workflowCtx, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	defer release(nil)
+
+	// the read may be older than a write persisted before the context was cleared, so it is dropped
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{}, nil,
+	).Run(func(mock.Arguments) {
+		workflowCtx.Clear()
+	}).Once()
+
+	s.Nil(s.cache.PrefetchWorkflowExecution(context.Background(), domainID, we))
+	s.Nil(workflowCtx.(*contextImpl).takePrefetched())
+}
@@ -27,6 +27,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -39,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/export"
 	"github.com/uber/cadence/service/history/shard"
 )
 
@@ -82,9 +85,23 @@ type (
 		LoadWorkflowExecution(ctx context.Context) (MutableState, error)
 		LoadWorkflowExecutionWithTaskVersion(ctx context.Context, incomingVersion int64) (MutableState, error)
 		LoadExecutionStats(ctx context.Context) (*persistence.ExecutionStats, error)
+		// GetReadSnapshot returns a read only copy of the last persisted mutable state,
+		// or nil if there is none. It can be called without holding the lock.
+		GetReadSnapshot() MutableState
+		// UpdateReadSnapshot returns the read snapshot, creating it from the loaded mutable state
+		// if there is none. It must be called with the lock held.
+		UpdateReadSnapshot() MutableState
 		Clear()
 
-		Lock(ctx context.Context) error
+		// BufferActivityHeartbeat keeps a heartbeat which is not persisted right away, it is applied to
+		// the mutable state by the next update of the workflow. It must be called with the lock held.
+		BufferActivityHeartbeat(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest, now time.Time)
+		// ApplyBufferedActivityHeartbeats applies the buffered heartbeats to the loaded mutable state and
+		// returns true if it was changed. It must be called with the lock held.
+		ApplyBufferedActivityHeartbeats() bool
+
+		// Lock acquires the workflow lock, waiters with a lower priority value acquire it first
+		Lock(ctx context.Context, priority int) error
 		Unlock()
 
 		GetHistorySize() int64
@@ -160,6 +177,10 @@ type (
 
 type (
 	contextImpl struct {
+		// estimated size in bytes of the mutable state, readable without holding
+		// the context lock by the cache to account for its memory usage
+		estimatedSize int64
+
 		domainID          string
 		workflowExecution types.WorkflowExecution
 		shard             shard.Context
@@ -167,10 +188,29 @@ type (
 		logger            log.Logger
 		metricsClient     metrics.Client
 
-		mutex           locks.Mutex
+		mutex           locks.PriorityMutex
 		mutableState    MutableState
 		stats           *persistence.ExecutionStats
 		updateCondition int64
+
+		snapshotLock sync.RWMutex
+		readSnapshot MutableState
+
+		// mutable state read by a prefetch without holding the context lock, it is only valid
+		// if the context was not cleared since the read started, see prefetch
+		prefetchLock       sync.Mutex
+		prefetchGeneration int64
+		prefetched         *persistence.GetWorkflowExecutionResponse
+
+		// heartbeats of running activities which are not persisted yet, by scheduleID
+		bufferedHeartbeats map[int64]*bufferedActivityHeartbeat
+	}
+
+	bufferedActivityHeartbeat struct {
+		startedID  int64
+		attempt    int32
+		request    *types.RecordActivityTaskHeartbeatRequest
+		receivedAt time.Time
 	}
 )
 
@@ -191,15 +231,15 @@ func NewContext(
 		executionManager:  executionManager,
 		logger:            logger,
 		metricsClient:     shard.GetMetricsClient(),
-		mutex:             locks.NewMutex(),
+		mutex:             locks.NewPriorityMutex(),
 		stats: &persistence.ExecutionStats{
 			HistorySize: 0,
 		},
 	}
 }
 
-func (c *contextImpl) Lock(ctx context.Context) error {
-	return c.mutex.Lock(ctx)
+func (c *contextImpl) Lock(ctx context.Context, priority int) error {
+	return c.mutex.Lock(ctx, priority)
 }
 
 func (c *contextImpl) Unlock() {
@@ -209,9 +249,49 @@ func (c *contextImpl) Unlock() {
 func (c *contextImpl) Clear() {
 	c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.WorkflowContextCleared)
 	c.mutableState = nil
+	c.setReadSnapshot(nil)
 	c.stats = &persistence.ExecutionStats{
 		HistorySize: 0,
 	}
+	c.updateEstimatedSize()
+	c.discardPrefetched()
+}
+
+func (c *contextImpl) BufferActivityHeartbeat(
+	ai *persistence.ActivityInfo,
+	request *types.RecordActivityTaskHeartbeatRequest,
+	now time.Time,
+) {
+	if c.bufferedHeartbeats == nil {
+		c.bufferedHeartbeats = make(map[int64]*bufferedActivityHeartbeat)
+	}
+	c.bufferedHeartbeats[ai.ScheduleID] = &bufferedActivityHeartbeat{
+		startedID:  ai.StartedID,
+		attempt:    ai.Attempt,
+		request:    request,
+		receivedAt: now,
+	}
+}
+
+func (c *contextImpl) ApplyBufferedActivityHeartbeats() bool {
+	if len(c.bufferedHeartbeats) == 0 || c.mutableState == nil {
+		return false
+	}
+
+	applied := false
+	for scheduleID, heartbeat := range c.bufferedHeartbeats {
+		ai, ok := c.mutableState.GetActivityInfo(scheduleID)
+		// the heartbeat is dropped if the activity attempt is gone or a later heartbeat was already persisted
+		if !ok || ai.StartedID != heartbeat.startedID || ai.Attempt != heartbeat.attempt ||
+			!heartbeat.receivedAt.After(ai.LastHeartBeatUpdatedTime) {
+			continue
+		}
+		c.mutableState.UpdateActivityProgress(ai, heartbeat.request)
+		ai.LastHeartBeatUpdatedTime = heartbeat.receivedAt
+		applied = true
+	}
+	c.bufferedHeartbeats = nil
+	return applied
 }
 
 func (c *contextImpl) GetDomainID() string {
@@ -259,12 +339,15 @@ func (c *contextImpl) LoadWorkflowExecutionWithTaskVersion(
 	}
 
 	if c.mutableState == nil {
-		response, err := c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
-			DomainID:  c.domainID,
-			Execution: c.workflowExecution,
-		})
-		if err != nil {
-			return nil, err
+		response := c.takePrefetched()
+		if response == nil {
+			response, err = c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
+				DomainID:  c.domainID,
+				Execution: c.workflowExecution,
+			})
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		c.mutableState = NewMutableStateBuilder(
@@ -276,6 +359,7 @@ func (c *contextImpl) LoadWorkflowExecutionWithTaskVersion(
 		c.mutableState.Load(response.State)
 
 		c.stats = response.State.ExecutionStats
+		c.updateEstimatedSize()
 		c.updateCondition = response.State.ExecutionInfo.NextEventID
 
 		// finally emit execution and session stats
@@ -314,6 +398,111 @@ func (c *contextImpl) LoadWorkflowExecutionWithTaskVersion(
 	return c.mutableState, nil
 }
 
+func (c *contextImpl) GetReadSnapshot() MutableState {
+	c.snapshotLock.RLock()
+	defer c.snapshotLock.RUnlock()
+
+	return c.readSnapshot
+}
+
+func (c *contextImpl) setReadSnapshot(snapshot MutableState) {
+	c.snapshotLock.Lock()
+	defer c.snapshotLock.Unlock()
+
+	c.readSnapshot = snapshot
+}
+
+// UpdateReadSnapshot copies the loaded mutable state into a new read snapshot if the last update
+// invalidated it. Snapshots are only created on read so updates never pay for the copy.
+// It returns nil if the mutable state is not loaded or read snapshots are disabled for the domain.
+func (c *contextImpl) UpdateReadSnapshot() MutableState {
+	if snapshot := c.GetReadSnapshot(); snapshot != nil {
+		return snapshot
+	}
+	if c.mutableState == nil {
+		return nil
+	}
+	domainEntry := c.mutableState.GetDomainEntry()
+	if !c.shard.GetConfig().EnableWorkflowReadSnapshot(domainEntry.GetInfo().Name) {
+		return nil
+	}
+
+	snapshot := newReadSnapshot(c.shard, c.logger, c.mutableState)
+	c.setReadSnapshot(snapshot)
+	return snapshot
+}
+
+// newReadSnapshot creates a read only copy of a mutable state, which is not changed by later updates of the original
+func newReadSnapshot(
+	shard shard.Context,
+	logger log.Logger,
+	mutableState MutableState,
+) MutableState {
+
+	snapshot := NewMutableStateBuilder(shard, logger, mutableState.GetDomainEntry())
+	snapshot.Load(copyWorkflowMutableState(mutableState.CopyToPersistence()))
+	return snapshot
+}
+
+func (c *contextImpl) updateEstimatedSize() {
+	atomic.StoreInt64(&c.estimatedSize, int64(estimateMutableStateSize(c.mutableState)))
+}
+
+// prefetch reads the mutable state from the DB without acquiring the context lock, the next load
+// of the mutable state under the lock uses it instead of reading the DB again.
+// Persisting mutable state requires it to be loaded and a loaded mutable state is only dropped by
+// Clear, so a read which started after the last Clear is never older than the persisted state
+// the next load would see.
+func (c *contextImpl) prefetch(
+	ctx context.Context,
+) error {
+
+	if atomic.LoadInt64(&c.estimatedSize) != 0 {
+		// mutable state is already loaded
+		return nil
+	}
+
+	c.prefetchLock.Lock()
+	generation := c.prefetchGeneration
+	pending := c.prefetched != nil
+	c.prefetchLock.Unlock()
+	if pending {
+		return nil
+	}
+
+	response, err := c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID:  c.domainID,
+		Execution: c.workflowExecution,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.prefetchLock.Lock()
+	defer c.prefetchLock.Unlock()
+	if generation == c.prefetchGeneration {
+		c.prefetched = response
+	}
+	return nil
+}
+
+func (c *contextImpl) takePrefetched() *persistence.GetWorkflowExecutionResponse {
+	c.prefetchLock.Lock()
+	defer c.prefetchLock.Unlock()
+
+	response := c.prefetched
+	c.prefetched = nil
+	return response
+}
+
+func (c *contextImpl) discardPrefetched() {
+	c.prefetchLock.Lock()
+	defer c.prefetchLock.Unlock()
+
+	c.prefetchGeneration++
+	c.prefetched = nil
+}
+
 // GetWorkflowExecution should only be used in tests
 func (c *contextImpl) GetWorkflowExecution() MutableState {
 	return c.mutableState
@@ -371,6 +560,12 @@ func (c *contextImpl) CreateWorkflowExecution(
 	}
 
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
+	c.setReadSnapshot(nil)
+
+	// the start events were persisted before the snapshot, so they are read back by the exporter
+	if branchToken, err := getSnapshotBranchToken(newWorkflow); err == nil {
+		c.exportHistory(newWorkflow.ExecutionInfo, branchToken, nil)
+	}
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
@@ -529,6 +724,8 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 	c.notifyTasksFromWorkflowSnapshot(resetWorkflow)
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
 	c.notifyTasksFromWorkflowMutation(currentWorkflow)
+	// the reset mutable state is not owned by this context, readers fall back to the lock until reload
+	c.setReadSnapshot(nil)
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
@@ -671,6 +868,8 @@ func (c *contextImpl) UpdateWorkflowExecutionTasks(
 
 	// notify current workflow tasks
 	c.notifyTasksFromWorkflowMutation(currentWorkflow)
+	// the read snapshot is recreated by the next read
+	c.setReadSnapshot(nil)
 
 	emitSessionUpdateStats(
 		c.metricsClient,
@@ -697,6 +896,10 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 		}
 	}()
 
+	if currentWorkflowTransactionPolicy == TransactionPolicyActive {
+		c.ApplyBufferedActivityHeartbeats()
+	}
+
 	currentWorkflow, currentWorkflowEventsSeq, err := c.mutableState.CloseTransactionAsMutation(
 		now,
 		currentWorkflowTransactionPolicy,
@@ -815,6 +1018,20 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 
 	// notify new workflow tasks
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
+	// the read snapshot is recreated by the next read
+	c.setReadSnapshot(nil)
+	c.updateEstimatedSize()
+
+	// export history only on the active side so replicated events are not exported twice
+	if currentWorkflowTransactionPolicy == TransactionPolicyActive {
+		c.exportHistory(currentWorkflow.ExecutionInfo, currentBranchToken, currentWorkflowEventsSeq)
+	}
+	if newWorkflow != nil && *newWorkflowTransactionPolicy == TransactionPolicyActive {
+		// a new run may start from a reset point, so its history is read back up to the first commit
+		if newBranchToken, err := newMutableState.GetCurrentBranchToken(); err == nil {
+			c.exportHistory(newWorkflow.ExecutionInfo, newBranchToken, nil)
+		}
+	}
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
@@ -843,6 +1060,23 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 	return nil
 }
 
+func (c *contextImpl) exportHistory(
+	executionInfo *persistence.WorkflowExecutionInfo,
+	branchToken []byte,
+	workflowEventsSeq []*persistence.WorkflowEvents,
+) {
+	c.shard.GetService().GetHistoryExporter().Export(&export.Request{
+		DomainID:    executionInfo.DomainID,
+		WorkflowID:  executionInfo.WorkflowID,
+		RunID:       executionInfo.RunID,
+		ShardID:     c.shard.GetShardID(),
+		BranchToken: branchToken,
+		NextEventID: executionInfo.NextEventID,
+		Closed:      executionInfo.State == persistence.WorkflowStateCompleted,
+		Batches:     workflowEventsSeq,
+	})
+}
+
 func (c *contextImpl) notifyTasksFromWorkflowSnapshot(
 	workflowSnapShot *persistence.WorkflowSnapshot,
 ) {
@@ -59,6 +59,32 @@ func (m *MockContext) EXPECT() *MockContextMockRecorder {
 	return m.recorder
 }
 
+// ApplyBufferedActivityHeartbeats mocks base method.
+func (m *MockContext) ApplyBufferedActivityHeartbeats() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyBufferedActivityHeartbeats")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ApplyBufferedActivityHeartbeats indicates an expected call of ApplyBufferedActivityHeartbeats.
+func (mr *MockContextMockRecorder) ApplyBufferedActivityHeartbeats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyBufferedActivityHeartbeats", reflect.TypeOf((*MockContext)(nil).ApplyBufferedActivityHeartbeats))
+}
+
+// BufferActivityHeartbeat mocks base method.
+func (m *MockContext) BufferActivityHeartbeat(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest, now time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BufferActivityHeartbeat", ai, request, now)
+}
+
+// BufferActivityHeartbeat indicates an expected call of BufferActivityHeartbeat.
+func (mr *MockContextMockRecorder) BufferActivityHeartbeat(ai, request, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BufferActivityHeartbeat", reflect.TypeOf((*MockContext)(nil).BufferActivityHeartbeat), ai, request, now)
+}
+
 // Clear mocks base method.
 func (m *MockContext) Clear() {
 	m.ctrl.T.Helper()
@@ -155,6 +181,20 @@ func (mr *MockContextMockRecorder) GetHistorySize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistorySize", reflect.TypeOf((*MockContext)(nil).GetHistorySize))
 }
 
+// GetReadSnapshot mocks base method.
+func (m *MockContext) GetReadSnapshot() MutableState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadSnapshot")
+	ret0, _ := ret[0].(MutableState)
+	return ret0
+}
+
+// GetReadSnapshot indicates an expected call of GetReadSnapshot.
+func (mr *MockContextMockRecorder) GetReadSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadSnapshot", reflect.TypeOf((*MockContext)(nil).GetReadSnapshot))
+}
+
 // GetWorkflowExecution mocks base method.
 func (m *MockContext) GetWorkflowExecution() MutableState {
 	m.ctrl.T.Helper()
@@ -215,17 +255,17 @@ func (mr *MockContextMockRecorder) LoadWorkflowExecutionWithTaskVersion(ctx, inc
 }
 
 // Lock mocks base method.
-func (m *MockContext) Lock(ctx context.Context) error {
+func (m *MockContext) Lock(ctx context.Context, priority int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Lock", ctx)
+	ret := m.ctrl.Call(m, "Lock", ctx, priority)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Lock indicates an expected call of Lock.
-func (mr *MockContextMockRecorder) Lock(ctx interface{}) *gomock.Call {
+func (mr *MockContextMockRecorder) Lock(ctx, priority interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockContext)(nil).Lock), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockContext)(nil).Lock), ctx, priority)
 }
 
 // PersistNonStartWorkflowBatchEvents mocks base method.
@@ -308,6 +348,20 @@ func (mr *MockContextMockRecorder) Unlock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockContext)(nil).Unlock))
 }
 
+// UpdateReadSnapshot mocks base method.
+func (m *MockContext) UpdateReadSnapshot() MutableState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReadSnapshot")
+	ret0, _ := ret[0].(MutableState)
+	return ret0
+}
+
+// UpdateReadSnapshot indicates an expected call of UpdateReadSnapshot.
+func (mr *MockContextMockRecorder) UpdateReadSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReadSnapshot", reflect.TypeOf((*MockContext)(nil).UpdateReadSnapshot))
+}
+
 // UpdateWorkflowExecutionAsActive mocks base method.
 func (m *MockContext) UpdateWorkflowExecutionAsActive(ctx context.Context, now time.Time) error {
 	m.ctrl.T.Helper()
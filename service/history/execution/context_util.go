@@ -23,6 +23,7 @@ package execution
 import (
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -30,6 +31,16 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
+// approximate in-memory sizes in bytes of the fixed fields of mutable state records
+const (
+	executionInfoSizeEstimate      = 1024
+	activityInfoSizeEstimate       = 512
+	timerInfoSizeEstimate          = 128
+	childExecutionInfoSizeEstimate = 512
+	requestCancelInfoSizeEstimate  = 64
+	signalInfoSizeEstimate         = 128
+)
+
 func emitWorkflowHistoryStats(
 	metricsClient metrics.Client,
 	domainName string,
@@ -167,3 +178,71 @@ func emitWorkflowCompletionStats(
 		)
 	}
 }
+
+func getSnapshotBranchToken(
+	snapshot *persistence.WorkflowSnapshot,
+) ([]byte, error) {
+	if snapshot.VersionHistories != nil {
+		currentVersionHistory, err := snapshot.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return nil, err
+		}
+		return currentVersionHistory.GetBranchToken(), nil
+	}
+	return snapshot.ExecutionInfo.BranchToken, nil
+}
+
+// estimateMutableStateSize returns the approximate number of bytes the mutable state holds in memory,
+// the fixed size of its records plus their variable length payloads
+func estimateMutableStateSize(
+	mutableState MutableState,
+) uint64 {
+	if mutableState == nil {
+		return 0
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	size := executionInfoSizeEstimate +
+		len(executionInfo.WorkflowID) +
+		len(executionInfo.TaskList) +
+		len(executionInfo.WorkflowTypeName) +
+		len(executionInfo.ParentWorkflowID) +
+		len(executionInfo.ExecutionContext) +
+		len(executionInfo.BranchToken) +
+		common.GetSizeOfMapStringToByteArray(executionInfo.Memo) +
+		common.GetSizeOfMapStringToByteArray(executionInfo.SearchAttributes) +
+		estimateEventSize(executionInfo.CompletionEvent)
+	for _, activityInfo := range mutableState.GetPendingActivityInfos() {
+		size += activityInfoSizeEstimate +
+			len(activityInfo.ActivityID) +
+			len(activityInfo.Details) +
+			estimateEventSize(activityInfo.ScheduledEvent) +
+			estimateEventSize(activityInfo.StartedEvent)
+	}
+	for _, timerInfo := range mutableState.GetPendingTimerInfos() {
+		size += timerInfoSizeEstimate + len(timerInfo.TimerID)
+	}
+	for _, childInfo := range mutableState.GetPendingChildExecutionInfos() {
+		size += childExecutionInfoSizeEstimate +
+			len(childInfo.WorkflowTypeName) +
+			estimateEventSize(childInfo.InitiatedEvent) +
+			estimateEventSize(childInfo.StartedEvent)
+	}
+	size += requestCancelInfoSizeEstimate * len(mutableState.GetPendingRequestCancelExternalInfos())
+	for _, signalInfo := range mutableState.GetPendingSignalExternalInfos() {
+		size += signalInfoSizeEstimate +
+			len(signalInfo.SignalName) +
+			len(signalInfo.Input) +
+			len(signalInfo.Control)
+	}
+	return uint64(size)
+}
+
+func estimateEventSize(
+	event *types.HistoryEvent,
+) int {
+	if event == nil || event.EventType == nil {
+		return 0
+	}
+	return int(common.GetSizeOfHistoryEvent(event))
+}
@@ -145,6 +145,55 @@ func GetResurrectedActivities(
 	return resurrectedActivity, nil
 }
 
+// IsActivityAttemptCompleted returns true if the given attempt of the activity with the given scheduleID
+// was completed, based on event history. It is used to detect retried completion requests.
+// Only the maxEvents events following the scheduled event are scanned, a completion after them is not found.
+func IsActivityAttemptCompleted(
+	ctx context.Context,
+	shard shard.Context,
+	mutableState MutableState,
+	scheduleID int64,
+	attempt int64,
+	maxEvents int64,
+) (bool, error) {
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return false, err
+	}
+
+	// activity started event of an activity with retry policy is only written
+	// together with its completed event, so both are scanned from scheduleID
+	startedAttempts := make(map[int64]int64)
+	iter := collection.NewPagingIterator(getHistoryPaginationFn(
+		ctx,
+		shard,
+		scheduleID,
+		common.MinInt64(mutableState.GetNextEventID(), scheduleID+maxEvents+1),
+		branchToken,
+	))
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return false, err
+		}
+		event := item.(*types.HistoryEvent)
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskStarted:
+			attributes := event.ActivityTaskStartedEventAttributes
+			if attributes.ScheduledEventID == scheduleID {
+				startedAttempts[event.ID] = int64(attributes.Attempt)
+			}
+		case types.EventTypeActivityTaskCompleted:
+			attributes := event.ActivityTaskCompletedEventAttributes
+			if attributes.ScheduledEventID == scheduleID {
+				startedAttempt, ok := startedAttempts[attributes.StartedEventID]
+				return ok && startedAttempt == attempt, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func getHistoryPaginationFn(
 	ctx context.Context,
 	shard shard.Context,
@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+type callerScopeContextKey struct{}
+
+const (
+	// LockPriorityHigh is the highest priority for acquiring a workflow lock
+	LockPriorityHigh = 0
+	// LockPriorityDefault is the priority for acquiring a workflow lock of callers without a configured priority
+	LockPriorityDefault = 1
+)
+
+// ContextWithCallerScope returns a copy of the parent context carrying the metrics scope of the caller,
+// the scope decides the priority of the caller when it acquires a workflow lock through the Cache
+func ContextWithCallerScope(parent context.Context, scope int) context.Context {
+	return context.WithValue(parent, callerScopeContextKey{}, scope)
+}
+
+func (c *Cache) getLockPriority(
+	ctx context.Context,
+) int {
+
+	scope, ok := ctx.Value(callerScopeContextKey{}).(int)
+	if !ok {
+		return LockPriorityDefault
+	}
+	switch priority := c.config.WorkflowLockPriorities()[metrics.GetScopeOperation(metrics.History, scope)].(type) {
+	case int:
+		return priority
+	case float64:
+		return int(priority)
+	default:
+		return LockPriorityDefault
+	}
+}
@@ -143,6 +143,8 @@ type (
 		GetPendingChildExecutionInfos() map[int64]*persistence.ChildExecutionInfo
 		GetPendingRequestCancelExternalInfos() map[int64]*persistence.RequestCancelInfo
 		GetPendingSignalExternalInfos() map[int64]*persistence.SignalInfo
+		GetPendingSignalRequestedIDs() map[string]struct{}
+		GetPendingSignalRequestedIDsByRecency() []string
 		GetRequestCancelInfo(int64) (*persistence.RequestCancelInfo, bool)
 		GetRetryBackoffDuration(errReason string) time.Duration
 		GetCronBackoffDuration(context.Context) (time.Duration, error)
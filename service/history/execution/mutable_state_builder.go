@@ -26,6 +26,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -107,6 +109,7 @@ type (
 		pendingSignalRequestedIDs map[string]struct{} // Set of signaled requestIds
 		updateSignalRequestedIDs  map[string]struct{} // Set of signaled requestIds since last update
 		deleteSignalRequestedIDs  map[string]struct{} // Deleted signaled requestIds
+		recentSignalRequestedIDs  []string            // Signaled requestIds added since the mutable state was loaded, oldest first
 
 		bufferedEvents       []*types.HistoryEvent // buffered history events that are already persisted
 		updateBufferedEvents []*types.HistoryEvent // buffered history events that needs to be persisted
@@ -1466,6 +1469,10 @@ func (e *mutableStateBuilder) GetPendingSignalExternalInfos() map[int64]*persist
 	return e.pendingSignalInfoIDs
 }
 
+func (e *mutableStateBuilder) GetPendingSignalRequestedIDs() map[string]struct{} {
+	return e.pendingSignalRequestedIDs
+}
+
 func (e *mutableStateBuilder) HasProcessedOrPendingDecision() bool {
 	return e.decisionTaskManager.HasProcessedOrPendingDecision()
 }
@@ -1593,6 +1600,7 @@ func (e *mutableStateBuilder) AddSignalRequested(
 	}
 	e.pendingSignalRequestedIDs[requestID] = struct{}{} // add requestID to set
 	e.updateSignalRequestedIDs[requestID] = struct{}{}
+	e.recentSignalRequestedIDs = append(e.recentSignalRequestedIDs, requestID)
 }
 
 func (e *mutableStateBuilder) DeleteSignalRequested(
@@ -1604,6 +1612,53 @@ func (e *mutableStateBuilder) DeleteSignalRequested(
 	e.deleteSignalRequestedIDs[requestID] = struct{}{}
 }
 
+// GetPendingSignalRequestedIDsByRecency returns the signal requestIDs, most recently added first.
+// The order of the requestIDs loaded from the DB is not persisted, they are older than the ones
+// added since and returned last, in sorted order.
+func (e *mutableStateBuilder) GetPendingSignalRequestedIDsByRecency() []string {
+	requestIDs := make([]string, 0, len(e.pendingSignalRequestedIDs))
+	added := make(map[string]struct{}, len(e.recentSignalRequestedIDs))
+	for i := len(e.recentSignalRequestedIDs) - 1; i >= 0; i-- {
+		requestID := e.recentSignalRequestedIDs[i]
+		if _, ok := added[requestID]; ok {
+			continue
+		}
+		if _, ok := e.pendingSignalRequestedIDs[requestID]; ok {
+			added[requestID] = struct{}{}
+			requestIDs = append(requestIDs, requestID)
+		}
+	}
+	var loadedRequestIDs []string
+	for requestID := range e.pendingSignalRequestedIDs {
+		if _, ok := added[requestID]; !ok {
+			loadedRequestIDs = append(loadedRequestIDs, requestID)
+		}
+	}
+	sort.Strings(loadedRequestIDs)
+	return append(requestIDs, loadedRequestIDs...)
+}
+
+// carryOverSignalRequestedIDs copies the most recent signal requestIDs of the previous run, up to the
+// configured limit, so that signals retried after continue-as-new are still deduplicated by the new run.
+// Like the requestIDs of a single run, they are only kept in the mutable state of the active cluster.
+func (e *mutableStateBuilder) carryOverSignalRequestedIDs(
+	previousExecutionState MutableState,
+) {
+
+	maxRequestIDs := e.config.MaxSignalRequestIDsCarriedOver(e.GetDomainEntry().GetInfo().Name)
+	if maxRequestIDs <= 0 {
+		return
+	}
+	requestIDs := previousExecutionState.GetPendingSignalRequestedIDsByRecency()
+	if len(requestIDs) > maxRequestIDs {
+		requestIDs = requestIDs[:maxRequestIDs]
+	}
+	// added oldest first, so the new run keeps their order
+	for i := len(requestIDs) - 1; i >= 0; i-- {
+		e.AddSignalRequested(requestIDs[i])
+	}
+}
+
 func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	parentExecutionInfo *types.ParentExecutionInfo,
 	execution types.WorkflowExecution,
@@ -1696,6 +1751,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		return nil, err
 	}
 
+	e.carryOverSignalRequestedIDs(previousExecutionState)
+
 	// TODO merge active & passive task generation
 	if err := e.taskGenerator.GenerateWorkflowStartTasks(
 		e.unixNanoToTime(event.GetTimestamp()),
@@ -4048,6 +4105,7 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 
 	// update last update time
 	e.executionInfo.LastUpdatedTimestamp = now
+	e.checkInvariants(transactionPolicy)
 
 	// we generate checksum here based on the assumption that the returned
 	// snapshot object is considered immutable. As of this writing, the only
@@ -4134,6 +4192,7 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 
 	// update last update time
 	e.executionInfo.LastUpdatedTimestamp = now
+	e.checkInvariants(transactionPolicy)
 
 	// we generate checksum here based on the assumption that the returned
 	// snapshot object is considered immutable. As of this writing, the only
@@ -4718,6 +4777,38 @@ func (e *mutableStateBuilder) shouldGenerateChecksum() bool {
 	return rand.Intn(100) < e.config.MutableStateChecksumGenProbability(e.domainEntry.GetInfo().Name)
 }
 
+func (e *mutableStateBuilder) checkInvariants(
+	transactionPolicy TransactionPolicy,
+) {
+
+	if e.domainEntry == nil ||
+		rand.Intn(100) >= e.config.MutableStateInvariantCheckProbability(e.domainEntry.GetInfo().Name) {
+		return
+	}
+
+	// timer tasks are only generated by the active side, see closeTransactionHandleActivityUserTimerTasks
+	violations := checkMutableStateInvariants(e, transactionPolicy == TransactionPolicyActive)
+	if len(violations) == 0 {
+		return
+	}
+
+	details := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		e.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateInvariantViolation)
+		details = append(details, violation.invariant+": "+violation.details)
+	}
+	// the whole mutable state is logged so the violation can be reproduced offline
+	e.logError(
+		"mutable state invariant violation",
+		tag.Counter(len(violations)),
+		tag.DetailInfo(strings.Join(details, "; ")),
+		tag.WorkflowNextEventID(e.GetNextEventID()),
+		tag.WorkflowState(e.executionInfo.State),
+		tag.CurrentVersion(e.GetCurrentVersion()),
+		tag.Value(e.CopyToPersistence()),
+	)
+}
+
 func (e *mutableStateBuilder) shouldVerifyChecksum() bool {
 	if e.domainEntry == nil {
 		return false
@@ -534,6 +534,36 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestGetPendingSignalRequestedIDsByRecency() {
+	msBuilder := newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+	msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo:      &persistence.WorkflowExecutionInfo{},
+		SignalRequestedIDs: map[string]struct{}{"loaded-2": {}, "loaded-1": {}},
+	})
+	msBuilder.AddSignalRequested("request-3")
+	msBuilder.AddSignalRequested("request-1")
+	msBuilder.AddSignalRequested("request-2")
+	msBuilder.DeleteSignalRequested("request-1")
+
+	s.Equal([]string{"request-2", "request-3", "loaded-1", "loaded-2"}, msBuilder.GetPendingSignalRequestedIDsByRecency())
+}
+
+func (s *mutableStateSuite) TestCarryOverSignalRequestedIDs() {
+	previous := newMutableStateBuilder(s.mockShard, s.logger, constants.TestLocalDomainEntry)
+	previous.AddSignalRequested("request-3")
+	previous.AddSignalRequested("request-1")
+	previous.AddSignalRequested("request-2")
+
+	s.msBuilder.carryOverSignalRequestedIDs(previous)
+	s.Empty(s.msBuilder.GetPendingSignalRequestedIDs())
+
+	// the most recent requestIDs are kept, in the same order
+	s.mockShard.GetConfig().MaxSignalRequestIDsCarriedOver = func(domain string) int { return 2 }
+	s.msBuilder.carryOverSignalRequestedIDs(previous)
+	s.Equal([]string{"request-2", "request-1"}, s.msBuilder.GetPendingSignalRequestedIDsByRecency())
+	s.Len(s.msBuilder.updateSignalRequestedIDs, 2)
+}
+
 func (s *mutableStateSuite) TestTransientDecisionTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -0,0 +1,173 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"fmt"
+)
+
+const (
+	invariantPendingActivity  = "pending_activity"
+	invariantTimerTaskExists  = "timer_task_exists"
+	invariantVersionHistories = "version_histories"
+)
+
+type (
+	// mutableStateInvariantViolation describes an invariant that does not hold for a mutable state
+	mutableStateInvariantViolation struct {
+		invariant string
+		details   string
+	}
+)
+
+// checkMutableStateInvariants verifies the given mutable state is internally consistent.
+// Timer task invariants only hold after active side timer tasks are generated, so they are
+// checked only when checkTimerTasks is true.
+func checkMutableStateInvariants(
+	mutableState MutableState,
+	checkTimerTasks bool,
+) []mutableStateInvariantViolation {
+
+	var violations []mutableStateInvariantViolation
+	violations = append(violations, checkPendingActivities(mutableState)...)
+	if checkTimerTasks && mutableState.IsWorkflowExecutionRunning() {
+		violations = append(violations, checkTimerTasksCreated(mutableState)...)
+	}
+	violations = append(violations, checkVersionHistories(mutableState)...)
+	return violations
+}
+
+func checkPendingActivities(
+	mutableState MutableState,
+) []mutableStateInvariantViolation {
+
+	var violations []mutableStateInvariantViolation
+	nextEventID := mutableState.GetNextEventID()
+	for scheduleID, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.ScheduleID != scheduleID {
+			violations = append(violations, newInvariantViolation(
+				invariantPendingActivity,
+				"activity %v stored under schedule ID %v has schedule ID %v",
+				ai.ActivityID, scheduleID, ai.ScheduleID,
+			))
+			continue
+		}
+		if ai.ScheduleID >= nextEventID {
+			violations = append(violations, newInvariantViolation(
+				invariantPendingActivity,
+				"activity %v schedule ID %v is not less than next event ID %v",
+				ai.ActivityID, ai.ScheduleID, nextEventID,
+			))
+		}
+		// started ID can also be a transient or buffered event ID, only real event IDs are checked
+		if ai.StartedID > 0 && (ai.StartedID <= ai.ScheduleID || ai.StartedID >= nextEventID) {
+			violations = append(violations, newInvariantViolation(
+				invariantPendingActivity,
+				"activity %v started ID %v is out of range (%v, %v)",
+				ai.ActivityID, ai.StartedID, ai.ScheduleID, nextEventID,
+			))
+		}
+		if indexed, ok := mutableState.GetActivityByActivityID(ai.ActivityID); !ok || indexed.ScheduleID != ai.ScheduleID {
+			violations = append(violations, newInvariantViolation(
+				invariantPendingActivity,
+				"activity %v with schedule ID %v is missing from activity ID index",
+				ai.ActivityID, ai.ScheduleID,
+			))
+		}
+	}
+	return violations
+}
+
+func checkTimerTasksCreated(
+	mutableState MutableState,
+) []mutableStateInvariantViolation {
+
+	// only the first timer of each sequence has a timer task,
+	// the following one is created when the previous one fires
+	var violations []mutableStateInvariantViolation
+	timerSequence := NewTimerSequence(mutableState)
+	if userTimers := timerSequence.LoadAndSortUserTimers(); len(userTimers) > 0 && !userTimers[0].TimerCreated {
+		violations = append(violations, newInvariantViolation(
+			invariantTimerTaskExists,
+			"no timer task for pending user timer with started ID %v firing at %v",
+			userTimers[0].EventID, userTimers[0].Timestamp,
+		))
+	}
+	if activityTimers := timerSequence.LoadAndSortActivityTimers(); len(activityTimers) > 0 && !activityTimers[0].TimerCreated {
+		violations = append(violations, newInvariantViolation(
+			invariantTimerTaskExists,
+			"no timer task of type %v for pending activity with schedule ID %v firing at %v",
+			activityTimers[0].TimerType, activityTimers[0].EventID, activityTimers[0].Timestamp,
+		))
+	}
+	return violations
+}
+
+func checkVersionHistories(
+	mutableState MutableState,
+) []mutableStateInvariantViolation {
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return nil
+	}
+
+	var violations []mutableStateInvariantViolation
+	for index, versionHistory := range versionHistories.Histories {
+		for i := 1; i < len(versionHistory.Items); i++ {
+			prev := versionHistory.Items[i-1]
+			item := versionHistory.Items[i]
+			if item.EventID <= prev.EventID || item.Version <= prev.Version {
+				violations = append(violations, newInvariantViolation(
+					invariantVersionHistories,
+					"version history %v is not monotonic: item (%v, %v) follows (%v, %v)",
+					index, item.EventID, item.Version, prev.EventID, prev.Version,
+				))
+			}
+		}
+	}
+
+	currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return append(violations, newInvariantViolation(invariantVersionHistories, "%v", err))
+	}
+	if lastItem, err := currentVersionHistory.GetLastItem(); err == nil &&
+		lastItem.EventID != mutableState.GetNextEventID()-1 {
+		violations = append(violations, newInvariantViolation(
+			invariantVersionHistories,
+			"current version history last event ID %v does not match next event ID %v",
+			lastItem.EventID, mutableState.GetNextEventID(),
+		))
+	}
+	return violations
+}
+
+func newInvariantViolation(
+	invariant string,
+	format string,
+	args ...interface{},
+) mutableStateInvariantViolation {
+
+	return mutableStateInvariantViolation{
+		invariant: invariant,
+		details:   fmt.Sprintf(format, args...),
+	}
+}
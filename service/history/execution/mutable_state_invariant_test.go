@@ -0,0 +1,123 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestCheckMutableStateInvariants_Consistent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		ActivityID:             "activity",
+		ScheduleID:             5,
+		StartedID:              common.EmptyEventID,
+		ScheduledTime:          now,
+		ScheduleToStartTimeout: 10,
+		ScheduleToCloseTimeout: 20,
+		TimerTaskStatus:        TimerTaskStatusCreatedScheduleToStart,
+	}
+	timerInfo := &persistence.TimerInfo{
+		TimerID:    "timer",
+		StartedID:  6,
+		ExpiryTime: now.Add(time.Minute),
+		TaskStatus: TimerTaskStatusCreated,
+	}
+	versionHistory := persistence.NewVersionHistory(nil, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(3, 1),
+		persistence.NewVersionHistoryItem(7, 2),
+	})
+
+	mutableState := NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetNextEventID().Return(int64(8)).AnyTimes()
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{5: activityInfo}).AnyTimes()
+	mutableState.EXPECT().GetActivityByActivityID("activity").Return(activityInfo, true).AnyTimes()
+	mutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{"timer": timerInfo}).AnyTimes()
+	mutableState.EXPECT().GetVersionHistories().Return(persistence.NewVersionHistories(versionHistory)).AnyTimes()
+
+	assert.Empty(t, checkMutableStateInvariants(mutableState, true))
+}
+
+func TestCheckMutableStateInvariants_Violations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		ActivityID:             "activity",
+		ScheduleID:             5,
+		StartedID:              9,
+		ScheduledTime:          now,
+		StartedTime:            now,
+		ScheduleToCloseTimeout: 20,
+		StartToCloseTimeout:    10,
+	}
+	timerInfo := &persistence.TimerInfo{
+		TimerID:    "timer",
+		StartedID:  6,
+		ExpiryTime: now.Add(time.Minute),
+		TaskStatus: TimerTaskStatusNone,
+	}
+	// NewVersionHistory rejects non monotonic items
+	versionHistory := &persistence.VersionHistory{Items: []*persistence.VersionHistoryItem{
+		{EventID: 3, Version: 2},
+		{EventID: 6, Version: 1},
+	}}
+
+	mutableState := NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetNextEventID().Return(int64(8)).AnyTimes()
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{5: activityInfo}).AnyTimes()
+	mutableState.EXPECT().GetActivityByActivityID("activity").Return(nil, false).AnyTimes()
+	mutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{"timer": timerInfo}).AnyTimes()
+	mutableState.EXPECT().GetVersionHistories().Return(persistence.NewVersionHistories(versionHistory)).AnyTimes()
+
+	invariants := func(violations []mutableStateInvariantViolation) map[string]int {
+		counts := make(map[string]int)
+		for _, violation := range violations {
+			counts[violation.invariant]++
+		}
+		return counts
+	}
+
+	assert.Equal(t, map[string]int{
+		invariantPendingActivity:  2, // started ID out of range, missing from index
+		invariantTimerTaskExists:  2, // user timer and activity timer
+		invariantVersionHistories: 2, // version decreases, last event ID mismatch
+	}, invariants(checkMutableStateInvariants(mutableState, true)))
+
+	// timer task invariants are skipped for passive transactions
+	assert.Equal(t, map[string]int{
+		invariantPendingActivity:  2,
+		invariantVersionHistories: 2,
+	}, invariants(checkMutableStateInvariants(mutableState, false)))
+}
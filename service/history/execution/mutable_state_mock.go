@@ -1399,6 +1399,34 @@ func (mr *MockMutableStateMockRecorder) GetPendingSignalExternalInfos() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSignalExternalInfos", reflect.TypeOf((*MockMutableState)(nil).GetPendingSignalExternalInfos))
 }
 
+// GetPendingSignalRequestedIDs mocks base method.
+func (m *MockMutableState) GetPendingSignalRequestedIDs() map[string]struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingSignalRequestedIDs")
+	ret0, _ := ret[0].(map[string]struct{})
+	return ret0
+}
+
+// GetPendingSignalRequestedIDs indicates an expected call of GetPendingSignalRequestedIDs.
+func (mr *MockMutableStateMockRecorder) GetPendingSignalRequestedIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSignalRequestedIDs", reflect.TypeOf((*MockMutableState)(nil).GetPendingSignalRequestedIDs))
+}
+
+// GetPendingSignalRequestedIDsByRecency mocks base method.
+func (m *MockMutableState) GetPendingSignalRequestedIDsByRecency() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingSignalRequestedIDsByRecency")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetPendingSignalRequestedIDsByRecency indicates an expected call of GetPendingSignalRequestedIDsByRecency.
+func (mr *MockMutableStateMockRecorder) GetPendingSignalRequestedIDsByRecency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSignalRequestedIDsByRecency", reflect.TypeOf((*MockMutableState)(nil).GetPendingSignalRequestedIDsByRecency))
+}
+
 // GetPendingTimerInfos mocks base method.
 func (m *MockMutableState) GetPendingTimerInfos() map[string]*persistence.TimerInfo {
 	m.ctrl.T.Helper()
@@ -24,6 +24,7 @@ package execution
 import (
 	"encoding/json"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
@@ -318,6 +319,55 @@ func CreatePersistenceMutableState(ms MutableState) *persistence.WorkflowMutable
 	}
 }
 
+// copyWorkflowMutableState copies the persistence representation of a mutable state,
+// the copy shares no maps, slices or events with the source and is safe to read concurrently
+func copyWorkflowMutableState(state *persistence.WorkflowMutableState) *persistence.WorkflowMutableState {
+	activityInfos := make(map[int64]*persistence.ActivityInfo, len(state.ActivityInfos))
+	for id, info := range state.ActivityInfos {
+		activityInfos[id] = CopyActivityInfo(info)
+	}
+	timerInfos := make(map[string]*persistence.TimerInfo, len(state.TimerInfos))
+	for id, info := range state.TimerInfos {
+		timerInfos[id] = CopyTimerInfo(info)
+	}
+	childInfos := make(map[int64]*persistence.ChildExecutionInfo, len(state.ChildExecutionInfos))
+	for id, info := range state.ChildExecutionInfos {
+		childInfos[id] = CopyChildInfo(info)
+	}
+	cancellationInfos := make(map[int64]*persistence.RequestCancelInfo, len(state.RequestCancelInfos))
+	for id, info := range state.RequestCancelInfos {
+		cancellationInfos[id] = CopyCancellationInfo(info)
+	}
+	signalInfos := make(map[int64]*persistence.SignalInfo, len(state.SignalInfos))
+	for id, info := range state.SignalInfos {
+		signalInfos[id] = CopySignalInfo(info)
+	}
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIDs))
+	for id := range state.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+
+	var bufferedEvents []*types.HistoryEvent
+	for _, event := range state.BufferedEvents {
+		bufferedEvents = append(bufferedEvents, deepCopyHistoryEvent(event))
+	}
+	var versionHistories *persistence.VersionHistories
+	if state.VersionHistories != nil {
+		versionHistories = state.VersionHistories.Duplicate()
+	}
+	return &persistence.WorkflowMutableState{
+		ExecutionInfo:       deepCopyWorkflowExecutionInfo(state.ExecutionInfo),
+		ActivityInfos:       activityInfos,
+		TimerInfos:          timerInfos,
+		ChildExecutionInfos: childInfos,
+		RequestCancelInfos:  cancellationInfos,
+		SignalInfos:         signalInfos,
+		SignalRequestedIDs:  signalRequestedIDs,
+		BufferedEvents:      bufferedEvents,
+		VersionHistories:    versionHistories,
+	}
+}
+
 // CopyWorkflowExecutionInfo copies WorkflowExecutionInfo
 func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *persistence.WorkflowExecutionInfo {
 	return &persistence.WorkflowExecutionInfo{
@@ -377,6 +427,66 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 	}
 }
 
+// deepCopyWorkflowExecutionInfo copies WorkflowExecutionInfo including its maps, slices and events,
+// CopyWorkflowExecutionInfo shares them with the source
+func deepCopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *persistence.WorkflowExecutionInfo {
+	info := CopyWorkflowExecutionInfo(sourceInfo)
+	info.DecisionScheduledTimestamp = sourceInfo.DecisionScheduledTimestamp
+	info.IsCron = sourceInfo.IsCron
+	info.CompletionEvent = deepCopyHistoryEvent(sourceInfo.CompletionEvent)
+	info.ExecutionContext = copyByteArray(sourceInfo.ExecutionContext)
+	info.AutoResetPoints = copyResetPoints(sourceInfo.AutoResetPoints)
+	info.Memo = copyMapOfByteArray(sourceInfo.Memo)
+	info.SearchAttributes = copyMapOfByteArray(sourceInfo.SearchAttributes)
+	if sourceInfo.NonRetriableErrors != nil {
+		info.NonRetriableErrors = append([]string{}, sourceInfo.NonRetriableErrors...)
+	}
+	info.BranchToken = copyByteArray(sourceInfo.BranchToken)
+	return info
+}
+
+func copyResetPoints(points *types.ResetPoints) *types.ResetPoints {
+	if points == nil {
+		return nil
+	}
+	copied := &types.ResetPoints{}
+	for _, point := range points.Points {
+		if point == nil {
+			copied.Points = append(copied.Points, nil)
+			continue
+		}
+		pointCopy := *point
+		if point.CreatedTimeNano != nil {
+			pointCopy.CreatedTimeNano = common.Int64Ptr(*point.CreatedTimeNano)
+		}
+		if point.ExpiringTimeNano != nil {
+			pointCopy.ExpiringTimeNano = common.Int64Ptr(*point.ExpiringTimeNano)
+		}
+		copied.Points = append(copied.Points, &pointCopy)
+	}
+	return copied
+}
+
+func copyMapOfByteArray(source map[string][]byte) map[string][]byte {
+	if source == nil {
+		return nil
+	}
+	copied := make(map[string][]byte, len(source))
+	for k, v := range source {
+		copied[k] = copyByteArray(v)
+	}
+	return copied
+}
+
+func copyByteArray(source []byte) []byte {
+	if source == nil {
+		return nil
+	}
+	copied := make([]byte, len(source))
+	copy(copied, source)
+	return copied
+}
+
 // CopyActivityInfo copies ActivityInfo
 func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.ActivityInfo {
 	details := make([]byte, len(sourceInfo.Details))
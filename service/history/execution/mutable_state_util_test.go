@@ -21,6 +21,8 @@
 package execution
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -148,3 +151,87 @@ func TestFindAutoResetPoint(t *testing.T) {
 	})
 	assert.Equal(t, pt, pt5)
 }
+
+func TestCopyWorkflowMutableState(t *testing.T) {
+	state := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			WorkflowID:                 "some random workflow ID",
+			NextEventID:                10,
+			DecisionScheduledTimestamp: 123,
+			SearchAttributes:           map[string][]byte{"CustomKeywordField": []byte("value")},
+			Memo:                       map[string][]byte{"memo": []byte("memo value")},
+			AutoResetPoints: &types.ResetPoints{
+				Points: []*types.ResetPointInfo{{BinaryChecksum: "checksum", Resettable: true}},
+			},
+		},
+		BufferedEvents: []*types.HistoryEvent{
+			{ID: common.BufferedEventID, EventType: types.EventTypeWorkflowExecutionSignaled.Ptr()},
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5, ActivityID: "activity"},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer": {TimerID: "timer", StartedID: 6},
+		},
+		SignalRequestedIDs: map[string]struct{}{"signal": {}},
+		VersionHistories:   persistence.NewVersionHistories(&persistence.VersionHistory{BranchToken: []byte("token")}),
+	}
+
+	copied := copyWorkflowMutableState(state)
+	assert.Equal(t, state.ExecutionInfo, copied.ExecutionInfo)
+	assert.Equal(t, state.ActivityInfos[5].ActivityID, copied.ActivityInfos[5].ActivityID)
+	assert.Equal(t, state.TimerInfos, copied.TimerInfos)
+	assert.Equal(t, state.SignalRequestedIDs, copied.SignalRequestedIDs)
+	assert.Equal(t, state.VersionHistories, copied.VersionHistories)
+	assert.Equal(t, state.BufferedEvents, copied.BufferedEvents)
+
+	// changes to the source must not be visible in the copy
+	state.ExecutionInfo.NextEventID = 11
+	state.ActivityInfos[5].StartedID = 7
+	delete(state.TimerInfos, "timer")
+	state.SignalRequestedIDs["another signal"] = struct{}{}
+	state.ExecutionInfo.SearchAttributes["CustomKeywordField"][0] = 'V'
+	state.ExecutionInfo.Memo["another memo"] = nil
+	state.ExecutionInfo.AutoResetPoints.Points[0].Resettable = false
+	state.BufferedEvents[0].ID = 12
+	assert.Equal(t, int64(10), copied.ExecutionInfo.NextEventID)
+	assert.Equal(t, []byte("value"), copied.ExecutionInfo.SearchAttributes["CustomKeywordField"])
+	assert.Len(t, copied.ExecutionInfo.Memo, 1)
+	assert.True(t, copied.ExecutionInfo.AutoResetPoints.Points[0].Resettable)
+	assert.Equal(t, common.BufferedEventID, copied.BufferedEvents[0].ID)
+	assert.Equal(t, int64(0), copied.ActivityInfos[5].StartedID)
+	assert.Len(t, copied.TimerInfos, 1)
+	assert.Len(t, copied.SignalRequestedIDs, 1)
+}
+
+func TestCopyWorkflowMutableState_ConcurrentUpsertSearchAttributes(t *testing.T) {
+	state := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			WorkflowID:       "some random workflow ID",
+			SearchAttributes: map[string][]byte{"CustomKeywordField": []byte("value")},
+		},
+	}
+	copied := copyWorkflowMutableState(state)
+
+	// upserts to the source while the copy is read must not race, run with -race
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			state.ExecutionInfo.SearchAttributes = mergeMapOfByteArray(
+				state.ExecutionInfo.SearchAttributes,
+				map[string][]byte{fmt.Sprintf("CustomKeywordField%v", i): []byte("value")},
+			)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		for key, value := range copied.ExecutionInfo.SearchAttributes {
+			assert.Equal(t, "CustomKeywordField", key)
+			assert.Equal(t, []byte("value"), value)
+		}
+	}
+	wg.Wait()
+	assert.Len(t, state.ExecutionInfo.SearchAttributes, 101)
+	assert.Len(t, copied.ExecutionInfo.SearchAttributes, 1)
+}
@@ -0,0 +1,319 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	exportTimeout = time.Minute
+	pageSize      = 100
+)
+
+type (
+	// Config is the config for the history exporter
+	Config struct {
+		// Mode is one of ModeDisabled, ModeClosed or ModeContinuous
+		Mode        dynamicconfig.StringPropertyFnWithDomainFilter
+		QueueSize   dynamicconfig.IntPropertyFn
+		Concurrency dynamicconfig.IntPropertyFn
+	}
+
+	// Exporter uploads the history of workflows to the blobstore after it is committed, histories are only
+	// exported by the cluster the domain is active in. Export is best effort, it never blocks nor fails the
+	// transaction which wrote the events.
+	Exporter interface {
+		common.Daemon
+		Export(request *Request)
+	}
+
+	// Request describes the history committed by a workflow transaction
+	Request struct {
+		DomainID    string
+		WorkflowID  string
+		RunID       string
+		ShardID     int
+		BranchToken []byte
+		// NextEventID is the next event ID of the run after the transaction
+		NextEventID int64
+		// Closed is true if the transaction closed the run
+		Closed bool
+		// Batches are the event batches written by the transaction,
+		// nil if all events up to NextEventID have to be read from the history
+		Batches []*persistence.WorkflowEvents
+	}
+
+	exportTask struct {
+		request    *Request
+		domainName string
+		mode       string
+	}
+
+	exporterImpl struct {
+		status       int32
+		shutdownChan chan struct{}
+		shutdownWG   sync.WaitGroup
+
+		config             *Config
+		currentClusterName string
+		blobstoreClient    blobstore.Client
+		historyManager     persistence.HistoryManager
+		domainCache        cache.DomainCache
+		timeSource         clock.TimeSource
+		scope              metrics.Scope
+		logger             log.Logger
+		taskChan           chan *exportTask
+	}
+
+	noopExporter struct{}
+)
+
+var _ Exporter = (*exporterImpl)(nil)
+
+// NewExporter creates a new history exporter, histories are not exported if the blobstore client is nil
+func NewExporter(
+	config *Config,
+	currentClusterName string,
+	blobstoreClient blobstore.Client,
+	historyManager persistence.HistoryManager,
+	domainCache cache.DomainCache,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) Exporter {
+	return &exporterImpl{
+		status:             common.DaemonStatusInitialized,
+		shutdownChan:       make(chan struct{}),
+		config:             config,
+		currentClusterName: currentClusterName,
+		blobstoreClient:    blobstoreClient,
+		historyManager:     historyManager,
+		domainCache:        domainCache,
+		timeSource:         timeSource,
+		scope:              metricsClient.Scope(metrics.HistoryExportScope),
+		logger:             logger.WithTags(tag.ComponentHistoryExporter),
+		taskChan:           make(chan *exportTask, config.QueueSize()),
+	}
+}
+
+// NewNoopExporter creates an exporter that drops all histories
+func NewNoopExporter() Exporter {
+	return &noopExporter{}
+}
+
+func (e *exporterImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	concurrency := e.config.Concurrency()
+	e.shutdownWG.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go e.exportLoop()
+	}
+
+	e.logger.Info("History exporter started.")
+}
+
+func (e *exporterImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(e.shutdownChan)
+	e.shutdownWG.Wait()
+	e.logger.Info("History exporter stopped.")
+}
+
+func (e *exporterImpl) Export(request *Request) {
+	if e.blobstoreClient == nil {
+		return
+	}
+	domainEntry, err := e.domainCache.GetDomainByID(request.DomainID)
+	if err != nil {
+		return
+	}
+	if active, _ := domainEntry.IsActiveIn(e.currentClusterName); !active {
+		return
+	}
+	domainName := domainEntry.GetInfo().Name
+	mode := e.config.Mode(domainName)
+	switch {
+	case mode == ModeContinuous:
+	case mode == ModeClosed && request.Closed:
+	default:
+		return
+	}
+
+	select {
+	case e.taskChan <- &exportTask{request: request, domainName: domainName, mode: mode}:
+	default:
+		e.scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.HistoryExportDropped)
+	}
+}
+
+func (e *exporterImpl) exportLoop() {
+	defer e.shutdownWG.Done()
+
+	for {
+		select {
+		case <-e.shutdownChan:
+			return
+		case task := <-e.taskChan:
+			e.export(task)
+		}
+	}
+}
+
+func (e *exporterImpl) export(task *exportTask) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	scope := e.scope.Tagged(metrics.DomainTag(task.domainName))
+	uploaded, err := e.upload(ctx, task)
+	scope.AddCounter(metrics.HistoryExportBlobsUploaded, int64(uploaded))
+	if err != nil {
+		scope.IncCounter(metrics.HistoryExportFailures)
+		e.logger.Warn("Failed to export workflow history.",
+			tag.WorkflowDomainName(task.domainName),
+			tag.WorkflowID(task.request.WorkflowID),
+			tag.WorkflowRunID(task.request.RunID),
+			tag.Error(err),
+		)
+	}
+}
+
+func (e *exporterImpl) upload(
+	ctx context.Context,
+	task *exportTask,
+) (int, error) {
+	request := task.request
+	if task.mode == ModeContinuous && request.Batches != nil {
+		uploaded := 0
+		for i, batch := range request.Batches {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			closed := request.Closed && i == len(request.Batches)-1
+			if err := e.put(ctx, task, batch.Events, closed); err != nil {
+				return uploaded, err
+			}
+			uploaded++
+		}
+		return uploaded, nil
+	}
+
+	events, err := e.readHistory(ctx, request)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	if err := e.put(ctx, task, events, request.Closed); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func (e *exporterImpl) put(
+	ctx context.Context,
+	task *exportTask,
+	events []*types.HistoryEvent,
+	closed bool,
+) error {
+	request := task.request
+	header := &Header{
+		FormatVersion:  FormatVersion,
+		DomainID:       request.DomainID,
+		DomainName:     task.domainName,
+		WorkflowID:     request.WorkflowID,
+		RunID:          request.RunID,
+		FirstEventID:   events[0].ID,
+		LastEventID:    events[len(events)-1].ID,
+		WorkflowClosed: closed,
+		ExportTime:     e.timeSource.Now().UnixNano(),
+	}
+	body, err := json.Marshal(&Blob{Header: header, Events: events})
+	if err != nil {
+		return err
+	}
+
+	key := FullHistoryKey(request.DomainID, request.WorkflowID, request.RunID)
+	if task.mode == ModeContinuous {
+		key = BatchKey(request.DomainID, request.WorkflowID, request.RunID, header.FirstEventID, header.LastEventID)
+	}
+	_, err = e.blobstoreClient.Put(ctx, &blobstore.PutRequest{
+		Key: key,
+		Blob: blobstore.Blob{
+			Tags: map[string]string{"domainID": request.DomainID, "runID": request.RunID},
+			Body: body,
+		},
+	})
+	return err
+}
+
+func (e *exporterImpl) readHistory(
+	ctx context.Context,
+	request *Request,
+) ([]*types.HistoryEvent, error) {
+	shardID := request.ShardID
+	readRequest := &persistence.ReadHistoryBranchRequest{
+		BranchToken: request.BranchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  request.NextEventID,
+		PageSize:    pageSize,
+		ShardID:     &shardID,
+	}
+	var events []*types.HistoryEvent
+	for {
+		response, err := e.historyManager.ReadHistoryBranch(ctx, readRequest)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, response.HistoryEvents...)
+		if len(response.NextPageToken) == 0 {
+			return events, nil
+		}
+		readRequest.NextPageToken = response.NextPageToken
+	}
+}
+
+func (e *noopExporter) Start() {}
+
+func (e *noopExporter) Stop() {}
+
+func (e *noopExporter) Export(*Request) {}
@@ -0,0 +1,259 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID   = "domain-id"
+	testDomainName = "domain-name"
+	testWorkflowID = "workflow/id"
+	testRunID      = "run-id"
+	testCluster    = "active"
+)
+
+func newTestExporter(
+	controller *gomock.Controller,
+	mode string,
+	blobstoreClient blobstore.Client,
+	historyManager persistence.HistoryManager,
+	activeCluster string,
+) *exporterImpl {
+	domainCache := cache.NewMockDomainCache(controller)
+	domainCache.EXPECT().GetDomainByID(testDomainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{ActiveClusterName: activeCluster},
+		0,
+	), nil).AnyTimes()
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Unix(0, 100))
+
+	return NewExporter(
+		&Config{
+			Mode:        dynamicconfig.GetStringPropertyFnFilteredByDomain(mode),
+			QueueSize:   dynamicconfig.GetIntPropertyFn(1),
+			Concurrency: dynamicconfig.GetIntPropertyFn(1),
+		},
+		testCluster,
+		blobstoreClient,
+		historyManager,
+		domainCache,
+		timeSource,
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+	).(*exporterImpl)
+}
+
+func newTestRequest(closed bool, batches ...[]*types.HistoryEvent) *Request {
+	request := &Request{
+		DomainID:    testDomainID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		ShardID:     1,
+		BranchToken: []byte("branch-token"),
+		NextEventID: 5,
+		Closed:      closed,
+	}
+	for _, events := range batches {
+		request.Batches = append(request.Batches, &persistence.WorkflowEvents{Events: events})
+	}
+	return request
+}
+
+func expectPuts(blobstoreClient *blobstore.MockClient, blobs map[string]*Blob) {
+	blobstoreClient.On("Put", mock.Anything, mock.Anything).Return(&blobstore.PutResponse{}, nil).Run(func(args mock.Arguments) {
+		request := args.Get(1).(*blobstore.PutRequest)
+		var blob Blob
+		if err := json.Unmarshal(request.Blob.Body, &blob); err == nil {
+			blobs[request.Key] = &blob
+		}
+	})
+}
+
+// drain runs the queued exports on the calling goroutine, the exporter is not started in tests
+func drain(exporter *exporterImpl) {
+	for {
+		select {
+		case task := <-exporter.taskChan:
+			exporter.export(task)
+		default:
+			return
+		}
+	}
+}
+
+func TestExporter_Continuous(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	blobstoreClient := &blobstore.MockClient{}
+	blobs := make(map[string]*Blob)
+	expectPuts(blobstoreClient, blobs)
+	exporter := newTestExporter(controller, ModeContinuous, blobstoreClient, nil, testCluster)
+
+	exporter.Export(newTestRequest(
+		true,
+		[]*types.HistoryEvent{{ID: 2}, {ID: 3}},
+		[]*types.HistoryEvent{{ID: 4}},
+	))
+	drain(exporter)
+
+	require.Len(t, blobs, 2)
+	first := blobs[BatchKey(testDomainID, testWorkflowID, testRunID, 2, 3)]
+	require.NotNil(t, first)
+	require.Equal(t, &Header{
+		FormatVersion: FormatVersion,
+		DomainID:      testDomainID,
+		DomainName:    testDomainName,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		FirstEventID:  2,
+		LastEventID:   3,
+		ExportTime:    100,
+	}, first.Header)
+	require.Len(t, first.Events, 2)
+	last := blobs[BatchKey(testDomainID, testWorkflowID, testRunID, 4, 4)]
+	require.NotNil(t, last)
+	require.True(t, last.Header.WorkflowClosed)
+}
+
+func TestExporter_ContinuousReadsHistoryWithoutBatches(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	blobstoreClient := &blobstore.MockClient{}
+	blobs := make(map[string]*Blob)
+	expectPuts(blobstoreClient, blobs)
+	historyManager := persistence.NewMockHistoryManager(controller)
+	historyManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 1}, {ID: 2}},
+	}, nil).Times(1)
+	exporter := newTestExporter(controller, ModeContinuous, blobstoreClient, historyManager, testCluster)
+
+	exporter.Export(newTestRequest(false))
+	drain(exporter)
+
+	blob := blobs[BatchKey(testDomainID, testWorkflowID, testRunID, 1, 2)]
+	require.NotNil(t, blob)
+	require.False(t, blob.Header.WorkflowClosed)
+}
+
+func TestExporter_Closed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	blobstoreClient := &blobstore.MockClient{}
+	blobs := make(map[string]*Blob)
+	expectPuts(blobstoreClient, blobs)
+	historyManager := persistence.NewMockHistoryManager(controller)
+	historyManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			require.Equal(t, int64(1), request.MinEventID)
+			require.Equal(t, int64(5), request.MaxEventID)
+			require.Equal(t, 1, *request.ShardID)
+			if len(request.NextPageToken) == 0 {
+				return &persistence.ReadHistoryBranchResponse{
+					HistoryEvents: []*types.HistoryEvent{{ID: 1}, {ID: 2}},
+					NextPageToken: []byte("next"),
+				}, nil
+			}
+			return &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*types.HistoryEvent{{ID: 3}, {ID: 4}},
+			}, nil
+		}).Times(2)
+	exporter := newTestExporter(controller, ModeClosed, blobstoreClient, historyManager, testCluster)
+
+	// open workflows are not exported in closed mode
+	exporter.Export(newTestRequest(false, []*types.HistoryEvent{{ID: 2}}))
+	require.Empty(t, exporter.taskChan)
+
+	exporter.Export(newTestRequest(true, []*types.HistoryEvent{{ID: 4}}))
+	drain(exporter)
+
+	require.Len(t, blobs, 1)
+	blob := blobs[FullHistoryKey(testDomainID, testWorkflowID, testRunID)]
+	require.NotNil(t, blob)
+	require.Equal(t, int64(1), blob.Header.FirstEventID)
+	require.Equal(t, int64(4), blob.Header.LastEventID)
+	require.True(t, blob.Header.WorkflowClosed)
+	require.Len(t, blob.Events, 4)
+}
+
+func TestExporter_Skipped(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	request := newTestRequest(true, []*types.HistoryEvent{{ID: 2}})
+
+	exporter := newTestExporter(controller, ModeDisabled, &blobstore.MockClient{}, nil, testCluster)
+	exporter.Export(request)
+	require.Empty(t, exporter.taskChan)
+
+	exporter = newTestExporter(controller, ModeContinuous, &blobstore.MockClient{}, nil, "standby")
+	exporter.Export(request)
+	require.Empty(t, exporter.taskChan)
+
+	exporter = newTestExporter(controller, ModeContinuous, nil, nil, testCluster)
+	exporter.Export(request)
+	require.Empty(t, exporter.taskChan)
+}
+
+func TestExporter_DropsWhenQueueFull(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	exporter := newTestExporter(controller, ModeContinuous, &blobstore.MockClient{}, nil, testCluster)
+	exporter.Export(newTestRequest(false, []*types.HistoryEvent{{ID: 2}}))
+	exporter.Export(newTestRequest(false, []*types.HistoryEvent{{ID: 3}}))
+	require.Len(t, exporter.taskChan, 1)
+}
+
+func TestKeys(t *testing.T) {
+	require.Equal(t,
+		"history_v1_domain-id_workflow%2Fid_run-id_00000000000000000002_00000000000000000010.json",
+		BatchKey(testDomainID, testWorkflowID, testRunID, 2, 10),
+	)
+	require.Equal(t,
+		"history_v1_domain-id_workflow%2Fid_run-id_full.json",
+		FullHistoryKey(testDomainID, testWorkflowID, testRunID),
+	)
+}
@@ -0,0 +1,105 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// Exported histories are written to the blobstore as JSON encoded Blobs, one object per key.
+//
+// Keys are flat so they work with every blobstore implementation, the workflow ID is path escaped:
+//
+//	history_v1_<domainID>_<workflowID>_<runID>_<firstEventID>_<lastEventID>.json   batches, in continuous mode
+//	history_v1_<domainID>_<workflowID>_<runID>_full.json                           full history, in closed mode
+//
+// Event IDs in keys are zero padded to 20 digits so keys of a run sort in event order. In continuous mode the
+// first blob of a run holds all events up to the first commit, so it also covers the events copied from the
+// base run of a reset. Exports are at least once, a batch may be uploaded again under the same key.
+// Changes to the blob layout which are not backward compatible bump FormatVersion.
+
+const (
+	// FormatVersion is the version of the export format, it prefixes every key
+	FormatVersion = 1
+
+	// ModeDisabled turns off history export for a domain
+	ModeDisabled = "disabled"
+	// ModeClosed exports the full history of a workflow once it closes
+	ModeClosed = "closed"
+	// ModeContinuous exports every event batch as soon as it is committed
+	ModeContinuous = "continuous"
+
+	keyPrefix = "history_v"
+)
+
+type (
+	// Blob is the content of an exported object
+	Blob struct {
+		Header *Header               `json:"header"`
+		Events []*types.HistoryEvent `json:"events"`
+	}
+
+	// Header describes the events contained in a Blob
+	Header struct {
+		FormatVersion  int    `json:"formatVersion"`
+		DomainID       string `json:"domainID"`
+		DomainName     string `json:"domainName"`
+		WorkflowID     string `json:"workflowID"`
+		RunID          string `json:"runID"`
+		FirstEventID   int64  `json:"firstEventID"`
+		LastEventID    int64  `json:"lastEventID"`
+		WorkflowClosed bool   `json:"workflowClosed"`
+		ExportTime     int64  `json:"exportTime"`
+	}
+)
+
+// BatchKey returns the key events [firstEventID, lastEventID] of a run are exported to in continuous mode
+func BatchKey(
+	domainID string,
+	workflowID string,
+	runID string,
+	firstEventID int64,
+	lastEventID int64,
+) string {
+	return fmt.Sprintf("%v_%020d_%020d.json", runKeyPrefix(domainID, workflowID, runID), firstEventID, lastEventID)
+}
+
+// FullHistoryKey returns the key the full history of a run is exported to in closed mode
+func FullHistoryKey(
+	domainID string,
+	workflowID string,
+	runID string,
+) string {
+	return runKeyPrefix(domainID, workflowID, runID) + "_full.json"
+}
+
+func runKeyPrefix(
+	domainID string,
+	workflowID string,
+	runID string,
+) string {
+	return fmt.Sprintf("%v%v_%v_%v_%v", keyPrefix, FormatVersion, domainID, url.PathEscape(workflowID), runID)
+}
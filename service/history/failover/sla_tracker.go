@@ -0,0 +1,69 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failover
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// SLATracker remembers when domains became active in the current cluster
+	// and reports how long it took until a workflow was started for them again.
+	// The zero value is ready to use.
+	SLATracker struct {
+		sync.Mutex
+		failoverTimes map[string]time.Time // domainID -> time the domain became active
+	}
+)
+
+// RecordFailover records that the given domains became active at the given time
+func (t *SLATracker) RecordFailover(
+	domainIDs map[string]struct{},
+	now time.Time,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.failoverTimes == nil {
+		t.failoverTimes = make(map[string]time.Time)
+	}
+	for domainID := range domainIDs {
+		t.failoverTimes[domainID] = now
+	}
+}
+
+// RecordStart returns the time elapsed since the domain became active if this is
+// the first workflow start for the domain after its failover
+func (t *SLATracker) RecordStart(
+	domainID string,
+	now time.Time,
+) (time.Duration, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	failoverTime, ok := t.failoverTimes[domainID]
+	if !ok {
+		return 0, false
+	}
+	delete(t.failoverTimes, domainID)
+	return now.Sub(failoverTime), true
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failover
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSLATracker(t *testing.T) {
+	var tracker SLATracker
+	now := time.Now()
+
+	_, ok := tracker.RecordStart("domain-1", now)
+	assert.False(t, ok)
+
+	tracker.RecordFailover(map[string]struct{}{"domain-1": {}, "domain-2": {}}, now)
+
+	latency, ok := tracker.RecordStart("domain-1", now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, latency)
+
+	_, ok = tracker.RecordStart("domain-1", now.Add(2*time.Minute))
+	assert.False(t, ok)
+
+	latency, ok = tracker.RecordStart("domain-2", now.Add(3*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, latency)
+}
@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/failover"
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/resource"
@@ -65,6 +66,8 @@ type (
 		common.Daemon
 
 		PrepareToStop(time.Duration) time.Duration
+		StopAcquiringShards()
+		NumShards() int
 		Health(context.Context) (*types.HealthStatus, error)
 		CloseShard(context.Context, *types.CloseShardRequest) error
 		DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
@@ -249,14 +252,35 @@ func (h *handlerImpl) Stop() {
 // PrepareToStop starts graceful traffic drain in preparation for shutdown
 func (h *handlerImpl) PrepareToStop(remainingTime time.Duration) time.Duration {
 	h.GetLogger().Info("ShutdownHandler: Initiating shardController shutdown")
-	h.controller.PrepareToStop()
+	h.StopAcquiringShards()
 	h.GetLogger().Info("ShutdownHandler: Waiting for traffic to drain")
-	remainingTime = common.SleepWithMinDuration(shardOwnershipTransferDelay, remainingTime)
+	remainingTime = h.waitForShardsHandedOff(common.MinDuration(shardOwnershipTransferDelay, remainingTime), remainingTime)
 	h.GetLogger().Info("ShutdownHandler: No longer taking rpc requests")
 	h.prepareToShutDown()
 	return remainingTime
 }
 
+// StopAcquiringShards stops acquiring shards, shards owned by this host are still served
+// until they are acquired by other hosts
+func (h *handlerImpl) StopAcquiringShards() {
+	h.controller.PrepareToStop()
+}
+
+// NumShards returns the number of shards owned by this host
+func (h *handlerImpl) NumShards() int {
+	return h.controller.NumShards()
+}
+
+// waitForShardsHandedOff waits up to maxWait for all shards to be acquired by other hosts
+func (h *handlerImpl) waitForShardsHandedOff(maxWait time.Duration, remainingTime time.Duration) time.Duration {
+	const checkInterval = 100 * time.Millisecond
+
+	for waited := time.Duration(0); waited < maxWait && h.NumShards() > 0; waited += checkInterval {
+		remainingTime = common.SleepWithMinDuration(checkInterval, remainingTime)
+	}
+	return remainingTime
+}
+
 func (h *handlerImpl) prepareToShutDown() {
 	atomic.StoreInt32(&h.shuttingDown, 1)
 }
@@ -301,7 +325,7 @@ func (h *handlerImpl) RecordActivityTaskHeartbeat(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordActivityTaskHeartbeatScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordActivityTaskHeartbeatScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -348,7 +372,7 @@ func (h *handlerImpl) RecordActivityTaskStarted(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordActivityTaskStartedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordActivityTaskStartedScope)
 	defer sw.Stop()
 
 	domainID := recordRequest.GetDomainUUID()
@@ -394,7 +418,7 @@ func (h *handlerImpl) RecordDecisionTaskStarted(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordDecisionTaskStartedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordDecisionTaskStartedScope)
 	defer sw.Stop()
 
 	domainID := recordRequest.GetDomainUUID()
@@ -450,7 +474,7 @@ func (h *handlerImpl) RespondActivityTaskCompleted(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskCompletedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskCompletedScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -497,7 +521,7 @@ func (h *handlerImpl) RespondActivityTaskFailed(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskFailedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskFailedScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -544,7 +568,7 @@ func (h *handlerImpl) RespondActivityTaskCanceled(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskCanceledScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondActivityTaskCanceledScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -591,7 +615,7 @@ func (h *handlerImpl) RespondDecisionTaskCompleted(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondDecisionTaskCompletedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondDecisionTaskCompletedScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -647,7 +671,7 @@ func (h *handlerImpl) RespondDecisionTaskFailed(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondDecisionTaskFailedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondDecisionTaskFailedScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -713,7 +737,7 @@ func (h *handlerImpl) StartWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryStartWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryStartWorkflowExecutionScope)
 	defer sw.Stop()
 
 	domainID := wrappedRequest.GetDomainUUID()
@@ -825,7 +849,7 @@ func (h *handlerImpl) ResetQueue(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetQueueScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetQueueScope)
 	defer sw.Stop()
 
 	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
@@ -859,7 +883,7 @@ func (h *handlerImpl) DescribeQueue(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeQueueScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeQueueScope)
 	defer sw.Stop()
 
 	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
@@ -893,7 +917,7 @@ func (h *handlerImpl) DescribeMutableState(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeMutabelStateScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeMutabelStateScope)
 	defer sw.Stop()
 
 	domainID := request.GetDomainUUID()
@@ -924,7 +948,7 @@ func (h *handlerImpl) GetMutableState(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetMutableStateScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetMutableStateScope)
 	defer sw.Stop()
 
 	domainID := getRequest.GetDomainUUID()
@@ -959,7 +983,7 @@ func (h *handlerImpl) PollMutableState(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPollMutableStateScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryPollMutableStateScope)
 	defer sw.Stop()
 
 	domainID := getRequest.GetDomainUUID()
@@ -994,7 +1018,7 @@ func (h *handlerImpl) DescribeWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryDescribeWorkflowExecutionScope)
 	defer sw.Stop()
 
 	domainID := request.GetDomainUUID()
@@ -1029,7 +1053,7 @@ func (h *handlerImpl) RequestCancelWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRequestCancelWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRequestCancelWorkflowExecutionScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1076,7 +1100,7 @@ func (h *handlerImpl) SignalWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistorySignalWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistorySignalWorkflowExecutionScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1120,7 +1144,7 @@ func (h *handlerImpl) SignalWithStartWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistorySignalWithStartWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistorySignalWithStartWorkflowExecutionScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1161,7 +1185,7 @@ func (h *handlerImpl) RemoveSignalMutableState(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRemoveSignalMutableStateScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRemoveSignalMutableStateScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1202,7 +1226,7 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryTerminateWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryTerminateWorkflowExecutionScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1243,7 +1267,7 @@ func (h *handlerImpl) ResetWorkflowExecution(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetWorkflowExecutionScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetWorkflowExecutionScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1282,7 +1306,7 @@ func (h *handlerImpl) QueryWorkflow(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryQueryWorkflowScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryQueryWorkflowScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1324,7 +1348,7 @@ func (h *handlerImpl) ScheduleDecisionTask(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryScheduleDecisionTaskScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryScheduleDecisionTaskScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1369,7 +1393,7 @@ func (h *handlerImpl) RecordChildExecutionCompleted(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordChildExecutionCompletedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordChildExecutionCompletedScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1419,7 +1443,7 @@ func (h *handlerImpl) ResetStickyTaskList(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetStickyTaskListScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetStickyTaskListScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1462,7 +1486,7 @@ func (h *handlerImpl) ReplicateEventsV2(
 		return errShuttingDown
 	}
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReplicateEventsV2Scope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryReplicateEventsV2Scope)
 	defer sw.Stop()
 
 	domainID := replicateRequest.GetDomainUUID()
@@ -1498,7 +1522,7 @@ func (h *handlerImpl) SyncShardStatus(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistorySyncShardStatusScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistorySyncShardStatusScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1540,7 +1564,7 @@ func (h *handlerImpl) SyncActivity(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistorySyncActivityScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistorySyncActivityScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1588,7 +1612,7 @@ func (h *handlerImpl) GetReplicationMessages(
 
 	h.GetLogger().Debug("Received GetReplicationMessages call.")
 
-	_, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationMessagesScope)
+	ctx, _, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationMessagesScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1645,7 +1669,7 @@ func (h *handlerImpl) GetDLQReplicationMessages(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	_, sw := h.startRequestProfile(ctx, metrics.HistoryGetDLQReplicationMessagesScope)
+	ctx, _, sw := h.startRequestProfile(ctx, metrics.HistoryGetDLQReplicationMessagesScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1721,7 +1745,7 @@ func (h *handlerImpl) ReapplyEvents(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReapplyEventsScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryReapplyEventsScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1763,7 +1787,7 @@ func (h *handlerImpl) CountDLQMessages(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryCountDLQMessagesScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryCountDLQMessagesScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1811,7 +1835,7 @@ func (h *handlerImpl) ReadDLQMessages(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReadDLQMessagesScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryReadDLQMessagesScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1835,7 +1859,7 @@ func (h *handlerImpl) PurgeDLQMessages(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPurgeDLQMessagesScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryPurgeDLQMessagesScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1863,7 +1887,7 @@ func (h *handlerImpl) MergeDLQMessages(
 		return nil, errShuttingDown
 	}
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryMergeDLQMessagesScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryMergeDLQMessagesScope)
 	defer sw.Stop()
 
 	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
@@ -1879,7 +1903,7 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *types.HistoryRefreshWorkflowTasksRequest) (retError error) {
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRefreshWorkflowTasksScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRefreshWorkflowTasksScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1917,7 +1941,7 @@ func (h *handlerImpl) NotifyFailoverMarkers(
 	request *types.NotifyFailoverMarkersRequest,
 ) (retError error) {
 
-	_, sw := h.startRequestProfile(ctx, metrics.HistoryNotifyFailoverMarkersScope)
+	ctx, _, sw := h.startRequestProfile(ctx, metrics.HistoryNotifyFailoverMarkersScope)
 	defer sw.Stop()
 
 	for _, token := range request.GetFailoverMarkerTokens() {
@@ -1935,7 +1959,7 @@ func (h *handlerImpl) GetCrossClusterTasks(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	_, sw := h.startRequestProfile(ctx, metrics.HistoryGetCrossClusterTasksScope)
+	ctx, _, sw := h.startRequestProfile(ctx, metrics.HistoryGetCrossClusterTasksScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -1997,7 +2021,7 @@ func (h *handlerImpl) RespondCrossClusterTasksCompleted(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondCrossClusterTasksCompletedScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryRespondCrossClusterTasksCompletedScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -2034,7 +2058,7 @@ func (h *handlerImpl) GetFailoverInfo(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
-	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetFailoverInfoScope)
+	ctx, scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetFailoverInfoScope)
 	defer sw.Stop()
 
 	if h.isShuttingDown() {
@@ -2165,11 +2189,13 @@ func (h *handlerImpl) emitInfoOrDebugLog(
 	}
 }
 
-func (h *handlerImpl) startRequestProfile(ctx context.Context, scope int) (metrics.Scope, metrics.Stopwatch) {
+// startRequestProfile starts the metrics of a request and returns a context carrying the request scope,
+// which decides the priority of the request when acquiring workflow locks
+func (h *handlerImpl) startRequestProfile(ctx context.Context, scope int) (context.Context, metrics.Scope, metrics.Stopwatch) {
 	metricsScope := h.GetMetricsClient().Scope(scope, metrics.GetContextTags(ctx)...)
 	metricsScope.IncCounter(metrics.CadenceRequests)
 	sw := metricsScope.StartTimer(metrics.CadenceLatency)
-	return metricsScope, sw
+	return execution.ContextWithCallerScope(ctx, scope), metricsScope, sw
 }
 
 func validateTaskToken(token *common.TaskToken) error {
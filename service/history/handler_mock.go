@@ -266,6 +266,20 @@ func (mr *MockHandlerMockRecorder) NotifyFailoverMarkers(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyFailoverMarkers", reflect.TypeOf((*MockHandler)(nil).NotifyFailoverMarkers), arg0, arg1)
 }
 
+// NumShards mocks base method.
+func (m *MockHandler) NumShards() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NumShards")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// NumShards indicates an expected call of NumShards.
+func (mr *MockHandlerMockRecorder) NumShards() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumShards", reflect.TypeOf((*MockHandler)(nil).NumShards))
+}
+
 // PollMutableState mocks base method.
 func (m *MockHandler) PollMutableState(arg0 context.Context, arg1 *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -694,6 +708,18 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
 
+// StopAcquiringShards mocks base method.
+func (m *MockHandler) StopAcquiringShards() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StopAcquiringShards")
+}
+
+// StopAcquiringShards indicates an expected call of StopAcquiringShards.
+func (mr *MockHandlerMockRecorder) StopAcquiringShards() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAcquiringShards", reflect.TypeOf((*MockHandler)(nil).StopAcquiringShards))
+}
+
 // SyncActivity mocks base method.
 func (m *MockHandler) SyncActivity(arg0 context.Context, arg1 *types.SyncActivityRequest) error {
 	m.ctrl.T.Helper()
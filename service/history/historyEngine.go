@@ -78,6 +78,13 @@ const (
 	TerminateIfRunningReason = "TerminateIfRunning Policy"
 	// TerminateIfRunningDetailsTemplate details template for terminateIfRunning
 	TerminateIfRunningDetailsTemplate = "New runID: %s"
+
+	queryResultCacheTTL      = time.Minute
+	queryResultCacheMaxCount = 1000
+
+	// duplicateActivityCompletionMaxScanEvents bounds the history scanned under the workflow lock
+	// to detect a retried activity completion
+	duplicateActivityCompletionMaxScanEvents = 1000
 )
 
 var (
@@ -118,7 +125,19 @@ type (
 		rawMatchingClient          matching.Client
 		clientChecker              client.VersionChecker
 		replicationDLQHandler      replication.DLQHandler
+		replicationLagTracker      replication.LagTracker
+		cacheWarmer                shard.CacheWarmer
 		failoverMarkerNotifier     failover.MarkerNotifier
+		failoverSLATracker         failover.SLATracker
+		queryResultCache           cache.Cache // queryResultCacheKey -> *types.HistoryQueryWorkflowResponse
+	}
+
+	queryResultCacheKey struct {
+		domainID               string
+		runID                  string
+		queryType              string
+		queryArgs              string
+		previousStartedEventID int64
 	}
 )
 
@@ -207,6 +226,10 @@ func NewEngineWithShardContext(
 			shard,
 			executionCache,
 		),
+		queryResultCache: cache.New(&cache.Options{
+			TTL:      queryResultCacheTTL,
+			MaxCount: queryResultCacheMaxCount,
+		}),
 	}
 	historyEngImpl.decisionHandler = decision.NewHandler(
 		shard,
@@ -326,6 +349,7 @@ func NewEngineWithShardContext(
 	historyEngImpl.replicationTaskProcessors = replicationTaskProcessors
 	replicationMessageHandler := replication.NewDLQHandler(shard, replicationTaskExecutors)
 	historyEngImpl.replicationDLQHandler = replicationMessageHandler
+	historyEngImpl.replicationLagTracker = replication.NewLagTracker(shard, nil)
 
 	shard.SetEngine(historyEngImpl)
 	return historyEngImpl
@@ -342,6 +366,10 @@ func (e *historyEngineImpl) Start() {
 	e.timerProcessor.Start()
 	e.crossClusterProcessor.Start()
 	e.replicationDLQHandler.Start()
+	e.replicationLagTracker.Start()
+	// the shard was just acquired, so its execution cache is cold
+	e.cacheWarmer = shard.NewCacheWarmer(e.shard, e.executionCache.PrefetchWorkflowExecution)
+	e.cacheWarmer.Start()
 
 	// failover callback will try to create a failover queue processor to scan all inflight tasks
 	// if domain needs to be failovered. However, in the multicursor queue logic, the scan range
@@ -369,6 +397,10 @@ func (e *historyEngineImpl) Stop() {
 	e.timerProcessor.Stop()
 	e.crossClusterProcessor.Stop()
 	e.replicationDLQHandler.Stop()
+	e.replicationLagTracker.Stop()
+	if e.cacheWarmer != nil {
+		e.cacheWarmer.Stop()
+	}
 
 	e.crossClusterTaskProcessors.Stop()
 
@@ -455,6 +487,7 @@ func (e *historyEngineImpl) registerDomainFailoverCallback() {
 				e.crossClusterProcessor.FailoverDomain(failoverDomainIDs)
 
 				now := e.shard.GetTimeSource().Now()
+				e.failoverSLATracker.RecordFailover(failoverDomainIDs, now)
 				// the fake tasks will not be actually used, we just need to make sure
 				// its length > 0 and has correct timestamp, to trigger a db scan
 				fakeDecisionTask := []persistence.Task{&persistence.DecisionTask{}}
@@ -551,13 +584,44 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	// child workflows are started by transfer tasks, shedding them would only grow the backlog
+	if startRequest.ParentExecutionInfo == nil {
+		if err := e.shard.GetLoadMonitor().CheckBusy(); err != nil {
+			return nil, err
+		}
+	}
 
-	return e.startWorkflowHelper(
+	resp, err = e.startWorkflowHelper(
 		ctx,
 		startRequest,
 		domainEntry,
 		metrics.HistoryStartWorkflowExecutionScope,
 		nil)
+	if err == nil {
+		e.recordFirstStartAfterFailover(domainEntry)
+	}
+	return resp, err
+}
+
+// recordFirstStartAfterFailover reports the time between a domain becoming active
+// in this cluster and the first workflow successfully started for it on this shard
+func (e *historyEngineImpl) recordFirstStartAfterFailover(
+	domainEntry *cache.DomainCacheEntry,
+) {
+	latency, ok := e.failoverSLATracker.RecordStart(domainEntry.GetInfo().ID, e.shard.GetTimeSource().Now())
+	if !ok {
+		return
+	}
+	e.metricsClient.Scope(
+		metrics.HistoryStartWorkflowExecutionScope,
+		metrics.DomainTag(domainEntry.GetInfo().Name),
+	).RecordTimer(metrics.DomainFailoverFirstStartLatency, latency)
+	e.logger.Info("First workflow started after domain failover.",
+		tag.WorkflowDomainName(domainEntry.GetInfo().Name),
+		tag.WorkflowDomainID(domainEntry.GetInfo().ID),
+		tag.ShardID(e.shard.GetShardID()),
+		tag.FailoverFirstStartLatency(latency),
+	)
 }
 
 // for startWorkflowHelper be reused by signalWithStart
@@ -1104,9 +1168,9 @@ func (e *historyEngineImpl) QueryWorkflow(
 		return nil, workflow.ErrConsistentQueryNotEnabled
 	}
 
-	execution := *request.GetRequest().GetExecution()
+	workflowExecution := *request.GetRequest().GetExecution()
 
-	mutableStateResp, err := e.getMutableState(ctx, request.GetDomainUUID(), execution)
+	mutableStateResp, err := e.getMutableState(ctx, request.GetDomainUUID(), workflowExecution)
 	if err != nil {
 		return nil, err
 	}
@@ -1139,7 +1203,7 @@ func (e *historyEngineImpl) QueryWorkflow(
 	deadline := time.Now().Add(queryFirstDecisionTaskWaitTime)
 	for mutableStateResp.GetPreviousStartedEventID() <= 0 && time.Now().Before(deadline) {
 		<-time.After(queryFirstDecisionTaskCheckInterval)
-		mutableStateResp, err = e.getMutableState(ctx, request.GetDomainUUID(), execution)
+		mutableStateResp, err = e.getMutableState(ctx, request.GetDomainUUID(), workflowExecution)
 		if err != nil {
 			return nil, err
 		}
@@ -1155,16 +1219,6 @@ func (e *historyEngineImpl) QueryWorkflow(
 		return nil, err
 	}
 
-	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.GetDomainUUID(), execution)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { release(retErr) }()
-	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	// There are two ways in which queries get dispatched to decider. First, queries can be dispatched on decision tasks.
 	// These decision tasks potentially contain new events and queries. The events are treated as coming before the query in time.
 	// The second way in which queries are dispatched to decider is directly through matching; in this approach queries can be
@@ -1177,18 +1231,68 @@ func (e *historyEngineImpl) QueryWorkflow(
 	// 3. the client requested eventual consistency, in this case there are no consistency requirements so dispatching directly through matching is safe
 	// 4. if there is no pending or started decision it means no events came before query arrived, so its safe to dispatch directly
 	isActive, _ := de.IsActiveIn(e.clusterMetadata.GetCurrentClusterName())
-	safeToDispatchDirectly := !isActive ||
-		!mutableState.IsWorkflowExecutionRunning() ||
-		req.GetQueryConsistencyLevel() == types.QueryConsistencyLevelEventual ||
-		(!mutableState.HasPendingDecision() && !mutableState.HasInFlightDecision())
-	if safeToDispatchDirectly {
-		release(nil)
-		msResp, err := e.getMutableState(ctx, request.GetDomainUUID(), execution)
+	safeToDispatchDirectly := func(mutableState execution.MutableState) bool {
+		return !isActive ||
+			!mutableState.IsWorkflowExecutionRunning() ||
+			req.GetQueryConsistencyLevel() == types.QueryConsistencyLevelEventual ||
+			(!mutableState.HasPendingDecision() && !mutableState.HasInFlightDecision())
+	}
+	queryDirectly := func() (*types.HistoryQueryWorkflowResponse, error) {
+		msResp, err := e.getMutableState(ctx, request.GetDomainUUID(), workflowExecution)
 		if err != nil {
 			return nil, err
 		}
 		req.Execution.RunID = msResp.Execution.RunID
-		return e.queryDirectlyThroughMatching(ctx, msResp, request.GetDomainUUID(), req, scope)
+		if !e.isQueryResultCacheable(req) {
+			return e.queryDirectlyThroughMatching(ctx, msResp, request.GetDomainUUID(), req, scope)
+		}
+
+		// the worker state only changes when a decision completes, so the result of an
+		// eventually consistent query stays valid until the previous started event ID moves
+		cacheKey := queryResultCacheKey{
+			domainID:               request.GetDomainUUID(),
+			runID:                  msResp.Execution.GetRunID(),
+			queryType:              req.Query.GetQueryType(),
+			queryArgs:              string(req.Query.GetQueryArgs()),
+			previousStartedEventID: msResp.GetPreviousStartedEventID(),
+		}
+		if resp, ok := e.queryResultCache.Get(cacheKey).(*types.HistoryQueryWorkflowResponse); ok {
+			scope.IncCounter(metrics.QueryResultCacheHitCount)
+			return resp, nil
+		}
+		resp, err := e.queryDirectlyThroughMatching(ctx, msResp, request.GetDomainUUID(), req, scope)
+		if err == nil && resp.GetResponse().GetQueryRejected() == nil {
+			e.queryResultCache.Put(cacheKey, resp)
+		}
+		return resp, err
+	}
+
+	// check against the read only mutable state first so that queries which can be
+	// dispatched directly do not wait for the workflow lock
+	readMutableState, readRelease, err := e.executionCache.GetWorkflowExecutionForRead(ctx, request.GetDomainUUID(), workflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	safeToDispatch := safeToDispatchDirectly(readMutableState)
+	readRelease(nil)
+	if safeToDispatch {
+		return queryDirectly()
+	}
+
+	// the query has to be buffered on the mutable state owned by the workflow context,
+	// which requires the lock, and the decision may have completed in the meantime
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.GetDomainUUID(), workflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retErr) }()
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if safeToDispatchDirectly(mutableState) {
+		release(nil)
+		return queryDirectly()
 	}
 
 	// If we get here it means query could not be dispatched through matching directly, so it must block
@@ -1227,12 +1331,7 @@ func (e *historyEngineImpl) QueryWorkflow(
 				return nil, workflow.ErrQueryEnteredInvalidState
 			}
 		case query.TerminationTypeUnblocked:
-			msResp, err := e.getMutableState(ctx, request.GetDomainUUID(), execution)
-			if err != nil {
-				return nil, err
-			}
-			req.Execution.RunID = msResp.Execution.RunID
-			return e.queryDirectlyThroughMatching(ctx, msResp, request.GetDomainUUID(), req, scope)
+			return queryDirectly()
 		case query.TerminationTypeFailed:
 			return nil, state.Failure
 		default:
@@ -1245,6 +1344,13 @@ func (e *historyEngineImpl) QueryWorkflow(
 	}
 }
 
+func (e *historyEngineImpl) isQueryResultCacheable(
+	queryRequest *types.QueryWorkflowRequest,
+) bool {
+	return queryRequest.GetQueryConsistencyLevel() == types.QueryConsistencyLevelEventual &&
+		e.config.EnableQueryResultCache(queryRequest.GetDomain())
+}
+
 func (e *historyEngineImpl) queryDirectlyThroughMatching(
 	ctx context.Context,
 	msResp *types.GetMutableStateResponse,
@@ -1367,24 +1473,19 @@ func (e *historyEngineImpl) getMutableState(
 	execution types.WorkflowExecution,
 ) (retResp *types.GetMutableStateResponse, retError error) {
 
-	wfContext, release, retError := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, execution)
+	mutableState, release, retError := e.executionCache.GetWorkflowExecutionForRead(ctx, domainID, execution)
 	if retError != nil {
 		return
 	}
 	defer func() { release(retError) }()
 
-	mutableState, retError := wfContext.LoadWorkflowExecution(ctx)
-	if retError != nil {
-		return
-	}
-
 	currentBranchToken, err := mutableState.GetCurrentBranchToken()
 	if err != nil {
 		return nil, err
 	}
 
 	executionInfo := mutableState.GetExecutionInfo()
-	execution.RunID = wfContext.GetExecution().RunID
+	execution.RunID = executionInfo.RunID
 	workflowState, workflowCloseState := mutableState.GetWorkflowStateCloseStatus()
 	retResp = &types.GetMutableStateResponse{
 		Execution:                            &execution,
@@ -1513,16 +1614,12 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	domainID := request.DomainUUID
 	wfExecution := *request.Request.Execution
 
-	wfContext, release, err0 := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, wfExecution)
-	if err0 != nil {
-		return nil, err0
+	mutableState, release, err := e.executionCache.GetWorkflowExecutionForRead(ctx, domainID, wfExecution)
+	if err != nil {
+		return nil, err
 	}
 	defer func() { release(retError) }()
 
-	mutableState, err1 := wfContext.LoadWorkflowExecution(ctx)
-	if err1 != nil {
-		return nil, err1
-	}
 	executionInfo := mutableState.GetExecutionInfo()
 
 	result := &types.DescribeWorkflowExecutionResponse{
@@ -1861,17 +1958,25 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 
 	var activityStartedTime time.Time
 	var taskList string
-	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
-		func(wfContext execution.Context, mutableState execution.MutableState) error {
+	err = workflow.UpdateWithActionFunc(
+		ctx,
+		e.executionCache,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
-				return workflow.ErrAlreadyCompleted
+				if e.isDuplicateActivityCompletion(ctx, mutableState, token, domainName) {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				return nil, workflow.ErrAlreadyCompleted
 			}
 
 			scheduleID := token.ScheduleID
 			if scheduleID == common.EmptyEventID { // client call CompleteActivityById, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
 				if err0 != nil {
-					return err0
+					return nil, err0
 				}
 			}
 			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
@@ -1887,7 +1992,13 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
-				return workflow.ErrStaleState
+				return nil, workflow.ErrStaleState
+			}
+
+			// the activity may have been completed by an earlier attempt of this request,
+			// e.g. one which timed out while the history host was restarting
+			if !isRunning && e.isDuplicateActivityCompletion(ctx, mutableState, token, domainName) {
+				return &workflow.UpdateAction{Noop: true}, nil
 			}
 
 			if !isRunning || ai.StartedID == common.EmptyEventID ||
@@ -1901,17 +2012,18 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
-				return workflow.ErrActivityTaskNotFound
+				return nil, workflow.ErrActivityTaskNotFound
 			}
 
 			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, request); err != nil {
 				// Unable to add ActivityTaskCompleted event to history
-				return &types.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
+				return nil, &types.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
 			}
 			activityStartedTime = ai.StartedTime
 			taskList = ai.TaskList
-			return nil
-		})
+			return workflow.UpdateWithNewDecision, nil
+		},
+	)
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope).
 			Tagged(
@@ -1925,6 +2037,44 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	return err
 }
 
+// isDuplicateActivityCompletion returns true if the activity attempt in the token is no longer pending
+// because it was already completed, in which case the completion request is a retry and can be acknowledged.
+func (e *historyEngineImpl) isDuplicateActivityCompletion(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	token *common.TaskToken,
+	domainName string,
+) bool {
+
+	// activities completed by ID can not be matched to an attempt
+	if token.ScheduleID == common.EmptyEventID || token.ScheduleID >= mutableState.GetNextEventID() {
+		return false
+	}
+
+	completed, err := execution.IsActivityAttemptCompleted(
+		ctx,
+		e.shard,
+		mutableState,
+		token.ScheduleID,
+		token.ScheduleAttempt,
+		duplicateActivityCompletionMaxScanEvents,
+	)
+	if err != nil {
+		e.logger.Warn("Failed to check for duplicate activity completion",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(token.WorkflowID),
+			tag.WorkflowRunID(token.RunID),
+			tag.WorkflowScheduleID(token.ScheduleID),
+			tag.Error(err),
+		)
+		return false
+	}
+	if completed {
+		e.metricsClient.IncCounter(metrics.HistoryRespondActivityTaskCompletedScope, metrics.DuplicateActivityCompletionCounter)
+	}
+	return completed
+}
+
 // RespondActivityTaskFailed completes an activity task failure.
 func (e *historyEngineImpl) RespondActivityTaskFailed(
 	ctx context.Context,
@@ -2145,18 +2295,18 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	}
 
 	var cancelRequested bool
-	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
-		func(wfContext execution.Context, mutableState execution.MutableState) error {
+	err = workflow.UpdateWithActionFunc(ctx, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				e.logger.Debug("Heartbeat failed")
-				return workflow.ErrAlreadyCompleted
+				return nil, workflow.ErrAlreadyCompleted
 			}
 
 			scheduleID := token.ScheduleID
 			if scheduleID == common.EmptyEventID { // client call RecordActivityHeartbeatByID, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, mutableState)
 				if err0 != nil {
-					return err0
+					return nil, err0
 				}
 			}
 			ai, isRunning := mutableState.GetActivityInfo(scheduleID)
@@ -2172,7 +2322,7 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 					tag.WorkflowScheduleID(scheduleID),
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
-				return workflow.ErrStaleState
+				return nil, workflow.ErrStaleState
 			}
 
 			if !isRunning || ai.StartedID == common.EmptyEventID ||
@@ -2187,7 +2337,7 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 					tag.WorkflowNextEventID(mutableState.GetNextEventID()),
 				)
 
-				return workflow.ErrActivityTaskNotFound
+				return nil, workflow.ErrActivityTaskNotFound
 			}
 
 			cancelRequested = ai.CancelRequested
@@ -2195,10 +2345,18 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 			e.logger.Debug(fmt.Sprintf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, CancelRequested: %v",
 				scheduleID, ai, cancelRequested))
 
+			if e.shouldCoalesceHeartbeat(domainEntry.GetInfo().Name, ai) {
+				// The mutable state is left untouched, the heartbeat is buffered by the workflow context
+				// and written by the next update of the workflow.
+				wfContext.BufferActivityHeartbeat(ai, request, e.timeSource.Now())
+				e.metricsClient.IncCounter(metrics.HistoryRecordActivityTaskHeartbeatScope, metrics.ActivityHeartbeatCoalescedCounter)
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
 			// Save progress and last HB reported time.
 			mutableState.UpdateActivityProgress(ai, request)
 
-			return nil
+			return &workflow.UpdateAction{}, nil
 		})
 
 	if err != nil {
@@ -2208,6 +2366,22 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	return &types.RecordActivityTaskHeartbeatResponse{CancelRequested: cancelRequested}, nil
 }
 
+// shouldCoalesceHeartbeat returns true if the activity heartbeat was persisted recently enough
+// that the current heartbeat does not need to be persisted
+func (e *historyEngineImpl) shouldCoalesceHeartbeat(
+	domainName string,
+	ai *persistence.ActivityInfo,
+) bool {
+	interval := e.config.ActivityHeartbeatCoalescingInterval(domainName)
+	if interval <= 0 || ai.HeartbeatTimeout <= 0 || ai.LastHeartBeatUpdatedTime.IsZero() {
+		return false
+	}
+	if maxInterval := time.Duration(ai.HeartbeatTimeout) * time.Second / 2; interval > maxInterval {
+		interval = maxInterval
+	}
+	return e.timeSource.Now().Sub(ai.LastHeartBeatUpdatedTime) < interval
+}
+
 // RequestCancelWorkflowExecution records request cancellation event for workflow execution
 func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -2275,6 +2449,12 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
 		return errDomainDeprecated
 	}
+	// signals from other workflows are sent by transfer tasks, shedding them would only grow the backlog
+	if signalRequest.ExternalWorkflowExecution == nil {
+		if err := e.shard.GetLoadMonitor().CheckBusy(); err != nil {
+			return err
+		}
+	}
 	domainID := domainEntry.GetInfo().ID
 
 	request := signalRequest.SignalRequest
@@ -2369,6 +2549,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
 		return nil, errDomainDeprecated
 	}
+	if err := e.shard.GetLoadMonitor().CheckBusy(); err != nil {
+		return nil, err
+	}
 	domainID := domainEntry.GetInfo().ID
 
 	sRequest := signalWithStartRequest.SignalWithStartRequest
@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
@@ -980,6 +981,29 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunID)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_ShardBusy() {
+	s.config.BusyPendingTaskThreshold = dynamicconfig.GetIntPropertyFn(10)
+	s.mockShard.GetLoadMonitor().UpdatePendingTasks(s, 20)
+	defer s.mockShard.GetLoadMonitor().UpdatePendingTasks(s, 0)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              constants.TestDomainID,
+			WorkflowID:                          "workflowID",
+			WorkflowType:                        &types.WorkflowType{Name: "workflowType"},
+			TaskList:                            &types.TaskList{Name: "testTaskList"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            "testIdentity",
+			RequestID:                           uuid.New(),
+		},
+	})
+	s.Nil(resp)
+	s.IsType(&types.ServiceBusyError{}, err)
+	s.True(err.(*types.ServiceBusyError).RetryAfter > 0)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	domainID := constants.TestDomainID
 	workflowID := "workflowID"
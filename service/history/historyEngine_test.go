@@ -605,6 +605,50 @@ func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching() {
 	s.Equal([]byte{1, 2, 3}, resp.GetResponse().GetQueryResult())
 }
 
+func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching_ResultCached() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "TestQueryWorkflow_DirectlyThroughMatching_ResultCached",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	enableQueryResultCache := s.mockHistoryEngine.config.EnableQueryResultCache
+	defer func() { s.mockHistoryEngine.config.EnableQueryResultCache = enableQueryResultCache }()
+	s.mockHistoryEngine.config.EnableQueryResultCache = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	s.mockHistoryEngine.queryResultCache = cache.New(&cache.Options{TTL: time.Minute, MaxCount: 10})
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.ID, nil, identity)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gweResponse, nil).Once()
+	s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{QueryResult: []byte{1, 2, 3}}, nil).Times(1)
+	s.mockHistoryEngine.matchingClient = s.mockMatchingClient
+	request := &types.HistoryQueryWorkflowRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.QueryWorkflowRequest{
+			Execution:             &workflowExecution,
+			Query:                 &types.WorkflowQuery{QueryType: "__stack_trace"},
+			QueryConsistencyLevel: types.QueryConsistencyLevelEventual.Ptr(),
+		},
+	}
+	for i := 0; i < 2; i++ {
+		resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), request)
+		s.NoError(err)
+		s.Equal([]byte{1, 2, 3}, resp.GetResponse().GetQueryResult())
+	}
+}
+
 func (s *engineSuite) TestQueryWorkflow_DecisionTaskDispatch_Timeout() {
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: "TestQueryWorkflow_DecisionTaskDispatch_Timeout",
@@ -2536,6 +2580,118 @@ func (s *engineSuite) TestRespondActivityTaskCompletedUpdateExecutionFailed() {
 
 func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskCompleted() {
 
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID:      we.WorkflowID,
+		RunID:           we.RunID,
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	activityStartedEvent := test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+	activityCompletedEvent := test.AddActivityTaskCompletedEvent(msBuilder, activityScheduledEvent.ID, activityStartedEvent.ID,
+		activityResult, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{activityScheduledEvent, activityStartedEvent, activityCompletedEvent},
+	}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedDuplicate() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	activityStartedEvent := test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+	activityCompletedEvent := test.AddActivityTaskCompletedEvent(msBuilder, activityScheduledEvent.ID, activityStartedEvent.ID,
+		activityResult, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{activityScheduledEvent, activityStartedEvent, activityCompletedEvent},
+	}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedDuplicate_ScanBounded() {
+
 	we := types.WorkflowExecution{
 		WorkflowID: "wId",
 		RunID:      constants.TestRunID,
@@ -2571,9 +2727,16 @@ func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskCompleted() {
 	test.AddDecisionTaskScheduledEvent(msBuilder)
 
 	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// a long history after the activity, only the events right after it are scanned
+	ms.ExecutionInfo.NextEventID = 100000
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MaxEventID == activityScheduledEvent.ID+duplicateActivityCompletionMaxScanEvents+1
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{activityScheduledEvent},
+	}, nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
 		DomainUUID: constants.TestDomainID,
@@ -2583,7 +2746,6 @@ func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskCompleted() {
 			Identity:  identity,
 		},
 	})
-	s.NotNil(err)
 	s.IsType(&types.EntityNotExistsError{}, err)
 }
 
@@ -3576,6 +3738,75 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
 	s.False(executionBuilder.HasPendingDecision())
 }
 
+func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_Coalesced() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	coalescingInterval := s.mockHistoryEngine.config.ActivityHeartbeatCoalescingInterval
+	defer func() { s.mockHistoryEngine.config.ActivityHeartbeatCoalescingInterval = coalescingInterval }()
+	s.mockHistoryEngine.config.ActivityHeartbeatCoalescingInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 60)
+	test.AddActivityTaskStartedEvent(msBuilder, activityScheduledEvent.ID, identity)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// No UpdateWorkflowExecution call is expected, the heartbeat is buffered by the workflow context.
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	details := []byte("details")
+
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), &types.HistoryRecordActivityTaskHeartbeatRequest{
+		DomainUUID: constants.TestDomainID,
+		HeartbeatRequest: &types.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+			Details:   details,
+		},
+	})
+	s.Nil(err)
+
+	wfContext, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(constants.TestDomainID, we)
+	s.NoError(err)
+	defer release(nil)
+	ai, ok := wfContext.GetWorkflowExecution().GetActivityInfo(5)
+	s.True(ok)
+	lastHeartBeatUpdatedTime := ai.LastHeartBeatUpdatedTime
+	s.Empty(ai.Details)
+
+	// the next update of the workflow applies the buffered heartbeat
+	s.True(wfContext.ApplyBufferedActivityHeartbeats())
+	s.Equal(details, ai.Details)
+	s.True(ai.LastHeartBeatUpdatedTime.After(lastHeartBeatUpdatedTime))
+	s.False(wfContext.ApplyBufferedActivityHeartbeats())
+}
+
 func (s *engineSuite) TestRecordActivityTaskHeartBeatByIDSuccess() {
 
 	we := types.WorkflowExecution{
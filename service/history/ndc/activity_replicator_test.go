@@ -97,6 +97,7 @@ func (s *activityReplicatorSuite) SetupTest() {
 	)
 
 	s.mockDomainCache = s.mockShard.Resource.DomainCache
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(constants.TestDomainName, nil).AnyTimes()
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
 	s.logger = s.mockShard.GetLogger()
 
@@ -168,7 +169,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_WorkflowClosed() {
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -221,7 +222,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_IncomingScheduleIDLarger_Inco
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -276,7 +277,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_IncomingScheduleIDLarger_Inco
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -337,7 +338,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_IncomingVers
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -415,7 +416,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_DifferentVersionHistories_Inc
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -504,7 +505,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_IncomingSche
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -589,7 +590,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_SameSchedule
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -661,7 +662,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_LocalVersion
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -734,7 +735,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityCompleted() {
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -790,7 +791,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_LocalActivity
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().AnyTimes()
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -853,7 +854,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_Update_SameVe
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().Times(1)
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -928,7 +929,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_Update_SameVe
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().Times(1)
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -1003,7 +1004,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_Update_Larger
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	context.EXPECT().Clear().Times(1)
 	_, err := s.executionCache.PutIfNotExist(key, context)
@@ -1078,7 +1079,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning() {
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	_, err := s.executionCache.PutIfNotExist(key, context)
 	s.NoError(err)
@@ -1165,7 +1166,7 @@ func (s *activityReplicatorSuite) TestSyncActivity_ActivityRunning_ZombieWorkflo
 	key := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
 	context := execution.NewMockContext(s.controller)
 	context.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(s.mockMutableState, nil).Times(1)
-	context.EXPECT().Lock(gomock.Any()).Return(nil)
+	context.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
 	context.EXPECT().Unlock().Times(1)
 	_, err := s.executionCache.PutIfNotExist(key, context)
 	s.NoError(err)
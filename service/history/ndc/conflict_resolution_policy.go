@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ndc
+
+import (
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// ConflictResolutionPolicyHighestVersion selects the branch with the highest last write version
+	ConflictResolutionPolicyHighestVersion = "highest-version"
+	// ConflictResolutionPolicyLongestBranch selects the branch with the most events,
+	// the last write version breaks ties
+	ConflictResolutionPolicyLongestBranch = "longest-branch"
+	// ConflictResolutionPolicyPreferredCluster selects the branch last written by the preferred cluster of the domain,
+	// the last write version breaks ties
+	ConflictResolutionPolicyPreferredCluster = "preferred-cluster"
+)
+
+type (
+	// ConflictResolver selects which of two conflicting branches of a workflow becomes its current branch.
+	// All the clusters of a domain must select the same branch out of the same branches for the workflow
+	// to converge, so a domain must use the same policy in all its clusters.
+	ConflictResolver interface {
+		// Prefers returns true if the branch ending with the candidate item should replace
+		// the current branch ending with the current item, the items never have the same version
+		Prefers(candidate *persistence.VersionHistoryItem, current *persistence.VersionHistoryItem) bool
+	}
+
+	highestVersionResolver struct{}
+
+	longestBranchResolver struct{}
+
+	preferredClusterResolver struct {
+		clusterMetadata  cluster.Metadata
+		preferredCluster string
+	}
+)
+
+// NewConflictResolver creates the conflict resolver of a policy, the highest version policy
+// is used for an unknown policy and for the preferred cluster policy without a preferred cluster
+func NewConflictResolver(
+	policy string,
+	preferredCluster string,
+	clusterMetadata cluster.Metadata,
+) ConflictResolver {
+
+	switch policy {
+	case ConflictResolutionPolicyLongestBranch:
+		return &longestBranchResolver{}
+	case ConflictResolutionPolicyPreferredCluster:
+		if preferredCluster == "" {
+			return &highestVersionResolver{}
+		}
+		return &preferredClusterResolver{
+			clusterMetadata:  clusterMetadata,
+			preferredCluster: preferredCluster,
+		}
+	default:
+		return &highestVersionResolver{}
+	}
+}
+
+func (r *highestVersionResolver) Prefers(
+	candidate *persistence.VersionHistoryItem,
+	current *persistence.VersionHistoryItem,
+) bool {
+
+	return candidate.Version > current.Version
+}
+
+func (r *longestBranchResolver) Prefers(
+	candidate *persistence.VersionHistoryItem,
+	current *persistence.VersionHistoryItem,
+) bool {
+
+	if candidate.EventID != current.EventID {
+		return candidate.EventID > current.EventID
+	}
+	return candidate.Version > current.Version
+}
+
+func (r *preferredClusterResolver) Prefers(
+	candidate *persistence.VersionHistoryItem,
+	current *persistence.VersionHistoryItem,
+) bool {
+
+	candidatePreferred := r.clusterMetadata.ClusterNameForFailoverVersion(candidate.Version) == r.preferredCluster
+	currentPreferred := r.clusterMetadata.ClusterNameForFailoverVersion(current.Version) == r.preferredCluster
+	if candidatePreferred != currentPreferred {
+		return candidatePreferred
+	}
+	return candidate.Version > current.Version
+}
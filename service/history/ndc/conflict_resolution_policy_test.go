@@ -0,0 +1,65 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ndc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestConflictResolver(t *testing.T) {
+	// the test cluster metadata has a failover version increment of 10,
+	// versions ending with 0 are written by the active cluster and with 1 by the standby cluster
+	activeShort := persistence.NewVersionHistoryItem(5, 10)
+	activeLong := persistence.NewVersionHistoryItem(8, 20)
+	standbyShort := persistence.NewVersionHistoryItem(5, 11)
+	standbyLong := persistence.NewVersionHistoryItem(8, 1)
+
+	tests := []struct {
+		name             string
+		policy           string
+		preferredCluster string
+		candidate        *persistence.VersionHistoryItem
+		current          *persistence.VersionHistoryItem
+		expected         bool
+	}{
+		{"highest version", ConflictResolutionPolicyHighestVersion, "", standbyShort, activeShort, true},
+		{"highest version, lower version", ConflictResolutionPolicyHighestVersion, "", standbyLong, activeShort, false},
+		{"unknown policy", "unknown", "", standbyShort, activeShort, true},
+		{"longest branch", ConflictResolutionPolicyLongestBranch, "", standbyLong, activeShort, true},
+		{"longest branch, shorter branch", ConflictResolutionPolicyLongestBranch, "", standbyShort, activeLong, false},
+		{"longest branch, same length", ConflictResolutionPolicyLongestBranch, "", standbyShort, activeShort, true},
+		{"preferred cluster", ConflictResolutionPolicyPreferredCluster, cluster.TestAlternativeClusterName, standbyLong, activeLong, true},
+		{"preferred cluster, other cluster", ConflictResolutionPolicyPreferredCluster, cluster.TestCurrentClusterName, standbyShort, activeShort, false},
+		{"preferred cluster, same cluster", ConflictResolutionPolicyPreferredCluster, cluster.TestCurrentClusterName, activeLong, activeShort, true},
+		{"preferred cluster, not set", ConflictResolutionPolicyPreferredCluster, "", standbyLong, activeLong, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := NewConflictResolver(tt.policy, tt.preferredCluster, cluster.TestActiveClusterMetadata)
+			assert.Equal(t, tt.expected, resolver.Prefers(tt.candidate, tt.current))
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -39,7 +40,7 @@ type (
 		prepareMutableState(
 			ctx ctx.Context,
 			branchIndex int,
-			incomingVersion int64,
+			incomingLastItem *persistence.VersionHistoryItem,
 		) (execution.MutableState, bool, error)
 	}
 
@@ -75,7 +76,7 @@ func newConflictResolver(
 func (r *conflictResolverImpl) prepareMutableState(
 	ctx ctx.Context,
 	branchIndex int,
-	incomingVersion int64,
+	incomingLastItem *persistence.VersionHistoryItem,
 ) (execution.MutableState, bool, error) {
 
 	versionHistories := r.mutableState.GetVersionHistories()
@@ -98,20 +99,20 @@ func (r *conflictResolverImpl) prepareMutableState(
 		return nil, false, err
 	}
 
-	// mutable state does not need rebuild
-	if incomingVersion < currentLastItem.Version {
-		return r.mutableState, false, nil
-	}
-
-	if incomingVersion == currentLastItem.Version {
+	if incomingLastItem.Version == currentLastItem.Version {
 		return nil, false, &types.BadRequestError{
 			Message: "nDCConflictResolver encounter replication task version == current branch last write version",
 		}
 	}
 
-	// task.getVersion() > currentLastItem
+	// mutable state does not need rebuild
+	if !r.getConflictResolver().Prefers(incomingLastItem, currentLastItem) {
+		return r.mutableState, false, nil
+	}
+
 	// incoming replication task, after application, will become the current branch
-	// (because higher version wins), we need to rebuild the mutable state for that
+	// (because the conflict resolution policy of the domain prefers it, by default the
+	// higher version wins), we need to rebuild the mutable state for that
 	rebuiltMutableState, err := r.rebuild(ctx, branchIndex, uuid.New())
 	if err != nil {
 		return nil, false, err
@@ -119,6 +120,17 @@ func (r *conflictResolverImpl) prepareMutableState(
 	return rebuiltMutableState, true, nil
 }
 
+func (r *conflictResolverImpl) getConflictResolver() ConflictResolver {
+
+	domainName := r.mutableState.GetDomainEntry().GetInfo().Name
+	config := r.shard.GetConfig()
+	return NewConflictResolver(
+		config.NDCConflictResolutionPolicy(domainName),
+		config.NDCConflictResolutionPreferredCluster(domainName),
+		r.shard.GetClusterMetadata(),
+	)
+}
+
 func (r *conflictResolverImpl) rebuild(
 	ctx ctx.Context,
 	branchIndex int,
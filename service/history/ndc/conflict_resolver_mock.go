@@ -31,6 +31,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	persistence "github.com/uber/cadence/common/persistence"
 	execution "github.com/uber/cadence/service/history/execution"
 )
 
@@ -58,9 +59,9 @@ func (m *MockconflictResolver) EXPECT() *MockconflictResolverMockRecorder {
 }
 
 // prepareMutableState mocks base method.
-func (m *MockconflictResolver) prepareMutableState(ctx context.Context, branchIndex int, incomingVersion int64) (execution.MutableState, bool, error) {
+func (m *MockconflictResolver) prepareMutableState(ctx context.Context, branchIndex int, incomingLastItem *persistence.VersionHistoryItem) (execution.MutableState, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "prepareMutableState", ctx, branchIndex, incomingVersion)
+	ret := m.ctrl.Call(m, "prepareMutableState", ctx, branchIndex, incomingLastItem)
 	ret0, _ := ret[0].(execution.MutableState)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -68,7 +69,7 @@ func (m *MockconflictResolver) prepareMutableState(ctx context.Context, branchIn
 }
 
 // prepareMutableState indicates an expected call of prepareMutableState.
-func (mr *MockconflictResolverMockRecorder) prepareMutableState(ctx, branchIndex, incomingVersion interface{}) *gomock.Call {
+func (mr *MockconflictResolverMockRecorder) prepareMutableState(ctx, branchIndex, incomingLastItem interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "prepareMutableState", reflect.TypeOf((*MockconflictResolver)(nil).prepareMutableState), ctx, branchIndex, incomingVersion)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "prepareMutableState", reflect.TypeOf((*MockconflictResolver)(nil).prepareMutableState), ctx, branchIndex, incomingLastItem)
 }
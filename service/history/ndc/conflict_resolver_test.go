@@ -29,7 +29,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/history/config"
@@ -89,6 +91,12 @@ func (s *conflictResolverSuite) SetupTest() {
 	s.workflowID = "some random workflow ID"
 	s.runID = uuid.New()
 
+	s.mockMutableState.EXPECT().GetDomainEntry().Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{},
+		"",
+	)).AnyTimes()
+
 	s.nDCConflictResolver = newConflictResolver(
 		s.mockShard, s.mockContext, s.mockMutableState, s.logger,
 	).(*conflictResolverImpl)
@@ -180,7 +188,37 @@ func (s *conflictResolverSuite) TestPrepareMutableState_NoRebuild() {
 	versionHistories := persistence.NewVersionHistories(versionHistory)
 	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
 
-	rebuiltMutableState, isRebuilt, err := s.nDCConflictResolver.prepareMutableState(ctx.Background(), 0, version)
+	rebuiltMutableState, isRebuilt, err := s.nDCConflictResolver.prepareMutableState(ctx.Background(), 0, versionHistoryItem)
+	s.NoError(err)
+	s.False(isRebuilt)
+	s.Equal(s.mockMutableState, rebuiltMutableState)
+}
+
+func (s *conflictResolverSuite) TestPrepareMutableState_LongestBranch_NoRebuild() {
+	s.mockShard.GetConfig().NDCConflictResolutionPolicy = dynamicconfig.GetStringPropertyFnFilteredByDomain(ConflictResolutionPolicyLongestBranch)
+	version := int64(12)
+
+	// current branch
+	versionHistory0 := persistence.NewVersionHistory(
+		[]byte("some random branch token"),
+		[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(5, version)},
+	)
+	// stale branch, longer than the current branch only with the incoming events
+	versionHistory1 := persistence.NewVersionHistory(
+		[]byte("other random branch token"),
+		[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(2, version)},
+	)
+	versionHistories := persistence.NewVersionHistories(versionHistory0)
+	_, _, err := versionHistories.AddVersionHistory(versionHistory1)
+	s.Nil(err)
+	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
+
+	// a higher version does not win over a longer branch
+	rebuiltMutableState, isRebuilt, err := s.nDCConflictResolver.prepareMutableState(
+		ctx.Background(),
+		1,
+		persistence.NewVersionHistoryItem(4, version+1),
+	)
 	s.NoError(err)
 	s.False(isRebuilt)
 	s.Equal(s.mockMutableState, rebuiltMutableState)
@@ -255,7 +293,11 @@ func (s *conflictResolverSuite) TestPrepareMutableState_Rebuild() {
 
 	s.mockContext.EXPECT().Clear().Times(1)
 	s.mockContext.EXPECT().SetHistorySize(int64(historySize)).Times(1)
-	rebuiltMutableState, isRebuilt, err := s.nDCConflictResolver.prepareMutableState(ctx, 1, incomingVersion)
+	rebuiltMutableState, isRebuilt, err := s.nDCConflictResolver.prepareMutableState(
+		ctx,
+		1,
+		persistence.NewVersionHistoryItem(lastEventID1+1, incomingVersion),
+	)
 	s.NoError(err)
 	s.NotNil(rebuiltMutableState)
 	s.True(isRebuilt)
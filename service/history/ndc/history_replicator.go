@@ -365,12 +365,12 @@ func (r *historyReplicatorImpl) applyNonStartEventsPrepareMutableState(
 	task replicationTask,
 ) (execution.MutableState, bool, error) {
 
-	incomingVersion := task.getVersion()
+	incomingLastItem := persistence.NewVersionHistoryItem(task.getLastEvent().ID, task.getVersion())
 	conflictResolver := r.newConflictResolver(context, mutableState, task.getLogger())
 	mutableState, isRebuilt, err := conflictResolver.prepareMutableState(
 		ctx,
 		branchIndex,
-		incomingVersion,
+		incomingLastItem,
 	)
 	if err != nil {
 		task.getLogger().Error(
@@ -0,0 +1,116 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/task"
+)
+
+const (
+	cachePrefetchTimeout = 10 * time.Second
+)
+
+type (
+	// cachePrefetcher warms the execution cache for a batch of loaded tasks in the background,
+	// so task executors are less likely to read mutable state while holding the workflow lock
+	cachePrefetcher struct {
+		executionCache *execution.Cache
+		enabled        dynamicconfig.BoolPropertyFn
+		logger         log.Logger
+
+		// at most one batch is prefetched at a time per queue processor
+		inProgress int32
+	}
+)
+
+func newCachePrefetcher(
+	executionCache *execution.Cache,
+	enabled dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) *cachePrefetcher {
+	return &cachePrefetcher{
+		executionCache: executionCache,
+		enabled:        enabled,
+		logger:         logger,
+	}
+}
+
+// prefetch starts loading the mutable states of the given tasks, it never blocks and skips
+// the batch if the previous one is still being prefetched
+func (p *cachePrefetcher) prefetch(
+	taskInfos []task.Info,
+) {
+	if p.executionCache == nil || p.enabled == nil || !p.enabled() || len(taskInfos) == 0 {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&p.inProgress, 0, 1) {
+		return
+	}
+
+	seen := make(map[definition.WorkflowIdentifier]struct{}, len(taskInfos))
+	var workflows []definition.WorkflowIdentifier
+	for _, taskInfo := range taskInfos {
+		key := definition.NewWorkflowIdentifier(taskInfo.GetDomainID(), taskInfo.GetWorkflowID(), taskInfo.GetRunID())
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		workflows = append(workflows, key)
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&p.inProgress, 0)
+
+		ctx, cancel := context.WithTimeout(context.Background(), cachePrefetchTimeout)
+		defer cancel()
+
+		for _, workflow := range workflows {
+			if err := p.executionCache.PrefetchWorkflowExecution(
+				ctx,
+				workflow.DomainID,
+				types.WorkflowExecution{
+					WorkflowID: workflow.WorkflowID,
+					RunID:      workflow.RunID,
+				},
+			); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				p.logger.Debug("Failed to prefetch workflow execution",
+					tag.WorkflowDomainID(workflow.DomainID),
+					tag.WorkflowID(workflow.WorkflowID),
+					tag.WorkflowRunID(workflow.RunID),
+					tag.Error(err),
+				)
+			}
+		}
+	}()
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
+)
+
+func TestCachePrefetcher(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistence.ShardInfo{ShardID: 10, RangeID: 1},
+		config.NewForTest(),
+	)
+	defer mockShard.Finish(t)
+	mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(&persistence.GetWorkflowExecutionResponse{}, nil).Times(2)
+
+	domainID := uuid.New()
+	runID := uuid.New()
+	taskInfos := []task.Info{
+		&persistence.TransferTaskInfo{DomainID: domainID, WorkflowID: "wf-1", RunID: runID, TaskID: 1},
+		&persistence.TransferTaskInfo{DomainID: domainID, WorkflowID: "wf-1", RunID: runID, TaskID: 2},
+		&persistence.TransferTaskInfo{DomainID: domainID, WorkflowID: "wf-2", RunID: uuid.New(), TaskID: 3},
+	}
+
+	// disabled prefetcher does not read the DB
+	prefetcher := newCachePrefetcher(
+		execution.NewCache(mockShard),
+		dynamicconfig.GetBoolPropertyFn(false),
+		loggerimpl.NewNopLogger(),
+	)
+	prefetcher.prefetch(taskInfos)
+	require.Equal(t, int32(0), atomic.LoadInt32(&prefetcher.inProgress))
+
+	// each workflow is read once
+	prefetcher.enabled = dynamicconfig.GetBoolPropertyFn(true)
+	prefetcher.prefetch(taskInfos)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&prefetcher.inProgress) == 0
+	}, time.Second, 10*time.Millisecond)
+}
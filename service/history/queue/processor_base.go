@@ -75,6 +75,7 @@ type (
 		EnableLoadQueueStates                dynamicconfig.BoolPropertyFn
 		EnableValidator                      dynamicconfig.BoolPropertyFn
 		ValidationInterval                   dynamicconfig.DurationPropertyFn
+		EnableCachePrefetch                  dynamicconfig.BoolPropertyFn
 		// MaxPendingTaskSize is used in cross cluster queue to limit the pending task count
 		MaxPendingTaskSize dynamicconfig.IntPropertyFn
 		MetricScope        int
@@ -190,6 +191,7 @@ func (p *processorBase) updateAckLevel() (bool, task.Key, error) {
 
 	if minAckLevel == nil {
 		// note that only failover processor will meet this condition
+		p.shard.GetLoadMonitor().UpdatePendingTasks(p, 0)
 		err := p.queueShutdown()
 		if err != nil {
 			p.logger.Error("Error shutdown queue", tag.Error(err))
@@ -199,6 +201,7 @@ func (p *processorBase) updateAckLevel() (bool, task.Key, error) {
 		return true, nil, nil
 	}
 
+	p.shard.GetLoadMonitor().UpdatePendingTasks(p, totalPengingTasks)
 	if totalPengingTasks > warnPendingTasks {
 		p.logger.Warn("Too many pending tasks.")
 	}
@@ -226,7 +229,7 @@ func (p *processorBase) updateAckLevel() (bool, task.Key, error) {
 func (p *processorBase) initializeSplitPolicy(
 	lookAheadFunc lookAheadFunc,
 ) ProcessingQueueSplitPolicy {
-	if !p.options.EnableSplit() {
+	if !p.options.EnableSplit() || !p.shard.GetConfig().QueueProcessorSplitRollout(p.shard.GetShardID()) {
 		return nil
 	}
 
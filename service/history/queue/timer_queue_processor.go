@@ -64,6 +64,7 @@ type (
 		ackLevel               time.Time
 		taskAllocator          TaskAllocator
 		activeTaskExecutor     task.Executor
+		executionCache         *execution.Cache
 		activeQueueProcessor   *timerQueueProcessorBase
 		standbyQueueProcessors map[string]*timerQueueProcessorBase
 		standbyQueueTimerGates map[string]RemoteTimerGate
@@ -100,6 +101,7 @@ func NewTimerQueueProcessor(
 		taskProcessor,
 		taskAllocator,
 		activeTaskExecutor,
+		executionCache,
 		logger,
 	)
 
@@ -137,6 +139,7 @@ func NewTimerQueueProcessor(
 			taskProcessor,
 			taskAllocator,
 			standbyTaskExecutor,
+			executionCache,
 			logger,
 		)
 	}
@@ -158,6 +161,7 @@ func NewTimerQueueProcessor(
 		ackLevel:               shard.GetTimerAckLevel(),
 		taskAllocator:          taskAllocator,
 		activeTaskExecutor:     activeTaskExecutor,
+		executionCache:         executionCache,
 		activeQueueProcessor:   activeQueueProcessor,
 		standbyQueueProcessors: standbyQueueProcessors,
 		standbyQueueTimerGates: standbyQueueTimerGates,
@@ -272,6 +276,7 @@ func (t *timerQueueProcessor) FailoverDomain(
 		t.taskProcessor,
 		t.taskAllocator,
 		t.activeTaskExecutor,
+		t.executionCache,
 		t.logger,
 		minLevel,
 		maxReadLevel,
@@ -441,6 +446,7 @@ func newTimerQueueActiveProcessor(
 	taskProcessor task.Processor,
 	taskAllocator TaskAllocator,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 ) *timerQueueProcessorBase {
 	config := shard.GetConfig()
@@ -486,6 +492,7 @@ func newTimerQueueActiveProcessor(
 		queueShutdown,
 		taskFilter,
 		taskExecutor,
+		executionCache,
 		logger,
 		shard.GetMetricsClient(),
 	)
@@ -498,6 +505,7 @@ func newTimerQueueStandbyProcessor(
 	taskProcessor task.Processor,
 	taskAllocator TaskAllocator,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 ) (*timerQueueProcessorBase, RemoteTimerGate) {
 	config := shard.GetConfig()
@@ -565,6 +573,7 @@ func newTimerQueueStandbyProcessor(
 		queueShutdown,
 		taskFilter,
 		taskExecutor,
+		executionCache,
 		logger,
 		shard.GetMetricsClient(),
 	), remoteTimerGate
@@ -577,6 +586,7 @@ func newTimerQueueFailoverProcessor(
 	taskProcessor task.Processor,
 	taskAllocator TaskAllocator,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 	minLevel, maxLevel time.Time,
 	domainIDs map[string]struct{},
@@ -645,6 +655,7 @@ func newTimerQueueFailoverProcessor(
 		queueShutdown,
 		taskFilter,
 		taskExecutor,
+		executionCache,
 		logger,
 		shardContext.GetMetricsClient(),
 	)
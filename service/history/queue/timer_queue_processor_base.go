@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -65,6 +66,7 @@ type (
 		*processorBase
 
 		taskInitializer task.Initializer
+		prefetcher      *cachePrefetcher
 
 		clusterName string
 
@@ -95,6 +97,7 @@ func newTimerQueueProcessorBase(
 	queueShutdown queueShutdownFn,
 	taskFilter task.Filter,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 	metricsClient metrics.Client,
 ) *timerQueueProcessorBase {
@@ -132,6 +135,7 @@ func newTimerQueueProcessorBase(
 				shard.GetConfig().TaskCriticalRetryCount,
 			)
 		},
+		prefetcher: newCachePrefetcher(executionCache, options.EnableCachePrefetch, processorBase.logger),
 
 		clusterName: clusterName,
 
@@ -339,6 +343,14 @@ func (t *timerQueueProcessorBase) processQueueCollections(levels map[int]struct{
 			continue
 		}
 
+		prefetchTaskInfos := make([]task.Info, 0, len(timerTaskInfos))
+		for _, taskInfo := range timerTaskInfos {
+			if domainFilter.Filter(taskInfo.GetDomainID()) {
+				prefetchTaskInfos = append(prefetchTaskInfos, taskInfo)
+			}
+		}
+		t.prefetcher.prefetch(prefetchTaskInfos)
+
 		tasks := make(map[task.Key]task.Task)
 		taskChFull := false
 		for _, taskInfo := range timerTaskInfos {
@@ -648,6 +660,7 @@ func newTimerQueueProcessorOptions(
 		SplitQueueIntervalJitterCoefficient:  config.TimerProcessorSplitQueueIntervalJitterCoefficient,
 		PollBackoffInterval:                  config.QueueProcessorPollBackoffInterval,
 		PollBackoffIntervalJitterCoefficient: config.QueueProcessorPollBackoffIntervalJitterCoefficient,
+		EnableCachePrefetch:                  config.QueueProcessorEnableCachePrefetch,
 	}
 
 	if isFailover {
@@ -849,6 +849,7 @@ func (s *timerQueueProcessorBaseSuite) newTestTimerQueueProcessorBase(
 		queueShutdown,
 		nil,
 		nil,
+		nil,
 		s.logger,
 		s.metricsClient,
 	)
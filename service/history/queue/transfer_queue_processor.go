@@ -78,6 +78,7 @@ type (
 		ackLevel               int64
 		taskAllocator          TaskAllocator
 		activeTaskExecutor     task.Executor
+		executionCache         *execution.Cache
 		activeQueueProcessor   *transferQueueProcessorBase
 		standbyQueueProcessors map[string]*transferQueueProcessorBase
 	}
@@ -113,6 +114,7 @@ func NewTransferQueueProcessor(
 		taskProcessor,
 		taskAllocator,
 		activeTaskExecutor,
+		executionCache,
 		logger,
 	)
 
@@ -147,6 +149,7 @@ func NewTransferQueueProcessor(
 			taskProcessor,
 			taskAllocator,
 			standbyTaskExecutor,
+			executionCache,
 			logger,
 		)
 	}
@@ -168,6 +171,7 @@ func NewTransferQueueProcessor(
 		ackLevel:               shard.GetTransferAckLevel(),
 		taskAllocator:          taskAllocator,
 		activeTaskExecutor:     activeTaskExecutor,
+		executionCache:         executionCache,
 		activeQueueProcessor:   activeQueueProcessor,
 		standbyQueueProcessors: standbyQueueProcessors,
 	}
@@ -276,6 +280,7 @@ func (t *transferQueueProcessor) FailoverDomain(
 		t.taskProcessor,
 		t.taskAllocator,
 		t.activeTaskExecutor,
+		t.executionCache,
 		t.logger,
 		minLevel,
 		maxReadLevel,
@@ -451,6 +456,7 @@ func newTransferQueueActiveProcessor(
 	taskProcessor task.Processor,
 	taskAllocator TaskAllocator,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 ) *transferQueueProcessorBase {
 	config := shard.GetConfig()
@@ -496,6 +502,7 @@ func newTransferQueueActiveProcessor(
 		queueShutdown,
 		taskFilter,
 		taskExecutor,
+		executionCache,
 		logger,
 		shard.GetMetricsClient(),
 	)
@@ -508,6 +515,7 @@ func newTransferQueueStandbyProcessor(
 	taskProcessor task.Processor,
 	taskAllocator TaskAllocator,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 ) *transferQueueProcessorBase {
 	config := shard.GetConfig()
@@ -571,6 +579,7 @@ func newTransferQueueStandbyProcessor(
 		queueShutdown,
 		taskFilter,
 		taskExecutor,
+		executionCache,
 		logger,
 		shard.GetMetricsClient(),
 	)
@@ -582,6 +591,7 @@ func newTransferQueueFailoverProcessor(
 	taskProcessor task.Processor,
 	taskAllocator TaskAllocator,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 	minLevel, maxLevel int64,
 	domainIDs map[string]struct{},
@@ -649,6 +659,7 @@ func newTransferQueueFailoverProcessor(
 		queueShutdown,
 		taskFilter,
 		taskExecutor,
+		executionCache,
 		logger,
 		shardContext.GetMetricsClient(),
 	)
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -58,6 +59,7 @@ type (
 		*processorBase
 
 		taskInitializer task.Initializer
+		prefetcher      *cachePrefetcher
 
 		notifyCh  chan struct{}
 		processCh chan struct{}
@@ -89,6 +91,7 @@ func newTransferQueueProcessorBase(
 	queueShutdown queueShutdownFn,
 	taskFilter task.Filter,
 	taskExecutor task.Executor,
+	executionCache *execution.Cache,
 	logger log.Logger,
 	metricsClient metrics.Client,
 ) *transferQueueProcessorBase {
@@ -126,6 +129,7 @@ func newTransferQueueProcessorBase(
 				shard.GetConfig().TaskCriticalRetryCount,
 			)
 		},
+		prefetcher: newCachePrefetcher(executionCache, options.EnableCachePrefetch, processorBase.logger),
 
 		notifyCh:  make(chan struct{}, 1),
 		processCh: make(chan struct{}, 1),
@@ -411,6 +415,14 @@ func (t *transferQueueProcessorBase) processQueueCollections() {
 			continue
 		}
 
+		prefetchTaskInfos := make([]task.Info, 0, len(transferTaskInfos))
+		for _, taskInfo := range transferTaskInfos {
+			if domainFilter.Filter(taskInfo.GetDomainID()) {
+				prefetchTaskInfos = append(prefetchTaskInfos, taskInfo)
+			}
+		}
+		t.prefetcher.prefetch(prefetchTaskInfos)
+
 		tasks := make(map[task.Key]task.Task)
 		taskChFull := false
 		for _, taskInfo := range transferTaskInfos {
@@ -569,6 +581,7 @@ func newTransferQueueProcessorOptions(
 		PollBackoffIntervalJitterCoefficient: config.QueueProcessorPollBackoffIntervalJitterCoefficient,
 		EnableValidator:                      config.TransferProcessorEnableValidator,
 		ValidationInterval:                   config.TransferProcessorValidationInterval,
+		EnableCachePrefetch:                  config.QueueProcessorEnableCachePrefetch,
 	}
 
 	if isFailover {
@@ -442,6 +442,7 @@ func (s *transferQueueProcessorBaseSuite) newTestTransferQueueProcessorBase(
 		transferQueueShutdown,
 		nil,
 		nil,
+		nil,
 		s.logger,
 		s.metricsClient,
 	)
@@ -0,0 +1,313 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	// LagTrackerMaxTasks is the max number of pending replication tasks of a shard read to compute its lag,
+	// the lag of a shard with more pending tasks is computed from the oldest ones only
+	LagTrackerMaxTasks = 10000
+
+	lagTrackerPageSize            = 1000
+	lagTrackerIntervalCoefficient = 0.1
+)
+
+type (
+	// DomainLag is the replication lag of a domain to a remote cluster
+	DomainLag struct {
+		DomainID string
+		Cluster  string
+		// PendingTasks is the number of replication tasks not yet acked by the remote cluster
+		PendingTasks int64
+		// TaskIDLag is the distance between the ack level of the remote cluster and the newest pending task
+		TaskIDLag int64
+		// Lag is the age of the oldest pending task
+		Lag time.Duration
+	}
+
+	// LagAlertFunc is called when the replication lag of a domain to a remote cluster exceeds its SLO
+	LagAlertFunc func(domainName string, lag *DomainLag, slo time.Duration)
+
+	// LagTracker periodically computes the replication lag of the domains of a shard to each remote
+	// cluster, emits it as metrics and raises an alert when it exceeds the SLO of the domain
+	LagTracker interface {
+		common.Daemon
+	}
+
+	lagTrackerImpl struct {
+		shard         shard.Context
+		alertFunc     LagAlertFunc
+		logger        log.Logger
+		metricsClient metrics.Client
+		done          chan struct{}
+		status        int32
+
+		// violations holds the domains and clusters which exceeded their SLO at the last computation,
+		// so the alert is only raised when the SLO starts being violated
+		violations map[lagKey]struct{}
+	}
+
+	lagKey struct {
+		domainID string
+		cluster  string
+	}
+)
+
+var _ LagTracker = (*lagTrackerImpl)(nil)
+
+// NewLagTracker creates a replication lag tracker for a shard. If alertFunc is nil,
+// SLO violations are logged and counted by the replication_lag_slo_violations metric only.
+func NewLagTracker(
+	shard shard.Context,
+	alertFunc LagAlertFunc,
+) LagTracker {
+
+	return &lagTrackerImpl{
+		shard:         shard,
+		alertFunc:     alertFunc,
+		logger:        shard.GetLogger().WithTags(tag.ComponentReplicationLagTracker),
+		metricsClient: shard.GetMetricsClient(),
+		done:          make(chan struct{}),
+		violations:    make(map[lagKey]struct{}),
+	}
+}
+
+// Start starts the lag tracker
+func (t *lagTrackerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go t.trackLoop()
+	t.logger.Info("Replication lag tracker started.")
+}
+
+// Stop stops the lag tracker
+func (t *lagTrackerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(t.done)
+	t.logger.Info("Replication lag tracker stopped.")
+}
+
+func (t *lagTrackerImpl) trackLoop() {
+	config := t.shard.GetConfig()
+	getInterval := func() time.Duration {
+		return backoff.JitDuration(
+			config.ReplicationLagTrackerInterval(),
+			lagTrackerIntervalCoefficient,
+		)
+	}
+
+	timer := time.NewTimer(getInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if config.EnableReplicationLagTracker() {
+				t.track(context.Background())
+			}
+			timer.Reset(getInterval())
+		case <-t.done:
+			return
+		}
+	}
+}
+
+func (t *lagTrackerImpl) track(ctx context.Context) {
+	clusterMetadata := t.shard.GetClusterMetadata()
+	ackLevels := make(map[string]int64)
+	for cluster, info := range clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || cluster == clusterMetadata.GetCurrentClusterName() {
+			continue
+		}
+		ackLevels[cluster] = t.shard.GetClusterReplicationLevel(cluster)
+	}
+
+	lags, err := ComputeReplicationLag(
+		ctx,
+		t.shard.GetExecutionManager(),
+		ackLevels,
+		t.shard.GetTransferMaxReadLevel(),
+		LagTrackerMaxTasks,
+		t.shard.GetTimeSource().Now(),
+		IsDomainReplicatedFunc(t.shard.GetDomainCache()),
+	)
+	if err != nil {
+		t.logger.Warn("Failed to compute replication lag.", tag.Error(err))
+		t.metricsClient.IncCounter(metrics.ReplicationLagTrackerScope, metrics.ReplicationLagTrackerFailures)
+		return
+	}
+
+	violations := make(map[lagKey]struct{})
+	for _, lag := range lags {
+		domainName, err := t.shard.GetDomainCache().GetDomainName(lag.DomainID)
+		if err != nil {
+			continue
+		}
+		scope := t.metricsClient.Scope(
+			metrics.ReplicationLagTrackerScope,
+			metrics.DomainTag(domainName),
+			metrics.TargetClusterTag(lag.Cluster),
+			metrics.InstanceTag(strconv.Itoa(t.shard.GetShardID())),
+		)
+		scope.UpdateGauge(metrics.ReplicationDomainPendingTasks, float64(lag.PendingTasks))
+		scope.UpdateGauge(metrics.ReplicationDomainTaskIDLag, float64(lag.TaskIDLag))
+		scope.RecordTimer(metrics.ReplicationDomainLag, lag.Lag)
+
+		slo := t.shard.GetConfig().ReplicationLagSLO(domainName)
+		if slo <= 0 || lag.Lag <= slo {
+			continue
+		}
+		key := lagKey{domainID: lag.DomainID, cluster: lag.Cluster}
+		violations[key] = struct{}{}
+		if _, ok := t.violations[key]; ok {
+			continue
+		}
+		scope.IncCounter(metrics.ReplicationLagSLOViolations)
+		t.logger.Warn("Replication lag exceeds SLO.",
+			tag.WorkflowDomainName(domainName),
+			tag.ClusterName(lag.Cluster),
+			tag.ReplicationLag(lag.Lag),
+			tag.ReplicationTaskIDLag(lag.TaskIDLag),
+		)
+		if t.alertFunc != nil {
+			t.alertFunc(domainName, lag, slo)
+		}
+	}
+	t.violations = violations
+}
+
+// ComputeReplicationLag reads the replication tasks of a shard which are not yet acked by the given remote clusters,
+// keyed by cluster name with their ack level, and returns the lag of each domain replicated to each of the clusters.
+// Domains without pending tasks are not returned. At most maxTasks tasks are read, or all of them if maxTasks is not
+// positive. isReplicated tells whether the tasks of a domain are replicated to a cluster, all of them are when it is nil.
+func ComputeReplicationLag(
+	ctx context.Context,
+	executionManager persistence.ExecutionManager,
+	ackLevels map[string]int64,
+	maxReadLevel int64,
+	maxTasks int,
+	now time.Time,
+	isReplicated func(domainID string, cluster string) bool,
+) ([]*DomainLag, error) {
+
+	if len(ackLevels) == 0 {
+		return nil, nil
+	}
+	minAckLevel := int64(math.MaxInt64)
+	for _, ackLevel := range ackLevels {
+		if ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+
+	lags := make(map[lagKey]*DomainLag)
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    minAckLevel,
+		MaxReadLevel: maxReadLevel,
+		BatchSize:    lagTrackerPageSize,
+	}
+	for numTasks := 0; maxTasks <= 0 || numTasks < maxTasks; {
+		response, err := executionManager.GetReplicationTasks(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Tasks {
+			for cluster, ackLevel := range ackLevels {
+				if task.TaskID <= ackLevel || (isReplicated != nil && !isReplicated(task.DomainID, cluster)) {
+					continue
+				}
+				key := lagKey{domainID: task.DomainID, cluster: cluster}
+				lag, ok := lags[key]
+				if !ok {
+					lag = &DomainLag{
+						DomainID: task.DomainID,
+						Cluster:  cluster,
+					}
+					lags[key] = lag
+				}
+				// tasks are read in order, the first one with a creation time is the oldest
+				if lag.Lag == 0 && task.CreationTime > 0 {
+					lag.Lag = now.Sub(time.Unix(0, task.CreationTime))
+				}
+				lag.PendingTasks++
+				lag.TaskIDLag = task.TaskID - ackLevel
+			}
+		}
+		numTasks += len(response.Tasks)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	result := make([]*DomainLag, 0, len(lags))
+	for _, lag := range lags {
+		if lag.Lag < 0 {
+			lag.Lag = 0
+		}
+		result = append(result, lag)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DomainID != result[j].DomainID {
+			return result[i].DomainID < result[j].DomainID
+		}
+		return result[i].Cluster < result[j].Cluster
+	})
+	return result, nil
+}
+
+// IsDomainReplicatedFunc returns a function which tells whether the tasks of a domain
+// in the domain cache are replicated to a cluster, for ComputeReplicationLag
+func IsDomainReplicatedFunc(
+	domainCache cache.DomainCache,
+) func(domainID string, cluster string) bool {
+
+	return func(domainID string, cluster string) bool {
+		domainEntry, err := domainCache.GetDomainByID(domainID)
+		if err != nil {
+			// the domain was deleted, its tasks are dropped when they are read
+			return false
+		}
+		return !skipTask(cluster, domainEntry)
+	}
+}
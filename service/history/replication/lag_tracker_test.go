@@ -0,0 +1,215 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	lagTrackerSuite struct {
+		suite.Suite
+		*require.Assertions
+		controller *gomock.Controller
+
+		mockShard       *shard.TestContext
+		mockDomainCache *cache.MockDomainCache
+		config          *config.Config
+
+		now time.Time
+	}
+)
+
+func TestLagTrackerSuite(t *testing.T) {
+	s := new(lagTrackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *lagTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.config = config.NewForTest()
+	s.config.ReplicationLagSLO = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID:                 0,
+			RangeID:                 1,
+			ClusterReplicationLevel: map[string]int64{cluster.TestAlternativeClusterName: 10},
+		},
+		s.config,
+	)
+	s.now = time.Now()
+	s.mockShard.Resource.TimeSource = clock.NewEventTimeSource().Update(s.now)
+	s.mockDomainCache = s.mockShard.Resource.DomainCache
+
+	replicated := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "replicated", Name: "replicated-name"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+	)
+	local := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "local", Name: "local-name"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		1,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID("replicated").Return(replicated, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID("local").Return(local, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID("deleted").Return(nil, errors.New("domain not found")).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName("replicated").Return("replicated-name", nil).AnyTimes()
+}
+
+func (s *lagTrackerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *lagTrackerSuite) mockReplicationTasks(oldestTaskAge time.Duration) {
+	s.mockShard.Resource.ExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    lagTrackerPageSize,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: "replicated", TaskID: 11, CreationTime: s.now.Add(-oldestTaskAge).UnixNano()},
+			{DomainID: "local", TaskID: 12, CreationTime: s.now.UnixNano()},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.mockShard.Resource.ExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:     10,
+		MaxReadLevel:  s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:     lagTrackerPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: "deleted", TaskID: 13, CreationTime: s.now.UnixNano()},
+			{DomainID: "replicated", TaskID: 14, CreationTime: s.now.UnixNano()},
+		},
+	}, nil).Once()
+}
+
+func (s *lagTrackerSuite) TestComputeReplicationLag() {
+	s.mockReplicationTasks(time.Second)
+
+	lags, err := ComputeReplicationLag(
+		context.Background(),
+		s.mockShard.GetExecutionManager(),
+		map[string]int64{cluster.TestAlternativeClusterName: 10},
+		s.mockShard.GetTransferMaxReadLevel(),
+		LagTrackerMaxTasks,
+		s.now,
+		IsDomainReplicatedFunc(s.mockDomainCache),
+	)
+	s.NoError(err)
+	s.Equal([]*DomainLag{
+		{
+			DomainID:     "replicated",
+			Cluster:      cluster.TestAlternativeClusterName,
+			PendingTasks: 2,
+			TaskIDLag:    4,
+			Lag:          time.Second,
+		},
+	}, lags)
+}
+
+func (s *lagTrackerSuite) TestComputeReplicationLag_NoRemoteCluster() {
+	lags, err := ComputeReplicationLag(
+		context.Background(),
+		s.mockShard.GetExecutionManager(),
+		nil,
+		s.mockShard.GetTransferMaxReadLevel(),
+		LagTrackerMaxTasks,
+		s.now,
+		IsDomainReplicatedFunc(s.mockDomainCache),
+	)
+	s.NoError(err)
+	s.Empty(lags)
+}
+
+func (s *lagTrackerSuite) TestTrack_AlertOnSLOViolation() {
+	var alerts []*DomainLag
+	tracker := NewLagTracker(s.mockShard, func(domainName string, lag *DomainLag, slo time.Duration) {
+		s.Equal("replicated-name", domainName)
+		s.Equal(time.Minute, slo)
+		alerts = append(alerts, lag)
+	}).(*lagTrackerImpl)
+
+	// the alert is raised when the SLO starts being violated only
+	s.mockReplicationTasks(2 * time.Minute)
+	tracker.track(context.Background())
+	s.Len(alerts, 1)
+	s.Equal(2*time.Minute, alerts[0].Lag)
+
+	s.mockReplicationTasks(3 * time.Minute)
+	tracker.track(context.Background())
+	s.Len(alerts, 1)
+
+	s.mockReplicationTasks(time.Second)
+	tracker.track(context.Background())
+	s.Len(alerts, 1)
+
+	s.mockReplicationTasks(2 * time.Minute)
+	tracker.track(context.Background())
+	s.Len(alerts, 2)
+}
+
+func (s *lagTrackerSuite) TestTrack_Failure() {
+	s.mockShard.Resource.ExecutionMgr.On("GetReplicationTasks", mock.Anything, mock.Anything).
+		Return(nil, errors.New("some error")).Once()
+
+	tracker := NewLagTracker(s.mockShard, func(string, *DomainLag, time.Duration) {
+		s.Fail("alert should not be raised")
+	}).(*lagTrackerImpl)
+	tracker.track(context.Background())
+	s.Empty(tracker.violations)
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	// SimulatedSource is an in-memory TaskAckManager for a source cluster shard.
+	// Replication tasks added to it are served to the polling cluster in order.
+	SimulatedSource struct {
+		sync.Mutex
+		batchSize int
+		tasks     []*types.ReplicationTask
+	}
+
+	// SimulatedTransport is an in-process admin client serving replication messages
+	// from source ack managers, with controllable latency and network partitions.
+	// Calls to any other admin API go to the embedded mock client.
+	SimulatedTransport struct {
+		*admin.MockClient
+
+		sync.Mutex
+		delay       time.Duration
+		partitioned bool
+		sources     map[int32]TaskAckManager
+		ackLevels   map[int32]int64
+	}
+
+	// Simulation wires the replication task fetcher, processor and executor of a target
+	// cluster shard to source cluster shards over a SimulatedTransport, so that replication
+	// changes can be validated in tests without running multiple clusters
+	Simulation struct {
+		Transport *SimulatedTransport
+
+		fetcher   TaskFetcher
+		processor TaskProcessor
+	}
+)
+
+var _ TaskAckManager = (*SimulatedSource)(nil)
+var _ admin.Client = (*SimulatedTransport)(nil)
+
+// NewSimulatedSource creates an empty source returning at most batchSize tasks per poll
+func NewSimulatedSource(batchSize int) *SimulatedSource {
+	return &SimulatedSource{
+		batchSize: batchSize,
+	}
+}
+
+// AddTask appends a replication task to the source and returns its task ID
+func (s *SimulatedSource) AddTask(task *types.ReplicationTask) int64 {
+	s.Lock()
+	defer s.Unlock()
+
+	task.SourceTaskID = int64(len(s.tasks) + 1)
+	s.tasks = append(s.tasks, task)
+	return task.SourceTaskID
+}
+
+// GetTask returns the replication task with the given task ID
+func (s *SimulatedSource) GetTask(
+	ctx context.Context,
+	taskInfo *types.ReplicationTaskInfo,
+) (*types.ReplicationTask, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if taskInfo.TaskID < 1 || taskInfo.TaskID > int64(len(s.tasks)) {
+		return nil, &types.EntityNotExistsError{Message: "replication task not found"}
+	}
+	return s.tasks[taskInfo.TaskID-1], nil
+}
+
+// GetTasks returns the replication tasks after lastReadTaskID
+func (s *SimulatedSource) GetTasks(
+	ctx context.Context,
+	pollingCluster string,
+	lastReadTaskID int64,
+) (*types.ReplicationMessages, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if lastReadTaskID < 0 {
+		lastReadTaskID = 0
+	}
+	end := lastReadTaskID + int64(s.batchSize)
+	if end > int64(len(s.tasks)) {
+		end = int64(len(s.tasks))
+	}
+	if end <= lastReadTaskID {
+		return &types.ReplicationMessages{
+			LastRetrievedMessageID: lastReadTaskID,
+		}, nil
+	}
+	return &types.ReplicationMessages{
+		ReplicationTasks:       append([]*types.ReplicationTask(nil), s.tasks[lastReadTaskID:end]...),
+		LastRetrievedMessageID: end,
+		HasMore:                end < int64(len(s.tasks)),
+	}, nil
+}
+
+// NewSimulatedTransport creates a transport serving replication messages from the given source shards
+func NewSimulatedTransport(
+	ctrl *gomock.Controller,
+	sources map[int32]TaskAckManager,
+) *SimulatedTransport {
+	return &SimulatedTransport{
+		MockClient: admin.NewMockClient(ctrl),
+		sources:    sources,
+		ackLevels:  make(map[int32]int64),
+	}
+}
+
+// SetDelay sets the latency added to every replication poll
+func (t *SimulatedTransport) SetDelay(delay time.Duration) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.delay = delay
+}
+
+// Partition fails all replication polls until Heal is called
+func (t *SimulatedTransport) Partition() {
+	t.Lock()
+	defer t.Unlock()
+
+	t.partitioned = true
+}
+
+// Heal ends a network partition started by Partition
+func (t *SimulatedTransport) Heal() {
+	t.Lock()
+	defer t.Unlock()
+
+	t.partitioned = false
+}
+
+// GetAckLevel returns the last replication task ID of the shard acked by the polling cluster
+func (t *SimulatedTransport) GetAckLevel(shardID int32) int64 {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.ackLevels[shardID]
+}
+
+// WaitForAckLevel waits until the polling cluster acked the shard up to the given task ID
+// and returns false if that does not happen within the timeout
+func (t *SimulatedTransport) WaitForAckLevel(shardID int32, ackLevel int64, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if t.GetAckLevel(shardID) >= ackLevel {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return t.GetAckLevel(shardID) >= ackLevel
+}
+
+// GetReplicationMessages serves replication messages from the source shards
+func (t *SimulatedTransport) GetReplicationMessages(
+	ctx context.Context,
+	request *types.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationMessagesResponse, error) {
+	t.Lock()
+	delay := t.delay
+	partitioned := t.partitioned
+	t.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+	if partitioned {
+		return nil, &types.InternalServiceError{Message: "simulated network partition"}
+	}
+
+	messagesByShard := make(map[int32]*types.ReplicationMessages)
+	for _, token := range request.Tokens {
+		source, ok := t.sources[token.GetShardID()]
+		if !ok {
+			continue
+		}
+
+		t.Lock()
+		if token.GetLastProcessedMessageID() > t.ackLevels[token.GetShardID()] {
+			t.ackLevels[token.GetShardID()] = token.GetLastProcessedMessageID()
+		}
+		t.Unlock()
+
+		messages, err := source.GetTasks(ctx, request.GetClusterName(), token.GetLastRetrievedMessageID())
+		if err != nil {
+			return nil, err
+		}
+		messagesByShard[token.GetShardID()] = messages
+	}
+	return &types.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+}
+
+// NewSimulation creates a simulation replicating from the source cluster shards to the target shard.
+// Replication tasks are applied through the real task executor to the given history engine,
+// which is either a mock or an engine built on top of the target shard.
+func NewSimulation(
+	ctrl *gomock.Controller,
+	targetShard shard.Context,
+	historyEngine engine.Engine,
+	historyResender ndc.HistoryResender,
+	sourceCluster string,
+	sources map[int32]TaskAckManager,
+) *Simulation {
+	transport := NewSimulatedTransport(ctrl, sources)
+	fetcher := newReplicationTaskFetcher(
+		targetShard.GetLogger(),
+		sourceCluster,
+		targetShard.GetClusterMetadata().GetCurrentClusterName(),
+		targetShard.GetConfig(),
+		transport,
+	)
+	executor := NewTaskExecutor(
+		targetShard,
+		targetShard.GetDomainCache(),
+		historyResender,
+		historyEngine,
+		targetShard.GetMetricsClient(),
+		targetShard.GetLogger(),
+	)
+	processor := NewTaskProcessor(
+		targetShard,
+		historyEngine,
+		targetShard.GetConfig(),
+		targetShard.GetMetricsClient(),
+		fetcher,
+		executor,
+	)
+	return &Simulation{
+		Transport: transport,
+		fetcher:   fetcher,
+		processor: processor,
+	}
+}
+
+// Start starts replicating to the target shard
+func (s *Simulation) Start() {
+	s.fetcher.Start()
+	s.processor.Start()
+}
+
+// Stop stops replicating to the target shard
+func (s *Simulation) Stop() {
+	s.processor.Stop()
+	s.fetcher.Stop()
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+func TestSimulation_ReplicatesAfterPartitionHeals(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := config.NewForTest()
+	cfg.ReplicationTaskFetcherAggregationInterval = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	cfg.ReplicationTaskFetcherErrorRetryWait = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	cfg.ReplicationTaskProcessorNoTaskRetryWait = dynamicconfig.GetDurationPropertyFnFilteredByShardID(10 * time.Millisecond)
+	cfg.ReplicationTaskProcessorCleanupInterval = dynamicconfig.GetDurationPropertyFnFilteredByShardID(time.Hour)
+	cfg.ShardSyncMinInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	targetShard := shard.NewTestContext(
+		controller,
+		&persistence.ShardInfo{
+			ShardID: 0,
+			RangeID: 1,
+		},
+		cfg,
+	)
+	defer targetShard.Finish(t)
+
+	domainID := uuid.New()
+	workflowID := "6d89f939-e6a4-4c26-a0ed-626ce27bcc9c" // belong to shard 0
+	targetShard.Resource.DomainCache.EXPECT().GetDomainByID(domainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		nil,
+		nil,
+		&persistence.DomainReplicationConfig{
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		0,
+	), nil).AnyTimes()
+
+	var lock sync.Mutex
+	applied := make(map[int64]struct{})
+	mockEngine := engine.NewMockEngine(controller)
+	mockEngine.EXPECT().SyncActivity(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.SyncActivityRequest) error {
+			lock.Lock()
+			defer lock.Unlock()
+			applied[request.ScheduledID] = struct{}{}
+			return nil
+		},
+	).AnyTimes()
+
+	source := NewSimulatedSource(2)
+	var lastTaskID int64
+	for scheduledID := int64(1); scheduledID <= 5; scheduledID++ {
+		lastTaskID = source.AddTask(&types.ReplicationTask{
+			TaskType: types.ReplicationTaskTypeSyncActivity.Ptr(),
+			SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{
+				DomainID:    domainID,
+				WorkflowID:  workflowID,
+				RunID:       uuid.New(),
+				ScheduledID: scheduledID,
+			},
+		})
+	}
+
+	simulation := NewSimulation(
+		controller,
+		targetShard,
+		mockEngine,
+		ndc.NewMockHistoryResender(controller),
+		cluster.TestAlternativeClusterName,
+		map[int32]TaskAckManager{0: source},
+	)
+	simulation.Transport.Partition()
+	simulation.Transport.SetDelay(5 * time.Millisecond)
+	simulation.Start()
+	defer simulation.Stop()
+
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	require.Empty(t, applied)
+	lock.Unlock()
+	require.Equal(t, int64(0), simulation.Transport.GetAckLevel(0))
+
+	simulation.Transport.Heal()
+	require.True(t, simulation.Transport.WaitForAckLevel(0, lastTaskID, 5*time.Second))
+	lock.Lock()
+	require.Len(t, applied, 5)
+	lock.Unlock()
+}
+
+func TestSimulatedSource_GetTasks(t *testing.T) {
+	source := NewSimulatedSource(2)
+	messages, err := source.GetTasks(context.Background(), cluster.TestCurrentClusterName, -1)
+	require.NoError(t, err)
+	require.Empty(t, messages.ReplicationTasks)
+	require.Equal(t, int64(0), messages.LastRetrievedMessageID)
+
+	for i := 0; i < 3; i++ {
+		source.AddTask(&types.ReplicationTask{TaskType: types.ReplicationTaskTypeSyncActivity.Ptr()})
+	}
+	messages, err = source.GetTasks(context.Background(), cluster.TestCurrentClusterName, 0)
+	require.NoError(t, err)
+	require.Len(t, messages.ReplicationTasks, 2)
+	require.Equal(t, int64(2), messages.LastRetrievedMessageID)
+	require.True(t, messages.HasMore)
+
+	messages, err = source.GetTasks(context.Background(), cluster.TestCurrentClusterName, 2)
+	require.NoError(t, err)
+	require.Len(t, messages.ReplicationTasks, 1)
+	require.Equal(t, int64(3), messages.ReplicationTasks[0].SourceTaskID)
+	require.False(t, messages.HasMore)
+
+	task, err := source.GetTask(context.Background(), &types.ReplicationTaskInfo{TaskID: 2})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), task.SourceTaskID)
+	_, err = source.GetTask(context.Background(), &types.ReplicationTaskInfo{TaskID: 4})
+	require.Error(t, err)
+}
@@ -97,6 +97,7 @@ func (s *taskAckManagerSuite) SetupTest() {
 	)
 
 	s.mockDomainCache = s.mockShard.Resource.DomainCache
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain", nil).AnyTimes()
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
 	s.mockHistoryMgr = s.mockShard.Resource.HistoryMgr
 
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/rpc"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 )
@@ -279,7 +280,8 @@ func (f *taskFetcherImpl) getMessages(
 		tokens = append(tokens, request.token)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), fetchTaskRequestTimeout)
+	ctx := rpc.ContextWithPriorityClass(context.Background(), rpc.PriorityClassReplication)
+	ctx, cancel := context.WithTimeout(ctx, fetchTaskRequestTimeout)
 	defer cancel()
 
 	request := &types.GetReplicationMessagesRequest{
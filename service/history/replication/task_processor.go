@@ -520,7 +520,7 @@ func (p *taskProcessorImpl) generateDLQRequest(
 	case types.ReplicationTaskTypeHistoryV2:
 		taskAttributes := replicationTask.GetHistoryTaskV2Attributes()
 		eventsDataBlob := persistence.NewDataBlobFromInternal(taskAttributes.GetEvents())
-		events, err := p.historySerializer.DeserializeBatchEvents(eventsDataBlob)
+		events, err := p.historySerializer.DeserializeBatchEventsLazy(eventsDataBlob)
 		if err != nil {
 			return nil, err
 		}
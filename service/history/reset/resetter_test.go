@@ -94,6 +94,7 @@ func (s *workflowResetterSuite) SetupTest() {
 		config.NewForTest(),
 	)
 	s.mockHistoryV2Mgr = s.mockShard.Resource.HistoryMgr
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(gomock.Any()).Return(constants.TestDomainName, nil).AnyTimes()
 
 	s.workflowResetter = NewWorkflowResetter(
 		s.mockShard,
@@ -445,7 +446,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 	}, nil).Once()
 
 	resetContext := execution.NewMockContext(s.controller)
-	resetContext.EXPECT().Lock(gomock.Any()).Return(nil).Times(1)
+	resetContext.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	resetContext.EXPECT().Unlock().Times(1)
 	resetMutableState := execution.NewMockMutableState(s.controller)
 	resetContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(resetMutableState, nil).Times(1)
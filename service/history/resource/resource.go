@@ -24,24 +24,31 @@ import (
 	"sync/atomic"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/export"
 )
 
 // Resource is the interface which expose common history resources
 type Resource interface {
 	resource.Resource
 	GetEventCache() events.Cache
+	GetCacheMemoryBudget() cache.MemoryBudget
+	GetHistoryExporter() export.Exporter
 }
 
 type resourceImpl struct {
 	status int32
 
 	resource.Resource
-	eventCache events.Cache
+	eventCache        events.Cache
+	cacheMemoryBudget cache.MemoryBudget
+	historyExporter   export.Exporter
 }
 
 // Start starts all resources
@@ -56,6 +63,8 @@ func (h *resourceImpl) Start() {
 	}
 
 	h.Resource.Start()
+	h.cacheMemoryBudget.Start()
+	h.historyExporter.Start()
 	h.GetLogger().Info("history resource started", tag.LifeCycleStarted)
 }
 
@@ -70,6 +79,8 @@ func (h *resourceImpl) Stop() {
 		return
 	}
 
+	h.historyExporter.Stop()
+	h.cacheMemoryBudget.Stop()
 	h.Resource.Stop()
 	h.GetLogger().Info("history resource stopped", tag.LifeCycleStopped)
 }
@@ -79,6 +90,16 @@ func (h *resourceImpl) GetEventCache() events.Cache {
 	return h.eventCache
 }
 
+// GetCacheMemoryBudget return cache memory budget
+func (h *resourceImpl) GetCacheMemoryBudget() cache.MemoryBudget {
+	return h.cacheMemoryBudget
+}
+
+// GetHistoryExporter return history exporter
+func (h *resourceImpl) GetHistoryExporter() export.Exporter {
+	return h.historyExporter
+}
+
 // New create a new resource containing common history dependencies
 func New(
 	params *resource.Params,
@@ -111,6 +132,13 @@ func New(
 		return nil, err
 	}
 
+	cacheMemoryBudget := cache.NewMemoryBudget(
+		config.CacheMemoryBudgetFraction,
+		config.CacheMemoryBudgetCheckInterval,
+		params.Logger,
+		params.MetricsClient,
+	)
+
 	eventCache := events.NewGlobalCache(
 		config.EventsCacheGlobalInitialCount(),
 		config.EventsCacheGlobalMaxCount(),
@@ -119,11 +147,36 @@ func New(
 		params.Logger,
 		params.MetricsClient,
 		uint64(config.EventsCacheMaxSize()),
+		cacheMemoryBudget.CapacityScale,
+	)
+
+	var blobstoreClient blobstore.Client
+	if serviceResource.GetBlobstoreClient() != nil {
+		blobstoreClient = blobstore.NewRetryableClient(
+			serviceResource.GetBlobstoreClient(),
+			common.CreatePersistenceRetryPolicy(),
+		)
+	}
+	historyExporter := export.NewExporter(
+		&export.Config{
+			Mode:        config.HistoryExportMode,
+			QueueSize:   config.HistoryExportQueueSize,
+			Concurrency: config.HistoryExportConcurrency,
+		},
+		serviceResource.GetClusterMetadata().GetCurrentClusterName(),
+		blobstoreClient,
+		serviceResource.GetHistoryManager(),
+		serviceResource.GetDomainCache(),
+		serviceResource.GetTimeSource(),
+		params.MetricsClient,
+		params.Logger,
 	)
 
 	historyResource = &resourceImpl{
-		Resource:   serviceResource,
-		eventCache: eventCache,
+		Resource:          serviceResource,
+		eventCache:        eventCache,
+		cacheMemoryBudget: cacheMemoryBudget,
+		historyExporter:   historyExporter,
 	}
 	return
 }
@@ -23,16 +23,20 @@ package resource
 import (
 	"github.com/golang/mock/gomock"
 
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/export"
 )
 
 type (
 	// Test is the test implementation used for testing
 	Test struct {
 		*resource.Test
-		EventCache *events.MockCache
+		EventCache        *events.MockCache
+		CacheMemoryBudget cache.MemoryBudget
+		HistoryExporter   export.Exporter
 	}
 )
 
@@ -44,8 +48,10 @@ func NewTest(
 	serviceMetricsIndex metrics.ServiceIdx,
 ) *Test {
 	return &Test{
-		Test:       resource.NewTest(controller, serviceMetricsIndex),
-		EventCache: events.NewMockCache(controller),
+		Test:              resource.NewTest(controller, serviceMetricsIndex),
+		EventCache:        events.NewMockCache(controller),
+		CacheMemoryBudget: cache.NewNoopMemoryBudget(),
+		HistoryExporter:   export.NewNoopExporter(),
 	}
 }
 
@@ -53,3 +59,13 @@ func NewTest(
 func (s *Test) GetEventCache() events.Cache {
 	return s.EventCache
 }
+
+// GetCacheMemoryBudget for testing
+func (s *Test) GetCacheMemoryBudget() cache.MemoryBudget {
+	return s.CacheMemoryBudget
+}
+
+// GetHistoryExporter for testing
+func (s *Test) GetHistoryExporter() export.Exporter {
+	return s.HistoryExporter
+}
@@ -37,11 +37,12 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler Handler
-	stopC   chan struct{}
-	params  *commonResource.Params
-	config  *config.Config
+	status     int32
+	drainStart int64 // unix nanos when draining started, accessed atomically
+	handler    Handler
+	stopC      chan struct{}
+	params     *commonResource.Params
+	config     *config.Config
 }
 
 // NewService builds a new cadence-history service
@@ -127,11 +128,10 @@ func (s *Service) Stop() {
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
-	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
-	s.GetMembershipResolver().EvictSelf()
+	s.Drain()
 
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
-	remainingTime = common.SleepWithMinDuration(gossipPropagationDelay, remainingTime)
+	remainingTime = common.SleepWithMinDuration(common.MaxDuration(0, gossipPropagationDelay-s.drainElapsed()), remainingTime)
 
 	remainingTime = s.handler.PrepareToStop(remainingTime)
 	_ = common.SleepWithMinDuration(gracePeriod, remainingTime)
@@ -143,3 +143,34 @@ func (s *Service) Stop() {
 
 	s.GetLogger().Info("history stopped")
 }
+
+// Drain removes this host from the membership ring and stops acquiring shards,
+// shards owned by this host are still served until other hosts acquire them
+func (s *Service) Drain() {
+	if !atomic.CompareAndSwapInt64(&s.drainStart, 0, time.Now().UnixNano()) {
+		return
+	}
+
+	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
+	s.GetMembershipResolver().EvictSelf()
+	s.handler.StopAcquiringShards()
+}
+
+// DrainStatus reports the shards still owned by this host, draining is done once all shards
+// are handed off to other hosts or the shutdown drain duration has elapsed
+func (s *Service) DrainStatus() common.DrainStatus {
+	if atomic.LoadInt64(&s.drainStart) == 0 {
+		return common.DrainStatus{}
+	}
+
+	numShards := s.handler.NumShards()
+	return common.DrainStatus{
+		Draining:  true,
+		Done:      numShards == 0 || s.drainElapsed() >= s.config.ShutdownDrainDuration(),
+		Remaining: int64(numShards),
+	}
+}
+
+func (s *Service) drainElapsed() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&s.drainStart)))
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	cacheWarmUpTimeout  = time.Minute
+	cacheWarmUpPageSize = 1000
+	// cacheWarmUpMaxTasks is the max number of pending transfer tasks read to find the recently updated executions
+	cacheWarmUpMaxTasks = 10000
+)
+
+type (
+	// CacheWarmUpFunc loads the mutable state of a workflow execution into the execution cache of the shard
+	CacheWarmUpFunc func(ctx context.Context, domainID string, execution types.WorkflowExecution) error
+
+	// CacheWarmer loads the recently updated executions of a newly acquired shard into its execution cache
+	// in the background, so the first tasks of the shard do not all have to read their mutable state
+	CacheWarmer interface {
+		common.Daemon
+	}
+
+	cacheWarmerImpl struct {
+		shard         Context
+		warmUpFn      CacheWarmUpFunc
+		logger        log.Logger
+		metricsClient metrics.Client
+		status        int32
+		ctx           context.Context
+		cancel        context.CancelFunc
+	}
+)
+
+var _ CacheWarmer = (*cacheWarmerImpl)(nil)
+
+// NewCacheWarmer creates a cache warmer for a shard, warmUpFn is called for each execution to load
+func NewCacheWarmer(
+	shard Context,
+	warmUpFn CacheWarmUpFunc,
+) CacheWarmer {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &cacheWarmerImpl{
+		shard:         shard,
+		warmUpFn:      warmUpFn,
+		logger:        shard.GetLogger().WithTags(tag.ComponentShardCacheWarmer),
+		metricsClient: shard.GetMetricsClient(),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// Start starts the warm-up if it is enabled, it returns immediately
+func (w *cacheWarmerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	maxExecutions := w.shard.GetConfig().ShardCacheWarmUpMaxExecutions()
+	if maxExecutions <= 0 {
+		return
+	}
+	go w.warmUp(maxExecutions)
+}
+
+// Stop stops the warm-up if it is still in progress
+func (w *cacheWarmerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	w.cancel()
+}
+
+func (w *cacheWarmerImpl) warmUp(
+	maxExecutions int,
+) {
+	ctx, cancel := context.WithTimeout(w.ctx, cacheWarmUpTimeout)
+	defer cancel()
+
+	sw := w.metricsClient.StartTimer(metrics.ShardCacheWarmUpScope, metrics.ShardCacheWarmUpLatency)
+	defer sw.Stop()
+
+	executions, err := w.getRecentlyUpdatedExecutions(ctx, maxExecutions)
+	if err != nil {
+		w.logger.Warn("Failed to read the recently updated executions of the shard.", tag.Error(err))
+		w.metricsClient.IncCounter(metrics.ShardCacheWarmUpScope, metrics.ShardCacheWarmUpFailures)
+		return
+	}
+
+	loaded := 0
+	for _, execution := range executions {
+		if err := w.warmUpFn(ctx, execution.DomainID, types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		}); err != nil {
+			if ctx.Err() != nil {
+				// the shard was closed or the warm-up timed out
+				break
+			}
+			w.metricsClient.IncCounter(metrics.ShardCacheWarmUpScope, metrics.ShardCacheWarmUpFailures)
+			continue
+		}
+		loaded++
+	}
+	w.metricsClient.AddCounter(metrics.ShardCacheWarmUpScope, metrics.ShardCacheWarmUpExecutions, int64(loaded))
+	w.logger.Info("Shard execution cache warm-up completed.", tag.Counter(loaded))
+}
+
+// getRecentlyUpdatedExecutions returns at most maxExecutions executions of the shard with pending transfer tasks,
+// ordered from the most recently updated one, i.e. the one with the newest task.
+// At most cacheWarmUpMaxTasks tasks are read, the executions of newer tasks are not returned.
+func (w *cacheWarmerImpl) getRecentlyUpdatedExecutions(
+	ctx context.Context,
+	maxExecutions int,
+) ([]definition.WorkflowIdentifier, error) {
+
+	lastTaskIDs := make(map[definition.WorkflowIdentifier]int64)
+	request := &persistence.GetTransferTasksRequest{
+		ReadLevel:    w.shard.GetTransferAckLevel(),
+		MaxReadLevel: w.shard.GetTransferMaxReadLevel(),
+		BatchSize:    cacheWarmUpPageSize,
+	}
+	for numTasks := 0; numTasks < cacheWarmUpMaxTasks; {
+		response, err := w.shard.GetExecutionManager().GetTransferTasks(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range response.Tasks {
+			switch task.TaskType {
+			case persistence.TransferTaskTypeCloseExecution,
+				persistence.TransferTaskTypeRecordWorkflowClosed,
+				persistence.TransferTaskTypeRecordChildExecutionCompleted,
+				persistence.TransferTaskTypeApplyParentClosePolicy:
+				// the execution is closed and not likely to be updated again
+				continue
+			}
+			lastTaskIDs[definition.NewWorkflowIdentifier(task.DomainID, task.WorkflowID, task.RunID)] = task.TaskID
+		}
+		numTasks += len(response.Tasks)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	executions := make([]definition.WorkflowIdentifier, 0, len(lastTaskIDs))
+	for execution := range lastTaskIDs {
+		executions = append(executions, execution)
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		return lastTaskIDs[executions[i]] > lastTaskIDs[executions[j]]
+	})
+	if len(executions) > maxExecutions {
+		executions = executions[:maxExecutions]
+	}
+	return executions, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+type (
+	cacheWarmerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		mockShard  *TestContext
+	}
+)
+
+func TestCacheWarmerSuite(t *testing.T) {
+	s := new(cacheWarmerSuite)
+	suite.Run(t, s)
+}
+
+func (s *cacheWarmerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	config := config.NewForTest()
+	config.ShardCacheWarmUpMaxExecutions = dynamicconfig.GetIntPropertyFn(2)
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID:          0,
+			RangeID:          1,
+			TransferAckLevel: 10,
+		},
+		config,
+	)
+}
+
+func (s *cacheWarmerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *cacheWarmerSuite) mockTransferTasks() {
+	s.mockShard.Resource.ExecutionMgr.On("GetTransferTasks", mock.Anything, &persistence.GetTransferTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    cacheWarmUpPageSize,
+	}).Return(&persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{DomainID: "domain", WorkflowID: "wf1", RunID: "run1", TaskID: 11, TaskType: persistence.TransferTaskTypeDecisionTask},
+			{DomainID: "domain", WorkflowID: "wf2", RunID: "run2", TaskID: 12, TaskType: persistence.TransferTaskTypeActivityTask},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.mockShard.Resource.ExecutionMgr.On("GetTransferTasks", mock.Anything, &persistence.GetTransferTasksRequest{
+		ReadLevel:     10,
+		MaxReadLevel:  s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:     cacheWarmUpPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{DomainID: "domain", WorkflowID: "wf3", RunID: "run3", TaskID: 13, TaskType: persistence.TransferTaskTypeCloseExecution},
+			{DomainID: "domain", WorkflowID: "wf1", RunID: "run1", TaskID: 14, TaskType: persistence.TransferTaskTypeDecisionTask},
+		},
+	}, nil).Once()
+}
+
+func (s *cacheWarmerSuite) TestGetRecentlyUpdatedExecutions() {
+	s.mockTransferTasks()
+
+	warmer := NewCacheWarmer(s.mockShard, nil).(*cacheWarmerImpl)
+	executions, err := warmer.getRecentlyUpdatedExecutions(context.Background(), 2)
+	s.NoError(err)
+	s.Equal([]definition.WorkflowIdentifier{
+		definition.NewWorkflowIdentifier("domain", "wf1", "run1"),
+		definition.NewWorkflowIdentifier("domain", "wf2", "run2"),
+	}, executions)
+}
+
+func (s *cacheWarmerSuite) TestWarmUp() {
+	s.mockTransferTasks()
+
+	var loaded []types.WorkflowExecution
+	warmer := NewCacheWarmer(s.mockShard, func(
+		ctx context.Context,
+		domainID string,
+		execution types.WorkflowExecution,
+	) error {
+		s.Equal("domain", domainID)
+		loaded = append(loaded, execution)
+		if execution.WorkflowID == "wf1" {
+			return errors.New("some error")
+		}
+		return nil
+	}).(*cacheWarmerImpl)
+	warmer.warmUp(1)
+	s.Equal([]types.WorkflowExecution{{WorkflowID: "wf1", RunID: "run1"}}, loaded)
+}
+
+func (s *cacheWarmerSuite) TestWarmUp_ReadFailure() {
+	s.mockShard.Resource.ExecutionMgr.On("GetTransferTasks", mock.Anything, mock.Anything).
+		Return(nil, errors.New("some error")).Once()
+
+	warmer := NewCacheWarmer(s.mockShard, func(context.Context, string, types.WorkflowExecution) error {
+		s.Fail("no execution should be loaded")
+		return nil
+	}).(*cacheWarmerImpl)
+	warmer.warmUp(2)
+}
+
+func (s *cacheWarmerSuite) TestStart_Disabled() {
+	s.mockShard.GetConfig().ShardCacheWarmUpMaxExecutions = dynamicconfig.GetIntPropertyFn(0)
+
+	warmer := NewCacheWarmer(s.mockShard, nil)
+	// no transfer task is read when the warm-up is disabled
+	warmer.Start()
+	warmer.Stop()
+}
@@ -56,6 +56,7 @@ type (
 		GetClusterMetadata() cluster.Metadata
 		GetConfig() *config.Config
 		GetEventsCache() events.Cache
+		GetLoadMonitor() LoadMonitor
 		GetLogger() log.Logger
 		GetThrottledLogger() log.Logger
 		GetMetricsClient() metrics.Client
@@ -143,6 +144,7 @@ type (
 		rangeID          int64
 		executionManager persistence.ExecutionManager
 		eventsCache      events.Cache
+		loadMonitor      LoadMonitor
 		closeCallback    func(int, *historyShardsItem)
 		closed           int32
 		config           *config.Config
@@ -152,6 +154,9 @@ type (
 
 		sync.RWMutex
 		lastUpdated               time.Time
+		shardInfoDirty            bool        // true if a shard info update was coalesced and is not persisted yet
+		shardInfoFlushTimer       *time.Timer // persists coalesced shard info updates
+		shardInfoFlushAttempts    int         // consecutive failed attempts to persist coalesced shard info updates
 		shardInfo                 *persistence.ShardInfo
 		transferSequenceNumber    int64
 		maxTransferSequenceNumber int64
@@ -188,6 +193,9 @@ const (
 	logWarnTimerLevelDiff       = time.Duration(30 * time.Minute)
 	historySizeLogThreshold     = 10 * 1024 * 1024
 	minContextTimeout           = 1 * time.Second
+
+	shardInfoFlushRetryInitialInterval = time.Second
+	shardInfoFlushRetryMaxInterval     = time.Minute
 )
 
 func (s *contextImpl) GetShardID() int {
@@ -1031,6 +1039,10 @@ func (s *contextImpl) GetEventsCache() events.Cache {
 	return s.eventsCache
 }
 
+func (s *contextImpl) GetLoadMonitor() LoadMonitor {
+	return s.loadMonitor
+}
+
 func (s *contextImpl) GetLogger() log.Logger {
 	return s.logger
 }
@@ -1059,6 +1071,12 @@ func (s *contextImpl) closeShard() {
 	// fails any writes that may start after this point.
 	s.shardInfo.RangeID = -1
 	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
+
+	// the shard lock is held by all callers, coalesced updates can no longer be persisted
+	if s.shardInfoFlushTimer != nil {
+		s.shardInfoFlushTimer.Stop()
+		s.shardInfoFlushTimer = nil
+	}
 }
 
 func (s *contextImpl) generateTransferTaskIDLocked() (int64, error) {
@@ -1169,6 +1187,7 @@ func (s *contextImpl) persistShardInfoLocked(
 	var err error
 	now := clock.NewRealTimeSource().Now()
 	if !isForced && s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
+		s.coalesceShardInfoUpdateLocked(now)
 		return nil
 	}
 	updatedShardInfo := s.shardInfo.Copy()
@@ -1190,11 +1209,57 @@ func (s *contextImpl) persistShardInfoLocked(
 		}
 	} else {
 		s.lastUpdated = now
+		s.shardInfoDirty = false
+		s.shardInfoFlushAttempts = 0
+		if s.shardInfoFlushTimer != nil {
+			s.shardInfoFlushTimer.Stop()
+			s.shardInfoFlushTimer = nil
+		}
 	}
 
 	return err
 }
 
+// coalesceShardInfoUpdateLocked defers a shard info update so that it is persisted together with the
+// following updates in a single conditional write, once ShardUpdateMinInterval has passed since the last write,
+// or earlier if the update would otherwise stay unpersisted for longer than ShardUpdateMaxStaleness
+func (s *contextImpl) coalesceShardInfoUpdateLocked(now time.Time) {
+	s.GetMetricsClient().IncCounter(metrics.ShardInfoScope, metrics.ShardInfoUpdateCoalescedCounter)
+	s.shardInfoDirty = true
+	if s.shardInfoFlushTimer != nil {
+		return
+	}
+
+	flushTime := s.lastUpdated.Add(s.config.ShardUpdateMinInterval())
+	if maxStaleness := s.config.ShardUpdateMaxStaleness(); maxStaleness > 0 && now.Add(maxStaleness).Before(flushTime) {
+		flushTime = now.Add(maxStaleness)
+	}
+	s.shardInfoFlushTimer = time.AfterFunc(flushTime.Sub(now), s.flushShardInfo)
+}
+
+func (s *contextImpl) flushShardInfo() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfoFlushTimer = nil
+	if !s.shardInfoDirty || s.isClosed() {
+		return
+	}
+	if err := s.forceUpdateShardInfoLocked(); err != nil {
+		s.logger.Warn("Failed to persist coalesced shard info update.", tag.Error(err))
+		if s.isClosed() {
+			return
+		}
+		// retry with backoff, the update would otherwise stay unpersisted until the next shard info update
+		retryPolicy := backoff.NewExponentialRetryPolicy(shardInfoFlushRetryInitialInterval)
+		retryPolicy.SetMaximumInterval(shardInfoFlushRetryMaxInterval)
+		retryPolicy.SetExpirationInterval(backoff.NoInterval)
+		delay := retryPolicy.ComputeNextDelay(0, s.shardInfoFlushAttempts)
+		s.shardInfoFlushAttempts++
+		s.shardInfoFlushTimer = time.AfterFunc(delay, s.flushShardInfo)
+	}
+}
+
 func (s *contextImpl) emitShardInfoMetricsLogsLocked() {
 	currentCluster := s.GetClusterMetadata().GetCurrentClusterName()
 	clusterInfo := s.GetClusterMetadata().GetAllClusterInfo()
@@ -1653,6 +1718,15 @@ func acquireShard(
 		previousShardOwnerWasDifferent: ownershipChanged,
 	}
 
+	// conditional writes are issued while holding the shard lock, so the rangeID can be read without it
+	context.executionManager = persistence.NewWorkflowExecutionPersistenceFencingAuditClient(
+		executionMgr,
+		context.config.EnableShardFencingAudit,
+		context.getRangeID,
+		context.Resource.GetMetricsClient(),
+		context.logger,
+	)
+
 	// TODO remove once migrated to global event cache
 	context.eventsCache = events.NewCache(
 		context.shardID,
@@ -1662,6 +1736,8 @@ func acquireShard(
 		context.Resource.GetMetricsClient(),
 	)
 
+	context.loadMonitor = NewLoadMonitor(context.shardID, context.config, context.GetTimeSource())
+
 	context.logger.Debug(fmt.Sprintf("Global event cache mode: %v", context.config.EventsCacheGlobalEnable()))
 
 	err1 := context.renewRangeLocked(true)
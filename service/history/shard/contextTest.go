@@ -83,6 +83,7 @@ func NewTestContext(
 		remoteClusterCurrentTime:  make(map[string]time.Time),
 		eventsCache:               eventsCache,
 	}
+	shard.loadMonitor = NewLoadMonitor(shard.shardID, config, resource.GetTimeSource())
 	return &TestContext{
 		contextImpl:     shard,
 		Resource:        resource,
@@ -37,6 +37,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
@@ -151,6 +152,92 @@ func (s *contextTestSuite) TestRenewRangeLockedRetriesExceeded() {
 	s.Error(err)
 }
 
+func (s *contextTestSuite) TestUpdateShardInfoCoalesced() {
+	s.context.config.ShardUpdateMinInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.context.config.ShardUpdateMaxStaleness = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	s.context.lastUpdated = time.Now()
+
+	persisted := make(chan *persistence.UpdateShardRequest, 1)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+		persisted <- args.Get(1).(*persistence.UpdateShardRequest)
+	})
+
+	s.NoError(s.context.UpdateTransferAckLevel(10))
+	s.NoError(s.context.UpdateTimerAckLevel(time.Unix(0, 20)))
+	s.NoError(s.context.UpdateTransferAckLevel(30))
+
+	select {
+	case request := <-persisted:
+		s.Equal(int64(30), request.ShardInfo.TransferAckLevel)
+		s.Equal(time.Unix(0, 20), request.ShardInfo.TimerAckLevel)
+	case <-time.After(time.Second):
+		s.Fail("coalesced shard info update is not persisted")
+	}
+
+	s.context.RLock()
+	defer s.context.RUnlock()
+	s.False(s.context.shardInfoDirty)
+}
+
+func (s *contextTestSuite) TestUpdateShardInfoForcedCancelsCoalescedUpdate() {
+	s.context.config.ShardUpdateMinInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.context.lastUpdated = time.Now()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Once().Return(nil)
+
+	s.NoError(s.context.UpdateTransferAckLevel(10))
+	s.context.Lock()
+	s.True(s.context.shardInfoDirty)
+	s.NotNil(s.context.shardInfoFlushTimer)
+	s.NoError(s.context.forceUpdateShardInfoLocked())
+	s.False(s.context.shardInfoDirty)
+	s.Nil(s.context.shardInfoFlushTimer)
+	s.context.Unlock()
+}
+
+func (s *contextTestSuite) TestUpdateShardInfoCoalescedRetried() {
+	s.context.config.ShardUpdateMinInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.context.config.ShardUpdateMaxStaleness = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	s.context.lastUpdated = time.Now()
+
+	persisted := make(chan *persistence.UpdateShardRequest, 1)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Once().Return(errors.New("some error"))
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+		persisted <- args.Get(1).(*persistence.UpdateShardRequest)
+	})
+
+	s.NoError(s.context.UpdateTransferAckLevel(10))
+
+	// the failed flush is retried after the backoff instead of waiting for the next update
+	select {
+	case request := <-persisted:
+		s.Equal(int64(10), request.ShardInfo.TransferAckLevel)
+	case <-time.After(2 * shardInfoFlushRetryInitialInterval):
+		s.Fail("coalesced shard info update is not retried")
+	}
+
+	s.context.RLock()
+	defer s.context.RUnlock()
+	s.False(s.context.shardInfoDirty)
+	s.Zero(s.context.shardInfoFlushAttempts)
+}
+
+func (s *contextTestSuite) TestCloseShardStopsCoalescedUpdate() {
+	s.context.config.ShardUpdateMinInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.context.lastUpdated = time.Now()
+	closed := make(chan int, 1)
+	s.context.closeCallback = func(shardID int, _ *historyShardsItem) {
+		closed <- shardID
+	}
+
+	s.NoError(s.context.UpdateTransferAckLevel(10))
+	s.context.Lock()
+	s.NotNil(s.context.shardInfoFlushTimer)
+	s.context.closeShard()
+	s.Nil(s.context.shardInfoFlushTimer)
+	s.context.Unlock()
+	s.Equal(s.context.shardID, <-closed)
+}
+
 func (s *contextTestSuite) TestReplicateFailoverMarkersSuccess() {
 	s.mockResource.ExecutionMgr.On("CreateFailoverMarkerTasks", mock.Anything, mock.Anything).Once().Return(nil)
 
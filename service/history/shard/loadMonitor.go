@@ -0,0 +1,176 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+type (
+	// LoadMonitor tracks the task backlog and workflow lock contention of a shard
+	// and decides whether the shard should shed new work
+	LoadMonitor interface {
+		// UpdatePendingTasks reports the number of pending tasks of a queue processor,
+		// a count of 0 removes the processor
+		UpdatePendingTasks(source interface{}, count int)
+		// RecordLockWait reports the time spent waiting for a workflow lock
+		RecordLockWait(wait time.Duration)
+		// CheckBusy returns a ServiceBusyError with a retry-after hint when the shard is overloaded
+		CheckBusy() error
+	}
+
+	loadMonitorImpl struct {
+		shardID    int
+		config     *config.Config
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		pendingTasks map[interface{}]int
+		// lock waits are averaged over the current and the previous window,
+		// so contention stops affecting the shard shortly after it goes away
+		windowStart   time.Time
+		curWaitSum    time.Duration
+		curWaitCount  int64
+		prevWaitSum   time.Duration
+		prevWaitCount int64
+	}
+)
+
+const (
+	lockWaitWindow = 10 * time.Second
+	// the retry-after hint grows with the overload ratio up to this factor of the base value
+	maxRetryAfterScale = 10
+)
+
+var _ LoadMonitor = (*loadMonitorImpl)(nil)
+
+// NewLoadMonitor creates a new LoadMonitor for the given shard
+func NewLoadMonitor(
+	shardID int,
+	config *config.Config,
+	timeSource clock.TimeSource,
+) LoadMonitor {
+	return &loadMonitorImpl{
+		shardID:      shardID,
+		config:       config,
+		timeSource:   timeSource,
+		pendingTasks: make(map[interface{}]int),
+		windowStart:  timeSource.Now(),
+	}
+}
+
+func (m *loadMonitorImpl) UpdatePendingTasks(
+	source interface{},
+	count int,
+) {
+	m.Lock()
+	defer m.Unlock()
+
+	if count <= 0 {
+		delete(m.pendingTasks, source)
+		return
+	}
+	m.pendingTasks[source] = count
+}
+
+func (m *loadMonitorImpl) RecordLockWait(
+	wait time.Duration,
+) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.rotateWindowLocked()
+	m.curWaitSum += wait
+	m.curWaitCount++
+}
+
+func (m *loadMonitorImpl) CheckBusy() error {
+	ratio := float64(0)
+	reason := ""
+
+	if threshold := m.config.BusyPendingTaskThreshold(); threshold > 0 {
+		if pending := m.getPendingTasks(); pending > threshold {
+			ratio = float64(pending) / float64(threshold)
+			reason = "task backlog"
+		}
+	}
+	if threshold := m.config.BusyLockWaitThreshold(); threshold > 0 {
+		if wait := m.getAverageLockWait(); wait > threshold {
+			if r := float64(wait) / float64(threshold); r > ratio {
+				ratio = r
+				reason = "workflow lock contention"
+			}
+		}
+	}
+
+	if ratio == 0 {
+		return nil
+	}
+	return &types.ServiceBusyError{
+		Message:    fmt.Sprintf("Shard %v is overloaded by %v.", m.shardID, reason),
+		RetryAfter: time.Duration(float64(m.config.BusyRetryAfter()) * math.Min(ratio, maxRetryAfterScale)),
+	}
+}
+
+func (m *loadMonitorImpl) getPendingTasks() int {
+	m.Lock()
+	defer m.Unlock()
+
+	total := 0
+	for _, count := range m.pendingTasks {
+		total += count
+	}
+	return total
+}
+
+func (m *loadMonitorImpl) getAverageLockWait() time.Duration {
+	m.Lock()
+	defer m.Unlock()
+
+	m.rotateWindowLocked()
+	count := m.curWaitCount + m.prevWaitCount
+	if count == 0 {
+		return 0
+	}
+	return (m.curWaitSum + m.prevWaitSum) / time.Duration(count)
+}
+
+func (m *loadMonitorImpl) rotateWindowLocked() {
+	elapsed := m.timeSource.Now().Sub(m.windowStart)
+	if elapsed < lockWaitWindow {
+		return
+	}
+
+	if elapsed < 2*lockWaitWindow {
+		m.prevWaitSum, m.prevWaitCount = m.curWaitSum, m.curWaitCount
+	} else {
+		m.prevWaitSum, m.prevWaitCount = 0, 0
+	}
+	m.curWaitSum, m.curWaitCount = 0, 0
+	m.windowStart = m.timeSource.Now()
+}
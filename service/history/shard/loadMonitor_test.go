@@ -0,0 +1,99 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+func newLoadMonitorTestConfig(
+	pendingTaskThreshold int,
+	lockWaitThreshold time.Duration,
+) *config.Config {
+	return &config.Config{
+		BusyPendingTaskThreshold: dynamicconfig.GetIntPropertyFn(pendingTaskThreshold),
+		BusyLockWaitThreshold:    dynamicconfig.GetDurationPropertyFn(lockWaitThreshold),
+		BusyRetryAfter:           dynamicconfig.GetDurationPropertyFn(time.Second),
+	}
+}
+
+func TestLoadMonitor_Disabled(t *testing.T) {
+	m := NewLoadMonitor(1, newLoadMonitorTestConfig(0, 0), clock.NewEventTimeSource())
+
+	m.UpdatePendingTasks("transfer", 1000000)
+	m.RecordLockWait(time.Hour)
+	assert.NoError(t, m.CheckBusy())
+}
+
+func TestLoadMonitor_PendingTasks(t *testing.T) {
+	m := NewLoadMonitor(1, newLoadMonitorTestConfig(100, 0), clock.NewEventTimeSource())
+
+	m.UpdatePendingTasks("transfer", 60)
+	m.UpdatePendingTasks("timer", 40)
+	assert.NoError(t, m.CheckBusy())
+
+	m.UpdatePendingTasks("timer", 90)
+	err := m.CheckBusy()
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	assert.Equal(t, 1500*time.Millisecond, err.(*types.ServiceBusyError).RetryAfter)
+
+	// the hint is capped
+	m.UpdatePendingTasks("timer", 100000)
+	err = m.CheckBusy()
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	assert.Equal(t, maxRetryAfterScale*time.Second, err.(*types.ServiceBusyError).RetryAfter)
+
+	// a count of 0 removes the source
+	m.UpdatePendingTasks("timer", 0)
+	assert.NoError(t, m.CheckBusy())
+}
+
+func TestLoadMonitor_LockWait(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Unix(0, 0))
+	m := NewLoadMonitor(1, newLoadMonitorTestConfig(0, 100*time.Millisecond), timeSource)
+
+	m.RecordLockWait(50 * time.Millisecond)
+	assert.NoError(t, m.CheckBusy())
+
+	m.RecordLockWait(350 * time.Millisecond)
+	err := m.CheckBusy()
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	assert.Equal(t, 2*time.Second, err.(*types.ServiceBusyError).RetryAfter)
+
+	// waits from the previous window still count
+	timeSource.Update(time.Unix(0, 0).Add(lockWaitWindow))
+	m.RecordLockWait(0)
+	assert.Error(t, m.CheckBusy())
+
+	// contention stops affecting the shard once it has gone away for a full window
+	timeSource.Update(time.Unix(0, 0).Add(3 * lockWaitWindow))
+	assert.NoError(t, m.CheckBusy())
+}
@@ -22,6 +22,7 @@ package task
 
 import (
 	"sync"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
@@ -115,7 +116,7 @@ func (a *priorityAssignerImpl) Assign(
 	// for case 2 and 3 the task will be a no-op in most cases, also give it a high priority so that
 	// it can be quickly verified/acked and won't prevent the ack level in the processor from advancing
 	// (especially for active processor)
-	if !a.rateLimiters.For(domainName).Allow() {
+	if !a.rateLimiters.For(domainName).Allow() && !a.isOverdueTimerTask(queueTask) {
 		queueTask.SetPriority(defaultTaskPriority)
 		taggedScope := a.scope.Tagged(metrics.DomainTag(domainName))
 		switch queueType {
@@ -133,6 +134,19 @@ func (a *priorityAssignerImpl) Assign(
 	return nil
 }
 
+// isOverdueTimerTask returns true if an active timer task fires late by more than the overdue threshold,
+// those tasks keep the high priority when the domain is throttled, so that a backlog of newly visible
+// tasks doesn't push short timers arbitrarily late
+func (a *priorityAssignerImpl) isOverdueTimerTask(
+	queueTask Task,
+) bool {
+	threshold := a.config.TimerProcessorOverdueTaskThreshold()
+	if queueTask.GetQueueType() != QueueTypeActiveTimer || threshold <= 0 {
+		return false
+	}
+	return time.Since(queueTask.GetVisibilityTimestamp()) > threshold
+}
+
 // getDomainInfo returns three pieces of information:
 //  1. domain name
 //  2. if domain is active
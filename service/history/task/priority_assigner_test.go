@@ -23,6 +23,7 @@ package task
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -231,6 +232,7 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 		mockTask := NewMockTask(s.controller)
 		mockTask.EXPECT().GetQueueType().Return(QueueTypeActiveTimer).AnyTimes()
 		mockTask.EXPECT().GetDomainID().Return(constants.TestDomainID).Times(1)
+		mockTask.EXPECT().GetVisibilityTimestamp().Return(time.Now()).AnyTimes()
 		mockTask.EXPECT().Priority().Return(common.NoPriority).Times(1)
 		if i < s.testTaskProcessRPS {
 			mockTask.EXPECT().SetPriority(common.GetTaskPriority(common.HighPriorityClass, common.DefaultPrioritySubclass)).Times(1)
@@ -243,6 +245,23 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 	}
 }
 
+func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask_OverdueTimer() {
+	s.mockDomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, nil).AnyTimes()
+
+	overdueTime := time.Now().Add(-2 * s.config.TimerProcessorOverdueTaskThreshold())
+	for i := 0; i != s.testTaskProcessRPS*2; i++ {
+		mockTask := NewMockTask(s.controller)
+		mockTask.EXPECT().GetQueueType().Return(QueueTypeActiveTimer).AnyTimes()
+		mockTask.EXPECT().GetDomainID().Return(constants.TestDomainID).Times(1)
+		mockTask.EXPECT().GetVisibilityTimestamp().Return(overdueTime).AnyTimes()
+		mockTask.EXPECT().Priority().Return(common.NoPriority).Times(1)
+		mockTask.EXPECT().SetPriority(common.GetTaskPriority(common.HighPriorityClass, common.DefaultPrioritySubclass)).Times(1)
+
+		err := s.priorityAssigner.Assign(mockTask)
+		s.NoError(err)
+	}
+}
+
 func (s *taskPriorityAssignerSuite) TestAssign_AlreadyAssigned() {
 	priority := 5
 
@@ -291,6 +291,12 @@ func (t *taskImpl) HandleErr(
 		return err
 	}
 
+	// this is a transient error, the task is retried once below the configured rate
+	if err == errActivityDispatchLimitExceeded || err == errChildWorkflowStartLimitExceeded {
+		t.scope.IncCounter(metrics.TaskLimitExceededCounterPerDomain)
+		return err
+	}
+
 	// this is a transient error
 	if isRedispatchErr(err) {
 		t.scope.IncCounter(metrics.TaskStandbyRetryCounterPerDomain)
@@ -355,7 +361,7 @@ func (t *taskImpl) HandleErr(
 func (t *taskImpl) RetryErr(
 	err error,
 ) bool {
-	if err == errWorkflowBusy || isRedispatchErr(err) || err == ErrTaskPendingActive || common.IsContextTimeoutError(err) {
+	if err == errWorkflowBusy || err == errActivityDispatchLimitExceeded || err == errChildWorkflowStartLimitExceeded || isRedispatchErr(err) || err == ErrTaskPendingActive || common.IsContextTimeoutError(err) {
 		return false
 	}
 
@@ -136,10 +136,12 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 		return nil
 	}
 
+	// heartbeats buffered by the context are applied first, so they count for the heartbeat timeout
+	// and the details of a timed out activity are the latest ones
+	updateMutableState := wfContext.ApplyBufferedActivityHeartbeats()
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
 	resurrectionCheckMinDelay := t.config.ResurrectionCheckMinDelay(mutableState.GetDomainEntry().GetInfo().Name)
-	updateMutableState := false
 
 	// initialized when a timer with delay >= resurrectionCheckMinDelay
 	// is encountered, so that we don't need to scan history multiple times
@@ -148,6 +150,9 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	scanWorkflowCtx, cancel := context.WithTimeout(context.Background(), scanWorkflowTimeout)
 	defer cancel()
 
+	// skew between expiry and firing of each timer, recorded once the fired events are persisted
+	var firedTimerSkews []time.Duration
+
 Loop:
 	for _, timerSequenceID := range timerSequence.LoadAndSortUserTimers() {
 		timerInfo, ok := mutableState.GetUserTimerInfoByEventID(timerSequenceID.EventID)
@@ -202,6 +207,7 @@ Loop:
 		if _, err := mutableState.AddTimerFiredEvent(timerInfo.TimerID); err != nil {
 			return err
 		}
+		firedTimerSkews = append(firedTimerSkews, delay)
 		updateMutableState = true
 	}
 
@@ -209,7 +215,16 @@ Loop:
 		return nil
 	}
 
-	return t.updateWorkflowExecution(ctx, wfContext, mutableState, updateMutableState)
+	if err := t.updateWorkflowExecution(ctx, wfContext, mutableState, updateMutableState); err != nil {
+		return err
+	}
+
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	scope := t.metricsClient.Scope(metrics.TimerQueueProcessorScope, metrics.DomainTag(domainName))
+	for _, skew := range firedTimerSkews {
+		scope.RecordTimer(metrics.UserTimerFireSkew, skew)
+	}
+	return nil
 }
 
 func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
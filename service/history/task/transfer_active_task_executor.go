@@ -25,6 +25,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -34,15 +35,19 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/cloudevents"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/webhook"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 )
@@ -62,6 +67,14 @@ var (
 	errUnknownTransferTask   = errors.New("unknown transfer task")
 	errWorkflowBusy          = errors.New("unable to get workflow execution lock within specified timeout")
 	errTargetDomainNotActive = errors.New("target domain not active")
+
+	errActivityDispatchLimitExceeded   = errors.New("activity dispatch rate limit exceeded for domain")
+	errChildWorkflowStartLimitExceeded = errors.New("child workflow start rate limit exceeded for parent workflow")
+)
+
+const (
+	childWorkflowStartRateLimitersTTL      = time.Hour
+	childWorkflowStartRateLimitersMaxCount = 10000
 )
 
 type (
@@ -71,6 +84,12 @@ type (
 		historyClient           history.Client
 		parentClosePolicyClient parentclosepolicy.Client
 		workflowResetter        reset.WorkflowResetter
+		webhookNotifier         webhook.Notifier
+		cloudEventsEmitter      cloudevents.Emitter
+		cloudEventsInflight     int32
+
+		activityDispatchRateLimiters   *quotas.Collection
+		childWorkflowStartRateLimiters cache.Cache // domainID/workflowID of the parent -> quotas.Limiter
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -102,6 +121,29 @@ func NewTransferActiveTaskExecutor(
 			config.NumParentClosePolicySystemWorkflows(),
 		),
 		workflowResetter: workflowResetter,
+		webhookNotifier: webhook.NewNotifier(
+			&webhook.Config{
+				MaxConcurrentDeliveries: config.WorkflowCloseWebhookMaxConcurrentDeliveries,
+				RequestTimeout:          config.WorkflowCloseWebhookRequestTimeout,
+				MaxRetryDuration:        config.WorkflowCloseWebhookMaxRetryDuration,
+			},
+			shard.GetLogger(),
+			shard.GetMetricsClient(),
+		),
+		cloudEventsEmitter: cloudevents.NewEmitter(
+			&cloudevents.Config{Sink: config.CloudEventsSink},
+			shard.GetService().GetMessagingClient,
+		),
+		activityDispatchRateLimiters: quotas.NewCollection(func(domain string) quotas.Limiter {
+			// the rate is cluster wide, so split it evenly across all shards
+			return quotas.NewDynamicRateLimiter(func() float64 {
+				return float64(config.ActivityDispatchRPSByDomain(domain)) / float64(config.NumberOfShards)
+			})
+		}),
+		childWorkflowStartRateLimiters: cache.New(&cache.Options{
+			TTL:      childWorkflowStartRateLimitersTTL,
+			MaxCount: childWorkflowStartRateLimitersMaxCount,
+		}),
 	}
 }
 
@@ -192,9 +234,46 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
+	if !t.allowActivityDispatch(task.DomainID) {
+		return errActivityDispatchLimitExceeded
+	}
 	return t.pushActivity(ctx, task, timeout)
 }
 
+func (t *transferActiveTaskExecutor) allowActivityDispatch(
+	domainID string,
+) bool {
+
+	domainName, err := t.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil || t.config.ActivityDispatchRPSByDomain(domainName) <= 0 {
+		return true
+	}
+	return t.activityDispatchRateLimiters.For(domainName).Allow()
+}
+
+func (t *transferActiveTaskExecutor) allowChildWorkflowStart(
+	task *persistence.TransferTaskInfo,
+) bool {
+
+	domainName, err := t.shard.GetDomainCache().GetDomainName(task.DomainID)
+	if err != nil || t.config.ChildWorkflowStartRPSPerParent(domainName) <= 0 {
+		return true
+	}
+
+	// all tasks of a parent workflow are processed by the same shard,
+	// so a limiter per parent workflow is enough to pace its children
+	limiter, err := t.childWorkflowStartRateLimiters.PutIfNotExist(
+		task.DomainID+"/"+task.WorkflowID,
+		quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(t.config.ChildWorkflowStartRPSPerParent(domainName))
+		}),
+	)
+	if err != nil {
+		return true
+	}
+	return limiter.(quotas.Limiter).Allow()
+}
+
 func (t *transferActiveTaskExecutor) processDecisionTask(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
@@ -243,11 +322,16 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	taskList := &types.TaskList{
 		Name: task.TaskList,
 	}
+	stickyPinTTL := time.Duration(0)
 	if mutableState.GetExecutionInfo().TaskList != task.TaskList {
 		// this decision is an sticky decision
 		// there shall already be an timer set
 		taskList.Kind = types.TaskListKindSticky.Ptr()
 		decisionTimeout = executionInfo.StickyScheduleToStartTimeout
+		if t.config.EnableStickyDecisionPinning(mutableState.GetDomainEntry().GetInfo().Name) {
+			// keep the mutable state loaded until the sticky decision is completed or times out
+			stickyPinTTL = time.Duration(executionInfo.StickyScheduleToStartTimeout+decision.DecisionTimeout) * time.Second
+		}
 	}
 	// TODO: for normal decision, we don't know if there's a scheduleToStart
 	// timeout timer task associated with the decision since it's determined
@@ -261,6 +345,12 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
+	if stickyPinTTL > 0 {
+		t.executionCache.PinForSticky(
+			definition.NewWorkflowIdentifier(task.DomainID, task.WorkflowID, task.RunID),
+			stickyPinTTL,
+		)
+	}
 	return t.pushDecision(ctx, task, taskList, decisionTimeout)
 }
 
@@ -463,6 +553,43 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 		); err != nil {
 			return err
 		}
+
+		if t.config.EnableWorkflowCloseWebhook(domainName) {
+			// webhook delivery is best effort and must not block the close execution task
+			if err := t.webhookNotifier.Notify(domainEntry, &webhook.CloseEvent{
+				Domain:            domainName,
+				WorkflowID:        task.WorkflowID,
+				RunID:             task.RunID,
+				WorkflowType:      workflowTypeName,
+				CloseStatus:       workflowCloseStatus.String(),
+				CloseTime:         time.Unix(0, workflowCloseTimestamp),
+				HistoryLength:     workflowHistoryLength,
+				CompletionEventID: completionEvent.ID,
+			}); err != nil {
+				t.logger.Warn("Failed to notify workflow close webhook.",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowID(task.WorkflowID),
+					tag.WorkflowRunID(task.RunID),
+					tag.Error(err),
+				)
+			}
+		}
+
+		closeTime := time.Unix(0, workflowCloseTimestamp)
+		t.emitCloudEvent(domainName, cloudevents.NewWorkflowClosedEvent(
+			t.shard.GetClusterMetadata().GetCurrentClusterName(),
+			&cloudevents.WorkflowData{
+				Domain:        domainName,
+				WorkflowID:    task.WorkflowID,
+				RunID:         task.RunID,
+				WorkflowType:  workflowTypeName,
+				TaskList:      executionInfo.TaskList,
+				StartTime:     time.Unix(0, workflowStartTimestamp),
+				CloseTime:     &closeTime,
+				CloseStatus:   workflowCloseStatus.String(),
+				HistoryLength: workflowHistoryLength,
+			},
+		))
 	}
 
 	// Communicate the result to parent execution if this is Child Workflow execution
@@ -828,6 +955,10 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 	// remaining 2 cases:
 	// workflow running, child not started, close policy is or is not abandon
 
+	if !t.allowChildWorkflowStart(task) {
+		return errChildWorkflowStartLimitExceeded
+	}
+
 	initiatedEvent, err := mutableState.GetChildExecutionInitiatedEvent(ctx, initiatedEventID)
 	if err != nil {
 		return err
@@ -964,7 +1095,7 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 	release(nil)
 
 	if recordStart {
-		return t.recordWorkflowStarted(
+		if err := t.recordWorkflowStarted(
 			ctx,
 			task.DomainID,
 			task.WorkflowID,
@@ -979,7 +1110,23 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 			numClusters,
 			visibilityMemo,
 			searchAttr,
-		)
+		); err != nil {
+			return err
+		}
+
+		domainName := domainEntry.GetInfo().Name
+		t.emitCloudEvent(domainName, cloudevents.NewWorkflowStartedEvent(
+			t.shard.GetClusterMetadata().GetCurrentClusterName(),
+			&cloudevents.WorkflowData{
+				Domain:       domainName,
+				WorkflowID:   task.WorkflowID,
+				RunID:        task.RunID,
+				WorkflowType: wfTypeName,
+				TaskList:     executionInfo.TaskList,
+				StartTime:    time.Unix(0, startTimestamp),
+			},
+		))
+		return nil
 	}
 	return t.upsertWorkflowExecution(
 		ctx,
@@ -999,6 +1146,42 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 	)
 }
 
+// emitCloudEvent emits the workflow lifecycle event in the background if enabled for the domain,
+// emission is best effort: events above the in-flight limit are dropped and failures do not fail the transfer task
+func (t *transferActiveTaskExecutor) emitCloudEvent(
+	domainName string,
+	event *cloudevents.Event,
+) {
+
+	if t.config.CloudEventsSink() == "" || !t.config.EnableCloudEvents(domainName) {
+		return
+	}
+
+	if int(atomic.AddInt32(&t.cloudEventsInflight, 1)) > t.config.CloudEventsMaxConcurrentEmits() {
+		atomic.AddInt32(&t.cloudEventsInflight, -1)
+		t.metricsClient.IncCounter(metrics.CloudEventsEmitterScope, metrics.CloudEventsEmitDropped)
+		return
+	}
+
+	go func() {
+		defer atomic.AddInt32(&t.cloudEventsInflight, -1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), taskRPCCallTimeout)
+		defer cancel()
+		if err := t.cloudEventsEmitter.Emit(ctx, event); err != nil {
+			t.metricsClient.IncCounter(metrics.CloudEventsEmitterScope, metrics.CloudEventsEmitFailures)
+			t.logger.Warn("Failed to emit workflow cloudevent.",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowID(event.Data.WorkflowID),
+				tag.WorkflowRunID(event.Data.RunID),
+				tag.Error(err),
+			)
+			return
+		}
+		t.metricsClient.IncCounter(metrics.CloudEventsEmitterScope, metrics.CloudEventsEmitted)
+	}()
+}
+
 func (t *transferActiveTaskExecutor) processResetWorkflow(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
@@ -24,6 +24,7 @@ import (
 	"context"
 	"math/rand"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -46,7 +47,9 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/cloudevents"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine"
@@ -244,6 +247,46 @@ func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Success() {
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_DispatchLimitExceeded() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, ai := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity-1",
+		"some random activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte{}, 1, 1, 1, 1,
+	)
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:        s.version,
+		DomainID:       s.domainID,
+		TargetDomainID: s.targetDomainID,
+		WorkflowID:     workflowExecution.GetWorkflowID(),
+		RunID:          workflowExecution.GetRunID(),
+		TaskID:         int64(59),
+		TaskList:       mutableState.GetExecutionInfo().TaskList,
+		TaskType:       persistence.TransferTaskTypeActivityTask,
+		ScheduleID:     event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), createAddActivityTaskRequest(transferTask, ai)).Return(nil).Times(1)
+	s.mockShard.GetConfig().ActivityDispatchRPSByDomain = dc.GetIntPropertyFilteredByDomain(1)
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Equal(errActivityDispatchLimitExceeded, err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Duplication() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
@@ -1276,6 +1319,33 @@ func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_TargetN
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_LimitExceeded() {
+	s.testProcessStartChildExecutionWithError(
+		s.childDomainID,
+		func(
+			mutableState execution.MutableState,
+			workflowExecution, childExecution types.WorkflowExecution,
+			event *types.HistoryEvent,
+			transferTask Task,
+			childInfo *persistence.ChildExecutionInfo,
+		) {
+			persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.ID, event.Version)
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+			s.mockShard.GetConfig().ChildWorkflowStartRPSPerParent = dc.GetIntPropertyFilteredByDomain(1)
+			// exhaust the limiter of the parent workflow
+			limiter := quotas.NewDynamicRateLimiter(func() float64 { return 1 })
+			s.True(limiter.Allow())
+			_, err = s.transferActiveTaskExecutor.childWorkflowStartRateLimiters.PutIfNotExist(
+				s.domainID+"/"+workflowExecution.GetWorkflowID(),
+				limiter,
+			)
+			s.NoError(err)
+		},
+		errChildWorkflowStartLimitExceeded,
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_Success_Dup() {
 	s.testProcessStartChildExecution(
 		s.childDomainID,
@@ -1592,6 +1662,34 @@ func (s *transferActiveTaskExecutorSuite) TestCopySearchAttributes() {
 	s.Equal(byte('1'), val[0])
 }
 
+func (s *transferActiveTaskExecutorSuite) TestEmitCloudEvent_InflightLimit() {
+	s.mockShard.GetConfig().EnableCloudEvents = dc.GetBoolPropertyFnFilteredByDomain(true)
+	s.mockShard.GetConfig().CloudEventsSink = dc.GetStringPropertyFn("https://example.com/events")
+	s.mockShard.GetConfig().CloudEventsMaxConcurrentEmits = dc.GetIntPropertyFn(1)
+	emitter := &blockingCloudEventsEmitter{
+		emittedCh: make(chan *cloudevents.Event, 2),
+		releaseCh: make(chan struct{}),
+	}
+	s.transferActiveTaskExecutor.cloudEventsEmitter = emitter
+
+	event := cloudevents.NewWorkflowStartedEvent("active", &cloudevents.WorkflowData{
+		Domain:     constants.TestDomainName,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+		StartTime:  time.Now(),
+	})
+	s.transferActiveTaskExecutor.emitCloudEvent(constants.TestDomainName, event)
+	s.Equal(event, <-emitter.emittedCh)
+
+	// the first event is still in flight, so the second one is dropped instead of blocking the task
+	s.transferActiveTaskExecutor.emitCloudEvent(constants.TestDomainName, event)
+	close(emitter.releaseCh)
+	s.Eventually(func() bool {
+		return atomic.LoadInt32(&s.transferActiveTaskExecutor.cloudEventsInflight) == 0
+	}, time.Second, time.Millisecond)
+	s.Empty(emitter.emittedCh)
+}
+
 func (s *transferActiveTaskExecutorSuite) newTransferTaskFromInfo(
 	info *persistence.TransferTaskInfo,
 ) Task {
@@ -1817,3 +1915,17 @@ func createUpsertWorkflowSearchAttributesRequest(
 		NumClusters:        numClusters,
 	}
 }
+
+type blockingCloudEventsEmitter struct {
+	emittedCh chan *cloudevents.Event
+	releaseCh chan struct{}
+}
+
+func (e *blockingCloudEventsEmitter) Emit(
+	ctx context.Context,
+	event *cloudevents.Event,
+) error {
+	e.emittedCh <- event
+	<-e.releaseCh
+	return nil
+}
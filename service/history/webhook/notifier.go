@@ -0,0 +1,338 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed by the domain signing key
+	SignatureHeader = "X-Cadence-Signature"
+	// TimestampHeader carries the unix timestamp in seconds at which the payload was signed
+	TimestampHeader = "X-Cadence-Timestamp"
+
+	signatureScheme = "sha256="
+
+	// httpClientTimeout bounds a single request, including reading the response body,
+	// whatever the value of the request timeout dynamic config
+	httpClientTimeout = time.Minute
+)
+
+var (
+	// ErrTooManyDeliveries is returned when the number of in-flight deliveries exceeds the limit
+	ErrTooManyDeliveries = errors.New("too many in-flight webhook deliveries")
+
+	errInvalidWebhookURL       = errors.New("webhook url must be an absolute https url")
+	errForbiddenWebhookAddress = errors.New("webhook url must not resolve to a loopback, link-local or private address")
+
+	// privateNetworks are the IPv4 private networks (RFC 1918) and the IPv6 unique local addresses (RFC 4193)
+	privateNetworks = mustParseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+)
+
+type (
+	// Notifier posts workflow close events to the webhook endpoint registered in the domain data.
+	// Delivery is best-effort and at-most-once: the close transfer task does not wait for the
+	// delivery, so an event is lost if the host restarts or the retries run out.
+	Notifier interface {
+		Notify(domainEntry *cache.DomainCacheEntry, event *CloseEvent) error
+	}
+
+	// Config is the config for the workflow close webhook notifier
+	Config struct {
+		MaxConcurrentDeliveries dynamicconfig.IntPropertyFn
+		RequestTimeout          dynamicconfig.DurationPropertyFn
+		MaxRetryDuration        dynamicconfig.DurationPropertyFn
+	}
+
+	// CloseEvent is the payload posted when a workflow closes. The result of the workflow is
+	// not included, it can be read from the completion event referenced by CompletionEventID.
+	CloseEvent struct {
+		Domain            string    `json:"domain"`
+		WorkflowID        string    `json:"workflowID"`
+		RunID             string    `json:"runID"`
+		WorkflowType      string    `json:"workflowType"`
+		CloseStatus       string    `json:"closeStatus"`
+		CloseTime         time.Time `json:"closeTime"`
+		HistoryLength     int64     `json:"historyLength"`
+		CompletionEventID int64     `json:"completionEventID"`
+	}
+
+	notifierImpl struct {
+		config        *Config
+		httpClient    *http.Client
+		logger        log.Logger
+		metricsClient metrics.Client
+		inflight      int32
+	}
+
+	statusCodeError struct {
+		statusCode int
+	}
+)
+
+var _ Notifier = (*notifierImpl)(nil)
+
+// NewNotifier creates a new workflow close webhook notifier
+func NewNotifier(
+	config *Config,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) Notifier {
+	return &notifierImpl{
+		config:        config,
+		httpClient:    newHTTPClient(newTransport()),
+		logger:        logger.WithTags(tag.ComponentWorkflowCloseWebhook),
+		metricsClient: metricsClient,
+	}
+}
+
+// Notify validates the webhook registered for the domain and delivers the event in the background,
+// retrying with backoff on transient failures. It is a no-op if the domain has no webhook registered.
+func (n *notifierImpl) Notify(
+	domainEntry *cache.DomainCacheEntry,
+	event *CloseEvent,
+) error {
+	data := domainEntry.GetInfo().Data
+	webhookURL, ok := data[common.DomainDataKeyForWebhookURL]
+	if !ok || webhookURL == "" {
+		return nil
+	}
+	if err := validateWebhookURL(webhookURL); err != nil {
+		return err
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	scope := n.metricsClient.Scope(metrics.WorkflowCloseWebhookScope, metrics.DomainTag(event.Domain))
+	if int(atomic.AddInt32(&n.inflight, 1)) > n.config.MaxConcurrentDeliveries() {
+		atomic.AddInt32(&n.inflight, -1)
+		scope.IncCounter(metrics.WebhookDeliveryDropped)
+		return ErrTooManyDeliveries
+	}
+
+	go func() {
+		defer atomic.AddInt32(&n.inflight, -1)
+
+		sw := scope.StartTimer(metrics.WebhookDeliveryLatency)
+		defer sw.Stop()
+		if err := n.deliver(webhookURL, data[common.DomainDataKeyForWebhookSigningKey], body); err != nil {
+			scope.IncCounter(metrics.WebhookDeliveryFailures)
+			n.logger.Warn("Failed to deliver workflow close webhook.",
+				tag.WorkflowDomainName(event.Domain),
+				tag.WorkflowID(event.WorkflowID),
+				tag.WorkflowRunID(event.RunID),
+				tag.Error(err),
+			)
+			return
+		}
+		scope.IncCounter(metrics.WebhookDeliverySuccess)
+	}()
+	return nil
+}
+
+func (n *notifierImpl) deliver(
+	webhookURL string,
+	signingKey string,
+	body []byte,
+) error {
+	maxRetryDuration := n.config.MaxRetryDuration()
+	ctx, cancel := context.WithTimeout(context.Background(), maxRetryDuration)
+	defer cancel()
+
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Minute)
+	policy.SetExpirationInterval(maxRetryDuration)
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(policy),
+		backoff.WithRetryableError(isRetryableError),
+	)
+	return throttleRetry.Do(ctx, func() error {
+		return n.post(ctx, webhookURL, signingKey, body)
+	})
+}
+
+func (n *notifierImpl) post(
+	ctx context.Context,
+	webhookURL string,
+	signingKey string,
+	body []byte,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, n.config.RequestTimeout())
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(TimestampHeader, timestamp)
+	request.Header.Set(SignatureHeader, signatureScheme+Sign(signingKey, timestamp, body))
+
+	response, err := n.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return &statusCodeError{statusCode: response.StatusCode}
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the payload,
+// receivers should recompute it to verify the payload was sent by cadence
+func Sign(
+	signingKey string,
+	timestamp string,
+	body []byte,
+) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newHTTPClient returns a client which doesn't follow redirects, so that an endpoint
+// can't send the signed payload to an address that was never validated
+func newHTTPClient(
+	transport *http.Transport,
+) *http.Client {
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Timeout: httpClientTimeout,
+	}
+}
+
+// newTransport returns a transport which refuses to connect to loopback, link-local and private addresses.
+// The check is made on the resolved address right before connecting, so it also covers host names
+// resolving, or later re-resolving, to an internal address.
+func newTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !isAllowedIP(net.ParseIP(host)) {
+				return errForbiddenWebhookAddress
+			}
+			return nil
+		},
+	}
+	return &http.Transport{
+		// no proxy, the address check would apply to the proxy instead of the endpoint
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+func isAllowedIP(
+	ip net.IP,
+) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return false
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(
+	cidrs ...string,
+) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func validateWebhookURL(
+	webhookURL string,
+) error {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return errInvalidWebhookURL
+	}
+	return nil
+}
+
+func isRetryableError(
+	err error,
+) bool {
+	if errors.Is(err, errForbiddenWebhookAddress) {
+		return false
+	}
+	if statusErr, ok := err.(*statusCodeError); ok {
+		return statusErr.statusCode >= http.StatusInternalServerError ||
+			statusErr.statusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+func (e *statusCodeError) Error() string {
+	return fmt.Sprintf("webhook endpoint responded with status code %v", e.statusCode)
+}
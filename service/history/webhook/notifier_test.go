@@ -0,0 +1,233 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	notifierSuite struct {
+		suite.Suite
+
+		server    *httptest.Server
+		handler   http.HandlerFunc
+		requestCh chan *http.Request
+		bodyCh    chan []byte
+		notifier  *notifierImpl
+	}
+)
+
+func TestNotifierSuite(t *testing.T) {
+	suite.Run(t, new(notifierSuite))
+}
+
+func (s *notifierSuite) SetupTest() {
+	s.requestCh = make(chan *http.Request, 10)
+	s.bodyCh = make(chan []byte, 10)
+	s.handler = func(w http.ResponseWriter, r *http.Request) {}
+	s.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		s.NoError(err)
+		s.requestCh <- r
+		s.bodyCh <- body
+		s.handler(w, r)
+	}))
+
+	s.notifier = NewNotifier(
+		&Config{
+			MaxConcurrentDeliveries: dynamicconfig.GetIntPropertyFn(10),
+			RequestTimeout:          dynamicconfig.GetDurationPropertyFn(time.Second),
+			MaxRetryDuration:        dynamicconfig.GetDurationPropertyFn(5 * time.Second),
+		},
+		log.NewNoop(),
+		metrics.NewClient(tally.NoopScope, metrics.History),
+	).(*notifierImpl)
+	// the test server listens on a loopback address, so only the address check is left out
+	s.notifier.httpClient = newHTTPClient(s.server.Client().Transport.(*http.Transport))
+}
+
+func (s *notifierSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *notifierSuite) TestNotify_Success() {
+	event := s.newCloseEvent()
+	s.NoError(s.notifier.Notify(s.newDomainEntry(s.server.URL, "secret"), event))
+
+	request, body := s.receive()
+	s.Equal(http.MethodPost, request.Method)
+	s.Equal("application/json", request.Header.Get("Content-Type"))
+	timestamp := request.Header.Get(TimestampHeader)
+	s.NotEmpty(timestamp)
+	s.Equal(signatureScheme+Sign("secret", timestamp, body), request.Header.Get(SignatureHeader))
+
+	var received CloseEvent
+	s.NoError(json.Unmarshal(body, &received))
+	s.Equal(event.RunID, received.RunID)
+	s.Equal(event.CloseStatus, received.CloseStatus)
+	s.Equal(event.CompletionEventID, received.CompletionEventID)
+	s.True(event.CloseTime.Equal(received.CloseTime))
+}
+
+func (s *notifierSuite) TestNotify_RetryOnServerError() {
+	var attempts int32
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}
+	s.NoError(s.notifier.Notify(s.newDomainEntry(s.server.URL, "secret"), s.newCloseEvent()))
+	s.receive()
+	s.receive()
+}
+
+func (s *notifierSuite) TestNotify_NoRetryOnClientError() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	s.NoError(s.notifier.Notify(s.newDomainEntry(s.server.URL, "secret"), s.newCloseEvent()))
+	s.receive()
+	select {
+	case <-s.requestCh:
+		s.Fail("client errors should not be retried")
+	case <-time.After(1500 * time.Millisecond):
+	}
+}
+
+func (s *notifierSuite) TestNotify_NoRedirect() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/redirected", http.StatusFound)
+	}
+	s.NoError(s.notifier.Notify(s.newDomainEntry(s.server.URL, "secret"), s.newCloseEvent()))
+	s.receive()
+	select {
+	case <-s.requestCh:
+		s.Fail("redirects should not be followed")
+	case <-time.After(1500 * time.Millisecond):
+	}
+}
+
+func (s *notifierSuite) TestTransport_ForbiddenAddress() {
+	response, err := newHTTPClient(newTransport()).Post(s.server.URL, "application/json", nil)
+	if response != nil {
+		response.Body.Close()
+	}
+	s.True(errors.Is(err, errForbiddenWebhookAddress))
+	s.False(isRetryableError(err))
+	select {
+	case <-s.requestCh:
+		s.Fail("loopback addresses should not be connected to")
+	default:
+	}
+}
+
+func (s *notifierSuite) TestIsAllowedIP() {
+	for ip, allowed := range map[string]bool{
+		"93.184.216.34":          true,
+		"2606:2800:220:1::248":   true,
+		"127.0.0.1":              false,
+		"::1":                    false,
+		"0.0.0.0":                false,
+		"169.254.169.254":        false,
+		"fe80::1":                false,
+		"10.1.2.3":               false,
+		"172.16.0.1":             false,
+		"192.168.1.1":            false,
+		"fd00::1":                false,
+		"::ffff:127.0.0.1":       false,
+		"::ffff:169.254.169.254": false,
+	} {
+		s.Equal(allowed, isAllowedIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func (s *notifierSuite) TestNotify_NotConfigured() {
+	s.NoError(s.notifier.Notify(s.newDomainEntry("", ""), s.newCloseEvent()))
+	s.Equal(int32(0), atomic.LoadInt32(&s.notifier.inflight))
+}
+
+func (s *notifierSuite) TestNotify_InvalidURL() {
+	s.Equal(errInvalidWebhookURL, s.notifier.Notify(s.newDomainEntry("http://example.com/hook", ""), s.newCloseEvent()))
+	s.Equal(errInvalidWebhookURL, s.notifier.Notify(s.newDomainEntry("not a url", ""), s.newCloseEvent()))
+}
+
+func (s *notifierSuite) TestNotify_TooManyDeliveries() {
+	atomic.StoreInt32(&s.notifier.inflight, 10)
+	s.Equal(ErrTooManyDeliveries, s.notifier.Notify(s.newDomainEntry(s.server.URL, ""), s.newCloseEvent()))
+	s.Equal(int32(10), atomic.LoadInt32(&s.notifier.inflight))
+}
+
+func (s *notifierSuite) receive() (*http.Request, []byte) {
+	select {
+	case request := <-s.requestCh:
+		return request, <-s.bodyCh
+	case <-time.After(5 * time.Second):
+		s.FailNow("webhook was not delivered")
+	}
+	return nil, nil
+}
+
+func (s *notifierSuite) newDomainEntry(webhookURL string, signingKey string) *cache.DomainCacheEntry {
+	data := map[string]string{}
+	if webhookURL != "" {
+		data[common.DomainDataKeyForWebhookURL] = webhookURL
+		data[common.DomainDataKeyForWebhookSigningKey] = signingKey
+	}
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-id", Name: "domain", Data: data},
+		&persistence.DomainConfig{},
+		"active",
+	)
+}
+
+func (s *notifierSuite) newCloseEvent() *CloseEvent {
+	return &CloseEvent{
+		Domain:            "domain",
+		WorkflowID:        "workflow-id",
+		RunID:             "run-id",
+		WorkflowType:      "workflow-type",
+		CloseStatus:       "COMPLETED",
+		CloseTime:         time.Unix(0, 1234567890),
+		HistoryLength:     10,
+		CompletionEventID: 10,
+	}
+}
@@ -0,0 +1,131 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/backlogalert"
+)
+
+const (
+	backlogAlertPublishTimeout = 10 * time.Second
+)
+
+type (
+	// backlogAlerter publishes an alert when the backlog of a task list crosses the configured
+	// count or age threshold, and another one when it drops back below both of them
+	backlogAlerter struct {
+		domainName   func() string
+		taskListID   *taskListID
+		config       *taskListConfig
+		publishers   backlogalert.PublisherCache
+		timeSource   clock.TimeSource
+		logger       log.Logger
+		metricScope  func() metrics.Scope
+		firing       bool
+		publishAsync bool
+	}
+)
+
+func newBacklogAlerter(tlMgr *taskListManagerImpl) *backlogAlerter {
+	return &backlogAlerter{
+		domainName:   tlMgr.domainName,
+		taskListID:   tlMgr.taskListID,
+		config:       tlMgr.config,
+		publishers:   tlMgr.engine.alertPublishers,
+		timeSource:   clock.NewRealTimeSource(),
+		logger:       tlMgr.logger,
+		metricScope:  tlMgr.metricScope,
+		publishAsync: true,
+	}
+}
+
+// check compares the backlog with the configured thresholds and publishes an alert on state changes,
+// it is not safe for concurrent use and is only called from the task list getTasksPump
+func (a *backlogAlerter) check(backlogCount int64, backlogAge time.Duration) {
+	destination := a.config.BacklogAlertDestination()
+	countThreshold := int64(a.config.BacklogAlertCountThreshold())
+	ageThreshold := a.config.BacklogAlertAgeThreshold()
+	if destination == "" || (countThreshold <= 0 && ageThreshold <= 0) {
+		a.firing = false
+		return
+	}
+
+	firing := (countThreshold > 0 && backlogCount >= countThreshold) ||
+		(ageThreshold > 0 && backlogAge >= ageThreshold)
+	if firing == a.firing {
+		return
+	}
+	a.firing = firing
+
+	state := backlogalert.StateResolved
+	if firing {
+		state = backlogalert.StateFiring
+	}
+	alert := &backlogalert.Alert{
+		Domain:              a.domainName(),
+		TaskList:            a.taskListID.name,
+		TaskListType:        getTaskListTypeName(a.taskListID.taskType),
+		State:               state,
+		BacklogCount:        backlogCount,
+		BacklogAgeSeconds:   int64(backlogAge / time.Second),
+		CountThreshold:      countThreshold,
+		AgeThresholdSeconds: int64(ageThreshold / time.Second),
+		Timestamp:           a.timeSource.Now(),
+	}
+	if a.publishAsync {
+		// publishing must not block the task list pump
+		go a.publish(destination, alert)
+	} else {
+		a.publish(destination, alert)
+	}
+}
+
+func (a *backlogAlerter) publish(destination string, alert *backlogalert.Alert) {
+	publisher, err := a.publishers.Get(destination)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), backlogAlertPublishTimeout)
+		defer cancel()
+		err = publisher.Publish(ctx, alert)
+	}
+	if err != nil {
+		a.metricScope().IncCounter(metrics.BacklogAlertFailedPerTaskListCounter)
+		a.logger.Warn("Failed to publish task list backlog alert.", tag.Value(alert.State), tag.Error(err))
+		return
+	}
+	a.metricScope().IncCounter(metrics.BacklogAlertPublishedPerTaskListCounter)
+}
+
+func getTaskListTypeName(taskListType int) string {
+	if taskListType == persistence.TaskListTypeActivity {
+		return "activity"
+	}
+	return "decision"
+}
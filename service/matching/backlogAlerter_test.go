@@ -0,0 +1,135 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/backlogalert"
+)
+
+type (
+	testAlertPublisher struct {
+		alerts []*backlogalert.Alert
+		err    error
+	}
+
+	testAlertPublisherCache struct {
+		destinations []string
+		publisher    *testAlertPublisher
+	}
+)
+
+func (p *testAlertPublisher) Publish(_ context.Context, alert *backlogalert.Alert) error {
+	p.alerts = append(p.alerts, alert)
+	return p.err
+}
+
+func (c *testAlertPublisherCache) Get(destination string) (backlogalert.Publisher, error) {
+	c.destinations = append(c.destinations, destination)
+	return c.publisher, nil
+}
+
+func TestBacklogAlerter(t *testing.T) {
+	publishers := &testAlertPublisherCache{publisher: &testAlertPublisher{}}
+	destination := ""
+	countThreshold := 100
+	ageThreshold := time.Minute
+	now := time.Unix(1600000000, 0)
+
+	scope := tally.NewTestScope("", nil)
+	alerter := &backlogAlerter{
+		domainName: func() string { return "test-domain" },
+		taskListID: &taskListID{
+			qualifiedTaskListName: qualifiedTaskListName{name: "test-tl", baseName: "test-tl"},
+			domainID:              "test-domain-id",
+			taskType:              persistence.TaskListTypeActivity,
+		},
+		config: &taskListConfig{
+			BacklogAlertDestination:    func() string { return destination },
+			BacklogAlertCountThreshold: func() int { return countThreshold },
+			BacklogAlertAgeThreshold:   func() time.Duration { return ageThreshold },
+		},
+		publishers: publishers,
+		timeSource: clock.NewEventTimeSource().Update(now),
+		logger:     log.NewNoop(),
+		metricScope: func() metrics.Scope {
+			return metrics.NewClient(scope, metrics.Matching).Scope(metrics.MatchingTaskListMgrScope)
+		},
+	}
+
+	// no destination configured
+	alerter.check(1000, time.Hour)
+	assert.Empty(t, publishers.publisher.alerts)
+
+	destination = "sqs:https://sqs.us-east-1.amazonaws.com/123456789012/backlog-alerts"
+	alerter.check(10, time.Second)
+	assert.Empty(t, publishers.publisher.alerts)
+
+	// count threshold crossed
+	alerter.check(100, time.Second)
+	require.Len(t, publishers.publisher.alerts, 1)
+	assert.Equal(t, &backlogalert.Alert{
+		Domain:              "test-domain",
+		TaskList:            "test-tl",
+		TaskListType:        "activity",
+		State:               backlogalert.StateFiring,
+		BacklogCount:        100,
+		BacklogAgeSeconds:   1,
+		CountThreshold:      100,
+		AgeThresholdSeconds: 60,
+		Timestamp:           now,
+	}, publishers.publisher.alerts[0])
+	assert.Equal(t, []string{destination}, publishers.destinations)
+
+	// still firing because of the age threshold, no new alert
+	alerter.check(10, 2*time.Minute)
+	require.Len(t, publishers.publisher.alerts, 1)
+
+	// resolved
+	alerter.check(10, time.Second)
+	require.Len(t, publishers.publisher.alerts, 2)
+	assert.Equal(t, backlogalert.StateResolved, publishers.publisher.alerts[1].State)
+
+	// failed publishes are counted
+	publishers.publisher.err = errors.New("publish failed")
+	countThreshold = 0
+	alerter.check(1000, 2*time.Minute)
+	require.Len(t, publishers.publisher.alerts, 3)
+	assert.Equal(t, backlogalert.StateFiring, publishers.publisher.alerts[2].State)
+	assert.Equal(t, int64(0), publishers.publisher.alerts[2].CountThreshold)
+	snapshot := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), snapshot["backlog_alert_published_per_tl+operation=TaskListMgr"].Value())
+	assert.Equal(t, int64(1), snapshot["backlog_alert_failed_per_tl+operation=TaskListMgr"].Value())
+}
@@ -0,0 +1,127 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package backlogalert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// StateFiring indicates the task list backlog is at or above a configured threshold
+	StateFiring = "firing"
+	// StateResolved indicates the task list backlog dropped back below all configured thresholds
+	StateResolved = "resolved"
+
+	sqsScheme    = "sqs"
+	pubsubScheme = "pubsub"
+)
+
+type (
+	// Alert is published when a task list backlog crosses, or recovers from, the configured thresholds
+	Alert struct {
+		Domain              string    `json:"domain"`
+		TaskList            string    `json:"taskList"`
+		TaskListType        string    `json:"taskListType"`
+		State               string    `json:"state"`
+		BacklogCount        int64     `json:"backlogCount"`
+		BacklogAgeSeconds   int64     `json:"backlogAgeSeconds"`
+		CountThreshold      int64     `json:"countThreshold"`
+		AgeThresholdSeconds int64     `json:"ageThresholdSeconds"`
+		Timestamp           time.Time `json:"timestamp"`
+	}
+
+	// Publisher publishes backlog alerts to an external queue or topic
+	Publisher interface {
+		Publish(ctx context.Context, alert *Alert) error
+	}
+
+	// PublisherCache creates publishers on first use and caches them by destination
+	PublisherCache interface {
+		Get(destination string) (Publisher, error)
+	}
+
+	publisherCacheImpl struct {
+		sync.Mutex
+		publishers   map[string]Publisher
+		newPublisher func(destination string) (Publisher, error)
+	}
+)
+
+// NewPublisherCache creates a new publisher cache
+func NewPublisherCache() PublisherCache {
+	return &publisherCacheImpl{
+		publishers:   make(map[string]Publisher),
+		newPublisher: newPublisher,
+	}
+}
+
+// Get returns the publisher for the destination, which is either
+// sqs:<queue url> or pubsub:projects/<project>/topics/<topic>
+func (c *publisherCacheImpl) Get(
+	destination string,
+) (Publisher, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if publisher, ok := c.publishers[destination]; ok {
+		return publisher, nil
+	}
+	publisher, err := c.newPublisher(destination)
+	if err != nil {
+		return nil, err
+	}
+	c.publishers[destination] = publisher
+	return publisher, nil
+}
+
+func newPublisher(
+	destination string,
+) (Publisher, error) {
+	parts := strings.SplitN(destination, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid backlog alert destination %q", destination)
+	}
+	switch parts[0] {
+	case sqsScheme:
+		return newSQSPublisher(parts[1])
+	case pubsubScheme:
+		return newPubSubPublisher(parts[1])
+	default:
+		return nil, fmt.Errorf("unsupported backlog alert destination scheme %q", parts[0])
+	}
+}
+
+func encodeAlert(
+	alert *Alert,
+) (string, error) {
+	payload, err := json.Marshal(alert)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
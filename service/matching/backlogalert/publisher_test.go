@@ -0,0 +1,165 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package backlogalert
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+type (
+	testSQSClient struct {
+		sqsiface.SQSAPI
+		inputs []*sqs.SendMessageInput
+	}
+)
+
+func (c *testSQSClient) SendMessageWithContext(
+	_ aws.Context,
+	input *sqs.SendMessageInput,
+	_ ...request.Option,
+) (*sqs.SendMessageOutput, error) {
+	c.inputs = append(c.inputs, input)
+	return &sqs.SendMessageOutput{}, nil
+}
+
+func TestNewPublisher(t *testing.T) {
+	publisher, err := newPublisher("sqs:https://sqs.us-west-2.amazonaws.com/123456789012/alerts")
+	require.NoError(t, err)
+	sqsPublisher, ok := publisher.(*sqsPublisher)
+	require.True(t, ok)
+	assert.Equal(t, "https://sqs.us-west-2.amazonaws.com/123456789012/alerts", sqsPublisher.queueURL)
+	assert.Equal(t, "us-west-2", aws.StringValue(sqsPublisher.client.(*sqs.SQS).Config.Region))
+
+	for _, destination := range []string{"", "sqs", "sqs:", "kafka:topic"} {
+		_, err := newPublisher(destination)
+		assert.Error(t, err, destination)
+	}
+}
+
+func TestPublisherCache(t *testing.T) {
+	created := 0
+	cache := &publisherCacheImpl{
+		publishers: make(map[string]Publisher),
+		newPublisher: func(destination string) (Publisher, error) {
+			if destination == "invalid" {
+				return nil, errors.New("invalid destination")
+			}
+			created++
+			return &sqsPublisher{queueURL: destination}, nil
+		},
+	}
+
+	first, err := cache.Get("a")
+	require.NoError(t, err)
+	second, err := cache.Get("a")
+	require.NoError(t, err)
+	assert.True(t, first == second)
+	_, err = cache.Get("b")
+	require.NoError(t, err)
+	assert.Equal(t, 2, created)
+
+	_, err = cache.Get("invalid")
+	assert.Error(t, err)
+}
+
+func TestSQSPublisher(t *testing.T) {
+	client := &testSQSClient{}
+	publisher := &sqsPublisher{client: client, queueURL: "queue-url"}
+	alert := newTestAlert()
+	require.NoError(t, publisher.Publish(context.Background(), alert))
+
+	require.Len(t, client.inputs, 1)
+	assert.Equal(t, "queue-url", aws.StringValue(client.inputs[0].QueueUrl))
+	var published Alert
+	require.NoError(t, json.Unmarshal([]byte(aws.StringValue(client.inputs[0].MessageBody)), &published))
+	assert.Equal(t, alert.TaskList, published.TaskList)
+	assert.Equal(t, alert.BacklogCount, published.BacklogCount)
+}
+
+func TestPubSubPublisher(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		w.Write([]byte(`{"messageIds":["1"]}`))
+	}))
+	defer server.Close()
+
+	publisher, err := newPubSubPublisher(
+		"projects/test-project/topics/test-topic",
+		option.WithEndpoint(server.URL),
+		option.WithHTTPClient(server.Client()),
+	)
+	require.NoError(t, err)
+	alert := newTestAlert()
+	require.NoError(t, publisher.Publish(context.Background(), alert))
+
+	request := <-requests
+	assert.Equal(t, "/v1/projects/test-project/topics/test-topic:publish", request.URL.Path)
+	var publishRequest struct {
+		Messages []struct {
+			Data       string            `json:"data"`
+			Attributes map[string]string `json:"attributes"`
+		} `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal(<-bodies, &publishRequest))
+	require.Len(t, publishRequest.Messages, 1)
+	assert.Equal(t, alert.State, publishRequest.Messages[0].Attributes["state"])
+	data, err := base64.StdEncoding.DecodeString(publishRequest.Messages[0].Data)
+	require.NoError(t, err)
+	var published Alert
+	require.NoError(t, json.Unmarshal(data, &published))
+	assert.Equal(t, alert.Domain, published.Domain)
+}
+
+func newTestAlert() *Alert {
+	return &Alert{
+		Domain:              "test-domain",
+		TaskList:            "test-tl",
+		TaskListType:        "decision",
+		State:               StateFiring,
+		BacklogCount:        1000,
+		BacklogAgeSeconds:   120,
+		CountThreshold:      500,
+		AgeThresholdSeconds: 60,
+		Timestamp:           time.Unix(1600000000, 0),
+	}
+}
@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package backlogalert
+
+import (
+	"context"
+	"encoding/base64"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+)
+
+type (
+	pubsubPublisher struct {
+		service *pubsub.Service
+		topic   string
+	}
+)
+
+// newPubSubPublisher creates a publisher sending alerts to the GCP Pub/Sub topic,
+// credentials are resolved by the Google application default credentials
+func newPubSubPublisher(
+	topic string,
+	opts ...option.ClientOption,
+) (Publisher, error) {
+	service, err := pubsub.NewService(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pubsubPublisher{
+		service: service,
+		topic:   topic,
+	}, nil
+}
+
+func (p *pubsubPublisher) Publish(
+	ctx context.Context,
+	alert *Alert,
+) error {
+	payload, err := encodeAlert(alert)
+	if err != nil {
+		return err
+	}
+	_, err = p.service.Projects.Topics.Publish(p.topic, &pubsub.PublishRequest{
+		Messages: []*pubsub.PubsubMessage{
+			{
+				Data: base64.StdEncoding.EncodeToString([]byte(payload)),
+				Attributes: map[string]string{
+					"domain":   alert.Domain,
+					"taskList": alert.TaskList,
+					"state":    alert.State,
+				},
+			},
+		},
+	}).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,80 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package backlogalert
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+)
+
+type (
+	sqsPublisher struct {
+		client   sqsiface.SQSAPI
+		queueURL string
+	}
+)
+
+// newSQSPublisher creates a publisher sending alerts to the SQS queue. Credentials are resolved
+// by the default AWS credential chain and the region is taken from the queue url when possible.
+func newSQSPublisher(
+	queueURL string,
+) (Publisher, error) {
+	parsed, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, err
+	}
+	awsConfig := &aws.Config{}
+	// queue urls look like https://sqs.<region>.amazonaws.com/<account>/<queue>
+	if hostParts := strings.Split(parsed.Hostname(), "."); len(hostParts) > 2 && hostParts[0] == "sqs" {
+		awsConfig.Region = aws.String(hostParts[1])
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &sqsPublisher{
+		client:   sqs.New(sess),
+		queueURL: queueURL,
+	}, nil
+}
+
+func (p *sqsPublisher) Publish(
+	ctx context.Context,
+	alert *Alert,
+) error {
+	payload, err := encodeAlert(alert)
+	if err != nil {
+		return err
+	}
+	_, err = p.client.SendMessageWithContext(ctx, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(p.queueURL),
+		MessageBody: aws.String(payload),
+	})
+	return err
+}
@@ -34,6 +34,8 @@ type (
 		PersistenceMaxQPS       dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS dynamicconfig.IntPropertyFn
 		EnableSyncMatch         dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		TaskListDraining        dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		SyncMatchRollout        dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		UserRPS                 dynamicconfig.IntPropertyFn
 		WorkerRPS               dynamicconfig.IntPropertyFn
 		DomainUserRPS           dynamicconfig.IntPropertyFnWithDomainFilter
@@ -58,10 +60,20 @@ type (
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+		// Shortens the time to hold a poll request as the number of outstanding polls on the host grows
+		AdaptiveLongPollEnabled        dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		AdaptiveLongPollMinInterval    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		AdaptiveLongPollMaxOutstanding dynamicconfig.IntPropertyFn
+
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+		// backlog alert configuration
+		BacklogAlertDestination    dynamicconfig.StringPropertyFnWithDomainFilter
+		BacklogAlertCountThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		BacklogAlertAgeThreshold   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 		// debugging configuration
@@ -81,6 +93,8 @@ type (
 	taskListConfig struct {
 		forwarderConfig
 		EnableSyncMatch func() bool
+		// Draining task lists do not sync match tasks forwarded from their child partitions
+		Draining func() bool
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
 		RangeSize                  int64
@@ -95,6 +109,15 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// backlog alert configuration
+		BacklogAlertDestination    func() string
+		BacklogAlertCountThreshold func() int
+		BacklogAlertAgeThreshold   func() time.Duration
+
+		// adaptive long poll configuration
+		AdaptiveLongPollEnabled        func() bool
+		AdaptiveLongPollMinInterval    func() time.Duration
+		AdaptiveLongPollMaxOutstanding func() int
 	}
 )
 
@@ -104,6 +127,8 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS),
 		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS),
 		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch),
+		SyncMatchRollout:                dc.GetRolloutPropertyFilteredByTaskListInfo(dynamicconfig.MatchingSyncMatchRolloutPercentage),
+		TaskListDraining:                dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskListDraining),
 		UserRPS:                         dc.GetIntProperty(dynamicconfig.MatchingUserRPS),
 		WorkerRPS:                       dc.GetIntProperty(dynamicconfig.MatchingWorkerRPS),
 		DomainUserRPS:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainUserRPS),
@@ -116,8 +141,14 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize),
+		AdaptiveLongPollEnabled:         dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveLongPoll),
+		AdaptiveLongPollMinInterval:     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveLongPollMinInterval),
+		AdaptiveLongPollMaxOutstanding:  dc.GetIntProperty(dynamicconfig.MatchingAdaptiveLongPollMaxOutstandingPolls),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize),
+		BacklogAlertDestination:         dc.GetStringPropertyFilteredByDomain(dynamicconfig.MatchingBacklogAlertDestination),
+		BacklogAlertCountThreshold:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingBacklogAlertCountThreshold),
+		BacklogAlertAgeThreshold:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingBacklogAlertAgeThreshold),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS),
 		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions),
 		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions),
@@ -158,7 +189,11 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 			return config.MinTaskThrottlingBurstSize(domainName, taskListName, taskType)
 		},
 		EnableSyncMatch: func() bool {
-			return config.EnableSyncMatch(domainName, taskListName, taskType)
+			return config.EnableSyncMatch(domainName, taskListName, taskType) &&
+				config.SyncMatchRollout(domainName, taskListName, taskType)
+		},
+		Draining: func() bool {
+			return config.TaskListDraining(domainName, id.baseName, taskType)
 		},
 		LongPollExpirationInterval: func() time.Duration {
 			return config.LongPollExpirationInterval(domainName, taskListName, taskType)
@@ -178,6 +213,24 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domainName, taskListName, taskType))
 		},
+		BacklogAlertDestination: func() string {
+			return config.BacklogAlertDestination(domainName)
+		},
+		BacklogAlertCountThreshold: func() int {
+			return config.BacklogAlertCountThreshold(domainName, taskListName, taskType)
+		},
+		BacklogAlertAgeThreshold: func() time.Duration {
+			return config.BacklogAlertAgeThreshold(domainName, taskListName, taskType)
+		},
+		AdaptiveLongPollEnabled: func() bool {
+			return config.AdaptiveLongPollEnabled(domainName, taskListName, taskType)
+		},
+		AdaptiveLongPollMinInterval: func() time.Duration {
+			return config.AdaptiveLongPollMinInterval(domainName, taskListName, taskType)
+		},
+		AdaptiveLongPollMaxOutstanding: func() int {
+			return config.AdaptiveLongPollMaxOutstanding()
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/cluster"
@@ -46,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/matching/backlogalert"
 )
 
 // Implements matching.Engine
@@ -70,6 +72,8 @@ type (
 	}
 
 	matchingEngineImpl struct {
+		outstandingPolls     int64 // number of polls held on this host, accessed atomically
+		draining             int32 // accessed atomically
 		taskManager          persistence.TaskManager
 		clusterMetadata      cluster.Metadata
 		historyService       history.Client
@@ -84,6 +88,7 @@ type (
 		domainCache          cache.DomainCache
 		versionChecker       client.VersionChecker
 		membershipResolver   membership.Resolver
+		alertPublishers      backlogalert.PublisherCache
 	}
 )
 
@@ -130,6 +135,7 @@ func NewEngine(taskManager persistence.TaskManager,
 		domainCache:          domainCache,
 		versionChecker:       client.NewVersionChecker(),
 		membershipResolver:   resolver,
+		alertPublishers:      backlogalert.NewPublisherCache(),
 	}
 }
 
@@ -144,6 +150,20 @@ func (e *matchingEngineImpl) Stop() {
 	}
 }
 
+// Drain stops sync matching new tasks, so they are persisted and picked up by the next owner of their task list
+func (e *matchingEngineImpl) Drain() {
+	atomic.StoreInt32(&e.draining, 1)
+}
+
+// OutstandingPolls returns the number of polls held on this host
+func (e *matchingEngineImpl) OutstandingPolls() int64 {
+	return atomic.LoadInt64(&e.outstandingPolls)
+}
+
+func (e *matchingEngineImpl) isDraining() bool {
+	return atomic.LoadInt32(&e.draining) != 0
+}
+
 func (e *matchingEngineImpl) getTaskLists(maxCount int) (lists []taskListManager) {
 	e.taskListsLock.RLock()
 	defer e.taskListsLock.RUnlock()
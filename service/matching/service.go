@@ -34,10 +34,12 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler Handler
-	stopC   chan struct{}
-	config  *Config
+	status     int32
+	drainStart int64 // unix nanos when draining started, accessed atomically
+	handler    Handler
+	engine     Engine
+	stopC      chan struct{}
+	config     *Config
 }
 
 // NewService builds a new cadence-matching service
@@ -84,7 +86,7 @@ func (s *Service) Start() {
 	logger := s.GetLogger()
 	logger.Info("matching starting")
 
-	engine := NewEngine(
+	s.engine = NewEngine(
 		s.GetTaskManager(),
 		s.GetClusterMetadata(),
 		s.GetHistoryClient(),
@@ -96,7 +98,7 @@ func (s *Service) Start() {
 		s.GetMembershipResolver(),
 	)
 
-	s.handler = NewHandler(engine, s.config, s.GetDomainCache(), s.GetMetricsClient(), s.GetLogger(), s.GetThrottledLogger())
+	s.handler = NewHandler(s.engine, s.config, s.GetDomainCache(), s.GetMetricsClient(), s.GetLogger(), s.GetThrottledLogger())
 
 	thriftHandler := NewThriftHandler(s.handler)
 	thriftHandler.register(s.GetDispatcher())
@@ -120,10 +122,9 @@ func (s *Service) Stop() {
 	}
 
 	// remove self from membership ring and wait for traffic to drain
-	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
-	s.GetMembershipResolver().EvictSelf()
+	s.Drain()
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
-	time.Sleep(s.config.ShutdownDrainDuration())
+	time.Sleep(s.config.ShutdownDrainDuration() - s.drainElapsed())
 
 	close(s.stopC)
 
@@ -132,3 +133,33 @@ func (s *Service) Stop() {
 
 	s.GetLogger().Info("matching stopped")
 }
+
+// Drain removes this host from the membership ring, so its task lists move to other hosts,
+// and stops sync matching so new tasks are persisted for the next owner of their task list
+func (s *Service) Drain() {
+	if !atomic.CompareAndSwapInt64(&s.drainStart, 0, time.Now().UnixNano()) {
+		return
+	}
+
+	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
+	s.GetMembershipResolver().EvictSelf()
+	s.engine.Drain()
+}
+
+// DrainStatus reports the polls still held on this host, draining is done once
+// others had time to discover this host left the membership ring
+func (s *Service) DrainStatus() common.DrainStatus {
+	if atomic.LoadInt64(&s.drainStart) == 0 {
+		return common.DrainStatus{}
+	}
+
+	return common.DrainStatus{
+		Draining:  true,
+		Done:      s.drainElapsed() >= s.config.ShutdownDrainDuration(),
+		Remaining: s.engine.OutstandingPolls(),
+	}
+}
+
+func (s *Service) drainElapsed() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&s.drainStart)))
+}
@@ -94,6 +94,7 @@ type (
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
 		taskGC           *taskGC
+		backlogAlerter   *backlogAlerter
 		taskAckManager   messaging.AckManager // tracks ackLevel for delivered messages
 		matcher          *TaskMatcher         // for matching a task producer with a poller
 		domainCache      cache.DomainCache
@@ -181,6 +182,7 @@ func newTaskListManager(
 	})
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr)
+	tlMgr.backlogAlerter = newBacklogAlerter(tlMgr)
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
 		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient)
@@ -215,8 +217,16 @@ func (c *taskListManagerImpl) Stop() {
 		return
 	}
 	close(c.shutdownCh)
+	if c.engine.isDraining() {
+		// the host is shutting down, persist the ack level so that the next owner
+		// of the task list does not dispatch the already completed tasks again
+		if err := c.taskReader.persistAckLevel(); err != nil {
+			c.logger.Warn("Failed to persist ack level on shutdown", tag.Error(err))
+		}
+	}
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	c.cancelOutstandingPolls()
 	c.engine.removeTaskListManager(c.taskListID)
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
@@ -250,6 +260,19 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return r, err
 		}
 
+		if isForwarded && c.config.Draining() {
+			// draining task list - leave the task to the child partition
+			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
+		}
+
+		if c.engine.isDraining() {
+			// draining host - persist the task so it is dispatched by the next owner of the task list
+			if isForwarded || params.activityTaskDispatchInfo != nil {
+				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
+			}
+			return c.taskWriter.appendTask(params.execution, params.taskInfo)
+		}
+
 		// active task, try sync match first
 		syncMatch, err = c.trySyncMatch(ctx, params)
 		if syncMatch {
@@ -322,9 +345,12 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// reached, instead of emptyTask, context timeout error is returned to the frontend by the rpc stack,
 	// which counts against our SLO. By shortening the timeout by a very small amount, the emptyTask can be
 	// returned to the handler before a context timeout error is generated.
-	childCtx, cancel := c.newChildContext(ctx, c.config.LongPollExpirationInterval(), returnEmptyTaskTimeBudget)
+	childCtx, cancel := c.newChildContext(ctx, c.longPollExpirationInterval(), returnEmptyTaskTimeBudget)
 	defer cancel()
 
+	atomic.AddInt64(&c.engine.outstandingPolls, 1)
+	defer atomic.AddInt64(&c.engine.outstandingPolls, -1)
+
 	pollerID, ok := ctx.Value(pollerIDKey).(string)
 	if ok && pollerID != "" {
 		// Found pollerID on context, add it to the map to allow it to be canceled in
@@ -379,6 +405,16 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	}
 }
 
+// cancelOutstandingPolls returns empty responses to the polls held on the task list,
+// so that pollers retry against the next owner instead of having their polls severed
+func (c *taskListManagerImpl) cancelOutstandingPolls() {
+	c.outstandingPollsLock.Lock()
+	defer c.outstandingPollsLock.Unlock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+}
+
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
@@ -561,6 +597,34 @@ func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskPa
 // method to create child context when childContext cannot use
 // all of parent's deadline but instead there is a need to leave
 // some time for parent to do some post-work
+// longPollExpirationInterval returns how long a poll is held before an empty response is returned.
+// With adaptive long poll enabled, the hold shrinks linearly from the configured interval to the min
+// interval while the outstanding polls on this host grow from half of the max to the max. Polls of a
+// task list with backlog are not shortened, since they are expected to be served from the backlog.
+func (c *taskListManagerImpl) longPollExpirationInterval() time.Duration {
+	interval := c.config.LongPollExpirationInterval()
+	if !c.config.AdaptiveLongPollEnabled() {
+		return interval
+	}
+
+	minInterval := c.config.AdaptiveLongPollMinInterval()
+	maxOutstanding := c.config.AdaptiveLongPollMaxOutstanding()
+	if minInterval >= interval || maxOutstanding <= 0 || c.taskAckManager.GetBacklogCount() > 0 {
+		return interval
+	}
+
+	half := float64(maxOutstanding) / 2
+	load := (float64(atomic.LoadInt64(&c.engine.outstandingPolls)) - half) / half
+	if load <= 0 {
+		return interval
+	}
+	if load > 1 {
+		load = 1
+	}
+	c.metricScope().IncCounter(metrics.ShortenedLongPollPerTaskListCounter)
+	return interval - time.Duration(float64(interval-minInterval)*load)
+}
+
 func (c *taskListManagerImpl) newChildContext(
 	parent context.Context,
 	timeout time.Duration,
@@ -331,3 +331,143 @@ func TestAddTaskStandby(t *testing.T) {
 	require.Error(t, err) // should not persist the task
 	require.False(t, syncMatch)
 }
+
+func TestAddTaskDraining(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.TaskListDraining = dynamicconfig.GetBoolPropertyFnFilteredByTaskListInfo(true)
+
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlMgrStartWithoutNotifyEvent(tlm)
+	defer tlm.Stop()
+
+	addTaskParam := addTaskParams{
+		execution: &types.WorkflowExecution{
+			WorkflowID: "some random workflowID",
+			RunID:      "some random runID",
+		},
+		taskInfo: &persistence.TaskInfo{
+			DomainID:               "domain",
+			WorkflowID:             "some random workflowID",
+			RunID:                  "some random runID",
+			ScheduleID:             2,
+			ScheduleToStartTimeout: 5,
+			CreatedTime:            time.Now(),
+		},
+		forwardedFrom: "from child partition",
+	}
+
+	syncMatch, err := tlm.AddTask(context.Background(), addTaskParam)
+	require.Equal(t, errRemoteSyncMatchFailed, err)
+	require.False(t, syncMatch)
+}
+
+func TestAddTaskHostDraining(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	tlMgrStartWithoutNotifyEvent(tlm)
+	// stop taskWriter so that we can check if there's any call to it
+	tlm.taskWriter.Stop()
+	tlm.engine.Drain()
+
+	addTaskParam := addTaskParams{
+		execution: &types.WorkflowExecution{
+			WorkflowID: "some random workflowID",
+			RunID:      "some random runID",
+		},
+		taskInfo: &persistence.TaskInfo{
+			DomainID:               "domain",
+			WorkflowID:             "some random workflowID",
+			RunID:                  "some random runID",
+			ScheduleID:             2,
+			ScheduleToStartTimeout: 5,
+			CreatedTime:            time.Now(),
+		},
+	}
+
+	// a waiting poller would sync match the task if the host was not draining
+	pollCtx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	pollErrC := make(chan error, 1)
+	go func() {
+		_, err := tlm.matcher.Poll(pollCtx)
+		pollErrC <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	syncMatch, err := tlm.AddTask(context.Background(), addTaskParam)
+	require.Equal(t, errShutdown, err) // task is persisted instead of sync matched
+	require.False(t, syncMatch)
+	require.Equal(t, ErrNoTasks, <-pollErrC)
+
+	addTaskParam.forwardedFrom = "from child partition"
+	syncMatch, err = tlm.AddTask(context.Background(), addTaskParam)
+	require.Equal(t, errRemoteSyncMatchFailed, err)
+	require.False(t, syncMatch)
+}
+
+func TestStopHostDraining(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	tlm.taskAckManager.SetAckLevel(10)
+
+	pollCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	pollErrC := make(chan error, 1)
+	go func() {
+		_, err := tlm.GetTask(context.WithValue(pollCtx, pollerIDKey, "some random pollerID"), nil)
+		pollErrC <- err
+	}()
+	require.Eventually(t, func() bool { return tlm.engine.OutstandingPolls() == 1 }, time.Second, 10*time.Millisecond)
+
+	tlm.engine.Drain()
+	tlm.Stop()
+
+	// the held poll gets an empty response instead of waiting for its deadline
+	select {
+	case err := <-pollErrC:
+		require.Equal(t, ErrNoTasks, err)
+	case <-time.After(time.Second):
+		t.Fatal("outstanding poll was not returned on stop")
+	}
+	require.Equal(t, int64(10), tlm.engine.taskManager.(*testTaskManager).getTaskListManager(tlm.taskListID).ackLevel)
+}
+
+func TestAdaptiveLongPollExpirationInterval(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	cfg.AdaptiveLongPollMinInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Second)
+	cfg.AdaptiveLongPollMaxOutstanding = dynamicconfig.GetIntPropertyFn(100)
+
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlm.engine.outstandingPolls = 100
+	require.Equal(t, time.Minute, tlm.longPollExpirationInterval())
+
+	cfg = defaultTestConfig()
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	cfg.AdaptiveLongPollEnabled = dynamicconfig.GetBoolPropertyFnFilteredByTaskListInfo(true)
+	cfg.AdaptiveLongPollMinInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Second)
+	cfg.AdaptiveLongPollMaxOutstanding = dynamicconfig.GetIntPropertyFn(100)
+
+	tlm = createTestTaskListManagerWithConfig(controller, cfg)
+	tlm.engine.outstandingPolls = 50
+	require.Equal(t, time.Minute, tlm.longPollExpirationInterval())
+	tlm.engine.outstandingPolls = 75
+	require.Equal(t, 35*time.Second, tlm.longPollExpirationInterval())
+	tlm.engine.outstandingPolls = 1000
+	require.Equal(t, 10*time.Second, tlm.longPollExpirationInterval())
+
+	// polls of a task list with backlog keep the full hold
+	require.NoError(t, tlm.taskAckManager.ReadItem(1))
+	require.Equal(t, time.Minute, tlm.longPollExpirationInterval())
+}
@@ -23,6 +23,7 @@ package matching
 import (
 	"context"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/log"
@@ -49,6 +50,8 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// creation time in unix nanos of the backlog task being dispatched, 0 if there is none
+		headTaskCreatedTime int64
 	}
 )
 
@@ -94,6 +97,7 @@ dispatchLoop:
 				break dispatchLoop
 			}
 			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false, nil)
+			atomic.StoreInt64(&tr.headTaskCreatedTime, taskInfo.CreatedTime.UnixNano())
 			for {
 				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
 				if err == nil {
@@ -108,6 +112,7 @@ dispatchLoop:
 				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
 				runtime.Gosched()
 			}
+			atomic.StoreInt64(&tr.headTaskCreatedTime, 0)
 		case <-tr.dispatcherShutdownC:
 			break dispatchLoop
 		}
@@ -166,6 +171,7 @@ getTasksPumpLoop:
 					// keep going as saving ack is not critical
 				}
 				tr.Signal() // periodically signal pump to check persistence for tasks
+				tr.tlMgr.backlogAlerter.check(tr.tlMgr.taskAckManager.GetBacklogCount(), tr.backlogAge())
 				updateAckTimer = time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 			}
 		case <-checkIdleTaskListTimer.C:
@@ -185,6 +191,15 @@ getTasksPumpLoop:
 	checkIdleTaskListTimer.Stop()
 }
 
+// backlogAge returns how long the oldest backlog task being dispatched has been waiting
+func (tr *taskReader) backlogAge() time.Duration {
+	createdTime := atomic.LoadInt64(&tr.headTaskCreatedTime)
+	if createdTime == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, createdTime))
+}
+
 func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	response, err := tr.tlMgr.executeWithRetry(func() (interface{}, error) {
 		return tr.tlMgr.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobreencoder
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	// ownershipKey is used to pick a single worker host running the re-encoder
+	ownershipKey   = "blob-reencoder"
+	requestTimeout = 10 * time.Second
+	listPageSize   = 100
+)
+
+type (
+	// Config is the config for the blob re-encoder
+	Config struct {
+		Interval dynamicconfig.DurationPropertyFn
+		RPS      dynamicconfig.IntPropertyFn
+	}
+
+	// Reencoder periodically rewrites persisted domain records so that they are stored with the
+	// encoding currently configured for them by the sql blob encodings dynamic config.
+	// Other blob types are rewritten by their owners as part of normal processing, e.g. workflow
+	// mutable state on the next update and tasks on completion, so only domains need a dedicated job.
+	Reencoder struct {
+		status             int32
+		config             *Config
+		domainManager      persistence.DomainManager
+		membershipResolver membership.Resolver
+		hostInfo           membership.HostInfo
+		rateLimiter        quotas.Limiter
+		logger             log.Logger
+		metricsScope       metrics.Scope
+		shutdownCh         chan struct{}
+	}
+)
+
+// New creates a new blob re-encoder
+func New(
+	config *Config,
+	domainManager persistence.DomainManager,
+	membershipResolver membership.Resolver,
+	hostInfo membership.HostInfo,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *Reencoder {
+	return &Reencoder{
+		status:             common.DaemonStatusInitialized,
+		config:             config,
+		domainManager:      domainManager,
+		membershipResolver: membershipResolver,
+		hostInfo:           hostInfo,
+		rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(config.RPS())
+		}),
+		logger:       logger.WithTags(tag.ComponentBlobReencoder),
+		metricsScope: metricsClient.Scope(metrics.BlobReencoderScope),
+		shutdownCh:   make(chan struct{}),
+	}
+}
+
+// Start starts the re-encoder
+func (r *Reencoder) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go r.reencodeLoop()
+	r.logger.Info("blob re-encoder started")
+}
+
+// Stop stops the re-encoder
+func (r *Reencoder) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(r.shutdownCh)
+	r.logger.Info("blob re-encoder stopped")
+}
+
+func (r *Reencoder) reencodeLoop() {
+	timer := time.NewTimer(r.config.Interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if r.isOwner() {
+				r.reencodeDomains()
+			}
+			timer.Reset(r.config.Interval())
+		case <-r.shutdownCh:
+			return
+		}
+	}
+}
+
+func (r *Reencoder) isOwner() bool {
+	info, err := r.membershipResolver.Lookup(service.Worker, ownershipKey)
+	if err != nil {
+		r.logger.Info("Failed to lookup host info. Skip current run.", tag.Error(err))
+		return false
+	}
+	return info.Identity() == r.hostInfo.Identity()
+}
+
+func (r *Reencoder) reencodeDomains() {
+	var token []byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		resp, err := r.domainManager.ListDomains(ctx, &persistence.ListDomainsRequest{
+			PageSize:      listPageSize,
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			r.logger.Warn("Failed to list domains", tag.Error(err))
+			r.metricsScope.IncCounter(metrics.BlobReencoderFailures)
+			return
+		}
+
+		for _, domain := range resp.Domains {
+			select {
+			case <-r.shutdownCh:
+				return
+			default:
+			}
+			if err := r.rateLimiter.Wait(context.Background()); err != nil {
+				return
+			}
+			if err := r.reencodeDomain(domain.Info.Name); err != nil {
+				r.logger.Warn("Failed to re-encode domain", tag.WorkflowDomainName(domain.Info.Name), tag.Error(err))
+				r.metricsScope.IncCounter(metrics.BlobReencoderFailures)
+				continue
+			}
+			r.metricsScope.IncCounter(metrics.BlobReencoderDomainsReencoded)
+		}
+
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return
+		}
+	}
+}
+
+// reencodeDomain writes the domain back unchanged, which encodes it with the currently configured encoding.
+// The metadata notification version is read before the domain, same as for any domain update,
+// so the write fails instead of overwriting a domain update which happened in between.
+func (r *Reencoder) reencodeDomain(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	metadata, err := r.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	domain, err := r.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	return r.domainManager.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+		Info:                        domain.Info,
+		Config:                      domain.Config,
+		ReplicationConfig:           domain.ReplicationConfig,
+		ConfigVersion:               domain.ConfigVersion,
+		FailoverVersion:             domain.FailoverVersion,
+		FailoverNotificationVersion: domain.FailoverNotificationVersion,
+		PreviousFailoverVersion:     domain.PreviousFailoverVersion,
+		FailoverEndTime:             domain.FailoverEndTime,
+		LastUpdatedTime:             domain.LastUpdatedTime,
+		NotificationVersion:         metadata.NotificationVersion,
+	})
+}
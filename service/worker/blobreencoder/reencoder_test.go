@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobreencoder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestReencoder_ReencodeDomains(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	domain := &persistence.GetDomainResponse{
+		Info:                &persistence.DomainInfo{ID: "domain-id", Name: "domain-name"},
+		Config:              &persistence.DomainConfig{Retention: 7},
+		ReplicationConfig:   &persistence.DomainReplicationConfig{ActiveClusterName: "active"},
+		ConfigVersion:       2,
+		FailoverVersion:     3,
+		NotificationVersion: 4,
+	}
+	domainManager := persistence.NewMockDomainManager(ctrl)
+	domainManager.EXPECT().ListDomains(gomock.Any(), &persistence.ListDomainsRequest{PageSize: listPageSize}).Return(&persistence.ListDomainsResponse{
+		Domains:       []*persistence.GetDomainResponse{domain},
+		NextPageToken: []byte("token"),
+	}, nil)
+	domainManager.EXPECT().ListDomains(gomock.Any(), &persistence.ListDomainsRequest{PageSize: listPageSize, NextPageToken: []byte("token")}).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{{Info: &persistence.DomainInfo{Name: "deleted-domain"}}},
+	}, nil)
+	domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 10}, nil).Times(2)
+	domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "domain-name"}).Return(domain, nil)
+	domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "deleted-domain"}).Return(nil, errors.New("not found"))
+	domainManager.EXPECT().UpdateDomain(gomock.Any(), &persistence.UpdateDomainRequest{
+		Info:                domain.Info,
+		Config:              domain.Config,
+		ReplicationConfig:   domain.ReplicationConfig,
+		ConfigVersion:       2,
+		FailoverVersion:     3,
+		NotificationVersion: 10,
+	}).Return(nil)
+
+	hostInfo := membership.NewHostInfo("host")
+	scope := tally.NewTestScope("", nil)
+	reencoder := New(
+		&Config{
+			Interval: dynamicconfig.GetDurationPropertyFn(time.Hour),
+			RPS:      dynamicconfig.GetIntPropertyFn(1000),
+		},
+		domainManager,
+		membership.NewMockResolver(ctrl),
+		hostInfo,
+		log.NewNoop(),
+		metrics.NewClient(scope, metrics.Worker),
+	)
+	reencoder.reencodeDomains()
+
+	counters := make(map[string]int64)
+	for _, counter := range scope.Snapshot().Counters() {
+		counters[counter.Name()] = counter.Value()
+	}
+	assert.Equal(t, int64(1), counters["blob_reencoder_domains_reencoded"])
+	assert.Equal(t, int64(1), counters["blob_reencoder_failures"])
+}
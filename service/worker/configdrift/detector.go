@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configdrift
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ownershipKey is used to pick a single worker host running the detector
+	ownershipKey   = "dynamic-config-drift-detector"
+	requestTimeout = 30 * time.Second
+)
+
+type (
+	// Config is the config for the dynamic config drift detector
+	Config struct {
+		DetectionInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// Detector periodically compares the fully resolved dynamic config of the current cluster
+	// with every enabled remote cluster and reports values which differ
+	Detector struct {
+		status             int32
+		config             *Config
+		clusterMetadata    cluster.Metadata
+		clientBean         client.Bean
+		dynamicConfig      dynamicconfig.Client
+		membershipResolver membership.Resolver
+		hostInfo           membership.HostInfo
+		logger             log.Logger
+		metricsScope       metrics.Scope
+		shutdownCh         chan struct{}
+	}
+)
+
+// New creates a new dynamic config drift detector
+func New(
+	config *Config,
+	clusterMetadata cluster.Metadata,
+	clientBean client.Bean,
+	dynamicConfig dynamicconfig.Client,
+	membershipResolver membership.Resolver,
+	hostInfo membership.HostInfo,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *Detector {
+	return &Detector{
+		status:             common.DaemonStatusInitialized,
+		config:             config,
+		clusterMetadata:    clusterMetadata,
+		clientBean:         clientBean,
+		dynamicConfig:      dynamicConfig,
+		membershipResolver: membershipResolver,
+		hostInfo:           hostInfo,
+		logger:             logger.WithTags(tag.ComponentDynamicConfigDriftDetector),
+		metricsScope:       metricsClient.Scope(metrics.DynamicConfigDriftDetectorScope),
+		shutdownCh:         make(chan struct{}),
+	}
+}
+
+// Start starts the detector
+func (d *Detector) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go d.detectLoop()
+	d.logger.Info("dynamic config drift detector started")
+}
+
+// Stop stops the detector
+func (d *Detector) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(d.shutdownCh)
+	d.logger.Info("dynamic config drift detector stopped")
+}
+
+func (d *Detector) detectLoop() {
+	timer := time.NewTimer(d.config.DetectionInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if d.isOwner() {
+				d.detect()
+			}
+			timer.Reset(d.config.DetectionInterval())
+		case <-d.shutdownCh:
+			return
+		}
+	}
+}
+
+func (d *Detector) isOwner() bool {
+	info, err := d.membershipResolver.Lookup(service.Worker, ownershipKey)
+	if err != nil {
+		d.logger.Info("Failed to lookup host info. Skip current run.", tag.Error(err))
+		return false
+	}
+	return info.Identity() == d.hostInfo.Identity()
+}
+
+func (d *Detector) detect() {
+	configured, err := d.dynamicConfig.ListValue(nil)
+	if err != nil {
+		d.logger.Warn("Failed to list local dynamic config", tag.Error(err))
+		d.metricsScope.IncCounter(metrics.DynamicConfigDriftDetectionFailures)
+		return
+	}
+	local, err := dynamicconfig.ResolveSnapshot(configured)
+	if err != nil {
+		d.logger.Warn("Failed to resolve local dynamic config", tag.Error(err))
+		d.metricsScope.IncCounter(metrics.DynamicConfigDriftDetectionFailures)
+		return
+	}
+
+	currentClusterName := d.clusterMetadata.GetCurrentClusterName()
+	for clusterName, info := range d.clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || clusterName == currentClusterName {
+			continue
+		}
+		d.detectForCluster(clusterName, local)
+	}
+}
+
+func (d *Detector) detectForCluster(clusterName string, local []*types.DynamicConfigEntry) {
+	scope := d.metricsScope.Tagged(metrics.TargetClusterTag(clusterName))
+	logger := d.logger.WithTags(tag.ClusterName(clusterName))
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := d.clientBean.GetRemoteAdminClient(clusterName).ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{})
+	if err != nil {
+		logger.Warn("Failed to list remote dynamic config", tag.Error(err))
+		scope.IncCounter(metrics.DynamicConfigDriftDetectionFailures)
+		return
+	}
+	remote, err := dynamicconfig.ResolveSnapshot(resp.GetEntries())
+	if err != nil {
+		logger.Warn("Failed to resolve remote dynamic config", tag.Error(err))
+		scope.IncCounter(metrics.DynamicConfigDriftDetectionFailures)
+		return
+	}
+
+	drifts := dynamicconfig.CompareSnapshots(local, remote)
+	scope.UpdateGauge(metrics.DynamicConfigDriftCount, float64(len(drifts)))
+	for _, drift := range drifts {
+		logger.Warn(
+			fmt.Sprintf("Dynamic config drift detected, local value: %v, remote value: %v", drift.LocalValue, drift.RemoteValue),
+			tag.Key(drift.Name),
+			tag.Value(drift.Filters),
+		)
+	}
+}
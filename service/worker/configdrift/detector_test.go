@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configdrift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDetector_Detect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	localConfig := dynamicconfig.NewInMemoryClient()
+	assert.NoError(t, localConfig.UpdateValue(dynamicconfig.TestGetIntPropertyKey, 10))
+
+	remoteAdminClient := admin.NewMockClient(ctrl)
+	remoteAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), gomock.Any()).Return(&types.ListDynamicConfigResponse{
+		Entries: []*types.DynamicConfigEntry{
+			{
+				Name: dynamicconfig.TestGetIntPropertyKey.String(),
+				Values: []*types.DynamicConfigValue{
+					{Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("20")}},
+				},
+			},
+		},
+	}, nil)
+	clientBean := client.NewMockBean(ctrl)
+	clientBean.EXPECT().GetRemoteAdminClient(cluster.TestAlternativeClusterName).Return(remoteAdminClient)
+
+	hostInfo := membership.NewHostInfo("host")
+	resolver := membership.NewMockResolver(ctrl)
+	resolver.EXPECT().Lookup(service.Worker, ownershipKey).Return(hostInfo, nil)
+
+	scope := tally.NewTestScope("", nil)
+	detector := New(
+		&Config{DetectionInterval: dynamicconfig.GetDurationPropertyFn(time.Minute)},
+		cluster.TestActiveClusterMetadata,
+		clientBean,
+		localConfig,
+		resolver,
+		hostInfo,
+		log.NewNoop(),
+		metrics.NewClient(scope, metrics.Worker),
+	)
+
+	assert.True(t, detector.isOwner())
+	detector.detect()
+
+	gauges := scope.Snapshot().Gauges()
+	var driftCount float64
+	for _, gauge := range gauges {
+		if gauge.Name() == "dynamic_config_drift_count" {
+			driftCount = gauge.Value()
+		}
+	}
+	assert.Equal(t, float64(1), driftCount)
+}
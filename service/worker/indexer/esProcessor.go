@@ -185,6 +185,8 @@ func (p *esProcessorImpl) ackKafkaMsgHelper(key string, nack bool) {
 	}
 
 	if nack {
+		// the message is moved to DLQ, visibility diverges from execution state until it is reprocessed
+		p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorNackedMessages)
 		kafkaMsg.Nack()
 	} else {
 		kafkaMsg.Ack()
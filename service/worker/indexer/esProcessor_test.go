@@ -238,6 +238,7 @@ func (s *esProcessorSuite) TestBulkAfterAction_Nack() {
 	mapVal := newKafkaMessageWithMetrics(mockKafkaMsg, &testStopWatch)
 	s.esProcessor.mapToKafkaMsg.Put(testKey, mapVal)
 	mockKafkaMsg.On("Nack").Return(nil).Once()
+	s.mockMetricClient.On("IncCounter", metrics.ESProcessorScope, metrics.ESProcessorNackedMessages).Once()
 	mockKafkaMsg.On("Value").Return(payload).Once()
 	s.mockBulkProcessor.On("RetrieveKafkaKey", request, mock.Anything, mock.Anything).Return(testKey)
 	s.esProcessor.bulkAfterAction(0, requests, response, nil)
@@ -275,6 +276,7 @@ func (s *esProcessorSuite) TestBulkAfterAction_Error() {
 	mapVal := newKafkaMessageWithMetrics(mockKafkaMsg, &testStopWatch)
 	s.esProcessor.mapToKafkaMsg.Put(testKey, mapVal)
 	mockKafkaMsg.On("Nack").Return(nil).Once()
+	s.mockMetricClient.On("IncCounter", metrics.ESProcessorScope, metrics.ESProcessorNackedMessages).Once()
 	mockKafkaMsg.On("Value").Return(payload).Once()
 	s.mockMetricClient.On("IncCounter", metrics.ESProcessorScope, metrics.ESProcessorFailures).Once()
 	s.mockBulkProcessor.On("RetrieveKafkaKey", request, mock.Anything, mock.Anything).Return(testKey)
@@ -312,6 +314,7 @@ func (s *esProcessorSuite) TestNackKafkaMsg() {
 	s.Equal(1, s.esProcessor.mapToKafkaMsg.Len())
 
 	mockKafkaMsg.On("Nack").Return(nil).Once()
+	s.mockMetricClient.On("IncCounter", metrics.ESProcessorScope, metrics.ESProcessorNackedMessages).Once()
 	s.esProcessor.nackKafkaMsg(key)
 	mockKafkaMsg.AssertExpectations(s.T())
 	s.Equal(0, s.esProcessor.mapToKafkaMsg.Len())
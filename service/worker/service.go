@@ -38,6 +38,8 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/blobreencoder"
+	"github.com/uber/cadence/service/worker/configdrift"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
@@ -49,6 +51,8 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 	"github.com/uber/cadence/service/worker/shadower"
+	"github.com/uber/cadence/service/worker/signalgateway"
+	"github.com/uber/cadence/service/worker/stuckworkflow"
 	"github.com/uber/cadence/service/worker/watchdog"
 )
 
@@ -74,6 +78,10 @@ type (
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
 		WatchdogConfig                      *watchdog.Config
+		ConfigDriftCfg                      *configdrift.Config
+		BlobReencoderCfg                    *blobreencoder.Config
+		StuckWorkflowDetectorCfg            *stuckworkflow.Config
+		SignalGatewayCfg                    *signalgateway.Config
 		failoverManagerCfg                  *failovermanager.Config
 		ThrottledLogRPS                     dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
@@ -86,6 +94,10 @@ type (
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
 		EnableWatchDog                      dynamicconfig.BoolPropertyFn
+		EnableConfigDriftDetection          dynamicconfig.BoolPropertyFn
+		EnableBlobReencoder                 dynamicconfig.BoolPropertyFn
+		EnableSignalGateway                 dynamicconfig.BoolPropertyFn
+		EnableStuckWorkflowDetection        dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -176,11 +188,33 @@ func NewConfig(params *resource.Params) *Config {
 		WatchdogConfig: &watchdog.Config{
 			CorruptWorkflowWatchdogPause: dc.GetBoolProperty(dynamicconfig.CorruptWorkflowWatchdogPause),
 		},
+		ConfigDriftCfg: &configdrift.Config{
+			DetectionInterval: dc.GetDurationProperty(dynamicconfig.DynamicConfigDriftDetectionInterval),
+		},
+		BlobReencoderCfg: &blobreencoder.Config{
+			Interval: dc.GetDurationProperty(dynamicconfig.WorkerBlobReencoderInterval),
+			RPS:      dc.GetIntProperty(dynamicconfig.WorkerBlobReencoderRPS),
+		},
+		StuckWorkflowDetectorCfg: &stuckworkflow.Config{
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+			Interval:         dc.GetDurationProperty(dynamicconfig.WorkerStuckWorkflowDetectionInterval),
+			RPS:              dc.GetIntProperty(dynamicconfig.WorkerStuckWorkflowDetectionRPS),
+			Threshold:        dc.GetDurationPropertyFilteredByDomain(dynamicconfig.WorkerStuckWorkflowThreshold),
+			AlertEnabled:     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkerStuckWorkflowAlertEnabled),
+		},
+		SignalGatewayCfg: &signalgateway.Config{
+			Concurrency:      dc.GetIntProperty(dynamicconfig.WorkerSignalGatewayConcurrency),
+			MaxRetryDuration: dc.GetDurationProperty(dynamicconfig.WorkerSignalGatewayMaxRetryDuration),
+		},
 		EnableBatcher:                       dc.GetBoolProperty(dynamicconfig.EnableBatcher),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer),
 		EnableWatchDog:                      dc.GetBoolProperty(dynamicconfig.EnableWatchDog),
+		EnableConfigDriftDetection:          dc.GetBoolProperty(dynamicconfig.EnableDynamicConfigDriftDetection),
+		EnableBlobReencoder:                 dc.GetBoolProperty(dynamicconfig.EnableBlobReencoder),
+		EnableSignalGateway:                 dc.GetBoolProperty(dynamicconfig.EnableSignalGateway),
+		EnableStuckWorkflowDetection:        dc.GetBoolProperty(dynamicconfig.EnableStuckWorkflowDetection),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicconfig.EnableFailoverManager),
 		EnableWorkflowShadower:              dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS),
@@ -243,6 +277,18 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableConfigDriftDetection() {
+		s.startConfigDriftDetector()
+	}
+	if s.config.EnableSignalGateway() {
+		s.startSignalGateway()
+	}
+	if s.config.EnableBlobReencoder() && s.isSQLDefaultStore() {
+		s.startBlobReencoder()
+	}
+	if s.config.EnableStuckWorkflowDetection() {
+		s.startStuckWorkflowDetector()
+	}
 	if s.config.EnableWorkflowShadower() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
@@ -320,6 +366,68 @@ func (s *Service) startWatchDog() {
 	}
 }
 
+func (s *Service) startConfigDriftDetector() {
+	detector := configdrift.New(
+		s.config.ConfigDriftCfg,
+		s.GetClusterMetadata(),
+		s.GetClientBean(),
+		s.params.DynamicConfig,
+		s.GetMembershipResolver(),
+		s.GetHostInfo(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+	)
+	detector.Start()
+}
+
+func (s *Service) startBlobReencoder() {
+	reencoder := blobreencoder.New(
+		s.config.BlobReencoderCfg,
+		s.GetDomainManager(),
+		s.GetMembershipResolver(),
+		s.GetHostInfo(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+	)
+	reencoder.Start()
+}
+
+func (s *Service) startStuckWorkflowDetector() {
+	detector := stuckworkflow.New(
+		s.config.StuckWorkflowDetectorCfg,
+		s.GetExecutionManager,
+		s.GetDomainCache(),
+		s.GetClusterMetadata().GetCurrentClusterName(),
+		s.GetMembershipResolver(),
+		s.GetHostInfo(),
+		s.GetTimeSource(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+	)
+	detector.Start()
+}
+
+// isSQLDefaultStore returns whether domains are stored in a sql store,
+// which is the only store encoding domain records with the sql blob encodings
+func (s *Service) isSQLDefaultStore() bool {
+	store, ok := s.params.PersistenceConfig.DataStores[s.params.PersistenceConfig.DefaultStore]
+	return ok && store.SQL != nil
+}
+
+func (s *Service) startSignalGateway() {
+	gateway := signalgateway.New(
+		s.config.SignalGatewayCfg,
+		s.GetMessagingClient(),
+		s.GetFrontendClient(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+	)
+	if err := gateway.Start(); err != nil {
+		gateway.Stop()
+		s.GetLogger().Fatal("fail to start signal gateway", tag.Error(err))
+	}
+}
+
 func (s *Service) startBatcher() {
 	params := &batcher.BootstrapParams{
 		Config:        *s.config.BatcherCfg,
@@ -0,0 +1,278 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package signalgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	consumerName   = common.SignalGatewayAppName + "-consumer"
+	identity       = "cadence-signal-gateway"
+	requestTimeout = 10 * time.Second
+)
+
+type (
+	// Config is the config for the signal gateway
+	Config struct {
+		Concurrency      dynamicconfig.IntPropertyFn
+		MaxRetryDuration dynamicconfig.DurationPropertyFn
+	}
+
+	// SignalMessage is the JSON encoded kafka message consumed by the signal gateway.
+	// Messages with the same domain and workflowID are delivered in the order they are consumed,
+	// so producers have to publish them to the same partition, e.g. by keying them with the workflowID.
+	SignalMessage struct {
+		Domain     string `json:"domain"`
+		WorkflowID string `json:"workflowID"`
+		RunID      string `json:"runID,omitempty"`
+		SignalName string `json:"signalName"`
+		Input      []byte `json:"input,omitempty"`
+		// DedupID is used as the request ID of the signal, so that redelivered messages are not signaled twice.
+		// If it is empty, an ID derived from the partition and offset of the message is used.
+		DedupID string `json:"dedupID,omitempty"`
+	}
+
+	// Gateway consumes signal messages from kafka and delivers them through SignalWorkflowExecution.
+	// Signals failing with a non retryable error, or after retrying for MaxRetryDuration, are sent to the DLQ topic.
+	Gateway struct {
+		status         int32
+		config         *Config
+		kafkaClient    messaging.Client
+		frontendClient frontend.Client
+		logger         log.Logger
+		metricsScope   metrics.Scope
+		consumer       messaging.Consumer
+		shutdownCh     chan struct{}
+		shutdownWG     sync.WaitGroup
+	}
+
+	signalTask struct {
+		msg    messaging.Message
+		signal *SignalMessage
+	}
+)
+
+// New creates a new signal gateway
+func New(
+	config *Config,
+	kafkaClient messaging.Client,
+	frontendClient frontend.Client,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *Gateway {
+	return &Gateway{
+		status:         common.DaemonStatusInitialized,
+		config:         config,
+		kafkaClient:    kafkaClient,
+		frontendClient: frontendClient,
+		logger:         logger.WithTags(tag.ComponentSignalGateway),
+		metricsScope:   metricsClient.Scope(metrics.SignalGatewayScope),
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+// Start starts consuming signal messages
+func (g *Gateway) Start() error {
+	if !atomic.CompareAndSwapInt32(&g.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	g.logger.Info("Signal gateway state changed", tag.LifeCycleStarting)
+	consumer, err := g.kafkaClient.NewConsumer(common.SignalGatewayAppName, consumerName)
+	if err != nil {
+		g.logger.Info("Signal gateway state changed", tag.LifeCycleStartFailed, tag.Error(err))
+		return err
+	}
+	if err := consumer.Start(); err != nil {
+		g.logger.Info("Signal gateway state changed", tag.LifeCycleStartFailed, tag.Error(err))
+		return err
+	}
+	g.consumer = consumer
+
+	g.shutdownWG.Add(1)
+	go g.dispatchLoop()
+
+	g.logger.Info("Signal gateway state changed", tag.LifeCycleStarted)
+	return nil
+}
+
+// Stop stops the gateway, messages which are not delivered yet are redelivered after restart
+func (g *Gateway) Stop() {
+	if !atomic.CompareAndSwapInt32(&g.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	g.logger.Info("Signal gateway state changed", tag.LifeCycleStopping)
+	defer g.logger.Info("Signal gateway state changed", tag.LifeCycleStopped)
+
+	close(g.shutdownCh)
+	if success := common.AwaitWaitGroup(&g.shutdownWG, time.Minute); !success {
+		g.logger.Info("Signal gateway state changed", tag.LifeCycleStopTimedout)
+	}
+	if g.consumer != nil {
+		g.consumer.Stop()
+	}
+}
+
+// dispatchLoop routes every message to a worker picked by its workflow, so that signals
+// to the same workflow are delivered one at a time and in order
+func (g *Gateway) dispatchLoop() {
+	defer g.shutdownWG.Done()
+
+	concurrency := common.MaxInt(1, g.config.Concurrency())
+	workerChs := make([]chan signalTask, concurrency)
+	var workerWG sync.WaitGroup
+	for i := range workerChs {
+		workerChs[i] = make(chan signalTask, 1)
+		workerWG.Add(1)
+		go g.deliverLoop(workerChs[i], &workerWG)
+	}
+	defer func() {
+		for _, ch := range workerChs {
+			close(ch)
+		}
+		workerWG.Wait()
+	}()
+
+	for {
+		select {
+		case <-g.shutdownCh:
+			return
+		case msg, ok := <-g.consumer.Messages():
+			if !ok {
+				g.logger.Info("Signal gateway consumer closed")
+				return
+			}
+			signal, err := decodeSignalMessage(msg)
+			if err != nil {
+				g.logger.Error("Failed to decode signal message", tag.KafkaPartition(msg.Partition()),
+					tag.KafkaOffset(msg.Offset()), tag.Error(err))
+				g.metricsScope.IncCounter(metrics.SignalGatewayCorruptedMessages)
+				g.nackMessage(msg)
+				continue
+			}
+			select {
+			case workerChs[getWorkerIndex(signal, concurrency)] <- signalTask{msg: msg, signal: signal}:
+			case <-g.shutdownCh:
+				return
+			}
+		}
+	}
+}
+
+func (g *Gateway) deliverLoop(taskCh <-chan signalTask, workerWG *sync.WaitGroup) {
+	defer workerWG.Done()
+
+	for task := range taskCh {
+		sw := g.metricsScope.StartTimer(metrics.SignalGatewayDeliveryLatency)
+		err := g.deliverSignal(task.signal)
+		sw.Stop()
+		if err != nil {
+			g.logger.Error("Failed to deliver signal, sending it to DLQ",
+				tag.WorkflowDomainName(task.signal.Domain),
+				tag.WorkflowID(task.signal.WorkflowID),
+				tag.Error(err))
+			g.nackMessage(task.msg)
+			continue
+		}
+		g.metricsScope.IncCounter(metrics.SignalGatewaySignalsDelivered)
+		if err := task.msg.Ack(); err != nil {
+			g.logger.Warn("Failed to ack signal message", tag.Error(err))
+		}
+	}
+}
+
+func (g *Gateway) deliverSignal(signal *SignalMessage) error {
+	policy := backoff.NewExponentialRetryPolicy(100 * time.Millisecond)
+	policy.SetMaximumInterval(10 * time.Second)
+	policy.SetExpirationInterval(g.config.MaxRetryDuration())
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(policy),
+		backoff.WithRetryableError(common.IsServiceTransientError),
+	)
+
+	return throttleRetry.Do(context.Background(), func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		return g.frontendClient.SignalWorkflowExecution(ctx, newSignalRequest(signal))
+	})
+}
+
+func (g *Gateway) nackMessage(msg messaging.Message) {
+	g.metricsScope.IncCounter(metrics.SignalGatewayMessagesSentToDLQ)
+	if err := msg.Nack(); err != nil {
+		g.logger.Error("Failed to send signal message to DLQ", tag.KafkaPartition(msg.Partition()),
+			tag.KafkaOffset(msg.Offset()), tag.Error(err))
+	}
+}
+
+func decodeSignalMessage(msg messaging.Message) (*SignalMessage, error) {
+	var signal SignalMessage
+	if err := json.Unmarshal(msg.Value(), &signal); err != nil {
+		return nil, err
+	}
+	if signal.Domain == "" || signal.WorkflowID == "" || signal.SignalName == "" {
+		return nil, fmt.Errorf("domain, workflowID and signalName are required, got %+v", signal)
+	}
+	if signal.DedupID == "" {
+		signal.DedupID = uuid.NewSHA1(uuid.NameSpace_OID, []byte(fmt.Sprintf("%v/%v/%v", consumerName, msg.Partition(), msg.Offset()))).String()
+	}
+	return &signal, nil
+}
+
+func newSignalRequest(signal *SignalMessage) *types.SignalWorkflowExecutionRequest {
+	return &types.SignalWorkflowExecutionRequest{
+		Domain: signal.Domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: signal.WorkflowID,
+			RunID:      signal.RunID,
+		},
+		SignalName: signal.SignalName,
+		Input:      signal.Input,
+		Identity:   identity,
+		RequestID:  signal.DedupID,
+	}
+}
+
+func getWorkerIndex(signal *SignalMessage, concurrency int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(signal.Domain + "/" + signal.WorkflowID))
+	return int(h.Sum32() % uint32(concurrency))
+}
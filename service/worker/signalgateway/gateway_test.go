@@ -0,0 +1,169 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package signalgateway
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/messaging/mocks"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	testKafkaClient struct {
+		consumer *testConsumer
+	}
+
+	testConsumer struct {
+		msgCh chan messaging.Message
+	}
+)
+
+func (c *testKafkaClient) NewConsumer(appName, consumerName string) (messaging.Consumer, error) {
+	return c.consumer, nil
+}
+
+func (c *testKafkaClient) NewProducer(appName string) (messaging.Producer, error) {
+	return nil, nil
+}
+
+func (c *testConsumer) Start() error                       { return nil }
+func (c *testConsumer) Stop()                              {}
+func (c *testConsumer) Messages() <-chan messaging.Message { return c.msgCh }
+
+func TestGateway(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	frontendClient := frontend.NewMockClient(ctrl)
+	consumer := &testConsumer{msgCh: make(chan messaging.Message)}
+	gateway := New(
+		&Config{
+			Concurrency:      dynamicconfig.GetIntPropertyFn(4),
+			MaxRetryDuration: dynamicconfig.GetDurationPropertyFn(time.Second),
+		},
+		&testKafkaClient{consumer: consumer},
+		frontendClient,
+		log.NewNoop(),
+		metrics.NewClient(tally.NewTestScope("", nil), metrics.Worker),
+	)
+	require.NoError(t, gateway.Start())
+	defer gateway.Stop()
+
+	newMessage := func(value []byte, offset int64, acked bool) (*mocks.Message, chan struct{}) {
+		done := make(chan struct{})
+		msg := &mocks.Message{}
+		msg.On("Value").Return(value)
+		msg.On("Partition").Return(int32(0))
+		msg.On("Offset").Return(offset)
+		method := "Nack"
+		if acked {
+			method = "Ack"
+		}
+		msg.On(method).Return(nil).Run(func(_ mock.Arguments) { close(done) })
+		return msg, done
+	}
+	waitFor := func(done chan struct{}) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("message was not processed")
+		}
+	}
+
+	// delivered signals are acked
+	value, err := json.Marshal(SignalMessage{
+		Domain:     "test-domain",
+		WorkflowID: "wid",
+		SignalName: "test-signal",
+		Input:      []byte("input"),
+		DedupID:    "dedup-id",
+	})
+	require.NoError(t, err)
+	frontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.SignalWorkflowExecutionRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+		SignalName:        "test-signal",
+		Input:             []byte("input"),
+		Identity:          identity,
+		RequestID:         "dedup-id",
+	}).Return(&types.InternalServiceError{}).Times(1)
+	frontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	msg, done := newMessage(value, 1, true)
+	consumer.msgCh <- msg
+	waitFor(done)
+
+	// corrupted messages are sent to DLQ
+	msg, done = newMessage([]byte("not json"), 2, false)
+	consumer.msgCh <- msg
+	waitFor(done)
+
+	// signals failing with non retryable errors are sent to DLQ
+	value, err = json.Marshal(SignalMessage{Domain: "test-domain", WorkflowID: "wid", SignalName: "test-signal"})
+	require.NoError(t, err)
+	frontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{}).Times(1)
+	msg, done = newMessage(value, 3, false)
+	consumer.msgCh <- msg
+	waitFor(done)
+}
+
+func TestDecodeSignalMessage(t *testing.T) {
+	newMessage := func(value string, offset int64) *mocks.Message {
+		msg := &mocks.Message{}
+		msg.On("Value").Return([]byte(value))
+		msg.On("Partition").Return(int32(1))
+		msg.On("Offset").Return(offset)
+		return msg
+	}
+
+	signal, err := decodeSignalMessage(newMessage(`{"domain":"d","workflowID":"w","signalName":"s"}`, 10))
+	require.NoError(t, err)
+	assert.NotEmpty(t, signal.DedupID)
+	redelivered, err := decodeSignalMessage(newMessage(`{"domain":"d","workflowID":"w","signalName":"s"}`, 10))
+	require.NoError(t, err)
+	assert.Equal(t, signal.DedupID, redelivered.DedupID)
+	next, err := decodeSignalMessage(newMessage(`{"domain":"d","workflowID":"w","signalName":"s"}`, 11))
+	require.NoError(t, err)
+	assert.NotEqual(t, signal.DedupID, next.DedupID)
+
+	_, err = decodeSignalMessage(newMessage(`{"domain":"d","workflowID":"w"}`, 12))
+	assert.Error(t, err)
+}
+
+func TestGetWorkerIndex(t *testing.T) {
+	signal := &SignalMessage{Domain: "d", WorkflowID: "w"}
+	index := getWorkerIndex(signal, 8)
+	assert.True(t, index >= 0 && index < 8)
+	assert.Equal(t, index, getWorkerIndex(&SignalMessage{Domain: "d", WorkflowID: "w", SignalName: "other"}, 8))
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stuckworkflow
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ownershipKey is used to pick a single worker host running the detector
+	ownershipKey = "stuck-workflow-detector"
+	listPageSize = 100
+)
+
+type (
+	// Config is the config for the stuck workflow detector
+	Config struct {
+		NumHistoryShards int
+		Interval         dynamicconfig.DurationPropertyFn
+		RPS              dynamicconfig.IntPropertyFn
+		Threshold        dynamicconfig.DurationPropertyFnWithDomainFilter
+		AlertEnabled     dynamicconfig.BoolPropertyFnWithDomainFilter
+	}
+
+	// ExecutionManagerProvider returns the execution manager of a shard
+	ExecutionManagerProvider func(shardID int) (persistence.ExecutionManager, error)
+
+	// Detector periodically scans the open workflows of all shards and reports the ones that are
+	// silently stalled: no state transition for longer than the threshold of their domain, no pending
+	// activity scheduled, started or heartbeating within the threshold and no user timer due in the future.
+	// Stuck workflows are counted per domain by the stuck_workflows gauge, and logged when they become stuck.
+	Detector struct {
+		status                   int32
+		config                   *Config
+		executionManagerProvider ExecutionManagerProvider
+		domainCache              cache.DomainCache
+		currentCluster           string
+		membershipResolver       membership.Resolver
+		hostInfo                 membership.HostInfo
+		timeSource               clock.TimeSource
+		logger                   log.Logger
+		metricsScope             metrics.Scope
+		ctx                      context.Context
+		cancel                   context.CancelFunc
+		shutdownCh               chan struct{}
+
+		// workflows reported by the last run, used to only alert on newly stuck workflows
+		lastReported map[types.WorkflowExecution]struct{}
+	}
+
+	// stuckWorkflow is a workflow found stuck by a detection run
+	stuckWorkflow struct {
+		domain          string
+		execution       types.WorkflowExecution
+		workflowType    string
+		lastUpdatedTime time.Time
+	}
+)
+
+// New creates a new stuck workflow detector
+func New(
+	config *Config,
+	executionManagerProvider ExecutionManagerProvider,
+	domainCache cache.DomainCache,
+	currentCluster string,
+	membershipResolver membership.Resolver,
+	hostInfo membership.HostInfo,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *Detector {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Detector{
+		status:                   common.DaemonStatusInitialized,
+		config:                   config,
+		executionManagerProvider: executionManagerProvider,
+		domainCache:              domainCache,
+		currentCluster:           currentCluster,
+		membershipResolver:       membershipResolver,
+		hostInfo:                 hostInfo,
+		timeSource:               timeSource,
+		logger:                   logger.WithTags(tag.ComponentStuckWorkflowDetector),
+		metricsScope:             metricsClient.Scope(metrics.StuckWorkflowDetectorScope),
+		ctx:                      ctx,
+		cancel:                   cancel,
+		shutdownCh:               make(chan struct{}),
+		lastReported:             make(map[types.WorkflowExecution]struct{}),
+	}
+}
+
+// Start starts the detector
+func (d *Detector) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go d.detectLoop()
+	d.logger.Info("stuck workflow detector started")
+}
+
+// Stop stops the detector
+func (d *Detector) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	d.cancel()
+	close(d.shutdownCh)
+	d.logger.Info("stuck workflow detector stopped")
+}
+
+func (d *Detector) detectLoop() {
+	timer := time.NewTimer(d.config.Interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if d.isOwner() {
+				d.detect(d.ctx)
+			}
+			timer.Reset(d.config.Interval())
+		case <-d.shutdownCh:
+			return
+		}
+	}
+}
+
+func (d *Detector) isOwner() bool {
+	info, err := d.membershipResolver.Lookup(service.Worker, ownershipKey)
+	if err != nil {
+		d.logger.Info("Failed to lookup host info. Skip current run.", tag.Error(err))
+		return false
+	}
+	return info.Identity() == d.hostInfo.Identity()
+}
+
+func (d *Detector) detect(ctx context.Context) {
+	sw := d.metricsScope.StartTimer(metrics.StuckWorkflowDetectionLatency)
+	defer sw.Stop()
+
+	workflows, err := d.scan(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.logger.Warn("Failed to detect stuck workflows", tag.Error(err))
+			d.metricsScope.IncCounter(metrics.StuckWorkflowDetectionFailures)
+		}
+		return
+	}
+
+	d.emit(workflows)
+	d.alert(workflows)
+}
+
+func (d *Detector) scan(ctx context.Context) ([]*stuckWorkflow, error) {
+	limiter := quotas.NewDynamicRateLimiter(func() float64 {
+		return float64(d.config.RPS())
+	})
+
+	var workflows []*stuckWorkflow
+	for shardID := 0; shardID < d.config.NumHistoryShards; shardID++ {
+		shardWorkflows, err := d.scanShard(ctx, shardID, limiter)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, shardWorkflows...)
+	}
+	return workflows, nil
+}
+
+func (d *Detector) scanShard(
+	ctx context.Context,
+	shardID int,
+	limiter quotas.Limiter,
+) ([]*stuckWorkflow, error) {
+
+	executionManager, err := d.executionManagerProvider(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var workflows []*stuckWorkflow
+	request := &persistence.ListConcreteExecutionsRequest{PageSize: listPageSize}
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := executionManager.ListConcreteExecutions(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range resp.Executions {
+			info := execution.ExecutionInfo
+			if info == nil || (info.State != persistence.WorkflowStateCreated && info.State != persistence.WorkflowStateRunning) {
+				continue
+			}
+			domainName, threshold, ok := d.getThreshold(info.DomainID)
+			if !ok || d.timeSource.Now().Sub(info.LastUpdatedTimestamp) < threshold {
+				continue
+			}
+
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			ms, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+				DomainID:  info.DomainID,
+				Execution: types.WorkflowExecution{WorkflowID: info.WorkflowID, RunID: info.RunID},
+			})
+			if err != nil {
+				if _, ok := err.(*types.EntityNotExistsError); ok {
+					// the execution was deleted since it was listed
+					continue
+				}
+				return nil, err
+			}
+			if !isStuck(ms.State, d.timeSource.Now(), threshold) {
+				continue
+			}
+
+			info = ms.State.ExecutionInfo
+			workflows = append(workflows, &stuckWorkflow{
+				domain:          domainName,
+				execution:       types.WorkflowExecution{WorkflowID: info.WorkflowID, RunID: info.RunID},
+				workflowType:    info.WorkflowTypeName,
+				lastUpdatedTime: info.LastUpdatedTimestamp,
+			})
+		}
+		if len(resp.PageToken) == 0 {
+			return workflows, nil
+		}
+		request.PageToken = resp.PageToken
+	}
+}
+
+// getThreshold returns the name and stuck threshold of a domain, workflows are only checked
+// in domains active in the current cluster and with a positive threshold
+func (d *Detector) getThreshold(domainID string) (string, time.Duration, bool) {
+	entry, err := d.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return "", 0, false
+	}
+	if active, _ := entry.IsActiveIn(d.currentCluster); !active {
+		return "", 0, false
+	}
+	domainName := entry.GetInfo().Name
+	threshold := d.config.Threshold(domainName)
+	return domainName, threshold, threshold > 0
+}
+
+// isStuck returns whether the workflow made no progress within the threshold and is not
+// waiting for anything that will make it progress without outside intervention
+func isStuck(
+	ms *persistence.WorkflowMutableState,
+	now time.Time,
+	threshold time.Duration,
+) bool {
+	cutoff := now.Add(-threshold)
+	if ms.ExecutionInfo.LastUpdatedTimestamp.After(cutoff) {
+		return false
+	}
+	for _, timerInfo := range ms.TimerInfos {
+		if timerInfo.ExpiryTime.After(now) {
+			return false
+		}
+	}
+	for _, activityInfo := range ms.ActivityInfos {
+		if activityInfo.ScheduledTime.After(cutoff) ||
+			activityInfo.StartedTime.After(cutoff) ||
+			activityInfo.LastHeartBeatUpdatedTime.After(cutoff) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *Detector) emit(workflows []*stuckWorkflow) {
+	counts := make(map[string]int)
+	for _, workflow := range workflows {
+		counts[workflow.domain]++
+	}
+	// domains without stuck workflows are emitted as well, so that the gauge drops back to 0
+	for _, entry := range d.domainCache.GetAllDomain() {
+		domainName := entry.GetInfo().Name
+		if d.config.Threshold(domainName) <= 0 {
+			continue
+		}
+		d.metricsScope.Tagged(metrics.DomainTag(domainName)).UpdateGauge(metrics.StuckWorkflowsGauge, float64(counts[domainName]))
+	}
+}
+
+func (d *Detector) alert(workflows []*stuckWorkflow) {
+	reported := make(map[types.WorkflowExecution]struct{}, len(workflows))
+	for _, workflow := range workflows {
+		reported[workflow.execution] = struct{}{}
+		if _, ok := d.lastReported[workflow.execution]; ok || !d.config.AlertEnabled(workflow.domain) {
+			continue
+		}
+		d.logger.Warn("Workflow is stuck",
+			tag.WorkflowDomainName(workflow.domain),
+			tag.WorkflowID(workflow.execution.WorkflowID),
+			tag.WorkflowRunID(workflow.execution.RunID),
+			tag.WorkflowType(workflow.workflowType),
+			tag.Timestamp(workflow.lastUpdatedTime),
+		)
+		d.metricsScope.Tagged(metrics.DomainTag(workflow.domain)).IncCounter(metrics.StuckWorkflowAlerts)
+	}
+	d.lastReported = reported
+}
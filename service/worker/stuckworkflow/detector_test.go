@@ -0,0 +1,170 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stuckworkflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDetector_Detect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Unix(1000000, 0)
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(now)
+	stale := now.Add(-2 * time.Hour)
+
+	activeDomain := cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-a-id", Name: "domain-a"}, &persistence.DomainConfig{}, "active")
+	passiveDomain := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-b-id", Name: "domain-b"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{ActiveClusterName: "standby"},
+		0,
+	)
+	noThresholdDomain := cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-c-id", Name: "domain-c"}, &persistence.DomainConfig{}, "active")
+	domainCache := cache.NewMockDomainCache(ctrl)
+	domainCache.EXPECT().GetDomainByID("domain-a-id").Return(activeDomain, nil).AnyTimes()
+	domainCache.EXPECT().GetDomainByID("domain-b-id").Return(passiveDomain, nil).AnyTimes()
+	domainCache.EXPECT().GetDomainByID("domain-c-id").Return(noThresholdDomain, nil).AnyTimes()
+	domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"domain-a": activeDomain,
+		"domain-b": passiveDomain,
+		"domain-c": noThresholdDomain,
+	}).AnyTimes()
+
+	newExecution := func(domainID, workflowID string, state int, lastUpdated time.Time) *persistence.ListConcreteExecutionsEntity {
+		return &persistence.ListConcreteExecutionsEntity{ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:             domainID,
+			WorkflowID:           workflowID,
+			RunID:                workflowID + "-run",
+			State:                state,
+			LastUpdatedTimestamp: lastUpdated,
+		}}
+	}
+	executionManager := &mocks.ExecutionManager{}
+	executionManager.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: listPageSize}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{
+				newExecution("domain-a-id", "stuck", persistence.WorkflowStateRunning, stale),
+				newExecution("domain-a-id", "waiting-for-timer", persistence.WorkflowStateRunning, stale),
+				newExecution("domain-a-id", "heartbeating", persistence.WorkflowStateRunning, stale),
+				newExecution("domain-a-id", "recently-updated", persistence.WorkflowStateRunning, now.Add(-10*time.Minute)),
+				newExecution("domain-a-id", "completed", persistence.WorkflowStateCompleted, stale),
+				newExecution("domain-b-id", "passive", persistence.WorkflowStateRunning, stale),
+				newExecution("domain-c-id", "no-threshold", persistence.WorkflowStateRunning, stale),
+			},
+		}, nil).Times(3)
+	mutableStates := map[string]*persistence.WorkflowMutableState{
+		"stuck": {
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				WorkflowID: "stuck", RunID: "stuck-run", WorkflowTypeName: "type", LastUpdatedTimestamp: stale, DecisionScheduleID: 5,
+			},
+			ActivityInfos:       map[int64]*persistence.ActivityInfo{3: {ScheduledTime: stale, StartedTime: stale}},
+			TimerInfos:          map[string]*persistence.TimerInfo{"fired": {ExpiryTime: stale}},
+			ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{4: {}},
+		},
+		"waiting-for-timer": {
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{WorkflowID: "waiting-for-timer", LastUpdatedTimestamp: stale, DecisionScheduleID: common.EmptyEventID},
+			TimerInfos:    map[string]*persistence.TimerInfo{"timer": {ExpiryTime: now.Add(time.Hour)}},
+		},
+		"heartbeating": {
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{WorkflowID: "heartbeating", LastUpdatedTimestamp: stale, DecisionScheduleID: common.EmptyEventID},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{3: {ScheduledTime: stale, StartedTime: stale, LastHeartBeatUpdatedTime: now.Add(-time.Minute)}},
+		},
+	}
+	for workflowID, state := range mutableStates {
+		workflowID := workflowID
+		executionManager.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+			return req.Execution.WorkflowID == workflowID
+		})).Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Times(3)
+	}
+
+	scope := tally.NewTestScope("", nil)
+	detector := New(
+		&Config{
+			NumHistoryShards: 1,
+			Interval:         dynamicconfig.GetDurationPropertyFn(time.Hour),
+			RPS:              dynamicconfig.GetIntPropertyFn(1000),
+			Threshold: func(domain string) time.Duration {
+				if domain == "domain-c" {
+					return 0
+				}
+				return time.Hour
+			},
+			AlertEnabled: dynamicconfig.GetBoolPropertyFnFilteredByDomain(true),
+		},
+		func(shardID int) (persistence.ExecutionManager, error) {
+			return executionManager, nil
+		},
+		domainCache,
+		"active",
+		membership.NewMockResolver(ctrl),
+		membership.NewHostInfo("host"),
+		timeSource,
+		log.NewNoop(),
+		metrics.NewClient(scope, metrics.Worker),
+	)
+	workflows, err := detector.scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*stuckWorkflow{
+		{
+			domain:          "domain-a",
+			execution:       types.WorkflowExecution{WorkflowID: "stuck", RunID: "stuck-run"},
+			workflowType:    "type",
+			lastUpdatedTime: stale,
+		},
+	}, workflows)
+
+	detector.detect(context.Background())
+	// still stuck on the next run, but only alerted once
+	detector.detect(context.Background())
+	executionManager.AssertExpectations(t)
+
+	snapshot := scope.Snapshot()
+	gauge, ok := snapshot.Gauges()["stuck_workflows+domain=domain-a,operation=StuckWorkflowDetector"]
+	require.True(t, ok)
+	assert.Equal(t, float64(1), gauge.Value())
+	_, ok = snapshot.Gauges()["stuck_workflows+domain=domain-c,operation=StuckWorkflowDetector"]
+	assert.False(t, ok)
+	alerts, ok := snapshot.Counters()["stuck_workflow_alerts+domain=domain-a,operation=StuckWorkflowDetector"]
+	require.True(t, ok)
+	assert.Equal(t, int64(1), alerts.Value())
+}
@@ -143,6 +143,73 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminMaintainCorruptWorkflow(c)
 			},
 		},
+		{
+			Name:  "export",
+			Usage: "Export the complete history of a workflow run, and optionally its mutable state, to a versioned file",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file",
+				},
+				cli.BoolFlag{
+					Name:  FlagIncludeMutableStateWithAlias,
+					Usage: "Include the mutable state of the run in the export",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name: "rerun",
+			Usage: "Start a new run with the input of an exported workflow and re-deliver its signals in order. " +
+				"The exported history and mutable state are not imported, decisions and activities are re-executed " +
+				"by the workers of the target domain and timers, cancellations and child workflows are not replayed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "File created by the export command",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Optional WorkflowID of the new run, default to the exported WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Optional TaskList of the new run, default to the exported TaskList",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRerunWorkflow(c)
+			},
+		},
+		{
+			Name:    "diagnose",
+			Aliases: []string{"diag"},
+			Usage:   "Compares mutable state with the state rebuilt from history and reports differences",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				getFormatFlag(),
+			},
+			Action: func(c *cli.Context) {
+				AdminDiagnoseWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -217,6 +284,25 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminCloseShard(c)
 			},
 		},
+		{
+			Name:      "reload",
+			Usage:     "Unload a shard from the host owning it and wait until it is loaded again. The shard is loaded by the host owning it on the membership ring, which is usually the same host",
+			ArgsUsage: "<shard_id>",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardID, can also be given as the first argument",
+				},
+				cli.IntFlag{
+					Name:  FlagWaitTimeoutWithAlias,
+					Usage: "Seconds to wait for the shard to be loaded again",
+					Value: defaultShardWaitTimeoutInSeconds,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReloadShard(c)
+			},
+		},
 		{
 			Name:    "removeTask",
 			Aliases: []string{"rmtk"},
@@ -385,6 +471,14 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, true).UpdateDomain(c)
 			},
 		},
+		{
+			Name:  "clone",
+			Usage: "Register a new domain with the configuration of an existing domain, flags override the copied configuration",
+			Flags: adminCloneDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, true).CloneDomain(c)
+			},
+		},
 		{
 			Name:    "deprecate",
 			Aliases: []string{"dep"},
@@ -684,11 +778,54 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminListTaskList(c)
 			},
 		},
+		{
+			Name:  "drain",
+			Usage: "Mark tasklist as draining and wait until its backlog is empty, so that its workers can be decommissioned",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagWaitTimeoutWithAlias,
+					Usage: "Seconds to wait for the backlog to be drained",
+					Value: defaultTaskListDrainTimeoutInSeconds,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDrainTaskList(c)
+			},
+		},
 	}
 }
 
 func newAdminClusterCommands() []cli.Command {
 	return []cli.Command{
+		{
+			Name:    "replication-report",
+			Aliases: []string{"rr"},
+			Usage:   "Report replication lag of all shards per remote cluster, per domain and per shard",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagShards,
+					Usage: "Comma separated shard IDs or inclusive ranges. Example: \"0-16383\".  Alternatively, feed one shard ID per line via STDIN.",
+				},
+				cli.IntFlag{
+					Name:  FlagTopN,
+					Usage: "Number of worst domains and shards to report",
+					Value: defaultReplicationReportTopN,
+				},
+				getFormatFlag(),
+			),
+			Action: func(c *cli.Context) {
+				AdminReplicationReport(c)
+			},
+		},
 		{
 			Name:    "add-search-attr",
 			Aliases: []string{"asa"},
@@ -757,7 +894,7 @@ func getDLQFlags() []cli.Flag {
 		},
 		cli.StringFlag{
 			Name:  FlagDLQTypeWithAlias,
-			Usage: "Type of DLQ to manage. (Options: domain, history)",
+			Usage: "Type of DLQ to manage. (Options: domain, history, replication)",
 			Value: "history",
 		},
 		cli.StringFlag{
@@ -768,6 +905,26 @@ func getDLQFlags() []cli.Flag {
 			Name:  FlagLastMessageIDWithAlias,
 			Usage: "The upper boundary of the read message",
 		},
+		cli.StringFlag{
+			Name:  FlagDomain,
+			Usage: "Only include messages of the domain with this name",
+		},
+		cli.StringFlag{
+			Name:  FlagDomainID,
+			Usage: "Only include messages of the domain with this ID",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "Only include messages of this WorkflowID",
+		},
+		cli.StringFlag{
+			Name:  FlagEarliestTimeWithAlias,
+			Usage: "Only include messages created at or after this time. Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+		},
+		cli.StringFlag{
+			Name:  FlagLatestTimeWithAlias,
+			Usage: "Only include messages created at or before this time. Supports the same formats as earliest_time.",
+		},
 	}
 }
 
@@ -781,7 +938,7 @@ func newAdminDLQCommands() []cli.Command {
 				getFormatFlag(),
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: domain, history)",
+					Usage: "Type of DLQ to manage. (Options: domain, history, replication)",
 					Value: "history",
 				},
 				cli.BoolFlag{
@@ -811,8 +968,8 @@ func newAdminDLQCommands() []cli.Command {
 		{
 			Name:    "purge",
 			Aliases: []string{"p"},
-			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id",
-			Flags:   getDLQFlags(),
+			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id. With filters, only the leading messages matching them are deleted, the other matching messages are reported as skipped",
+			Flags:   append(getDLQFlags(), getFormatFlag()),
 			Action: func(c *cli.Context) {
 				AdminPurgeDLQMessages(c)
 			},
@@ -820,8 +977,8 @@ func newAdminDLQCommands() []cli.Command {
 		{
 			Name:    "merge",
 			Aliases: []string{"m"},
-			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id",
-			Flags:   getDLQFlags(),
+			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id. With filters, only the leading messages matching them are merged, the other matching messages are reported as skipped",
+			Flags:   append(getDLQFlags(), getFormatFlag()),
 			Action: func(c *cli.Context) {
 				AdminMergeDLQMessages(c)
 			},
@@ -934,6 +1091,65 @@ func newDBCommands() []cli.Command {
 				AdminDBClean(c)
 			},
 		},
+		{
+			Name:  "size-report",
+			Usage: "scan executions in database and report the largest histories and mutable states",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagShards,
+					Usage: "Comma separated shard IDs or inclusive ranges. Example: \"0-16383\".  Alternatively, feed one shard ID per line via STDIN.",
+				},
+				cli.Float64Flag{
+					Name:  FlagSampleRate,
+					Usage: "Fraction of executions to read the size of, in (0, 1]",
+					Value: 1,
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of database requests per second",
+					Value: defaultSizeReportRPS,
+				},
+				cli.IntFlag{
+					Name:  FlagTopN,
+					Usage: "Number of largest executions to report",
+					Value: defaultSizeReportTopN,
+				},
+				getFormatFlag(),
+			),
+			Action: func(c *cli.Context) {
+				AdminDBSizeReport(c)
+			},
+		},
+		{
+			Name:  "decode",
+			Usage: "decode a blob of any persistence table and print it as JSON",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:   FlagInputWithAlias,
+					EnvVar: "Input",
+					Usage:  "Input of the encoded blob.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputEncodingWithAlias,
+					Usage: "Encoding of the input: [hex|base64] (Default: hex)",
+				},
+				cli.StringFlag{
+					Name:  FlagBlobEncoding,
+					Usage: "Optional encoding of the blob: [thriftrw|proto3|json], default is to try all of them",
+				},
+				cli.StringFlag{
+					Name:  FlagBlobType,
+					Usage: "Optional type of the blob, e.g. WorkflowExecutionInfo or HistoryEvents, default is to try all known types",
+				},
+				cli.StringFlag{
+					Name:  FlagCompression,
+					Usage: "Optional compression of the blob: [gzip|zlib]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBDecode(c)
+			},
+		},
 		{
 			Name:  "decode_thrift",
 			Usage: "decode thrift object, print into JSON if the data is matching with any supported struct",
@@ -1022,6 +1238,10 @@ func newAdminFailoverCommands() []cli.Command {
 					Usage: "Optional cron schedule on failover drill. Please specify failover drill wait time " +
 						"if this field is specific",
 				},
+				cli.BoolFlag{
+					Name:  FlagFollow,
+					Usage: "Optional stream the failover progress until the failover workflow is closed",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminFailoverStart(c)
@@ -1108,6 +1328,24 @@ func newAdminFailoverCommands() []cli.Command {
 				AdminFailoverAbort(c)
 			},
 		},
+		{
+			Name:    "watch",
+			Aliases: []string{"w"},
+			Usage:   "stream the progress of the failover workflow until it is closed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional Failover workflow runID, default is latest runID",
+				},
+				cli.BoolFlag{
+					Name:  FlagFailoverDrillWithAlias,
+					Usage: "Optional to watch the failover drill workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverWatch(c)
+			},
+		},
 		{
 			Name:    "rollback",
 			Aliases: []string{"ro"},
@@ -1136,6 +1374,10 @@ func newAdminFailoverCommands() []cli.Command {
 					Usage: "Optional number of domains to failover in one batch",
 					Value: defaultBatchFailoverSize,
 				},
+				cli.BoolFlag{
+					Name:  FlagFollow,
+					Usage: "Optional stream the rollback progress until the failover workflow is closed",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminFailoverRollback(c)
@@ -1270,5 +1512,34 @@ func newAdminConfigStoreCommands() []cli.Command {
 				AdminListDynamicConfig(c)
 			},
 		},
+		{
+			Name:    "export-dynamic-config",
+			Aliases: []string{"exportdc", "e"},
+			Usage:   "Export fully resolved Dynamic Config, including default values of keys which are not configured",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file to write to, if not provided output is written to stdout",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "diff-dynamic-config",
+			Aliases: []string{"diffdc", "d"},
+			Usage:   "Compare fully resolved Dynamic Config with a snapshot exported from another cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Snapshot file created by export-dynamic-config",
+				},
+				getFormatFlag(),
+			},
+			Action: func(c *cli.Context) {
+				AdminDiffDynamicConfig(c)
+			},
+		},
 	}
 }
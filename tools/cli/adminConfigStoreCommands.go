@@ -23,9 +23,11 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -45,6 +47,14 @@ type cliFilter struct {
 	Value interface{}
 }
 
+// DynamicConfigDriftRow is a single dynamic config value which differs between two clusters
+type DynamicConfigDriftRow struct {
+	Name        string `header:"Name" json:"name"`
+	Filters     string `header:"Filters" json:"filters"`
+	LocalValue  string `header:"Local Value" json:"localValue"`
+	RemoteValue string `header:"Remote Value" json:"remoteValue"`
+}
+
 // AdminGetDynamicConfig gets value of specified dynamic config parameter matching specified filter
 func AdminGetDynamicConfig(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -209,6 +219,134 @@ func AdminListDynamicConfig(c *cli.Context) {
 	}
 }
 
+// AdminExportDynamicConfig exports the fully resolved dynamic config of the cluster: all keys with their
+// configured values per filter, and the default value for keys which are not configured.
+func AdminExportDynamicConfig(c *cli.Context) {
+	entries := getResolvedDynamicConfig(c)
+
+	cliEntries := make([]*cliEntry, 0, len(entries))
+	for _, dcEntry := range entries {
+		cliEntry, err := convertToInputEntry(dcEntry)
+		if err != nil {
+			ErrorAndExit("Cannot parse list response", err)
+		}
+		cliEntries = append(cliEntries, cliEntry)
+	}
+
+	outputFile := getOutputFile(c.String(FlagOutputFilename))
+	defer outputFile.Close()
+	data, err := json.MarshalIndent(cliEntries, "", "  ")
+	if err != nil {
+		ErrorAndExit("Failed to encode dynamic config", err)
+	}
+	if _, err := outputFile.Write(append(data, '\n')); err != nil {
+		ErrorAndExit("Failed to write dynamic config", err)
+	}
+}
+
+// AdminDiffDynamicConfig compares the fully resolved dynamic config of the cluster with a previously
+// exported snapshot, usually taken from another cluster, and prints the values which differ.
+func AdminDiffDynamicConfig(c *cli.Context) {
+	inputFile := getRequiredOption(c, FlagInputFile)
+	data, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		ErrorAndExit("Failed to read input file", err)
+	}
+	var cliEntries []*cliEntry
+	if err := json.Unmarshal(data, &cliEntries); err != nil {
+		ErrorAndExit("Failed to decode input file", err)
+	}
+	remote, err := convertFromInputEntries(cliEntries)
+	if err != nil {
+		ErrorAndExit("Failed to convert input file", err)
+	}
+	remote, err = dynamicconfig.ResolveSnapshot(remote)
+	if err != nil {
+		ErrorAndExit("Failed to resolve input file", err)
+	}
+
+	local, err := normalizeEntries(getResolvedDynamicConfig(c))
+	if err != nil {
+		ErrorAndExit("Failed to normalize dynamic config", err)
+	}
+
+	table := []DynamicConfigDriftRow{}
+	for _, drift := range dynamicconfig.CompareSnapshots(local, remote) {
+		table = append(table, DynamicConfigDriftRow{
+			Name:        drift.Name,
+			Filters:     drift.Filters,
+			LocalValue:  drift.LocalValue,
+			RemoteValue: drift.RemoteValue,
+		})
+	}
+	Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable})
+}
+
+func getResolvedDynamicConfig(c *cli.Context) []*types.DynamicConfigEntry {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	val, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{})
+	if err != nil {
+		ErrorAndExit("Failed to list dynamic config value(s)", err)
+	}
+	entries, err := dynamicconfig.ResolveSnapshot(val.GetEntries())
+	if err != nil {
+		ErrorAndExit("Failed to resolve dynamic config", err)
+	}
+	return entries
+}
+
+// normalizeEntries re-encodes all values so that they can be compared with values decoded from an exported file
+func normalizeEntries(entries []*types.DynamicConfigEntry) ([]*types.DynamicConfigEntry, error) {
+	cliEntries := make([]*cliEntry, 0, len(entries))
+	for _, dcEntry := range entries {
+		cliEntry, err := convertToInputEntry(dcEntry)
+		if err != nil {
+			return nil, err
+		}
+		cliEntries = append(cliEntries, cliEntry)
+	}
+	return convertFromInputEntries(cliEntries)
+}
+
+func convertFromInputEntries(cliEntries []*cliEntry) ([]*types.DynamicConfigEntry, error) {
+	entries := make([]*types.DynamicConfigEntry, 0, len(cliEntries))
+	for _, cliEntry := range cliEntries {
+		entry := &types.DynamicConfigEntry{Name: cliEntry.Name}
+		for _, cliValue := range cliEntry.Values {
+			valueBlob, err := newJSONDataBlob(cliValue.Value)
+			if err != nil {
+				return nil, err
+			}
+			value := &types.DynamicConfigValue{Value: valueBlob}
+			for _, cliFilter := range cliValue.Filters {
+				filterBlob, err := newJSONDataBlob(cliFilter.Value)
+				if err != nil {
+					return nil, err
+				}
+				value.Filters = append(value.Filters, &types.DynamicConfigFilter{Name: cliFilter.Name, Value: filterBlob})
+			}
+			entry.Values = append(entry.Values, value)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func newJSONDataBlob(v interface{}) (*types.DataBlob, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
+
 func convertToInputEntry(dcEntry *types.DynamicConfigEntry) (*cliEntry, error) {
 	newValues := make([]*cliValue, 0, len(dcEntry.Values))
 	for _, value := range dcEntry.Values {
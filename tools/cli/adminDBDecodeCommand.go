@@ -0,0 +1,387 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// blobCodec decodes a persistence blob of one type and encodes it back to confirm the decoding
+	blobCodec struct {
+		decode func(data []byte, encoding common.EncodingType) (interface{}, error)
+		encode func(value interface{}, encoding common.EncodingType) ([]byte, error)
+	}
+
+	// decodedBlob is a blob successfully decoded into one of the known persistence types
+	decodedBlob struct {
+		typeName string
+		encoding common.EncodingType
+		value    interface{}
+	}
+)
+
+var blobEncodings = []common.EncodingType{
+	common.EncodingTypeThriftRW,
+	common.EncodingTypeProto,
+	common.EncodingTypeJSON,
+}
+
+var blobCodecs = map[string]blobCodec{
+	"ShardInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.ShardInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.ShardInfoToBlob(value.(*serialization.ShardInfo))
+		},
+	),
+	"DomainInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.DomainInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.DomainInfoToBlob(value.(*serialization.DomainInfo))
+		},
+	),
+	"HistoryTreeInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.HistoryTreeInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.HistoryTreeInfoToBlob(value.(*serialization.HistoryTreeInfo))
+		},
+	),
+	"WorkflowExecutionInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.WorkflowExecutionInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.WorkflowExecutionInfoToBlob(value.(*serialization.WorkflowExecutionInfo))
+		},
+	),
+	"ActivityInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.ActivityInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.ActivityInfoToBlob(value.(*serialization.ActivityInfo))
+		},
+	),
+	"ChildExecutionInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.ChildExecutionInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.ChildExecutionInfoToBlob(value.(*serialization.ChildExecutionInfo))
+		},
+	),
+	"SignalInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.SignalInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.SignalInfoToBlob(value.(*serialization.SignalInfo))
+		},
+	),
+	"RequestCancelInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.RequestCancelInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.RequestCancelInfoToBlob(value.(*serialization.RequestCancelInfo))
+		},
+	),
+	"TimerInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.TimerInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.TimerInfoToBlob(value.(*serialization.TimerInfo))
+		},
+	),
+	"TaskInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.TaskInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.TaskInfoToBlob(value.(*serialization.TaskInfo))
+		},
+	),
+	"TaskListInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.TaskListInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.TaskListInfoToBlob(value.(*serialization.TaskListInfo))
+		},
+	),
+	"TransferTaskInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.TransferTaskInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.TransferTaskInfoToBlob(value.(*serialization.TransferTaskInfo))
+		},
+	),
+	"CrossClusterTaskInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.CrossClusterTaskInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.CrossClusterTaskInfoToBlob(value.(*serialization.CrossClusterTaskInfo))
+		},
+	),
+	"TimerTaskInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.TimerTaskInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.TimerTaskInfoToBlob(value.(*serialization.TimerTaskInfo))
+		},
+	),
+	"ReplicationTaskInfo": parserCodec(
+		func(p serialization.Parser, data []byte, encoding string) (interface{}, error) {
+			return p.ReplicationTaskInfoFromBlob(data, encoding)
+		},
+		func(p serialization.Parser, value interface{}) (persistence.DataBlob, error) {
+			return p.ReplicationTaskInfoToBlob(value.(*serialization.ReplicationTaskInfo))
+		},
+	),
+	"HistoryEvents": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeBatchEvents(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeBatchEvents(value.([]*types.HistoryEvent), encoding))
+		},
+	},
+	"HistoryEvent": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeEvent(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeEvent(value.(*types.HistoryEvent), encoding))
+		},
+	},
+	"Memo": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeVisibilityMemo(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeVisibilityMemo(value.(*types.Memo), encoding))
+		},
+	},
+	"ResetPoints": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeResetPoints(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeResetPoints(value.(*types.ResetPoints), encoding))
+		},
+	},
+	"BadBinaries": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeBadBinaries(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeBadBinaries(value.(*types.BadBinaries), encoding))
+		},
+	},
+	"VersionHistories": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeVersionHistories(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeVersionHistories(value.(*types.VersionHistories), encoding))
+		},
+	},
+	"PendingFailoverMarkers": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializePendingFailoverMarkers(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializePendingFailoverMarkers(value.([]*types.FailoverMarkerAttributes), encoding))
+		},
+	},
+	"ProcessingQueueStates": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeProcessingQueueStates(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeProcessingQueueStates(value.(*types.ProcessingQueueStates), encoding))
+		},
+	},
+	"DynamicConfigBlob": {
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			return blobSerializer.DeserializeDynamicConfigBlob(persistence.NewDataBlob(data, encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			return getDataBlobBytes(blobSerializer.SerializeDynamicConfigBlob(value.(*types.DynamicConfigBlob), encoding))
+		},
+	},
+}
+
+var blobSerializer = persistence.NewPayloadSerializer()
+
+// AdminDBDecode decodes a raw blob read from any persistence table and prints it as JSON
+func AdminDBDecode(c *cli.Context) {
+	data, err := decodeInput(c)
+	if err != nil {
+		ErrorAndExit("Failed to decode input", err)
+	}
+	data, err = decompressBlob(data, c.String(FlagCompression))
+	if err != nil {
+		ErrorAndExit("Failed to decompress input", err)
+	}
+
+	encodings := blobEncodings
+	if c.IsSet(FlagBlobEncoding) {
+		encodings = []common.EncodingType{common.EncodingType(c.String(FlagBlobEncoding))}
+	}
+	typeNames := getBlobTypeNames()
+	if c.IsSet(FlagBlobType) {
+		typeName := c.String(FlagBlobType)
+		if _, ok := blobCodecs[typeName]; !ok {
+			ErrorAndExit(fmt.Sprintf("Unknown blob type %v, supported types are %v", typeName, typeNames), nil)
+		}
+		typeNames = []string{typeName}
+	}
+
+	decoded := decodeBlob(data, typeNames, encodings)
+	if len(decoded) == 0 {
+		ErrorAndExit("Input data cannot be decoded into any known type", nil)
+	}
+	for _, blob := range decoded {
+		fmt.Printf("=======Decode into type %v with encoding %v ========\n", blob.typeName, blob.encoding)
+		prettyPrintJSONObject(blob.value)
+	}
+}
+
+// decodeBlob tries every type and encoding, a decoding is kept only if encoding it back results in the same data
+func decodeBlob(data []byte, typeNames []string, encodings []common.EncodingType) []decodedBlob {
+	var decoded []decodedBlob
+	for _, typeName := range typeNames {
+		for _, encoding := range encodings {
+			if value, err := roundTripBlob(blobCodecs[typeName], data, encoding); err == nil {
+				decoded = append(decoded, decodedBlob{typeName: typeName, encoding: encoding, value: value})
+			}
+		}
+	}
+	return decoded
+}
+
+// roundTripBlob decodes the data and confirms that encoding it back gives the same data,
+// it recovers from codecs panicking on malformed input or on encodings they do not implement
+func roundTripBlob(codec blobCodec, data []byte, encoding common.EncodingType) (value interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to decode blob: %v", r)
+		}
+	}()
+	value, err = codec.decode(data, encoding)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := codec.encode(value, encoding)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(data, encoded) {
+		return nil, fmt.Errorf("blob is not a %v encoded value", encoding)
+	}
+	return value, nil
+}
+
+func decompressBlob(data []byte, compression string) ([]byte, error) {
+	var reader io.ReadCloser
+	var err error
+	switch compression {
+	case "":
+		return data, nil
+	case "gzip":
+		reader, err = gzip.NewReader(bytes.NewReader(data))
+	case "zlib":
+		reader, err = zlib.NewReader(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("unknown compression: %s", compression)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// parserCodec wraps the decoding and encoding of a type serialized by serialization.Parser
+func parserCodec(
+	decode func(p serialization.Parser, data []byte, encoding string) (interface{}, error),
+	encode func(p serialization.Parser, value interface{}) (persistence.DataBlob, error),
+) blobCodec {
+	return blobCodec{
+		decode: func(data []byte, encoding common.EncodingType) (interface{}, error) {
+			parser, err := serialization.NewParser(encoding, common.EncodingTypeThriftRW, common.EncodingTypeProto)
+			if err != nil {
+				return nil, err
+			}
+			return decode(parser, data, string(encoding))
+		},
+		encode: func(value interface{}, encoding common.EncodingType) ([]byte, error) {
+			parser, err := serialization.NewParser(encoding, common.EncodingTypeThriftRW, common.EncodingTypeProto)
+			if err != nil {
+				return nil, err
+			}
+			blob, err := encode(parser, value)
+			return blob.Data, err
+		},
+	}
+}
+
+func getDataBlobBytes(blob *persistence.DataBlob, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return blob.Data, nil
+}
+
+func getBlobTypeNames() []string {
+	var typeNames []string
+	for typeName := range blobCodecs {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	return typeNames
+}
@@ -0,0 +1,82 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDecodeBlob(t *testing.T) {
+	parser, err := serialization.NewParser(common.EncodingTypeThriftRW, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	info := &serialization.WorkflowExecutionInfo{WorkflowTypeName: "test-workflow-type", TaskList: "test-tasklist"}
+	blob, err := parser.WorkflowExecutionInfoToBlob(info)
+	require.NoError(t, err)
+
+	decoded := decodeBlob(blob.Data, getBlobTypeNames(), blobEncodings)
+	require.NotEmpty(t, decoded)
+	var found bool
+	for _, d := range decoded {
+		if d.typeName == "WorkflowExecutionInfo" && d.encoding == common.EncodingTypeThriftRW {
+			assert.Equal(t, info.WorkflowTypeName, d.value.(*serialization.WorkflowExecutionInfo).WorkflowTypeName)
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	events, err := blobSerializer.SerializeBatchEvents([]*types.HistoryEvent{{ID: 1, Version: 2}}, common.EncodingTypeJSON)
+	require.NoError(t, err)
+	decoded = decodeBlob(events.Data, []string{"HistoryEvents"}, []common.EncodingType{common.EncodingTypeJSON})
+	require.Len(t, decoded, 1)
+	assert.Equal(t, int64(1), decoded[0].value.([]*types.HistoryEvent)[0].ID)
+
+	assert.Empty(t, decodeBlob([]byte("not a blob"), []string{"ShardInfo"}, blobEncodings))
+}
+
+func TestDecompressBlob(t *testing.T) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte("payload"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	data, err := decompressBlob(buf.Bytes(), "gzip")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), data)
+
+	data, err = decompressBlob([]byte("payload"), "")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), data)
+
+	_, err = decompressBlob([]byte("payload"), "lz4")
+	assert.Error(t, err)
+}
@@ -0,0 +1,188 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultSizeReportTopN = 20
+	defaultSizeReportRPS  = 100
+
+	templateSizeReport = "Scanned {{.Scanned}} executions, sampled {{.Sampled}}.\nLargest histories:\n{{table .LargestHistories}}\nLargest mutable states:\n{{table .LargestMutableStates}}"
+)
+
+type (
+	// SizeReport is the list of workflow executions with the largest histories and mutable states
+	SizeReport struct {
+		Scanned              int                `json:"scanned"`
+		Sampled              int                `json:"sampled"`
+		LargestHistories     []ExecutionSizeRow `json:"largestHistories"`
+		LargestMutableStates []ExecutionSizeRow `json:"largestMutableStates"`
+	}
+
+	// ExecutionSizeRow is the history and mutable state size of a single workflow execution
+	ExecutionSizeRow struct {
+		ShardID          int    `header:"Shard ID" json:"shardID"`
+		Domain           string `header:"Domain" json:"domain"`
+		WorkflowID       string `header:"Workflow ID" json:"workflowID"`
+		RunID            string `header:"Run ID" json:"runID"`
+		HistorySize      int64  `header:"History Size" json:"historySize"`
+		EventCount       int64  `header:"Event Count" json:"eventCount"`
+		MutableStateSize int    `header:"Mutable State Size" json:"mutableStateSize"`
+	}
+
+	// topExecutions keeps the n largest executions according to less
+	topExecutions struct {
+		n    int
+		less func(a, b ExecutionSizeRow) bool
+		rows []ExecutionSizeRow
+	}
+)
+
+// AdminDBSizeReport scans executions of the given shards and reports the largest histories and mutable states.
+// The scan can take long, so every persistence call gets its own context instead of one for the whole scan.
+func AdminDBSizeReport(c *cli.Context) {
+	sampleRate := c.Float64(FlagSampleRate)
+	if sampleRate <= 0 || sampleRate > 1 {
+		ErrorAndExit(fmt.Sprintf("Sample rate has to be in (0, 1], got %v", sampleRate), nil)
+	}
+	getDomainName := newPersistenceDomainNameResolver(c, initializeDomainManager(c))
+	var domainID string
+	if domain := c.GlobalString(FlagDomain); domain != "" {
+		ctx, cancel := newContext(c)
+		resp, err := initializeDomainManager(c).GetDomain(ctx, &persistence.GetDomainRequest{Name: domain})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get domain %v", domain), err)
+		}
+		domainID = resp.Info.ID
+	}
+
+	topN := c.Int(FlagTopN)
+	largestHistories := newTopExecutions(topN, func(a, b ExecutionSizeRow) bool { return a.HistorySize < b.HistorySize })
+	largestMutableStates := newTopExecutions(topN, func(a, b ExecutionSizeRow) bool { return a.MutableStateSize < b.MutableStateSize })
+	limiter := quotas.NewSimpleRateLimiter(c.Int(FlagRPS))
+	report := &SizeReport{}
+
+	for shardID := range getShards(c) {
+		executionManager := initializeExecutionStore(c, shardID)
+		request := &persistence.ListConcreteExecutionsRequest{PageSize: defaultPageSize}
+		for {
+			if err := limiter.Wait(context.Background()); err != nil {
+				ErrorAndExit("Failed to rate limit the size report", err)
+			}
+			ctx, cancel := newContext(c)
+			resp, err := executionManager.ListConcreteExecutions(ctx, request)
+			cancel()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to list executions of shard %d", shardID), err)
+			}
+			for _, execution := range resp.Executions {
+				info := execution.ExecutionInfo
+				if info == nil || (domainID != "" && info.DomainID != domainID) {
+					continue
+				}
+				report.Scanned++
+				if rand.Float64() >= sampleRate {
+					continue
+				}
+				if err := limiter.Wait(context.Background()); err != nil {
+					ErrorAndExit("Failed to rate limit the size report", err)
+				}
+				ctx, cancel := newContext(c)
+				ms, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+					DomainID:  info.DomainID,
+					Execution: types.WorkflowExecution{WorkflowID: info.WorkflowID, RunID: info.RunID},
+				})
+				cancel()
+				if err != nil {
+					// the execution may have been deleted since it was listed
+					continue
+				}
+				report.Sampled++
+				row := newExecutionSizeRow(shardID, getDomainName(info.DomainID), ms)
+				largestHistories.add(row)
+				largestMutableStates.add(row)
+			}
+			if len(resp.PageToken) == 0 {
+				break
+			}
+			request.PageToken = resp.PageToken
+		}
+		executionManager.Close()
+	}
+
+	report.LargestHistories = largestHistories.rows
+	report.LargestMutableStates = largestMutableStates.rows
+	Render(c, report, RenderOptions{DefaultTemplate: templateSizeReport, Color: true})
+}
+
+func newExecutionSizeRow(shardID int, domain string, ms *persistence.GetWorkflowExecutionResponse) ExecutionSizeRow {
+	info := ms.State.ExecutionInfo
+	row := ExecutionSizeRow{
+		ShardID:    shardID,
+		Domain:     domain,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+		EventCount: info.NextEventID - 1,
+	}
+	if ms.State.ExecutionStats != nil {
+		row.HistorySize = ms.State.ExecutionStats.HistorySize
+	}
+	if ms.MutableStateStats != nil {
+		row.MutableStateSize = ms.MutableStateStats.MutableStateSize
+	}
+	return row
+}
+
+func newTopExecutions(n int, less func(a, b ExecutionSizeRow) bool) *topExecutions {
+	return &topExecutions{n: n, less: less}
+}
+
+// add inserts the row keeping rows sorted from largest to smallest
+func (t *topExecutions) add(row ExecutionSizeRow) {
+	if t.n <= 0 {
+		return
+	}
+	if len(t.rows) == t.n && !t.less(t.rows[t.n-1], row) {
+		return
+	}
+	i := sort.Search(len(t.rows), func(i int) bool { return t.less(t.rows[i], row) })
+	t.rows = append(t.rows, ExecutionSizeRow{})
+	copy(t.rows[i+1:], t.rows[i:])
+	t.rows[i] = row
+	if len(t.rows) > t.n {
+		t.rows = t.rows[:t.n]
+	}
+}
@@ -0,0 +1,66 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTopExecutions(t *testing.T) {
+	top := newTopExecutions(3, func(a, b ExecutionSizeRow) bool { return a.HistorySize < b.HistorySize })
+	for _, size := range []int64{5, 1, 9, 3, 7, 9} {
+		top.add(ExecutionSizeRow{HistorySize: size})
+	}
+	var sizes []int64
+	for _, row := range top.rows {
+		sizes = append(sizes, row.HistorySize)
+	}
+	assert.Equal(t, []int64{9, 9, 7}, sizes)
+
+	empty := newTopExecutions(0, top.less)
+	empty.add(ExecutionSizeRow{HistorySize: 1})
+	assert.Empty(t, empty.rows)
+}
+
+func TestNewExecutionSizeRow(t *testing.T) {
+	row := newExecutionSizeRow(3, "test-domain", &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo:  &persistence.WorkflowExecutionInfo{WorkflowID: "wid", RunID: "rid", NextEventID: 11},
+			ExecutionStats: &persistence.ExecutionStats{HistorySize: 2048},
+		},
+		MutableStateStats: &persistence.MutableStateStats{MutableStateSize: 512},
+	})
+	assert.Equal(t, ExecutionSizeRow{
+		ShardID:          3,
+		Domain:           "test-domain",
+		WorkflowID:       "wid",
+		RunID:            "rid",
+		HistorySize:      2048,
+		EventCount:       10,
+		MutableStateSize: 512,
+	}, row)
+}
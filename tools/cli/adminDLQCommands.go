@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -32,6 +33,8 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -39,6 +42,11 @@ import (
 
 const (
 	defaultPageSize = 1000
+
+	dlqOperationStatusSucceeded = "succeeded"
+	dlqOperationStatusPartial   = "partially succeeded"
+	dlqOperationStatusFailed    = "failed"
+	dlqOperationStatusSkipped   = "skipped"
 )
 
 type DLQRow struct {
@@ -49,6 +57,7 @@ type DLQRow struct {
 	RunID           string                     `header:"Run ID" json:"runID"`
 	TaskID          int64                      `header:"Task ID" json:"taskID"`
 	TaskType        *types.ReplicationTaskType `header:"Task Type" json:"taskType"`
+	CreationTime    int64                      `json:"creationTime,omitempty"`
 	Version         int64                      `json:"version"`
 	FirstEventID    int64                      `json:"firstEventID"`
 	NextEventID     int64                      `json:"nextEventID"`
@@ -64,6 +73,33 @@ type DLQRow struct {
 	NewRunEventIDs []int64 `header:"New Run Event IDs"`
 }
 
+// DLQOperationRow is the result of merging or purging the DLQ of a single shard
+type DLQOperationRow struct {
+	ShardID           int     `header:"Shard ID" json:"shardID"`
+	LastMessageID     int64   `header:"Last Message ID" json:"lastMessageID,omitempty"`
+	Skipped           int     `header:"Skipped" json:"skipped"`
+	SkippedMessageIDs []int64 `header:"Skipped Message IDs" json:"skippedMessageIDs,omitempty"`
+	Status            string  `header:"Status" json:"status"`
+	Error             string  `header:"Error" json:"error,omitempty"`
+}
+
+// dlqSelection tracks the DLQ messages of a shard selected by the filters. Messages can only be merged
+// or purged as a range, so the selection ends before the first message which doesn't match the filters,
+// the matching messages after it are skipped.
+type dlqSelection struct {
+	lastMessageID     *int64
+	skippedMessageIDs []int64
+	blocked           bool
+}
+
+type dlqFilter struct {
+	domainName   string
+	domainID     string
+	workflowID   string
+	earliestTime int64
+	latestTime   int64
+}
+
 type HistoryDLQCountRow struct {
 	SourceCluster string `header:"Source Cluster" json:"sourceCluster"`
 	ShardID       int32  `header:"Shard ID" json:"shardID"`
@@ -125,11 +161,12 @@ func AdminGetDLQMessages(c *cli.Context) {
 	ctx, cancel := newContext(c)
 	defer cancel()
 
-	client := cFactory.ServerFrontendClient(c)
 	adminClient := cFactory.ServerAdminClient(c)
+	getDomainName := newDomainNameResolver(ctx, cFactory.ServerFrontendClient(c))
 
 	dlqType := toQueueType(getRequiredOption(c, FlagDLQType))
 	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	filter := newDLQFilter(c)
 
 	remainingMessageCount := common.EndMessageID
 	if c.IsSet(FlagMaxMessageCount) {
@@ -140,93 +177,22 @@ func AdminGetDLQMessages(c *cli.Context) {
 		lastMessageID = c.Int64(FlagLastMessageID)
 	}
 
-	// Cache for domain names
-	domainNames := map[string]string{}
-	getDomainName := func(domainId string) string {
-		if domainName, ok := domainNames[domainId]; ok {
-			return domainName
-		}
-
-		resp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(domainId)})
-		if err != nil {
-			ErrorAndExit("failed to describe domain", err)
-		}
-		domainNames[domainId] = resp.DomainInfo.Name
-		return resp.DomainInfo.Name
-	}
-
-	readShard := func(shardID int) []DLQRow {
-		var rows []DLQRow
-		var pageToken []byte
-
-		for {
-			resp, err := adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
-				Type:                  dlqType,
-				SourceCluster:         sourceCluster,
-				ShardID:               int32(shardID),
-				InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
-				MaximumPageSize:       defaultPageSize,
-				NextPageToken:         pageToken,
-			})
-			if err != nil {
-				ErrorAndExit(fmt.Sprintf("fail to read dlq message for shard: %d", shardID), err)
-			}
-
-			replicationTasks := map[int64]*types.ReplicationTask{}
-			for _, task := range resp.ReplicationTasks {
-				replicationTasks[task.SourceTaskID] = task
-			}
-
-			for _, info := range resp.ReplicationTasksInfo {
-				task := replicationTasks[info.TaskID]
-
-				var taskType *types.ReplicationTaskType
-				if task != nil {
-					taskType = task.TaskType
-				}
-
-				events := deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetEvents())
-				newRunEvents := deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetNewRunEvents())
-
-				rows = append(rows, DLQRow{
-					ShardID:         shardID,
-					DomainName:      getDomainName(info.DomainID),
-					DomainID:        info.DomainID,
-					WorkflowID:      info.WorkflowID,
-					RunID:           info.RunID,
-					TaskType:        taskType,
-					TaskID:          info.TaskID,
-					Version:         info.Version,
-					FirstEventID:    info.FirstEventID,
-					NextEventID:     info.NextEventID,
-					ScheduledID:     info.ScheduledID,
-					ReplicationTask: task,
-					Events:          events,
-					EventIDs:        collectEventIDs(events),
-					NewRunEvents:    newRunEvents,
-					NewRunEventIDs:  collectEventIDs(newRunEvents),
-				})
-
-				remainingMessageCount--
-				if remainingMessageCount <= 0 {
-					return rows
-				}
-			}
-
-			if len(resp.NextPageToken) == 0 {
-				break
-			}
-			pageToken = resp.NextPageToken
-		}
-		return rows
-	}
-
 	table := []DLQRow{}
 	for shardID := range getShards(c) {
 		if remainingMessageCount <= 0 {
 			break
 		}
-		table = append(table, readShard(shardID)...)
+		err := readDLQShard(ctx, adminClient, dlqType, sourceCluster, shardID, lastMessageID, getDomainName, func(row DLQRow) bool {
+			if !filter.matches(row) {
+				return true
+			}
+			table = append(table, row)
+			remainingMessageCount--
+			return remainingMessageCount > 0
+		})
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("fail to read dlq message for shard: %d", shardID), err)
+		}
 	}
 
 	Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
@@ -234,68 +200,290 @@ func AdminGetDLQMessages(c *cli.Context) {
 
 // AdminPurgeDLQMessages deletes messages from DLQ
 func AdminPurgeDLQMessages(c *cli.Context) {
-	dlqType := getRequiredOption(c, FlagDLQType)
+	dlqType := toQueueType(getRequiredOption(c, FlagDLQType))
 	sourceCluster := getRequiredOption(c, FlagSourceCluster)
-	var lastMessageID *int64
-	if c.IsSet(FlagLastMessageID) {
-		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
-	}
 
 	adminClient := cFactory.ServerAdminClient(c)
+	table := []DLQOperationRow{}
 	for shardID := range getShards(c) {
+		row := DLQOperationRow{ShardID: shardID}
+		selection, ok := getDLQOperationSelection(c, adminClient, dlqType, sourceCluster, shardID, &row)
+		if !ok {
+			table = append(table, row)
+			continue
+		}
+
 		ctx, cancel := newContext(c)
 		err := adminClient.PurgeDLQMessages(ctx, &types.PurgeDLQMessagesRequest{
-			Type:                  toQueueType(dlqType),
+			Type:                  dlqType,
 			SourceCluster:         sourceCluster,
 			ShardID:               int32(shardID),
-			InclusiveEndMessageID: lastMessageID,
+			InclusiveEndMessageID: selection.lastMessageID,
 		})
 		cancel()
-		if err != nil {
-			fmt.Printf("Failed to purge DLQ message in shard %v with error: %v.\n", shardID, err)
-			continue
-		}
+		row.complete(selection, err)
+		table = append(table, row)
 		time.Sleep(10 * time.Millisecond)
-		fmt.Printf("Successfully purge DLQ Messages in shard %v.\n", shardID)
 	}
+	Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+	warnSkippedDLQMessages(table)
 }
 
 // AdminMergeDLQMessages merges message from DLQ
 func AdminMergeDLQMessages(c *cli.Context) {
-	dlqType := getRequiredOption(c, FlagDLQType)
+	dlqType := toQueueType(getRequiredOption(c, FlagDLQType))
 	sourceCluster := getRequiredOption(c, FlagSourceCluster)
-	var lastMessageID *int64
-	if c.IsSet(FlagLastMessageID) {
-		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
-	}
 
 	adminClient := cFactory.ServerAdminClient(c)
-ShardIDLoop:
+	table := []DLQOperationRow{}
 	for shardID := range getShards(c) {
+		row := DLQOperationRow{ShardID: shardID}
+		selection, ok := getDLQOperationSelection(c, adminClient, dlqType, sourceCluster, shardID, &row)
+		if !ok {
+			table = append(table, row)
+			continue
+		}
+
 		request := &types.MergeDLQMessagesRequest{
-			Type:                  toQueueType(dlqType),
+			Type:                  dlqType,
 			SourceCluster:         sourceCluster,
 			ShardID:               int32(shardID),
-			InclusiveEndMessageID: lastMessageID,
+			InclusiveEndMessageID: selection.lastMessageID,
 			MaximumPageSize:       defaultPageSize,
 		}
-
+		var err error
 		for {
 			ctx, cancel := newContext(c)
-			response, err := adminClient.MergeDLQMessages(ctx, request)
+			var response *types.MergeDLQMessagesResponse
+			response, err = adminClient.MergeDLQMessages(ctx, request)
 			cancel()
-			if err != nil {
-				fmt.Printf("Failed to merge DLQ message in shard %v with error: %v.\n", shardID, err)
-				continue ShardIDLoop
+			if err != nil || len(response.NextPageToken) == 0 {
+				break
 			}
+			request.NextPageToken = response.NextPageToken
+		}
+		row.complete(selection, err)
+		table = append(table, row)
+	}
+	Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+	warnSkippedDLQMessages(table)
+}
 
-			if len(response.NextPageToken) == 0 {
-				break
+// getDLQOperationSelection returns the messages a merge or purge should process for the shard.
+// Without filters, all the messages up to the last message ID flag are selected.
+// False is returned if the shard should be skipped, in which case the row has been filled in.
+func getDLQOperationSelection(
+	c *cli.Context,
+	adminClient admin.Client,
+	dlqType *types.DLQType,
+	sourceCluster string,
+	shardID int,
+	row *DLQOperationRow,
+) (*dlqSelection, bool) {
+	selection := &dlqSelection{}
+	if c.IsSet(FlagLastMessageID) {
+		selection.lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
+	}
+
+	filter := newDLQFilter(c)
+	if filter.isEmpty() {
+		return selection, true
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	readUpTo := common.EndMessageID
+	if selection.lastMessageID != nil {
+		readUpTo = *selection.lastMessageID
+	}
+	selection.lastMessageID = nil
+	getDomainName := newDomainNameResolver(ctx, cFactory.ServerFrontendClient(c))
+	err := readDLQShard(ctx, adminClient, dlqType, sourceCluster, shardID, readUpTo, getDomainName, func(dlqRow DLQRow) bool {
+		selection.add(dlqRow.TaskID, filter.matches(dlqRow))
+		return true
+	})
+	if err != nil {
+		row.Status = dlqOperationStatusFailed
+		row.Error = err.Error()
+		return nil, false
+	}
+	if selection.lastMessageID == nil {
+		row.Status = dlqOperationStatusSkipped
+		row.Skipped = len(selection.skippedMessageIDs)
+		row.SkippedMessageIDs = selection.skippedMessageIDs
+		return nil, false
+	}
+	return selection, true
+}
+
+// add adds the next message of the shard to the selection
+func (s *dlqSelection) add(taskID int64, matches bool) {
+	switch {
+	case !matches:
+		s.blocked = true
+	case !s.blocked:
+		s.lastMessageID = common.Int64Ptr(taskID)
+	default:
+		s.skippedMessageIDs = append(s.skippedMessageIDs, taskID)
+	}
+}
+
+// warnSkippedDLQMessages tells which selected messages were left in the DLQ, if any
+func warnSkippedDLQMessages(table []DLQOperationRow) {
+	skipped := 0
+	for _, row := range table {
+		skipped += row.Skipped
+	}
+	if skipped > 0 {
+		fmt.Fprintf(os.Stderr, "%d messages matching the filters were skipped, as they follow messages which don't match them. "+
+			"Their IDs are listed per shard, they can be processed once the messages before them are.\n", skipped)
+	}
+}
+
+// readDLQShard reads DLQ messages of the shard in order and passes each of them to the handler,
+// reading stops as soon as the handler returns false
+func readDLQShard(
+	ctx context.Context,
+	adminClient admin.Client,
+	dlqType *types.DLQType,
+	sourceCluster string,
+	shardID int,
+	lastMessageID int64,
+	getDomainName func(string) string,
+	handler func(DLQRow) bool,
+) error {
+	var pageToken []byte
+	for {
+		resp, err := adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
+			Type:                  dlqType,
+			SourceCluster:         sourceCluster,
+			ShardID:               int32(shardID),
+			InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+			MaximumPageSize:       defaultPageSize,
+			NextPageToken:         pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		replicationTasks := map[int64]*types.ReplicationTask{}
+		for _, task := range resp.ReplicationTasks {
+			replicationTasks[task.SourceTaskID] = task
+		}
+
+		for _, info := range resp.ReplicationTasksInfo {
+			task := replicationTasks[info.TaskID]
+
+			var taskType *types.ReplicationTaskType
+			if task != nil {
+				taskType = task.TaskType
 			}
 
-			request.NextPageToken = response.NextPageToken
+			events := deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetEvents())
+			newRunEvents := deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetNewRunEvents())
+
+			if !handler(DLQRow{
+				ShardID:         shardID,
+				DomainName:      getDomainName(info.DomainID),
+				DomainID:        info.DomainID,
+				WorkflowID:      info.WorkflowID,
+				RunID:           info.RunID,
+				TaskType:        taskType,
+				TaskID:          info.TaskID,
+				CreationTime:    task.GetCreationTime(),
+				Version:         info.Version,
+				FirstEventID:    info.FirstEventID,
+				NextEventID:     info.NextEventID,
+				ScheduledID:     info.ScheduledID,
+				ReplicationTask: task,
+				Events:          events,
+				EventIDs:        collectEventIDs(events),
+				NewRunEvents:    newRunEvents,
+				NewRunEventIDs:  collectEventIDs(newRunEvents),
+			}) {
+				return nil
+			}
 		}
-		fmt.Printf("Successfully merged all messages in shard %v.\n", shardID)
+
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// newDomainNameResolver returns a function resolving domain IDs to names, results are cached
+func newDomainNameResolver(ctx context.Context, client frontend.Client) func(string) string {
+	domainNames := map[string]string{}
+	return func(domainID string) string {
+		if domainName, ok := domainNames[domainID]; ok {
+			return domainName
+		}
+
+		resp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(domainID)})
+		if err != nil {
+			ErrorAndExit("failed to describe domain", err)
+		}
+		domainNames[domainID] = resp.DomainInfo.Name
+		return resp.DomainInfo.Name
+	}
+}
+
+func newDLQFilter(c *cli.Context) dlqFilter {
+	filter := dlqFilter{
+		domainName: c.String(FlagDomain),
+		domainID:   c.String(FlagDomainID),
+		workflowID: c.String(FlagWorkflowID),
+	}
+	if c.IsSet(FlagEarliestTime) {
+		filter.earliestTime = parseTime(c.String(FlagEarliestTime), 0)
+	}
+	if c.IsSet(FlagLatestTime) {
+		filter.latestTime = parseTime(c.String(FlagLatestTime), 0)
+	}
+	return filter
+}
+
+func (f dlqFilter) isEmpty() bool {
+	return f == dlqFilter{}
+}
+
+// matches returns true if the DLQ message satisfies all the filters.
+// Messages without a creation time never match a time range filter.
+func (f dlqFilter) matches(row DLQRow) bool {
+	if f.domainName != "" && f.domainName != row.DomainName {
+		return false
+	}
+	if f.domainID != "" && f.domainID != row.DomainID {
+		return false
+	}
+	if f.workflowID != "" && f.workflowID != row.WorkflowID {
+		return false
+	}
+	if f.earliestTime != 0 && row.CreationTime < f.earliestTime {
+		return false
+	}
+	if f.latestTime != 0 && (row.CreationTime == 0 || row.CreationTime > f.latestTime) {
+		return false
+	}
+	return true
+}
+
+func (r *DLQOperationRow) complete(selection *dlqSelection, err error) {
+	if selection.lastMessageID != nil {
+		r.LastMessageID = *selection.lastMessageID
+	}
+	r.Skipped = len(selection.skippedMessageIDs)
+	r.SkippedMessageIDs = selection.skippedMessageIDs
+	switch {
+	case err != nil:
+		r.Status = dlqOperationStatusFailed
+		r.Error = err.Error()
+	case r.Skipped > 0:
+		r.Status = dlqOperationStatusPartial
+	default:
+		r.Status = dlqOperationStatusSucceeded
 	}
 }
 
@@ -354,7 +542,7 @@ func toQueueType(dlqType string) *types.DLQType {
 	switch dlqType {
 	case "domain":
 		return types.DLQTypeDomain.Ptr()
-	case "history":
+	case "history", "replication":
 		return types.DLQTypeReplication.Ptr()
 	default:
 		ErrorAndExit("The queue type is not supported.", fmt.Errorf("the queue type is not supported. Type: %v", dlqType))
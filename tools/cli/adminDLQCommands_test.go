@@ -0,0 +1,147 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDLQFilter_Matches(t *testing.T) {
+	row := DLQRow{
+		DomainName:   "test-domain",
+		DomainID:     "test-domain-id",
+		WorkflowID:   "test-workflow-id",
+		CreationTime: 100,
+	}
+
+	testCases := []struct {
+		name     string
+		filter   dlqFilter
+		expected bool
+	}{
+		{name: "empty", filter: dlqFilter{}, expected: true},
+		{name: "domain name", filter: dlqFilter{domainName: "test-domain"}, expected: true},
+		{name: "other domain name", filter: dlqFilter{domainName: "other-domain"}, expected: false},
+		{name: "domain ID", filter: dlqFilter{domainID: "test-domain-id"}, expected: true},
+		{name: "other workflow ID", filter: dlqFilter{workflowID: "other-workflow-id"}, expected: false},
+		{name: "within time range", filter: dlqFilter{earliestTime: 50, latestTime: 150}, expected: true},
+		{name: "before time range", filter: dlqFilter{earliestTime: 150}, expected: false},
+		{name: "after time range", filter: dlqFilter{latestTime: 50}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter.matches(row))
+		})
+	}
+	assert.False(t, dlqFilter{latestTime: 50}.matches(DLQRow{}))
+}
+
+func TestReadDLQShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adminClient := admin.NewMockClient(ctrl)
+	adminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(&types.ReadDLQMessagesResponse{
+		ReplicationTasksInfo: []*types.ReplicationTaskInfo{
+			{DomainID: "domain-1", WorkflowID: "wid-1", TaskID: 1},
+			{DomainID: "domain-2", WorkflowID: "wid-2", TaskID: 2},
+			{DomainID: "domain-1", WorkflowID: "wid-3", TaskID: 3},
+		},
+		ReplicationTasks: []*types.ReplicationTask{
+			{SourceTaskID: 1, CreationTime: common.Int64Ptr(10)},
+		},
+	}, nil)
+
+	var rows []DLQRow
+	err := readDLQShard(
+		context.Background(),
+		adminClient,
+		types.DLQTypeReplication.Ptr(),
+		"source",
+		1,
+		common.EndMessageID,
+		func(domainID string) string { return domainID + "-name" },
+		func(row DLQRow) bool {
+			rows = append(rows, row)
+			return len(rows) < 2
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "domain-1-name", rows[0].DomainName)
+	assert.Equal(t, int64(10), rows[0].CreationTime)
+	assert.Equal(t, int64(2), rows[1].TaskID)
+	assert.Equal(t, int64(0), rows[1].CreationTime)
+}
+
+func TestDLQSelection_Add(t *testing.T) {
+	testCases := []struct {
+		name              string
+		matches           []bool
+		lastMessageID     *int64
+		skippedMessageIDs []int64
+	}{
+		{name: "all match", matches: []bool{true, true, true}, lastMessageID: common.Int64Ptr(3)},
+		{name: "none match", matches: []bool{false, false, false}},
+		{name: "leading matches", matches: []bool{true, true, false}, lastMessageID: common.Int64Ptr(2)},
+		{name: "gap", matches: []bool{true, false, true, false, true}, lastMessageID: common.Int64Ptr(1), skippedMessageIDs: []int64{3, 5}},
+		{name: "first does not match", matches: []bool{false, true, true}, skippedMessageIDs: []int64{2, 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selection := &dlqSelection{}
+			for i, matches := range tc.matches {
+				selection.add(int64(i+1), matches)
+			}
+			assert.Equal(t, tc.lastMessageID, selection.lastMessageID)
+			assert.Equal(t, tc.skippedMessageIDs, selection.skippedMessageIDs)
+		})
+	}
+}
+
+func TestDLQOperationRow_Complete(t *testing.T) {
+	row := DLQOperationRow{ShardID: 1}
+	row.complete(&dlqSelection{lastMessageID: common.Int64Ptr(10)}, nil)
+	assert.Equal(t, DLQOperationRow{ShardID: 1, LastMessageID: 10, Status: dlqOperationStatusSucceeded}, row)
+
+	row = DLQOperationRow{ShardID: 1}
+	row.complete(&dlqSelection{lastMessageID: common.Int64Ptr(10), skippedMessageIDs: []int64{12, 14}}, nil)
+	assert.Equal(t, DLQOperationRow{ShardID: 1, LastMessageID: 10, Skipped: 2, SkippedMessageIDs: []int64{12, 14}, Status: dlqOperationStatusPartial}, row)
+
+	row = DLQOperationRow{ShardID: 1}
+	row.complete(&dlqSelection{lastMessageID: common.Int64Ptr(10), skippedMessageIDs: []int64{12}}, assert.AnError)
+	assert.Equal(t, dlqOperationStatusFailed, row.Status)
+	assert.Equal(t, assert.AnError.Error(), row.Error)
+	assert.Equal(t, 1, row.Skipped)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"os/user"
 	"time"
 
@@ -41,6 +42,10 @@ const (
 	defaultBatchFailoverSize                = 20
 	defaultBatchFailoverWaitTimeInSeconds   = 30
 	defaultFailoverWorkflowTimeoutInSeconds = 1200
+	failoverProgressInterval                = 10 * time.Second
+
+	failoverDomainSucceeded = "succeeded"
+	failoverDomainFailed    = "failed"
 )
 
 type startParams struct {
@@ -55,6 +60,15 @@ type startParams struct {
 	cron                           string
 }
 
+// FailoverDomainRow is the failover progress of a single domain processed by the failover workflow
+type FailoverDomainRow struct {
+	Domain          string `header:"Domain"`
+	Status          string `header:"Status"`
+	ActiveCluster   string `header:"Active Cluster"`
+	CompletedShards int32  `header:"Completed Shards"`
+	PendingShards   int    `header:"Pending Shards"`
+}
+
 // AdminFailoverStart start failover workflow
 func AdminFailoverStart(c *cli.Context) {
 	params := &startParams{
@@ -68,7 +82,19 @@ func AdminFailoverStart(c *cli.Context) {
 		drillWaitTime:                  c.Int(FlagFailoverDrillWaitTime),
 		cron:                           c.String(FlagCronSchedule),
 	}
-	failoverStart(c, params)
+	runID := failoverStart(c, params)
+	if c.Bool(FlagFollow) {
+		workflowID := failovermanager.FailoverWorkflowID
+		if params.drillWaitTime > 0 {
+			workflowID = failovermanager.DrillWorkflowID
+		}
+		followFailover(c, workflowID, runID)
+	}
+}
+
+// AdminFailoverWatch streams the progress of a failover workflow until it is closed
+func AdminFailoverWatch(c *cli.Context) {
+	followFailover(c, getFailoverWorkflowID(c), getRunID(c))
 }
 
 // AdminFailoverPause pause failover workflow
@@ -185,7 +211,10 @@ func AdminFailoverRollback(c *cli.Context) {
 		failoverTimeout:                c.Int(FlagFailoverTimeout),
 		failoverWorkflowTimeout:        c.Int(FlagExecutionTimeout),
 	}
-	failoverStart(c, params)
+	rollbackRunID := failoverStart(c, params)
+	if c.Bool(FlagFollow) {
+		followFailover(c, failovermanager.FailoverWorkflowID, rollbackRunID)
+	}
 }
 
 // AdminFailoverList list failover runs
@@ -244,7 +273,7 @@ func getRunID(c *cli.Context) string {
 	return ""
 }
 
-func failoverStart(c *cli.Context, params *startParams) {
+func failoverStart(c *cli.Context, params *startParams) string {
 	validateStartParams(params)
 
 	workflowID := failovermanager.FailoverWorkflowID
@@ -324,6 +353,76 @@ func failoverStart(c *cli.Context, params *startParams) {
 	fmt.Println("Failover workflow started")
 	fmt.Println("wid: " + workflowID)
 	fmt.Println("rid: " + wf.GetRunID())
+	return wf.GetRunID()
+}
+
+// followFailover polls the failover workflow and prints the progress of every processed domain until the workflow is closed
+func followFailover(c *cli.Context, workflowID string, runID string) {
+	client := getCadenceClient(c)
+	for {
+		tcCtx, cancel := newContext(c)
+		result := query(tcCtx, client, workflowID, runID)
+		descResp, err := client.DescribeWorkflowExecution(tcCtx, &types.DescribeWorkflowExecutionRequest{
+			Domain: common.SystemLocalDomainName,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      runID,
+			},
+		})
+		if err != nil {
+			cancel()
+			ErrorAndExit("Failed to describe failover workflow", err)
+		}
+		info := descResp.GetWorkflowExecutionInfo()
+		closed := info != nil && info.CloseStatus != nil
+		if isWorkflowTerminated(descResp) {
+			result.State = failovermanager.WorkflowAborted
+		}
+		rows := describeFailoverDomains(tcCtx, client, result)
+		cancel()
+
+		fmt.Printf("Failover from %v to %v is %v: %d of %d domains succeeded, %d failed.\n",
+			result.SourceCluster, result.TargetCluster, result.State, result.Success, result.TotalDomains, result.Failed)
+		if len(rows) > 0 {
+			RenderTable(os.Stdout, rows, RenderOptions{Color: true})
+		}
+		if closed || !isWorkflowRunning(result) {
+			if result.Failed > 0 || result.State == failovermanager.WorkflowAborted {
+				fmt.Printf("Run 'cadence admin cluster failover rollback --rid %v' to move the processed domains back to %v.\n",
+					info.GetExecution().GetRunID(), result.SourceCluster)
+			}
+			return
+		}
+		time.Sleep(failoverProgressInterval)
+	}
+}
+
+// describeFailoverDomains describes the active cluster and graceful failover progress of the domains processed so far
+func describeFailoverDomains(
+	tcCtx context.Context,
+	client frontend.Client,
+	result *failovermanager.QueryResult,
+) []FailoverDomainRow {
+	var rows []FailoverDomainRow
+	addRows := func(domains []string, status string) {
+		for _, domain := range domains {
+			row := FailoverDomainRow{Domain: domain, Status: status}
+			resp, err := client.DescribeDomain(tcCtx, &types.DescribeDomainRequest{Name: common.StringPtr(domain)})
+			if err != nil {
+				fmt.Printf("Failed to describe domain %v: %v\n", domain, err)
+			} else {
+				if resp.ReplicationConfiguration != nil {
+					row.ActiveCluster = resp.ReplicationConfiguration.ActiveClusterName
+				}
+				row.CompletedShards = resp.FailoverInfo.GetCompletedShardCount()
+				row.PendingShards = len(resp.FailoverInfo.GetPendingShards())
+			}
+			rows = append(rows, row)
+		}
+	}
+	addRows(result.SuccessDomains, failoverDomainSucceeded)
+	addRows(result.FailedDomains, failoverDomainFailed)
+	return rows
 }
 
 func getFailoverWorkflowID(c *cli.Context) string {
@@ -0,0 +1,284 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/replication"
+)
+
+const (
+	defaultReplicationReportTopN = 10
+
+	templateReplicationReport = "Remote clusters:\n{{table .Clusters}}\nWorst domains:\n{{table .Domains}}\nWorst shards:\n{{table .Shards}}"
+)
+
+type (
+	// ReplicationReport is the replication lag of the current cluster aggregated per remote cluster, domain and shard
+	ReplicationReport struct {
+		Clusters []ReplicationClusterRow `json:"clusters"`
+		Domains  []ReplicationDomainRow  `json:"domains"`
+		Shards   []ReplicationShardRow   `json:"shards"`
+	}
+
+	// ReplicationClusterRow is the replication lag towards a single remote cluster
+	ReplicationClusterRow struct {
+		Cluster      string        `header:"Cluster" json:"cluster"`
+		Shards       int           `header:"Shards" json:"shards"`
+		PendingTasks int64         `header:"Pending Tasks" json:"pendingTasks"`
+		MaxLag       time.Duration `header:"Max Lag" json:"maxLag"`
+		WorstShardID int           `header:"Worst Shard" json:"worstShardID"`
+		DLQMessages  int64         `header:"DLQ Messages" json:"dlqMessages"`
+	}
+
+	// ReplicationDomainRow is the replication lag of a single domain towards a remote cluster
+	ReplicationDomainRow struct {
+		Cluster      string        `header:"Cluster" json:"cluster"`
+		Domain       string        `header:"Domain" json:"domain"`
+		PendingTasks int64         `header:"Pending Tasks" json:"pendingTasks"`
+		MaxLag       time.Duration `header:"Max Lag" json:"maxLag"`
+	}
+
+	// ReplicationShardRow is the replication lag of a single shard towards a remote cluster
+	ReplicationShardRow struct {
+		ShardID      int           `header:"Shard ID" json:"shardID"`
+		Cluster      string        `header:"Cluster" json:"cluster"`
+		AckLevel     int64         `header:"Ack Level" json:"ackLevel"`
+		PendingTasks int64         `header:"Pending Tasks" json:"pendingTasks"`
+		MaxLag       time.Duration `header:"Max Lag" json:"maxLag"`
+		DLQMessages  int64         `header:"DLQ Messages" json:"dlqMessages"`
+	}
+
+	// replicationLag counts pending replication tasks and tracks the age of the oldest one
+	replicationLag struct {
+		pendingTasks int64
+		maxLag       time.Duration
+	}
+
+	// shardReplicationStatus is the replication status of one shard towards one remote cluster
+	shardReplicationStatus struct {
+		shardID     int
+		cluster     string
+		ackLevel    int64
+		dlqMessages int64
+		lag         replicationLag
+		domainLags  map[string]*replicationLag
+	}
+)
+
+// AdminReplicationReport reads replication ack levels, pending replication tasks and DLQ sizes of all shards
+// and prints the replication lag per remote cluster together with the worst domains and shards.
+// Every pending replication task of a shard is read, so each shard gets its own long poll context.
+func AdminReplicationReport(c *cli.Context) {
+	shardManager := initializeShardManager(c)
+	getDomainName := newPersistenceDomainNameResolver(c, initializeDomainManager(c))
+
+	var statuses []*shardReplicationStatus
+	for shardID := range getShards(c) {
+		ctx, cancel := newContextForLongPoll(c)
+		shardStatuses, err := getShardReplicationStatus(ctx, c, shardManager, shardID)
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get replication status of shard %d", shardID), err)
+		}
+		statuses = append(statuses, shardStatuses...)
+	}
+
+	report := newReplicationReport(statuses, getDomainName, c.Int(FlagTopN))
+	Render(c, report, RenderOptions{DefaultTemplate: templateReplicationReport, Color: true})
+}
+
+func getShardReplicationStatus(
+	ctx context.Context,
+	c *cli.Context,
+	shardManager persistence.ShardManager,
+	shardID int,
+) ([]*shardReplicationStatus, error) {
+	shard, err := shardManager.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return nil, err
+	}
+	executionManager := initializeExecutionStore(c, shardID)
+	defer executionManager.Close()
+
+	clusters := map[string]struct{}{}
+	for cluster := range shard.ShardInfo.ClusterReplicationLevel {
+		clusters[cluster] = struct{}{}
+	}
+	for cluster := range shard.ShardInfo.ReplicationDLQAckLevel {
+		clusters[cluster] = struct{}{}
+	}
+
+	var statuses []*shardReplicationStatus
+	ackLevels := map[string]int64{}
+	for cluster := range clusters {
+		status := &shardReplicationStatus{
+			shardID:    shardID,
+			cluster:    cluster,
+			ackLevel:   shard.ShardInfo.ClusterReplicationLevel[cluster],
+			domainLags: map[string]*replicationLag{},
+		}
+		dlqSize, err := executionManager.GetReplicationDLQSize(ctx, &persistence.GetReplicationDLQSizeRequest{
+			SourceClusterName: cluster,
+		})
+		if err != nil {
+			return nil, err
+		}
+		status.dlqMessages = dlqSize.Size
+		ackLevels[cluster] = status.ackLevel
+		statuses = append(statuses, status)
+	}
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	// the lag is computed the same way as by the replication lag tracker of history shards,
+	// except that every pending task is read and domains are not filtered by their clusters
+	domainLags, err := replication.ComputeReplicationLag(ctx, executionManager, ackLevels, math.MaxInt64, 0, time.Now(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, domainLag := range domainLags {
+		for _, status := range statuses {
+			if status.cluster == domainLag.Cluster {
+				status.addDomainLag(domainLag)
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func (s *shardReplicationStatus) addDomainLag(domainLag *replication.DomainLag) {
+	lag := &replicationLag{pendingTasks: domainLag.PendingTasks, maxLag: domainLag.Lag}
+	s.lag.merge(lag)
+	if existing, ok := s.domainLags[domainLag.DomainID]; ok {
+		existing.merge(lag)
+		return
+	}
+	s.domainLags[domainLag.DomainID] = lag
+}
+
+func (l *replicationLag) merge(other *replicationLag) {
+	l.pendingTasks += other.pendingTasks
+	if other.maxLag > l.maxLag {
+		l.maxLag = other.maxLag
+	}
+}
+
+func (l *replicationLag) roundedMaxLag() time.Duration {
+	return l.maxLag.Round(time.Second)
+}
+
+// newReplicationReport aggregates the replication status of all shards,
+// domains and shards are sorted by pending tasks and only the top N are kept
+func newReplicationReport(
+	statuses []*shardReplicationStatus,
+	getDomainName func(string) string,
+	topN int,
+) *ReplicationReport {
+	report := &ReplicationReport{}
+	clusters := map[string]*ReplicationClusterRow{}
+	clusterLags := map[string]*replicationLag{}
+	worstShardLags := map[string]int64{}
+	domainLags := map[string]map[string]*replicationLag{}
+
+	for _, status := range statuses {
+		row, ok := clusters[status.cluster]
+		if !ok {
+			row = &ReplicationClusterRow{Cluster: status.cluster, WorstShardID: status.shardID}
+			clusters[status.cluster] = row
+			clusterLags[status.cluster] = &replicationLag{}
+			domainLags[status.cluster] = map[string]*replicationLag{}
+			worstShardLags[status.cluster] = -1
+		}
+		row.Shards++
+		row.DLQMessages += status.dlqMessages
+		clusterLags[status.cluster].merge(&status.lag)
+		if status.lag.pendingTasks > worstShardLags[status.cluster] {
+			worstShardLags[status.cluster] = status.lag.pendingTasks
+			row.WorstShardID = status.shardID
+		}
+		for domainID, lag := range status.domainLags {
+			domainLag, ok := domainLags[status.cluster][domainID]
+			if !ok {
+				domainLag = &replicationLag{}
+				domainLags[status.cluster][domainID] = domainLag
+			}
+			domainLag.merge(lag)
+		}
+
+		report.Shards = append(report.Shards, ReplicationShardRow{
+			ShardID:      status.shardID,
+			Cluster:      status.cluster,
+			AckLevel:     status.ackLevel,
+			PendingTasks: status.lag.pendingTasks,
+			MaxLag:       status.lag.roundedMaxLag(),
+			DLQMessages:  status.dlqMessages,
+		})
+	}
+
+	for cluster, row := range clusters {
+		row.PendingTasks = clusterLags[cluster].pendingTasks
+		row.MaxLag = clusterLags[cluster].roundedMaxLag()
+		report.Clusters = append(report.Clusters, *row)
+		for domainID, lag := range domainLags[cluster] {
+			report.Domains = append(report.Domains, ReplicationDomainRow{
+				Cluster:      cluster,
+				Domain:       getDomainName(domainID),
+				PendingTasks: lag.pendingTasks,
+				MaxLag:       lag.roundedMaxLag(),
+			})
+		}
+	}
+
+	sort.Slice(report.Clusters, func(i, j int) bool {
+		return report.Clusters[i].Cluster < report.Clusters[j].Cluster
+	})
+	sort.Slice(report.Domains, func(i, j int) bool {
+		if report.Domains[i].PendingTasks != report.Domains[j].PendingTasks {
+			return report.Domains[i].PendingTasks > report.Domains[j].PendingTasks
+		}
+		return report.Domains[i].Domain < report.Domains[j].Domain
+	})
+	sort.Slice(report.Shards, func(i, j int) bool {
+		if report.Shards[i].PendingTasks != report.Shards[j].PendingTasks {
+			return report.Shards[i].PendingTasks > report.Shards[j].PendingTasks
+		}
+		return report.Shards[i].ShardID < report.Shards[j].ShardID
+	})
+	if topN > 0 && len(report.Domains) > topN {
+		report.Domains = report.Domains[:topN]
+	}
+	if topN > 0 && len(report.Shards) > topN {
+		report.Shards = report.Shards[:topN]
+	}
+	return report
+}
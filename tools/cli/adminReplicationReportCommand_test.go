@@ -0,0 +1,83 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/service/history/replication"
+)
+
+func TestNewReplicationReport(t *testing.T) {
+	newStatus := func(shardID int, cluster string, ackLevel, dlqMessages int64) *shardReplicationStatus {
+		return &shardReplicationStatus{
+			shardID:     shardID,
+			cluster:     cluster,
+			ackLevel:    ackLevel,
+			dlqMessages: dlqMessages,
+			domainLags:  map[string]*replicationLag{},
+		}
+	}
+	domainLag := func(domainID string, cluster string, pendingTasks int64, lagSeconds int64) *replication.DomainLag {
+		return &replication.DomainLag{
+			DomainID:     domainID,
+			Cluster:      cluster,
+			PendingTasks: pendingTasks,
+			Lag:          time.Duration(lagSeconds)*time.Second + time.Millisecond,
+		}
+	}
+
+	shard1 := newStatus(1, "standby", 10, 2)
+	shard1.addDomainLag(domainLag("d1", "standby", 1, 60))
+	shard1.addDomainLag(domainLag("d2", "standby", 1, 30))
+	shard2 := newStatus(2, "standby", 20, 0)
+	shard2.addDomainLag(domainLag("d1", "standby", 2, 120))
+	shard2.addDomainLag(domainLag("d2", "standby", 1, 5))
+	shard2Other := newStatus(2, "other", 30, 1)
+	shard2Other.addDomainLag(domainLag("d2", "other", 1, 5))
+
+	names := map[string]string{"d1": "domain-1"}
+	getDomainName := func(id string) string {
+		if name, ok := names[id]; ok {
+			return name
+		}
+		return id
+	}
+	report := newReplicationReport([]*shardReplicationStatus{shard1, shard2, shard2Other}, getDomainName, 2)
+
+	assert.Equal(t, []ReplicationClusterRow{
+		{Cluster: "other", Shards: 1, PendingTasks: 1, MaxLag: 5 * time.Second, WorstShardID: 2, DLQMessages: 1},
+		{Cluster: "standby", Shards: 2, PendingTasks: 5, MaxLag: 120 * time.Second, WorstShardID: 2, DLQMessages: 2},
+	}, report.Clusters)
+	assert.Equal(t, []ReplicationDomainRow{
+		{Cluster: "standby", Domain: "domain-1", PendingTasks: 3, MaxLag: 120 * time.Second},
+		{Cluster: "standby", Domain: "d2", PendingTasks: 2, MaxLag: 30 * time.Second},
+	}, report.Domains)
+	assert.Equal(t, []ReplicationShardRow{
+		{ShardID: 2, Cluster: "standby", AckLevel: 20, PendingTasks: 3, MaxLag: 120 * time.Second},
+		{ShardID: 1, Cluster: "standby", AckLevel: 10, PendingTasks: 2, MaxLag: 60 * time.Second, DLQMessages: 2},
+	}, report.Shards)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultShardWaitTimeoutInSeconds = 60
+	shardPollInterval                = time.Second
+)
+
+// AdminReloadShard unloads a shard from the host currently owning it and waits until it is loaded again.
+// There is no shard handoff between history hosts, the shard is loaded again by whichever host owns it on
+// the membership ring, so this reloads the shard in place unless ring membership has changed.
+func AdminReloadShard(c *cli.Context) {
+	shardID := getShardIDArgument(c)
+	adminClient := cFactory.ServerAdminClient(c)
+
+	owner, err := describeShardOwner(c, adminClient, shardID)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to describe owner of shard %d", shardID), err)
+		return
+	}
+
+	fmt.Printf("Closing shard %d on %v.\n", shardID, owner.Address)
+	ctx, cancel := newContext(c)
+	err = adminClient.CloseShard(ctx, &types.CloseShardRequest{ShardID: int32(shardID)})
+	cancel()
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to close shard %d", shardID), err)
+	}
+
+	timeout := time.Duration(c.Int(FlagWaitTimeout)) * time.Second
+	start := time.Now()
+	for {
+		owner, err = describeShardOwner(c, adminClient, shardID)
+		switch {
+		case err != nil:
+			fmt.Printf("Failed to describe owner of shard %d, retrying: %v\n", shardID, err)
+		case containsShard(owner.ShardIDs, shardID):
+			fmt.Printf("Shard %d is loaded by %v after %v.\n", shardID, owner.Address, time.Since(start).Round(time.Millisecond))
+			return
+		default:
+			fmt.Printf("Waiting for shard %d to be loaded by %v (%v elapsed).\n", shardID, owner.Address, time.Since(start).Round(time.Second))
+		}
+		if time.Since(start) > timeout {
+			ErrorAndExit(fmt.Sprintf("Shard %d was not loaded within %v", shardID, timeout), nil)
+			return
+		}
+		time.Sleep(shardPollInterval)
+	}
+}
+
+// describeShardOwner describes the history host which owns the shard according to the membership ring
+func describeShardOwner(c *cli.Context, adminClient admin.Client, shardID int) (*types.DescribeHistoryHostResponse, error) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	return adminClient.DescribeHistoryHost(ctx, &types.DescribeHistoryHostRequest{
+		ShardIDForHost: common.Int32Ptr(int32(shardID)),
+	})
+}
+
+// getShardIDArgument reads the shard ID from the first argument, falling back to the shard ID flag
+func getShardIDArgument(c *cli.Context) int {
+	if c.NArg() == 0 {
+		return getRequiredIntOption(c, FlagShardID)
+	}
+	shardID, err := strconv.Atoi(c.Args().First())
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Invalid shard ID %q", c.Args().First()), err)
+	}
+	return shardID
+}
+
+func containsShard(shardIDs []int32, shardID int) bool {
+	for _, id := range shardIDs {
+		if int(id) == shardID {
+			return true
+		}
+	}
+	return false
+}
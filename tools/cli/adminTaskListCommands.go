@@ -21,15 +21,27 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
+const (
+	defaultTaskListDrainTimeoutInSeconds = 3600
+	taskListDrainPollInterval            = 5 * time.Second
+)
+
 type (
 	TaskListRow struct {
 		Name        string `header:"Task List Name"`
@@ -110,6 +122,153 @@ func AdminListTaskList(c *cli.Context) {
 	RenderTable(os.Stdout, table, RenderOptions{Color: true, Border: true})
 }
 
+// AdminDrainTaskList marks a task list as draining and waits until the backlog of all its partitions is empty
+func AdminDrainTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+
+	ctx, cancel := newContext(c)
+	err := markTaskListDraining(ctx, adminClient, domain, taskList, taskListType)
+	cancel()
+	if err != nil {
+		ErrorAndExit("Failed to mark task list as draining", err)
+	}
+	fmt.Printf("Task list %v is draining, set %v to false to undo.\n", taskList, dynamicconfig.MatchingTaskListDraining.String())
+
+	timeout := time.Duration(c.Int(FlagWaitTimeout)) * time.Second
+	start := time.Now()
+	for {
+		ctx, cancel := newContext(c)
+		backlog, err := getTaskListBacklog(ctx, frontendClient, domain, taskList, taskListType)
+		cancel()
+		switch {
+		case err != nil:
+			fmt.Printf("Failed to describe task list %v, retrying: %v\n", taskList, err)
+		case backlog == 0:
+			fmt.Printf("Backlog of task list %v is empty after %v, it is safe to decommission its workers.\n", taskList, time.Since(start).Round(time.Second))
+			return
+		default:
+			fmt.Printf("Waiting for backlog of %d tasks in task list %v (%v elapsed).\n", backlog, taskList, time.Since(start).Round(time.Second))
+		}
+		if time.Since(start) > timeout {
+			ErrorAndExit(fmt.Sprintf("Backlog of task list %v was not drained within %v", taskList, timeout), nil)
+			return
+		}
+		time.Sleep(taskListDrainPollInterval)
+	}
+}
+
+// markTaskListDraining sets the draining dynamic config for the task list, keeping values stored for other task lists
+func markTaskListDraining(
+	ctx context.Context,
+	adminClient admin.Client,
+	domain string,
+	taskList string,
+	taskListType types.TaskListType,
+) error {
+	configName := dynamicconfig.MatchingTaskListDraining.String()
+	drainingValue, err := convertFromInputValue(&cliValue{
+		Value: true,
+		Filters: []*cliFilter{
+			{Name: dynamicconfig.DomainName.String(), Value: domain},
+			{Name: dynamicconfig.TaskListName.String(), Value: taskList},
+			{Name: dynamicconfig.TaskType.String(), Value: getPersistenceTaskListType(taskListType)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	existing, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{ConfigName: configName})
+	if err != nil {
+		return err
+	}
+	values := []*types.DynamicConfigValue{drainingValue}
+	for _, entry := range existing.GetEntries() {
+		if entry.Name != configName {
+			continue
+		}
+		for _, value := range entry.Values {
+			if !equalDynamicConfigFilters(value.Filters, drainingValue.Filters) {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return adminClient.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName:   configName,
+		ConfigValues: values,
+	})
+}
+
+// getTaskListBacklog sums the backlog of all partitions of the task list
+func getTaskListBacklog(
+	ctx context.Context,
+	frontendClient frontend.Client,
+	domain string,
+	taskList string,
+	taskListType types.TaskListType,
+) (int64, error) {
+	partitions, err := frontendClient.ListTaskListPartitions(ctx, &types.ListTaskListPartitionsRequest{
+		Domain:   domain,
+		TaskList: &types.TaskList{Name: taskList},
+	})
+	if err != nil {
+		return 0, err
+	}
+	partitionKeys := []string{taskList}
+	partitionMetadata := partitions.DecisionTaskListPartitions
+	if taskListType == types.TaskListTypeActivity {
+		partitionMetadata = partitions.ActivityTaskListPartitions
+	}
+	if len(partitionMetadata) > 0 {
+		partitionKeys = partitionKeys[:0]
+		for _, partition := range partitionMetadata {
+			partitionKeys = append(partitionKeys, partition.Key)
+		}
+	}
+
+	var backlog int64
+	for _, key := range partitionKeys {
+		response, err := frontendClient.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+			Domain:                domain,
+			TaskList:              &types.TaskList{Name: key},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+		})
+		if err != nil {
+			return 0, err
+		}
+		backlog += response.GetTaskListStatus().GetBacklogCountHint()
+	}
+	return backlog, nil
+}
+
+func getPersistenceTaskListType(taskListType types.TaskListType) int {
+	if taskListType == types.TaskListTypeActivity {
+		return persistence.TaskListTypeActivity
+	}
+	return persistence.TaskListTypeDecision
+}
+
+func equalDynamicConfigFilters(a, b []*types.DynamicConfigFilter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !bytes.Equal(a[i].Value.GetData(), b[i].Value.GetData()) {
+			return false
+		}
+	}
+	return true
+}
+
 func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 	table := []TaskListStatusRow{{
 		ReadLevel: taskListStatus.GetReadLevel(),
@@ -0,0 +1,281 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	diagnoseCategoryActivity      = "activity"
+	diagnoseCategoryTimer         = "timer"
+	diagnoseCategoryChild         = "child-workflow"
+	diagnoseCategoryRequestCancel = "request-cancel"
+	diagnoseCategorySignal        = "signal-external"
+	diagnoseCategoryNextEventID   = "next-event-id"
+	diagnoseCategoryVersion       = "version-history"
+
+	diagnoseIssueMissingInMutableState = "pending in history but missing in mutable state"
+	diagnoseIssueMissingInHistory      = "pending in mutable state but not in history"
+	diagnoseIssueMismatch              = "mutable state does not match history"
+)
+
+type (
+	// DiagnoseRow is a single difference between mutable state and the state rebuilt from history
+	DiagnoseRow struct {
+		Category     string `header:"Category" json:"category"`
+		ID           string `header:"ID" json:"id"`
+		MutableState string `header:"Mutable State" json:"mutableState"`
+		History      string `header:"History" json:"history"`
+		Issue        string `header:"Issue" json:"issue"`
+	}
+
+	// rebuiltState is the subset of mutable state which can be derived from history events alone
+	rebuiltState struct {
+		activities     map[int64]struct{}
+		timers         map[string]struct{}
+		children       map[int64]struct{}
+		requestCancels map[int64]struct{}
+		signals        map[int64]struct{}
+		nextEventID    int64
+		versionHistory *persistence.VersionHistory
+	}
+)
+
+// AdminDiagnoseWorkflow compares the mutable state of a workflow run with the state rebuilt from its history
+func AdminDiagnoseWorkflow(c *cli.Context) {
+	resp := describeMutableState(c)
+	ms := persistence.WorkflowMutableState{}
+	if err := json.Unmarshal([]byte(resp.GetMutableStateInDatabase()), &ms); err != nil {
+		ErrorAndExit("Failed to decode mutable state", err)
+	}
+	if ms.ExecutionInfo == nil {
+		ErrorAndExit("Mutable state has no execution info", nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	history, err := GetHistory(ctx, cFactory.ServerFrontendClient(c), domain, ms.ExecutionInfo.WorkflowID, ms.ExecutionInfo.RunID)
+	if err != nil {
+		ErrorAndExit("Failed to get workflow history", err)
+	}
+
+	rebuilt, err := rebuildStateFromHistory(history.GetEvents())
+	if err != nil {
+		ErrorAndExit("Failed to rebuild state from history", err)
+	}
+	rows := diffMutableState(&ms, rebuilt)
+	if len(rows) == 0 {
+		fmt.Println("Mutable state is consistent with history.")
+		return
+	}
+	fmt.Printf("Found %d difference(s), mutable state may be corrupted.\n", len(rows))
+	Render(c, rows, RenderOptions{Color: true, DefaultTemplate: templateTable})
+}
+
+// rebuildStateFromHistory replays history events and tracks every pending entity
+func rebuildStateFromHistory(events []*types.HistoryEvent) (*rebuiltState, error) {
+	state := &rebuiltState{
+		activities:     make(map[int64]struct{}),
+		timers:         make(map[string]struct{}),
+		children:       make(map[int64]struct{}),
+		requestCancels: make(map[int64]struct{}),
+		signals:        make(map[int64]struct{}),
+		versionHistory: persistence.NewVersionHistory(nil, nil),
+	}
+
+	for _, event := range events {
+		if err := state.versionHistory.AddOrUpdateItem(persistence.NewVersionHistoryItem(event.ID, event.Version)); err != nil {
+			return nil, err
+		}
+		state.nextEventID = event.ID + 1
+
+		switch event.GetEventType() {
+		case types.EventTypeActivityTaskScheduled:
+			state.activities[event.ID] = struct{}{}
+		case types.EventTypeActivityTaskCompleted:
+			delete(state.activities, event.ActivityTaskCompletedEventAttributes.GetScheduledEventID())
+		case types.EventTypeActivityTaskFailed:
+			delete(state.activities, event.ActivityTaskFailedEventAttributes.GetScheduledEventID())
+		case types.EventTypeActivityTaskTimedOut:
+			delete(state.activities, event.ActivityTaskTimedOutEventAttributes.GetScheduledEventID())
+		case types.EventTypeActivityTaskCanceled:
+			delete(state.activities, event.ActivityTaskCanceledEventAttributes.GetScheduledEventID())
+		case types.EventTypeTimerStarted:
+			state.timers[event.TimerStartedEventAttributes.GetTimerID()] = struct{}{}
+		case types.EventTypeTimerFired:
+			delete(state.timers, event.TimerFiredEventAttributes.GetTimerID())
+		case types.EventTypeTimerCanceled:
+			delete(state.timers, event.TimerCanceledEventAttributes.GetTimerID())
+		case types.EventTypeStartChildWorkflowExecutionInitiated:
+			state.children[event.ID] = struct{}{}
+		case types.EventTypeStartChildWorkflowExecutionFailed:
+			delete(state.children, event.StartChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionCompleted:
+			delete(state.children, event.ChildWorkflowExecutionCompletedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionFailed:
+			delete(state.children, event.ChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionCanceled:
+			delete(state.children, event.ChildWorkflowExecutionCanceledEventAttributes.GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionTimedOut:
+			delete(state.children, event.ChildWorkflowExecutionTimedOutEventAttributes.GetInitiatedEventID())
+		case types.EventTypeChildWorkflowExecutionTerminated:
+			delete(state.children, event.ChildWorkflowExecutionTerminatedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+			state.requestCancels[event.ID] = struct{}{}
+		case types.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+			delete(state.requestCancels, event.RequestCancelExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeExternalWorkflowExecutionCancelRequested:
+			delete(state.requestCancels, event.ExternalWorkflowExecutionCancelRequestedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeSignalExternalWorkflowExecutionInitiated:
+			state.signals[event.ID] = struct{}{}
+		case types.EventTypeSignalExternalWorkflowExecutionFailed:
+			delete(state.signals, event.SignalExternalWorkflowExecutionFailedEventAttributes.GetInitiatedEventID())
+		case types.EventTypeExternalWorkflowExecutionSignaled:
+			delete(state.signals, event.ExternalWorkflowExecutionSignaledEventAttributes.GetInitiatedEventID())
+		}
+	}
+	return state, nil
+}
+
+// diffMutableState returns one row for every difference between mutable state and the rebuilt state
+func diffMutableState(ms *persistence.WorkflowMutableState, rebuilt *rebuiltState) []DiagnoseRow {
+	var rows []DiagnoseRow
+
+	activities := make(map[int64]struct{}, len(ms.ActivityInfos))
+	for id := range ms.ActivityInfos {
+		activities[id] = struct{}{}
+	}
+	rows = append(rows, diffEventIDSets(diagnoseCategoryActivity, activities, rebuilt.activities)...)
+
+	children := make(map[int64]struct{}, len(ms.ChildExecutionInfos))
+	for id := range ms.ChildExecutionInfos {
+		children[id] = struct{}{}
+	}
+	rows = append(rows, diffEventIDSets(diagnoseCategoryChild, children, rebuilt.children)...)
+
+	requestCancels := make(map[int64]struct{}, len(ms.RequestCancelInfos))
+	for id := range ms.RequestCancelInfos {
+		requestCancels[id] = struct{}{}
+	}
+	rows = append(rows, diffEventIDSets(diagnoseCategoryRequestCancel, requestCancels, rebuilt.requestCancels)...)
+
+	signals := make(map[int64]struct{}, len(ms.SignalInfos))
+	for id := range ms.SignalInfos {
+		signals[id] = struct{}{}
+	}
+	rows = append(rows, diffEventIDSets(diagnoseCategorySignal, signals, rebuilt.signals)...)
+
+	timers := make(map[string]struct{}, len(ms.TimerInfos))
+	for id := range ms.TimerInfos {
+		timers[id] = struct{}{}
+	}
+	rows = append(rows, diffStringSets(diagnoseCategoryTimer, timers, rebuilt.timers)...)
+
+	if ms.ExecutionInfo != nil && ms.ExecutionInfo.NextEventID != rebuilt.nextEventID {
+		rows = append(rows, DiagnoseRow{
+			Category:     diagnoseCategoryNextEventID,
+			MutableState: strconv.FormatInt(ms.ExecutionInfo.NextEventID, 10),
+			History:      strconv.FormatInt(rebuilt.nextEventID, 10),
+			Issue:        diagnoseIssueMismatch,
+		})
+	}
+
+	if ms.VersionHistories != nil {
+		current, err := ms.VersionHistories.GetCurrentVersionHistory()
+		msItems := ""
+		if err == nil {
+			msItems = versionHistoryItemsToString(current.Items)
+		}
+		historyItems := versionHistoryItemsToString(rebuilt.versionHistory.Items)
+		if msItems != historyItems {
+			rows = append(rows, DiagnoseRow{
+				Category:     diagnoseCategoryVersion,
+				MutableState: msItems,
+				History:      historyItems,
+				Issue:        diagnoseIssueMismatch,
+			})
+		}
+	}
+	return rows
+}
+
+func diffEventIDSets(category string, ms map[int64]struct{}, history map[int64]struct{}) []DiagnoseRow {
+	msStrings := make(map[string]struct{}, len(ms))
+	for id := range ms {
+		msStrings[strconv.FormatInt(id, 10)] = struct{}{}
+	}
+	historyStrings := make(map[string]struct{}, len(history))
+	for id := range history {
+		historyStrings[strconv.FormatInt(id, 10)] = struct{}{}
+	}
+	return diffStringSets(category, msStrings, historyStrings)
+}
+
+func diffStringSets(category string, ms map[string]struct{}, history map[string]struct{}) []DiagnoseRow {
+	var rows []DiagnoseRow
+	for id := range ms {
+		if _, ok := history[id]; !ok {
+			rows = append(rows, DiagnoseRow{
+				Category:     category,
+				ID:           id,
+				MutableState: "pending",
+				History:      "not pending",
+				Issue:        diagnoseIssueMissingInHistory,
+			})
+		}
+	}
+	for id := range history {
+		if _, ok := ms[id]; !ok {
+			rows = append(rows, DiagnoseRow{
+				Category:     category,
+				ID:           id,
+				MutableState: "not pending",
+				History:      "pending",
+				Issue:        diagnoseIssueMissingInMutableState,
+			})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].ID < rows[j].ID
+	})
+	return rows
+}
+
+func versionHistoryItemsToString(items []*persistence.VersionHistoryItem) string {
+	result := ""
+	for i, item := range items {
+		if i > 0 {
+			result += ", "
+		}
+		result += fmt.Sprintf("(%d, %d)", item.EventID, item.Version)
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestRebuildStateFromHistory(t *testing.T) {
+	events := []*types.HistoryEvent{
+		{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 2, Version: 1, EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+		{ID: 3, Version: 1, EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+		{ID: 4, Version: 1, EventType: types.EventTypeTimerStarted.Ptr(), TimerStartedEventAttributes: &types.TimerStartedEventAttributes{TimerID: "t1"}},
+		{ID: 5, Version: 2, EventType: types.EventTypeActivityTaskCompleted.Ptr(), ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{ScheduledEventID: 2}},
+		{ID: 6, Version: 2, EventType: types.EventTypeStartChildWorkflowExecutionInitiated.Ptr()},
+	}
+
+	state, err := rebuildStateFromHistory(events)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]struct{}{3: {}}, state.activities)
+	assert.Equal(t, map[string]struct{}{"t1": {}}, state.timers)
+	assert.Equal(t, map[int64]struct{}{6: {}}, state.children)
+	assert.Equal(t, int64(7), state.nextEventID)
+	assert.Equal(t, "(4, 1), (6, 2)", versionHistoryItemsToString(state.versionHistory.Items))
+}
+
+func TestDiffMutableState(t *testing.T) {
+	state := &rebuiltState{
+		activities:     map[int64]struct{}{3: {}},
+		timers:         map[string]struct{}{"t1": {}},
+		children:       map[int64]struct{}{},
+		requestCancels: map[int64]struct{}{},
+		signals:        map[int64]struct{}{},
+		nextEventID:    7,
+		versionHistory: persistence.NewVersionHistory(nil, []*persistence.VersionHistoryItem{
+			persistence.NewVersionHistoryItem(6, common.EmptyVersion),
+		}),
+	}
+
+	ms := &persistence.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistence.ActivityInfo{3: {}},
+		TimerInfos:    map[string]*persistence.TimerInfo{"t1": {}},
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: 7},
+		VersionHistories: persistence.NewVersionHistories(persistence.NewVersionHistory(nil, []*persistence.VersionHistoryItem{
+			persistence.NewVersionHistoryItem(6, common.EmptyVersion),
+		})),
+	}
+	assert.Empty(t, diffMutableState(ms, state))
+
+	ms.ActivityInfos = map[int64]*persistence.ActivityInfo{2: {}}
+	ms.ExecutionInfo.NextEventID = 8
+	rows := diffMutableState(ms, state)
+	assert.Equal(t, []DiagnoseRow{
+		{Category: diagnoseCategoryActivity, ID: "2", MutableState: "pending", History: "not pending", Issue: diagnoseIssueMissingInHistory},
+		{Category: diagnoseCategoryActivity, ID: "3", MutableState: "not pending", History: "pending", Issue: diagnoseIssueMissingInMutableState},
+		{Category: diagnoseCategoryNextEventID, MutableState: "8", History: "7", Issue: diagnoseIssueMismatch},
+	}, rows)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// workflowExportFormatVersion is the version of the export file format,
+// it has to be increased whenever a change is made which older versions cannot read
+const workflowExportFormatVersion = 1
+
+// WorkflowExport is the self-contained file format of an exported workflow run
+type WorkflowExport struct {
+	FormatVersion int                   `json:"formatVersion"`
+	ExportedAt    time.Time             `json:"exportedAt"`
+	Domain        string                `json:"domain"`
+	WorkflowID    string                `json:"workflowID"`
+	RunID         string                `json:"runID"`
+	History       []*types.HistoryEvent `json:"history"`
+	// MutableState is the mutable state as stored in the database, only present if it was requested
+	MutableState json.RawMessage `json:"mutableState,omitempty"`
+}
+
+// AdminExportWorkflow exports the complete history of a workflow run, and optionally its mutable state, to a file
+func AdminExportWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	if rid == "" {
+		resp, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain:    domain,
+			Execution: &types.WorkflowExecution{WorkflowID: wid},
+		})
+		if err != nil {
+			ErrorAndExit("Failed to describe current run of workflow", err)
+		}
+		rid = resp.WorkflowExecutionInfo.Execution.GetRunID()
+	}
+
+	history, err := GetHistory(ctx, frontendClient, domain, wid, rid)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+	}
+
+	export := WorkflowExport{
+		FormatVersion: workflowExportFormatVersion,
+		ExportedAt:    time.Now().UTC(),
+		Domain:        domain,
+		WorkflowID:    wid,
+		RunID:         rid,
+		History:       history.GetEvents(),
+	}
+	if c.Bool(FlagIncludeMutableState) {
+		resp, err := cFactory.ServerAdminClient(c).DescribeWorkflowExecution(ctx, &types.AdminDescribeWorkflowExecutionRequest{
+			Domain:    domain,
+			Execution: &types.WorkflowExecution{WorkflowID: wid, RunID: rid},
+		})
+		if err != nil {
+			ErrorAndExit("Get workflow mutableState failed", err)
+		}
+		export.MutableState = json.RawMessage(resp.GetMutableStateInDatabase())
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		ErrorAndExit("Failed to serialize workflow export.", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to write workflow export file.", err)
+	}
+	fmt.Printf("Exported %d events of workflow id: %s, run id: %s to %s.\n", len(export.History), wid, rid, outputFileName)
+}
+
+// AdminRerunWorkflow starts a new run with the input of an exported workflow and re-delivers its signals in order.
+// The exported history and mutable state are not imported: the new run re-executes its decisions and activities,
+// which makes it possible to reproduce a production run against a development worker as long as the run only
+// depends on its input and signals.
+func AdminRerunWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+
+	// This code is only used in the CLI. The input provided is from a trusted user.
+	// #nosec
+	data, err := ioutil.ReadFile(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to read workflow export file.", err)
+	}
+	export, err := decodeWorkflowExport(data)
+	if err != nil {
+		ErrorAndExit("Failed to decode workflow export file.", err)
+	}
+
+	startRequest, signals, err := newRerunRequests(export, domain)
+	if err != nil {
+		ErrorAndExit("Failed to rerun workflow.", err)
+	}
+	if c.IsSet(FlagWorkflowID) {
+		startRequest.WorkflowID = c.String(FlagWorkflowID)
+	}
+	if c.IsSet(FlagTaskList) {
+		startRequest.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	frontendClient := cFactory.ServerFrontendClient(c)
+	resp, err := frontendClient.StartWorkflowExecution(ctx, startRequest)
+	if err != nil {
+		ErrorAndExit("Failed to start new run of exported workflow.", err)
+	}
+
+	execution := &types.WorkflowExecution{WorkflowID: startRequest.WorkflowID, RunID: resp.GetRunID()}
+	for _, signal := range signals {
+		signal.WorkflowExecution = execution
+		if err := frontendClient.SignalWorkflowExecution(ctx, signal); err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to re-deliver signal %s.", signal.SignalName), err)
+		}
+	}
+	fmt.Printf("Started workflow id: %s, run id: %s again as run id: %s with %d signal(s).\n",
+		export.WorkflowID, export.RunID, resp.GetRunID(), len(signals))
+}
+
+func decodeWorkflowExport(data []byte) (*WorkflowExport, error) {
+	var export WorkflowExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, err
+	}
+	if export.FormatVersion < 1 || export.FormatVersion > workflowExportFormatVersion {
+		return nil, fmt.Errorf("unsupported export format version %d, supported versions are 1 to %d",
+			export.FormatVersion, workflowExportFormatVersion)
+	}
+	if len(export.History) == 0 {
+		return nil, fmt.Errorf("export contains no history events")
+	}
+	return &export, nil
+}
+
+// newRerunRequests builds the request starting a new run of the exported workflow,
+// followed by the requests re-delivering its signals in the order they were received
+func newRerunRequests(export *WorkflowExport, domain string) (*types.StartWorkflowExecutionRequest, []*types.SignalWorkflowExecutionRequest, error) {
+	attributes := export.History[0].WorkflowExecutionStartedEventAttributes
+	if export.History[0].GetEventType() != types.EventTypeWorkflowExecutionStarted || attributes == nil {
+		return nil, nil, fmt.Errorf("first event of the export is not %v", types.EventTypeWorkflowExecutionStarted)
+	}
+
+	startRequest := &types.StartWorkflowExecutionRequest{
+		Domain:                              domain,
+		WorkflowID:                          export.WorkflowID,
+		WorkflowType:                        attributes.WorkflowType,
+		TaskList:                            attributes.TaskList,
+		Input:                               attributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      attributes.TaskStartToCloseTimeoutSeconds,
+		Identity:                            getCliIdentity(),
+		RequestID:                           uuid.New(),
+		RetryPolicy:                         attributes.RetryPolicy,
+		CronSchedule:                        attributes.CronSchedule,
+		Memo:                                attributes.Memo,
+		SearchAttributes:                    attributes.SearchAttributes,
+		Header:                              attributes.Header,
+	}
+
+	var signals []*types.SignalWorkflowExecutionRequest
+	for _, event := range export.History {
+		if event.GetEventType() != types.EventTypeWorkflowExecutionSignaled {
+			continue
+		}
+		signals = append(signals, &types.SignalWorkflowExecutionRequest{
+			Domain:     domain,
+			SignalName: event.WorkflowExecutionSignaledEventAttributes.GetSignalName(),
+			Input:      event.WorkflowExecutionSignaledEventAttributes.Input,
+			Identity:   getCliIdentity(),
+			RequestID:  uuid.New(),
+		})
+	}
+	return startRequest, signals, nil
+}
@@ -0,0 +1,91 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestWorkflowExport_RoundTrip(t *testing.T) {
+	export := WorkflowExport{
+		FormatVersion: workflowExportFormatVersion,
+		Domain:        "source-domain",
+		WorkflowID:    "wid",
+		RunID:         "rid",
+		History: []*types.HistoryEvent{
+			{
+				ID:        1,
+				EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "task-list"},
+					Input:                               []byte("input"),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				},
+			},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+			{
+				ID:        3,
+				EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+				WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "signal",
+					Input:      []byte("signal-input"),
+				},
+			},
+		},
+		MutableState: json.RawMessage(`{"ExecutionInfo":{}}`),
+	}
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+
+	decoded, err := decodeWorkflowExport(data)
+	require.NoError(t, err)
+	assert.Equal(t, export.History, decoded.History)
+
+	startRequest, signals, err := newRerunRequests(decoded, "target-domain")
+	require.NoError(t, err)
+	assert.Equal(t, "target-domain", startRequest.Domain)
+	assert.Equal(t, "wid", startRequest.WorkflowID)
+	assert.Equal(t, "workflow-type", startRequest.WorkflowType.Name)
+	assert.Equal(t, "task-list", startRequest.TaskList.Name)
+	assert.Equal(t, []byte("input"), startRequest.Input)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "signal", signals[0].SignalName)
+	assert.Equal(t, []byte("signal-input"), signals[0].Input)
+}
+
+func TestDecodeWorkflowExport_UnsupportedVersion(t *testing.T) {
+	_, err := decodeWorkflowExport([]byte(`{"formatVersion": 2, "history": [{"eventId": 1}]}`))
+	assert.Error(t, err)
+
+	_, err = decodeWorkflowExport([]byte(`{"formatVersion": 1}`))
+	assert.Error(t, err)
+}
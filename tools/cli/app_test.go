@@ -21,6 +21,10 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -31,12 +35,14 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/failovermanager"
 )
 
 type cliAppSuite struct {
@@ -444,6 +450,27 @@ func (s *cliAppSuite) TestQueryWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestBatchQueryWorkflow() {
+	inputFile, err := ioutil.TempFile("", "batch-query")
+	s.NoError(err)
+	defer os.Remove(inputFile.Name())
+	_, err = inputFile.WriteString("wid-1\trid-1\nwid-2\n")
+	s.NoError(err)
+	s.NoError(inputFile.Close())
+
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.QueryWorkflowRequest, _ ...yarpc.CallOption) (*types.QueryWorkflowResponse, error) {
+			s.Equal("query-type-test", request.Query.QueryType)
+			if request.Execution.WorkflowID == "wid-1" {
+				s.Equal("rid-1", request.Execution.RunID)
+				return &types.QueryWorkflowResponse{QueryResult: []byte("query-result")}, nil
+			}
+			return nil, &types.EntityNotExistsError{Message: "faked error"}
+		}).Times(2)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "batch-query", "-if", inputFile.Name(), "-qt", "query-type-test"})
+	s.Nil(err)
+}
+
 var (
 	closeStatus = types.WorkflowExecutionCloseStatusCompleted
 
@@ -584,6 +611,16 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminReloadShard() {
+	s.serverAdminClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Return(&types.DescribeHistoryHostResponse{
+		Address:  "host-1",
+		ShardIDs: []int32{1},
+	}, nil).Times(2)
+	s.serverAdminClient.EXPECT().CloseShard(gomock.Any(), &types.CloseShardRequest{ShardID: 1}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "reload", "1"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
@@ -609,6 +646,37 @@ func (s *cliAppSuite) TestAdminFailover() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminFailover_Follow() {
+	runID := uuid.New()
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{RunID: runID}, nil)
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	queryResult, err := json.Marshal(failovermanager.QueryResult{
+		TotalDomains:   2,
+		Success:        1,
+		Failed:         1,
+		State:          failovermanager.WorkflowCompleted,
+		SourceCluster:  "active",
+		TargetCluster:  "standby",
+		SuccessDomains: []string{"d1"},
+		FailedDomains:  []string{"d2"},
+	})
+	s.NoError(err)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{QueryResult: queryResult}, nil)
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			Execution:   &types.WorkflowExecution{WorkflowID: failovermanager.FailoverWorkflowID, RunID: runID},
+			CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		},
+	}, nil)
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "standby"},
+		FailoverInfo:             &types.FailoverInfo{CompletedShardCount: 4},
+	}, nil)
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	err = s.app.Run([]string{"", "admin", "cl", "fo", "start", "--tc", "standby", "--sc", "active", "--follow"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -623,6 +691,45 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminRefreshWorkflowTasks() {
+	s.serverAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &types.RefreshWorkflowTasksRequest{
+		Domain:    domainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "refresh-tasks", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+
+	s.serverAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "workflow", "refresh-tasks", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDrainTaskList() {
+	otherValue, err := convertFromInputValue(&cliValue{
+		Value:   true,
+		Filters: []*cliFilter{{Name: "taskListName", Value: "other-taskList"}},
+	})
+	s.NoError(err)
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), gomock.Any()).Return(&types.ListDynamicConfigResponse{
+		Entries: []*types.DynamicConfigEntry{{Name: "matching.taskListDraining", Values: []*types.DynamicConfigValue{otherValue}}},
+	}, nil)
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDynamicConfigRequest, _ ...interface{}) error {
+			s.Equal("matching.taskListDraining", request.ConfigName)
+			s.Len(request.ConfigValues, 2)
+			s.Equal(otherValue, request.ConfigValues[1])
+			return nil
+		})
+	s.serverFrontendClient.EXPECT().ListTaskListPartitions(gomock.Any(), gomock.Any()).Return(&types.ListTaskListPartitionsResponse{
+		ActivityTaskListPartitions: []*types.TaskListPartitionMetadata{{Key: "test-taskList"}, {Key: "/__cadence_sys/test-taskList/1"}},
+	}, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(&types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{BacklogCountHint: 0},
+	}, nil).Times(2)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "drain", "-tl", "test-taskList", "-tlt", "activity"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
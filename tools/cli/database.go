@@ -181,6 +181,26 @@ func initializeDomainManager(c *cli.Context) persistence.DomainManager {
 	return domainManager
 }
 
+// newPersistenceDomainNameResolver returns a cached domain ID to name lookup, falling back to the ID if the domain cannot be read.
+// Every lookup gets its own context, so the resolver can be used during and after long scans.
+func newPersistenceDomainNameResolver(c *cli.Context, domainManager persistence.DomainManager) func(string) string {
+	domainNames := map[string]string{}
+	return func(domainID string) string {
+		if name, ok := domainNames[domainID]; ok {
+			return name
+		}
+		name := domainID
+		ctx, cancel := newContext(c)
+		resp, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: domainID})
+		cancel()
+		if err == nil {
+			name = resp.Info.Name
+		}
+		domainNames[domainID] = name
+		return name
+	}
+}
+
 var persistenceFactory client.Factory
 
 func getPersistenceFactory(c *cli.Context) client.Factory {
@@ -153,6 +153,128 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 	}
 }
 
+// CloneDomain registers a new domain with the configuration of an existing domain,
+// parameters given as flags take precedence over the copied configuration
+func (d *domainCLIImpl) CloneDomain(c *cli.Context) {
+	fromDomain := getRequiredOption(c, FlagFromDomain)
+	toDomain := getRequiredOption(c, FlagToDomain)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	source, err := d.describeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(fromDomain)})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation DescribeDomain failed.", err)
+		} else {
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", fromDomain), err)
+		}
+	}
+
+	request := newCloneDomainRequest(source, toDomain)
+	if c.IsSet(FlagDescription) {
+		request.Description = c.String(FlagDescription)
+	}
+	if c.IsSet(FlagOwnerEmail) {
+		request.OwnerEmail = c.String(FlagOwnerEmail)
+	}
+	if c.IsSet(FlagRetentionDays) {
+		request.WorkflowExecutionRetentionPeriodInDays = int32(c.Int(FlagRetentionDays))
+	}
+	if c.IsSet(FlagIsGlobalDomain) {
+		request.IsGlobalDomain, err = strconv.ParseBool(c.String(FlagIsGlobalDomain))
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagIsGlobalDomain), err)
+		}
+	}
+	if c.IsSet(FlagDomainData) {
+		for key, value := range c.Generic(FlagDomainData).(*flag.StringMap).Value() {
+			request.Data[key] = value
+		}
+	}
+	if c.IsSet(FlagActiveClusterName) {
+		request.ActiveClusterName = c.String(FlagActiveClusterName)
+	}
+	if c.IsSet(FlagClusters) {
+		request.Clusters = []*types.ClusterReplicationConfiguration{{ClusterName: c.String(FlagClusters)}}
+		for _, clusterStr := range c.Args() {
+			request.Clusters = append(request.Clusters, &types.ClusterReplicationConfiguration{
+				ClusterName: clusterStr,
+			})
+		}
+	}
+	if c.IsSet(FlagHistoryArchivalStatus) {
+		request.HistoryArchivalStatus = archivalStatus(c, FlagHistoryArchivalStatus)
+	}
+	if c.IsSet(FlagHistoryArchivalURI) {
+		request.HistoryArchivalURI = c.String(FlagHistoryArchivalURI)
+	}
+	if c.IsSet(FlagVisibilityArchivalStatus) {
+		request.VisibilityArchivalStatus = archivalStatus(c, FlagVisibilityArchivalStatus)
+	}
+	if c.IsSet(FlagVisibilityArchivalURI) {
+		request.VisibilityArchivalURI = c.String(FlagVisibilityArchivalURI)
+	}
+	request.SecurityToken = c.String(FlagSecurityToken)
+
+	if err := d.registerDomain(ctx, request); err != nil {
+		if _, ok := err.(*types.DomainAlreadyExistsError); !ok {
+			ErrorAndExit("Register Domain operation failed.", err)
+		} else {
+			ErrorAndExit(fmt.Sprintf("Domain %s already registered.", toDomain), err)
+		}
+	}
+
+	// bad binaries cannot be set on registration
+	if badBinaries := source.Configuration.GetBadBinaries(); badBinaries != nil && len(badBinaries.Binaries) > 0 {
+		_, err := d.updateDomain(ctx, &types.UpdateDomainRequest{
+			Name:          toDomain,
+			BadBinaries:   badBinaries,
+			SecurityToken: request.SecurityToken,
+		})
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Domain %s registered but copying bad binaries failed.", toDomain), err)
+		}
+	}
+	fmt.Printf("Domain %s successfully cloned from %s.\n", toDomain, fromDomain)
+}
+
+// newCloneDomainRequest creates a request registering a domain with the configuration of the source domain
+func newCloneDomainRequest(source *types.DescribeDomainResponse, name string) *types.RegisterDomainRequest {
+	info := source.GetDomainInfo()
+	config := source.Configuration
+	replicationConfig := source.ReplicationConfiguration
+
+	data := make(map[string]string, len(info.GetData()))
+	for key, value := range info.GetData() {
+		data[key] = value
+	}
+	var clusters []*types.ClusterReplicationConfiguration
+	for _, cluster := range replicationConfig.GetClusters() {
+		clusters = append(clusters, &types.ClusterReplicationConfiguration{ClusterName: cluster.GetClusterName()})
+	}
+	var historyArchivalStatus, visibilityArchivalStatus *types.ArchivalStatus
+	if config != nil {
+		historyArchivalStatus = config.HistoryArchivalStatus
+		visibilityArchivalStatus = config.VisibilityArchivalStatus
+	}
+
+	return &types.RegisterDomainRequest{
+		Name:                                   name,
+		Description:                            info.GetDescription(),
+		OwnerEmail:                             info.GetOwnerEmail(),
+		Data:                                   data,
+		WorkflowExecutionRetentionPeriodInDays: config.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             common.BoolPtr(config.GetEmitMetric()),
+		Clusters:                               clusters,
+		ActiveClusterName:                      replicationConfig.GetActiveClusterName(),
+		IsGlobalDomain:                         source.GetIsGlobalDomain(),
+		HistoryArchivalStatus:                  historyArchivalStatus,
+		HistoryArchivalURI:                     config.GetHistoryArchivalURI(),
+		VisibilityArchivalStatus:               visibilityArchivalStatus,
+		VisibilityArchivalURI:                  config.GetVisibilityArchivalURI(),
+	}
+}
+
 // UpdateDomain updates a domain
 func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 	domainName := getRequiredGlobalOption(c, FlagDomain)
@@ -0,0 +1,82 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestNewCloneDomainRequest(t *testing.T) {
+	source := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name:        "source-domain",
+			Description: "description",
+			OwnerEmail:  "owner@example.com",
+			Data:        map[string]string{"key": "value"},
+			UUID:        "source-domain-id",
+		},
+		Configuration: &types.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: 7,
+			EmitMetric:                             true,
+			HistoryArchivalStatus:                  types.ArchivalStatusEnabled.Ptr(),
+			HistoryArchivalURI:                     "file:///tmp/history",
+			VisibilityArchivalStatus:               types.ArchivalStatusDisabled.Ptr(),
+		},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "active",
+			Clusters: []*types.ClusterReplicationConfiguration{
+				{ClusterName: "active"},
+				{ClusterName: "standby"},
+			},
+		},
+		IsGlobalDomain: true,
+	}
+
+	request := newCloneDomainRequest(source, "target-domain")
+	assert.Equal(t, &types.RegisterDomainRequest{
+		Name:                                   "target-domain",
+		Description:                            "description",
+		OwnerEmail:                             "owner@example.com",
+		Data:                                   map[string]string{"key": "value"},
+		WorkflowExecutionRetentionPeriodInDays: 7,
+		EmitMetric:                             common.BoolPtr(true),
+		Clusters: []*types.ClusterReplicationConfiguration{
+			{ClusterName: "active"},
+			{ClusterName: "standby"},
+		},
+		ActiveClusterName:        "active",
+		IsGlobalDomain:           true,
+		HistoryArchivalStatus:    types.ArchivalStatusEnabled.Ptr(),
+		HistoryArchivalURI:       "file:///tmp/history",
+		VisibilityArchivalStatus: types.ArchivalStatusDisabled.Ptr(),
+	}, request)
+
+	// data is copied so that overrides do not modify the source
+	request.Data["key"] = "other"
+	assert.Equal(t, "value", source.DomainInfo.Data["key"])
+}
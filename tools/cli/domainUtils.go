@@ -201,6 +201,20 @@ var (
 		getFormatFlag(),
 	}
 
+	cloneDomainFlags = append(
+		[]cli.Flag{
+			cli.StringFlag{
+				Name:  FlagFromDomain,
+				Usage: "Name of the domain to copy the configuration from",
+			},
+			cli.StringFlag{
+				Name:  FlagToDomain,
+				Usage: "Name of the domain to register",
+			},
+		},
+		registerDomainFlags...,
+	)
+
 	adminDomainCommonFlags = getDBFlags()
 
 	adminRegisterDomainFlags = append(
@@ -213,6 +227,11 @@ var (
 		adminDomainCommonFlags...,
 	)
 
+	adminCloneDomainFlags = append(
+		cloneDomainFlags,
+		adminDomainCommonFlags...,
+	)
+
 	adminDeprecateDomainFlags = append(
 		deprecateDomainFlags,
 		adminDomainCommonFlags...,
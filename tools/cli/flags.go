@@ -291,6 +291,18 @@ const (
 	FlagTransport                         = "transport"
 	FlagTransportWithAlias                = FlagTransport + ", t"
 	FlagFormat                            = "format"
+	FlagWaitTimeout                       = "wait_timeout_seconds"
+	FlagWaitTimeoutWithAlias              = FlagWaitTimeout + ", wts"
+	FlagIncludeMutableState               = "include_mutable_state"
+	FlagIncludeMutableStateWithAlias      = FlagIncludeMutableState + ", ims"
+	FlagFromDomain                        = "from"
+	FlagToDomain                          = "to"
+	FlagTopN                              = "top"
+	FlagFollow                            = "follow"
+	FlagSampleRate                        = "sample_rate"
+	FlagBlobEncoding                      = "blob_encoding"
+	FlagBlobType                          = "blob_type"
+	FlagCompression                       = "compression"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -713,6 +725,46 @@ func getFlagsForQuery() []cli.Flag {
 	}
 }
 
+func getFlagsForBatchQuery() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagListQueryWithAlias,
+			Usage: "Visibility query to get workflows to query",
+		},
+		cli.StringFlag{
+			Name:  FlagInputFileWithAlias,
+			Usage: "Input file with one workflow per line of WorkflowID and RunID. RunID is optional, default to current runID if not specified.",
+		},
+		cli.StringFlag{
+			Name:  FlagInputSeparator,
+			Value: "\t",
+			Usage: "Separator for input file(default to tab)",
+		},
+		cli.StringFlag{
+			Name:  FlagQueryTypeWithAlias,
+			Usage: "The query type you want to run",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the query, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name:  FlagQueryRejectConditionWithAlias,
+			Usage: "Optional flag to reject queries based on workflow state. Valid values are \"not_open\" and \"not_completed_cleanly\"",
+		},
+		cli.StringFlag{
+			Name:  FlagQueryConsistencyLevelWithAlias,
+			Usage: "Optional flag to set query consistency level. Valid values are \"eventual\" and \"strong\"",
+		},
+		cli.IntFlag{
+			Name:  FlagParallism,
+			Value: 10,
+			Usage: "Number of queries to run in parallel",
+		},
+		getFormatFlag(),
+	}
+}
+
 // all flags of query except QueryType
 func getFlagsForStack() []cli.Flag {
 	flags := getFlagsForQuery()
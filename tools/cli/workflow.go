@@ -160,6 +160,16 @@ func newWorkflowCommands() []cli.Command {
 				QueryWorkflow(c)
 			},
 		},
+		{
+			Name:        "batch-query",
+			Aliases:     []string{"bq"},
+			Usage:       "query workflow executions from a visibility query or an input file",
+			Description: "queries are sent with bounded concurrency and results are aggregated into a table or JSON",
+			Flags:       getFlagsForBatchQuery(),
+			Action: func(c *cli.Context) {
+				BatchQueryWorkflow(c)
+			},
+		},
 		{
 			Name:  "stack",
 			Usage: "query workflow execution with __stack_trace as query type",
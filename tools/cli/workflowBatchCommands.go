@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -281,3 +282,139 @@ func validateBatchType(bt string) bool {
 	}
 	return false
 }
+
+// BatchQueryRow is the result of querying a single workflow as part of a batch query
+type BatchQueryRow struct {
+	WorkflowID string `header:"Workflow ID" json:"workflowID"`
+	RunID      string `header:"Run ID" json:"runID"`
+	Status     string `header:"Status" json:"status"`
+	Result     string `header:"Result" maxLength:"64" json:"result,omitempty"`
+	Error      string `header:"Error" json:"error,omitempty"`
+}
+
+const (
+	batchQueryStatusSucceeded = "succeeded"
+	batchQueryStatusRejected  = "rejected"
+	batchQueryStatusFailed    = "failed"
+)
+
+// BatchQueryWorkflow queries all workflows from a visibility query or an input file with bounded concurrency
+// and aggregates the results
+func BatchQueryWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	queryType := getRequiredOption(c, FlagQueryType)
+	input := c.String(FlagInput)
+	parallel := c.Int(FlagParallism)
+	if parallel <= 0 {
+		ErrorAndExit(fmt.Sprintf("%v must be positive", FlagParallism), nil)
+	}
+
+	var executions []types.WorkflowExecution
+	switch {
+	case c.IsSet(FlagInputFile) && c.IsSet(FlagListQuery):
+		ErrorAndExit("Only one of input file and list query is allowed", nil)
+	case c.IsSet(FlagInputFile):
+		executions = loadWorkflowExecutionsFromFile(c.String(FlagInputFile), c.String(FlagInputSeparator))
+	case c.IsSet(FlagListQuery):
+		executions = getAllWorkflowExecutionsByQuery(c, c.String(FlagListQuery))
+	default:
+		ErrorAndExit("Must provide input file or list query to get target workflows to query", nil)
+	}
+
+	serviceClient := cFactory.ServerFrontendClient(c)
+	rows := make([]BatchQueryRow, len(executions))
+	indexes := make(chan int)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				execution := executions[idx]
+				row := BatchQueryRow{WorkflowID: execution.WorkflowID, RunID: execution.RunID}
+
+				ctx, cancel := newContext(c)
+				resp, err := serviceClient.QueryWorkflow(ctx, newQueryWorkflowRequest(c, domain, execution, queryType, input))
+				cancel()
+				switch {
+				case err != nil:
+					row.Status = batchQueryStatusFailed
+					row.Error = err.Error()
+				case resp.QueryRejected != nil:
+					row.Status = batchQueryStatusRejected
+					row.Error = fmt.Sprintf("workflow is in state: %v", resp.QueryRejected.CloseStatus)
+				default:
+					row.Status = batchQueryStatusSucceeded
+					row.Result = string(resp.QueryResult)
+				}
+				rows[idx] = row
+			}
+		}()
+	}
+	for idx := range executions {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+
+	failed := 0
+	for _, row := range rows {
+		if row.Status != batchQueryStatusSucceeded {
+			failed++
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Queried %d workflow(s), %d failed or rejected.\n", len(rows), failed)
+	Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// loadWorkflowExecutionsFromFile reads one workflow per line of WorkflowID and optional RunID
+func loadWorkflowExecutionsFromFile(fileName, separator string) []types.WorkflowExecution {
+	// This code is only used in the CLI. The input provided is from a trusted user.
+	// #nosec
+	file, err := os.Open(fileName)
+	if err != nil {
+		ErrorAndExit("Open failed", err)
+	}
+	defer file.Close()
+
+	var executions []types.WorkflowExecution
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+		cols := strings.Split(line, separator)
+		execution := types.WorkflowExecution{WorkflowID: strings.TrimSpace(cols[0])}
+		if len(cols) > 1 {
+			execution.RunID = strings.TrimSpace(cols[1])
+		}
+		executions = append(executions, execution)
+	}
+	if err := scanner.Err(); err != nil {
+		ErrorAndExit("Failed to read input file", err)
+	}
+	return executions
+}
+
+func getAllWorkflowExecutionsByQuery(c *cli.Context, query string) []types.WorkflowExecution {
+	wfClient := getWorkflowClient(c)
+	pageSize := 1000
+	var nextPageToken []byte
+	var info []*types.WorkflowExecutionInfo
+	var executions []types.WorkflowExecution
+	for {
+		info, nextPageToken = scanWorkflowExecutions(wfClient, pageSize, nextPageToken, query, c)
+		for _, we := range info {
+			executions = append(executions, types.WorkflowExecution{
+				WorkflowID: we.Execution.GetWorkflowID(),
+				RunID:      we.Execution.GetRunID(),
+			})
+		}
+
+		if nextPageToken == nil {
+			break
+		}
+	}
+	return executions
+}
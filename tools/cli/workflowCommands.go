@@ -605,12 +605,31 @@ func queryWorkflowHelper(c *cli.Context, queryType string) {
 
 	tcCtx, cancel := newContext(c)
 	defer cancel()
+	queryRequest := newQueryWorkflowRequest(c, domain, types.WorkflowExecution{WorkflowID: wid, RunID: rid}, queryType, input)
+	queryResponse, err := serviceClient.QueryWorkflow(tcCtx, queryRequest)
+	if err != nil {
+		ErrorAndExit("Query workflow failed.", err)
+		return
+	}
+
+	if queryResponse.QueryRejected != nil {
+		fmt.Printf("Query was rejected, workflow is in state: %v\n", *queryResponse.QueryRejected.CloseStatus)
+	} else {
+		// assume it is json encoded
+		fmt.Print(string(queryResponse.QueryResult))
+	}
+}
+
+func newQueryWorkflowRequest(
+	c *cli.Context,
+	domain string,
+	execution types.WorkflowExecution,
+	queryType string,
+	input string,
+) *types.QueryWorkflowRequest {
 	queryRequest := &types.QueryWorkflowRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wid,
-			RunID:      rid,
-		},
+		Domain:    domain,
+		Execution: &execution,
 		Query: &types.WorkflowQuery{
 			QueryType: queryType,
 		},
@@ -642,18 +661,7 @@ func queryWorkflowHelper(c *cli.Context, queryType string) {
 		}
 		queryRequest.QueryConsistencyLevel = &consistencyLevel
 	}
-	queryResponse, err := serviceClient.QueryWorkflow(tcCtx, queryRequest)
-	if err != nil {
-		ErrorAndExit("Query workflow failed.", err)
-		return
-	}
-
-	if queryResponse.QueryRejected != nil {
-		fmt.Printf("Query was rejected, workflow is in state: %v\n", *queryResponse.QueryRejected.CloseStatus)
-	} else {
-		// assume it is json encoded
-		fmt.Print(string(queryResponse.QueryResult))
-	}
+	return queryRequest
 }
 
 // ListWorkflow list workflow executions based on filters